@@ -0,0 +1,331 @@
+package commands
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/thomas-maurice/nis/internal/application/services"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+	"github.com/thomas-maurice/nis/internal/infrastructure/natsserver"
+	"github.com/thomas-maurice/nis/internal/infrastructure/persistence"
+)
+
+const (
+	devOperatorName = "dev"
+	devClusterName  = "dev"
+	devAccountName  = "demo"
+	devUserName     = "demo"
+	devSecretsFile  = "dev-secrets.json"
+)
+
+var devCmd = &cobra.Command{
+	Use:   "dev",
+	Short: "Run NIS with an embedded NATS server for local development",
+	Long: `Run NIS with an embedded NATS server for local development.
+
+Everything lives in the --dir directory: a SQLite database, generated
+secrets, the NATS resolver and JetStream store, and a demo.creds file.
+On the first run the command creates an admin API user, an operator with
+its system account, a demo account and user, and registers the embedded
+server as a cluster. Later runs reuse the same state.
+
+Like serve, it must run from the directory holding the migrations.
+
+Not for production use: the secrets are stored in plain text next to the
+database.`,
+	RunE: runDev,
+}
+
+func init() {
+	rootCmd.AddCommand(devCmd)
+
+	devCmd.Flags().String("dir", ".nis-dev", "directory holding the development state")
+	devCmd.Flags().String("address", "127.0.0.1:8080", "gRPC server listen address")
+	devCmd.Flags().String("nats-host", "127.0.0.1", "embedded NATS server listen host")
+	devCmd.Flags().Int("nats-port", 4222, "embedded NATS server client port")
+	devCmd.Flags().Int("nats-http-port", 8222, "embedded NATS server monitoring port (0 disables it)")
+	devCmd.Flags().Bool("nats-logs", false, "log the embedded NATS server output")
+	devCmd.Flags().String("admin-username", "admin", "username of the bootstrapped admin API user")
+}
+
+// devSecrets are generated on the first run and reused afterwards, so the database
+// stays readable across restarts
+type devSecrets struct {
+	EncryptionKey string `json:"encryption_key"`
+	JWTSecret     string `json:"jwt_secret"`
+	AdminPassword string `json:"admin_password"`
+}
+
+func runDev(cmd *cobra.Command, args []string) error {
+	dir, _ := cmd.Flags().GetString("dir")
+	address, _ := cmd.Flags().GetString("address")
+	natsHost, _ := cmd.Flags().GetString("nats-host")
+	natsPort, _ := cmd.Flags().GetInt("nats-port")
+	natsHTTPPort, _ := cmd.Flags().GetInt("nats-http-port")
+	natsLogs, _ := cmd.Flags().GetBool("nats-logs")
+	adminUsername, _ := cmd.Flags().GetString("admin-username")
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	secrets, err := loadDevSecrets(filepath.Join(dir, devSecretsFile))
+	if err != nil {
+		return err
+	}
+
+	// The development settings win over any config file or environment
+	viper.Set("server.address", address)
+	viper.Set("database.driver", "sqlite")
+	viper.Set("database.dsn", filepath.Join(dir, "nis.db"))
+	viper.Set("database.auto_migrate", true)
+	viper.Set("encryption.keys", []any{})
+	viper.Set("encryption.key", secrets.EncryptionKey)
+	viper.Set("encryption.key_id", "dev")
+	viper.Set("auth.jwt_secret", secrets.JWTSecret)
+	viper.Set("leader_election.mode", services.LeaderModeSingle)
+
+	ctx := context.Background()
+	operator, sysAccount, err := bootstrapDev(ctx, adminUsername, secrets.AdminPassword)
+	if err != nil {
+		return err
+	}
+
+	natsServer, err := natsserver.Start(natsserver.Config{
+		ServerName:       "nis-dev",
+		Host:             natsHost,
+		Port:             natsPort,
+		HTTPPort:         natsHTTPPort,
+		OperatorJWT:      operator.JWT,
+		SystemAccountJWT: sysAccount.JWT,
+		DataDir:          filepath.Join(dir, "nats"),
+		Logs:             natsLogs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to start embedded NATS server: %w", err)
+	}
+	defer natsServer.Shutdown()
+
+	credsPath := filepath.Join(dir, devUserName+".creds")
+	if err := registerDevCluster(ctx, operator, natsServer.ClientURL(), credsPath); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ NIS development environment ready\n")
+	fmt.Printf("  API:         http://%s\n", displayAddress(address))
+	fmt.Printf("  Login:       %s / %s\n", adminUsername, secrets.AdminPassword)
+	fmt.Printf("  Operator:    %s\n", operator.Name)
+	fmt.Printf("  Cluster:     %s (%s)\n", devClusterName, natsServer.ClientURL())
+	if monitorURL := natsServer.MonitorURL(); monitorURL != "" {
+		fmt.Printf("  Monitoring:  %s\n", monitorURL)
+	}
+	fmt.Printf("  Demo creds:  %s\n", credsPath)
+	fmt.Printf("\n  nats --server %s --creds %s pub demo.hello world\n\n", natsServer.ClientURL(), credsPath)
+
+	return runServe(cmd, args)
+}
+
+// loadDevSecrets reads the development secrets, generating them on the first run
+func loadDevSecrets(path string) (*devSecrets, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		var secrets devSecrets
+		if err := json.Unmarshal(data, &secrets); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		return &secrets, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	// The encryption key must be exactly 32 bytes, 16 random bytes hex encoded
+	secrets := &devSecrets{}
+	for _, secret := range []struct {
+		dst  *string
+		size int
+	}{
+		{&secrets.EncryptionKey, 16},
+		{&secrets.JWTSecret, 32},
+		{&secrets.AdminPassword, 12},
+	} {
+		buf := make([]byte, secret.size)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, fmt.Errorf("failed to generate secret: %w", err)
+		}
+		*secret.dst = hex.EncodeToString(buf)
+	}
+
+	data, err = json.MarshalIndent(secrets, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return secrets, nil
+}
+
+// openDevServices connects to the development database and wires the services on it
+func openDevServices(ctx context.Context) (persistence.RepositoryFactory, *coreServices, error) {
+	repoFactory, err := createRepositoryFactory()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := repoFactory.Connect(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	encryptor, err := initEncryptionService()
+	if err != nil {
+		_ = repoFactory.Close()
+		return nil, nil, fmt.Errorf("failed to initialize encryption: %w", err)
+	}
+	return repoFactory, newCoreServices(repoFactory, encryptor), nil
+}
+
+// bootstrapDev migrates the database and creates the admin API user and the
+// operator unless they exist. It returns the operator and its system account.
+func bootstrapDev(ctx context.Context, adminUsername, adminPassword string) (*entities.Operator, *entities.Account, error) {
+	repoFactory, core, err := openDevServices(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = repoFactory.Close() }()
+
+	if err := repoFactory.Migrate(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to run migrations: %w", err)
+	}
+
+	_, err = repoFactory.APIUserRepository().GetByUsername(ctx, adminUsername)
+	if errors.Is(err, repositories.ErrNotFound) {
+		authService := services.NewAuthService(repoFactory.APIUserRepository(), viper.GetString("auth.jwt_secret"), 0)
+		_, err = authService.CreateAPIUser(ctx, services.CreateAPIUserRequest{
+			Username: adminUsername,
+			Password: adminPassword,
+			Role:     entities.RoleAdmin,
+		}, &entities.APIUser{Role: entities.RoleAdmin})
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to bootstrap admin user: %w", err)
+	}
+
+	operator, err := core.operators.GetOperatorByName(ctx, devOperatorName)
+	if errors.Is(err, repositories.ErrNotFound) {
+		operator, err = core.operators.CreateOperator(ctx, services.CreateOperatorRequest{
+			Name:        devOperatorName,
+			Description: "Operator of the nis dev environment",
+		})
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to bootstrap operator: %w", err)
+	}
+
+	sysAccount, err := core.accounts.GetAccountByPublicKey(ctx, operator.SystemAccountPubKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get system account: %w", err)
+	}
+	return operator, sysAccount, nil
+}
+
+// registerDevCluster registers the embedded server as a cluster, creates the demo
+// account and user, pushes the accounts to the server and writes the demo
+// credentials to credsPath
+func registerDevCluster(ctx context.Context, operator *entities.Operator, natsURL, credsPath string) error {
+	repoFactory, core, err := openDevServices(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = repoFactory.Close() }()
+	defer core.clusters.Close()
+
+	cluster, err := core.clusters.GetClusterByName(ctx, devClusterName)
+	switch {
+	case errors.Is(err, repositories.ErrNotFound):
+		cluster, err = core.clusters.CreateCluster(ctx, services.CreateClusterRequest{
+			Name:        devClusterName,
+			Description: "Embedded NATS server of the nis dev environment",
+			ServerURLs:  []string{natsURL},
+			OperatorID:  operator.ID,
+		})
+	case err == nil && !slices.Equal(cluster.ServerURLs, []string{natsURL}):
+		// The port may change between runs
+		cluster, err = core.clusters.UpdateCluster(ctx, cluster.ID, services.UpdateClusterRequest{
+			ServerURLs: []string{natsURL},
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to register dev cluster: %w", err)
+	}
+
+	account, err := core.accounts.GetAccountByName(ctx, operator.ID, devAccountName)
+	if errors.Is(err, repositories.ErrNotFound) {
+		account, err = core.accounts.CreateAccount(ctx, services.CreateAccountRequest{
+			OperatorID:            operator.ID,
+			Name:                  devAccountName,
+			Description:           "Demo account of the nis dev environment",
+			JetStreamEnabled:      true,
+			JetStreamMaxMemory:    256 << 20,
+			JetStreamMaxStorage:   1 << 30,
+			JetStreamMaxStreams:   -1,
+			JetStreamMaxConsumers: -1,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to bootstrap demo account: %w", err)
+	}
+
+	user, err := core.users.GetUserByName(ctx, account.ID, devUserName)
+	if errors.Is(err, repositories.ErrNotFound) {
+		user, err = core.users.CreateUser(ctx, services.CreateUserRequest{
+			AccountID:   account.ID,
+			Name:        devUserName,
+			Description: "Demo user of the nis dev environment",
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to bootstrap demo user: %w", err)
+	}
+
+	result, err := core.clusters.SyncCluster(ctx, cluster.ID, false)
+	if err != nil {
+		return fmt.Errorf("failed to sync dev cluster: %w", err)
+	}
+	for _, syncErr := range result.Errors {
+		logging.LogFromContext(ctx).Warn("failed to push account to dev cluster",
+			"account", syncErr.AccountName, "error", syncErr.Error)
+	}
+
+	creds, err := core.users.GetUserCredentials(ctx, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get demo credentials: %w", err)
+	}
+	if err := os.WriteFile(credsPath, []byte(creds), 0o600); err != nil {
+		return fmt.Errorf("failed to write %s: %w", credsPath, err)
+	}
+	return nil
+}
+
+// displayAddress turns a listen address into one a browser can open
+func displayAddress(address string) string {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
@@ -34,6 +34,12 @@ func serverVersion() string {
 	return "dev"
 }
 
+// healthCheckTick is how often the health loop looks for clusters due for a check
+const healthCheckTick = 10 * time.Second
+
+// jetStreamUsageInterval is how often the account JetStream usage gauges are refreshed
+const jetStreamUsageInterval = 60 * time.Second
+
 var serveCmd = &cobra.Command{
 	Use:   "serve",
 	Short: "Start the NATS Identity Service gRPC server",
@@ -58,6 +64,16 @@ func init() {
 	serveCmd.Flags().Duration("jwt-ttl", 24*time.Hour, "JWT token TTL")
 	serveCmd.Flags().Bool("auto-migrate", true, "automatically run database migrations on startup")
 	serveCmd.Flags().Bool("enable-ui", true, "enable web UI")
+	serveCmd.Flags().Int("sync-concurrency", services.DefaultSyncConcurrency, "number of account JWTs pushed in parallel during cluster sync")
+	serveCmd.Flags().Duration("sync-timeout", services.DefaultSyncTimeout, "deadline for a whole cluster sync run")
+	serveCmd.Flags().String("leader-election", "auto", "leader election for periodic tasks: auto (lease on postgres, single on sqlite), lease or single")
+	serveCmd.Flags().Duration("leader-lease-ttl", services.DefaultLeaderLeaseTTL, "how long a leader lease lasts without renewal")
+	serveCmd.Flags().Duration("health-check-retention", services.DefaultHealthCheckRetention, "how long cluster health check history is kept")
+	serveCmd.Flags().Duration("auth-failure-retention", services.DefaultAuthFailureRetention, "how long the NATS authentication failure log is kept")
+	serveCmd.Flags().Duration("account-stats-retention", services.DefaultAccountStatsRetention, "how long the hourly account traffic statistics are kept")
+	serveCmd.Flags().String("jetstream-overcommit", "warn", "account JetStream limits exceeding cluster capacity: warn or deny")
+	serveCmd.Flags().Duration("rotation-interval", services.DefaultRotationScheduleInterval, "how often the users due for a scheduled credential rotation are rotated")
+	serveCmd.Flags().String("rotation-webhook", "", "URL notified with a JSON POST when a scheduled rotation makes new user credentials available")
 
 	// Observability flags. Prometheus /metrics is on by default and zero-cost
 	// when nothing scrapes it. OTel tracing is off by default — turning it on
@@ -79,6 +95,16 @@ func init() {
 	_ = viper.BindPFlag("auth.jwt_ttl", serveCmd.Flags().Lookup("jwt-ttl"))
 	_ = viper.BindPFlag("database.auto_migrate", serveCmd.Flags().Lookup("auto-migrate"))
 	_ = viper.BindPFlag("server.enable_ui", serveCmd.Flags().Lookup("enable-ui"))
+	_ = viper.BindPFlag("cluster.sync_concurrency", serveCmd.Flags().Lookup("sync-concurrency"))
+	_ = viper.BindPFlag("cluster.sync_timeout", serveCmd.Flags().Lookup("sync-timeout"))
+	_ = viper.BindPFlag("leader_election.mode", serveCmd.Flags().Lookup("leader-election"))
+	_ = viper.BindPFlag("leader_election.lease_ttl", serveCmd.Flags().Lookup("leader-lease-ttl"))
+	_ = viper.BindPFlag("cluster.health_check_retention", serveCmd.Flags().Lookup("health-check-retention"))
+	_ = viper.BindPFlag("cluster.auth_failure_retention", serveCmd.Flags().Lookup("auth-failure-retention"))
+	_ = viper.BindPFlag("cluster.account_stats_retention", serveCmd.Flags().Lookup("account-stats-retention"))
+	_ = viper.BindPFlag("cluster.jetstream_overcommit", serveCmd.Flags().Lookup("jetstream-overcommit"))
+	_ = viper.BindPFlag("rotation.interval", serveCmd.Flags().Lookup("rotation-interval"))
+	_ = viper.BindPFlag("rotation.webhook_url", serveCmd.Flags().Lookup("rotation-webhook"))
 	_ = viper.BindPFlag("metrics.enabled", serveCmd.Flags().Lookup("metrics-enabled"))
 	_ = viper.BindPFlag("tracing.enabled", serveCmd.Flags().Lookup("tracing-enabled"))
 	_ = viper.BindPFlag("tracing.endpoint", serveCmd.Flags().Lookup("tracing-endpoint"))
@@ -166,15 +192,33 @@ func runServe(cmd *cobra.Command, args []string) error {
 		_ = tracingShutdown(shutdownCtx)
 	}()
 
+	// Elect the replica that runs the periodic tasks. SQLite deployments are
+	// single-replica by nature, so they skip the lease unless asked otherwise.
+	leader, err := newLeaderElector(dbDriver, repoFactory)
+	if err != nil {
+		return err
+	}
+	logger.Info("leader election configured", "mode", leader.Mode(), "instance", leader.ID())
+
 	// Register domain gauges and start the periodic refresh. Inventory queries
 	// cost a handful of COUNT(*); running them at 60s cadence stays well below
 	// Prometheus' typical scrape interval without paying per-scrape.
-	var domainGauges *metrics.DomainGauges
+	var (
+		domainGauges    *metrics.DomainGauges
+		jetStreamGauges *metrics.JetStreamGauges
+	)
 	if metricsProvider != nil {
 		domainGauges, err = metrics.RegisterDomainGauges(repoFactory)
 		if err != nil {
 			return fmt.Errorf("failed to register domain gauges: %w", err)
 		}
+		jetStreamGauges, err = metrics.RegisterJetStreamGauges()
+		if err != nil {
+			return fmt.Errorf("failed to register JetStream gauges: %w", err)
+		}
+		if err := metrics.RegisterLeaderGauge(leader.ID(), leader.IsLeader); err != nil {
+			return fmt.Errorf("failed to register leader gauge: %w", err)
+		}
 	}
 
 	// Initialize Casbin enforcer
@@ -183,54 +227,40 @@ func runServe(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to initialize Casbin: %w", err)
 	}
 
-	// Initialize JWT service
-	jwtService := services.NewJWTService(encryptor)
-
 	// Initialize business services using repository factory
-	// Note: accountService must be created before operatorService because
-	// operator creation uses accountService to create the $SYS account
-	accountService := services.NewAccountService(
-		repoFactory.AccountRepository(),
-		repoFactory.OperatorRepository(),
-		repoFactory.ScopedSigningKeyRepository(),
-		jwtService,
-		encryptor,
-	)
-
-	operatorService := services.NewOperatorService(
-		repoFactory.OperatorRepository(),
-		repoFactory.AccountRepository(),
-		repoFactory.UserRepository(),
-		accountService,
-		jwtService,
-		encryptor,
-	)
-
-	userService := services.NewUserService(
-		repoFactory.UserRepository(),
-		repoFactory.AccountRepository(),
-		repoFactory.ScopedSigningKeyRepository(),
-		jwtService,
-		encryptor,
-	)
-
-	scopedKeyService := services.NewScopedSigningKeyService(
-		repoFactory.ScopedSigningKeyRepository(),
-		repoFactory.AccountRepository(),
-		repoFactory.OperatorRepository(),
-		jwtService,
-		encryptor,
+	core := newCoreServices(repoFactory, encryptor)
+	accountService := core.accounts
+	operatorService := core.operators
+	userService := core.users
+	scopedKeyService := core.scopedKeys
+	clusterService := core.clusters
+	clusterService.SetLogger(logger)
+	clusterService.SetSyncLimits(
+		viper.GetInt("cluster.sync_concurrency"),
+		viper.GetDuration("cluster.sync_timeout"),
 	)
+	clusterService.SetHealthCheckRetention(viper.GetDuration("cluster.health_check_retention"))
+	clusterService.SetAuthFailureRetention(viper.GetDuration("cluster.auth_failure_retention"))
+	clusterService.SetAccountStatsRetention(viper.GetDuration("cluster.account_stats_retention"))
+	if webhookURL := viper.GetString("rotation.webhook_url"); webhookURL != "" {
+		userService.SetRotationNotifier(services.NewWebhookRotationNotifier(webhookURL, services.DefaultRotationWebhookTimeout))
+	}
+	defer clusterService.Close()
+	if metricsProvider != nil {
+		if err := metrics.RegisterNATSConnectionGauge(clusterService.ConnectionStates); err != nil {
+			return fmt.Errorf("failed to register NATS connection gauge: %w", err)
+		}
+	}
 
-	clusterService := services.NewClusterService(
-		repoFactory.ClusterRepository(),
-		repoFactory.OperatorRepository(),
-		repoFactory.AccountRepository(),
-		repoFactory.UserRepository(),
-		repoFactory.ScopedSigningKeyRepository(),
-		encryptor,
-		jwtService,
-	)
+	// Check account JetStream limits against the capacity recorded by the health checks
+	switch overcommit := viper.GetString("cluster.jetstream_overcommit"); overcommit {
+	case "", "warn":
+		accountService.SetJetStreamCapacityChecker(clusterService, false)
+	case "deny":
+		accountService.SetJetStreamCapacityChecker(clusterService, true)
+	default:
+		return fmt.Errorf("invalid JetStream overcommit policy %q (expected warn or deny)", overcommit)
+	}
 
 	authService := services.NewAuthService(
 		repoFactory.APIUserRepository(),
@@ -244,6 +274,7 @@ func runServe(cmd *cobra.Command, args []string) error {
 		repoFactory.UserRepository(),
 		repoFactory.ScopedSigningKeyRepository(),
 		repoFactory.ClusterRepository(),
+		repoFactory.UserKeyRepository(),
 		operatorService,
 		accountService,
 		userService,
@@ -271,6 +302,7 @@ func runServe(cmd *cobra.Command, args []string) error {
 			RepoFactory:     repoFactory,
 			Encryptor:       encryptor,
 			MetricsProvider: metricsProvider,
+			Leader:          leader,
 		},
 		operatorService,
 		accountService,
@@ -293,39 +325,99 @@ func runServe(cmd *cobra.Command, args []string) error {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
-	// Start cluster health check goroutine
+	// Compete for leadership until shutdown, then hand the lease over
+	leaderDone := make(chan struct{})
 	go func() {
-		ticker := time.NewTicker(60 * time.Second)
+		defer close(leaderDone)
+		leader.Run(ctx)
+	}()
+
+	// Start cluster health check goroutine. Each cluster has its own interval, so the
+	// loop only ticks often enough to pick up the clusters that are due. Only the
+	// leader runs the checks, so replicas do not race on the cluster rows.
+	go func() {
+		ticker := time.NewTicker(healthCheckTick)
 		defer ticker.Stop()
 
+		checkHealth := func() {
+			if !leader.IsLeader() {
+				return
+			}
+			if err := clusterService.CheckAllClustersHealth(ctx); err != nil {
+				logger.Error("health check error", "error", err)
+			}
+		}
+
 		// Do an initial health check after 5 seconds
 		time.Sleep(5 * time.Second)
-		if err := clusterService.CheckAllClustersHealth(ctx); err != nil {
-			logger.Error("health check error", "error", err)
-		}
+		checkHealth()
 
 		for {
 			select {
 			case <-ticker.C:
-				if err := clusterService.CheckAllClustersHealth(ctx); err != nil {
-					logger.Error("health check error", "error", err)
-				}
+				checkHealth()
 			case <-ctx.Done():
 				return
 			}
 		}
 	}()
 
-	// Start domain gauge refresh loop. Single goroutine, 60s cadence.
+	// Start domain gauge refresh loop. Single goroutine, 60s cadence, leader only.
 	if domainGauges != nil {
-		go domainGauges.RefreshLoop(ctx, 60*time.Second)
+		go domainGauges.RefreshLoop(ctx, 60*time.Second, leader.IsLeader)
+	}
+
+	// Start the account JetStream usage loop. One JSZ request per cluster, leader only;
+	// followers publish no samples so the gauges are not duplicated across replicas.
+	if jetStreamGauges != nil {
+		go func() {
+			ticker := time.NewTicker(jetStreamUsageInterval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ticker.C:
+					if !leader.IsLeader() {
+						jetStreamGauges.Store(nil)
+						continue
+					}
+					usage, err := clusterService.CollectJetStreamUsage(ctx)
+					if err != nil {
+						logger.Error("JetStream usage collection error", "error", err)
+						continue
+					}
+					jetStreamGauges.Store(jetStreamSamples(usage))
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
 	}
 
+	// Record the users' last connections from the clusters' connect/disconnect events,
+	// and their authentication failures. Only the leader subscribes, so each event is
+	// written once.
+	eventMonitor := services.NewClusterEventMonitor(clusterService)
+	go eventMonitor.Run(ctx, services.DefaultEventReconcileInterval, leader.IsLeader)
+
+	// Record the accounts' traffic from one STATZ round per cluster, leader only
+	statsCollector := services.NewAccountStatsCollector(clusterService)
+	go statsCollector.Run(ctx, services.DefaultAccountStatsInterval, leader.IsLeader)
+
+	// Revoke the rotated user keys whose grace period ended, leader only
+	go userService.RunUserKeyRevocations(ctx, services.DefaultUserKeyRevocationInterval, leader.IsLeader)
+
+	// Stop trusting the rotated scoped signing keys whose grace period ended, leader only
+	go scopedKeyService.RunSigningKeyRetirements(ctx, services.DefaultSigningKeyRetirementInterval, leader.IsLeader)
+
+	// Rotate the users due for a scheduled credential rotation, leader only
+	go userService.RunScheduledRotations(ctx, viper.GetDuration("rotation.interval"), leader.IsLeader)
+
 	// Start server in a goroutine
 	errChan := make(chan error, 1)
 	go func() {
 		logger.Info("starting NATS Identity Service",
-			"address", address, "health_check_interval", "60s")
+			"address", address, "health_check_tick", healthCheckTick.String())
 		if err := server.Start(); err != nil {
 			errChan <- err
 		}
@@ -336,6 +428,7 @@ func runServe(cmd *cobra.Command, args []string) error {
 	case <-sigChan:
 		logger.Info("received shutdown signal, gracefully shutting down")
 		cancel()
+		<-leaderDone
 		return server.Shutdown()
 	case err := <-errChan:
 		return fmt.Errorf("server error: %w", err)
@@ -344,6 +437,120 @@ func runServe(cmd *cobra.Command, args []string) error {
 	}
 }
 
+// coreServices are the business services shared by serve and dev
+type coreServices struct {
+	accounts   *services.AccountService
+	operators  *services.OperatorService
+	users      *services.UserService
+	scopedKeys *services.ScopedSigningKeyService
+	clusters   *services.ClusterService
+}
+
+// newCoreServices wires the business services on top of the repository factory
+func newCoreServices(repoFactory persistence.RepositoryFactory, encryptor encryption.Encryptor) *coreServices {
+	jwtService := services.NewJWTService(encryptor)
+
+	clusterService := services.NewClusterService(
+		services.ClusterRepositories{
+			Clusters:     repoFactory.ClusterRepository(),
+			Servers:      repoFactory.ClusterServerRepository(),
+			HealthChecks: repoFactory.ClusterHealthCheckRepository(),
+			AuthFailures: repoFactory.AuthFailureRepository(),
+			AccountStats: repoFactory.AccountStatsRepository(),
+			Leafnodes:    repoFactory.LeafnodeProfileRepository(),
+			Placements:   repoFactory.AccountPlacementRepository(),
+		},
+		repoFactory.OperatorRepository(),
+		repoFactory.AccountRepository(),
+		repoFactory.UserRepository(),
+		repoFactory.UserKeyRepository(),
+		repoFactory.ScopedSigningKeyRepository(),
+		encryptor,
+		jwtService,
+	)
+
+	// accountService must be created before operatorService because operator
+	// creation uses accountService to create the $SYS account
+	accountService := services.NewAccountService(
+		repoFactory.AccountRepository(),
+		repoFactory.OperatorRepository(),
+		repoFactory.ScopedSigningKeyRepository(),
+		jwtService,
+		encryptor,
+	)
+	accountService.SetClusterService(clusterService)
+
+	userService := services.NewUserService(
+		repoFactory.UserRepository(),
+		repoFactory.AccountRepository(),
+		repoFactory.OperatorRepository(),
+		repoFactory.ScopedSigningKeyRepository(),
+		repoFactory.UserKeyRepository(),
+		repoFactory.RotationPolicyRepository(),
+		jwtService,
+		encryptor,
+	)
+	userService.SetClusterService(clusterService)
+	accountService.SetUserService(userService)
+
+	operatorService := services.NewOperatorService(
+		repoFactory.OperatorRepository(),
+		repoFactory.AccountRepository(),
+		repoFactory.UserRepository(),
+		accountService,
+		userService,
+		jwtService,
+		encryptor,
+	)
+	operatorService.SetClusterService(clusterService)
+
+	scopedKeyService := services.NewScopedSigningKeyService(
+		repoFactory.ScopedSigningKeyRepository(),
+		repoFactory.AccountRepository(),
+		repoFactory.OperatorRepository(),
+		userService,
+		jwtService,
+		encryptor,
+	)
+	scopedKeyService.SetClusterService(clusterService)
+
+	return &coreServices{
+		accounts:   accountService,
+		operators:  operatorService,
+		users:      userService,
+		scopedKeys: scopedKeyService,
+		clusters:   clusterService,
+	}
+}
+
+// newLeaderElector builds the elector selected by leader_election.mode: "lease"
+// competes for a lease row, "single" always leads, and "auto" picks single on
+// SQLite and lease otherwise.
+func newLeaderElector(dbDriver string, repoFactory persistence.RepositoryFactory) (*services.LeaderElector, error) {
+	instanceID := services.NewInstanceID()
+
+	mode := viper.GetString("leader_election.mode")
+	if mode == "" || mode == "auto" {
+		mode = services.LeaderModeLease
+		if dbDriver == "sqlite" {
+			mode = services.LeaderModeSingle
+		}
+	}
+
+	switch mode {
+	case services.LeaderModeLease:
+		return services.NewLeaderElector(
+			repoFactory.LeaderLeaseRepository(),
+			instanceID,
+			viper.GetDuration("leader_election.lease_ttl"),
+		), nil
+	case services.LeaderModeSingle:
+		return services.NewSingleLeaderElector(instanceID), nil
+	default:
+		return nil, fmt.Errorf("invalid leader election mode %q (expected auto, lease or single)", mode)
+	}
+}
+
 func initEncryptionService() (encryption.Encryptor, error) {
 	// Try to load encryption keys from config file first
 	var encryptionKeys []struct {
@@ -423,6 +630,27 @@ func initCasbin() (*casbin.Enforcer, error) {
 	return services.NewCasbinEnforcer()
 }
 
+// jetStreamSamples converts collected account usage into gauge samples
+func jetStreamSamples(usage []services.AccountUsage) []metrics.AccountJetStreamSample {
+	samples := make([]metrics.AccountJetStreamSample, 0, len(usage))
+	for _, u := range usage {
+		samples = append(samples, metrics.AccountJetStreamSample{
+			Cluster:          u.ClusterName,
+			Account:          u.Account.Name,
+			AccountPublicKey: u.Account.PublicKey,
+			Memory:           u.Memory,
+			Storage:          u.Storage,
+			Streams:          u.Streams,
+			Consumers:        u.Consumers,
+			MaxMemory:        u.Account.JetStreamMaxMemory,
+			MaxStorage:       u.Account.JetStreamMaxStorage,
+			MaxStreams:       u.Account.JetStreamMaxStreams,
+			MaxConsumers:     u.Account.JetStreamMaxConsumers,
+		})
+	}
+	return samples
+}
+
 // maybeInitMetrics returns (nil, nil, nil) when metrics are disabled in
 // config, otherwise builds an OTel meter provider backed by a Prometheus
 // registry and returns the provider + a handler for /metrics.
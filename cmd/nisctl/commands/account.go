@@ -3,11 +3,13 @@ package commands
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
 	"github.com/thomas-maurice/nis/internal/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var accountCmd = &cobra.Command{
@@ -44,6 +46,43 @@ var accountDeleteCmd = &cobra.Command{
 	RunE:  runAccountDelete,
 }
 
+var accountConnectionsCmd = &cobra.Command{
+	Use:   "connections NAME",
+	Short: "List the live connections of an account",
+	Long: `List the client connections of an account on every server of the operator's
+clusters, with the NIS user and scoped signing key each connection authenticated as.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAccountConnections,
+}
+
+var accountUsageCmd = &cobra.Command{
+	Use:   "usage NAME",
+	Short: "Show the JetStream usage of an account against its limits",
+	Long: `Show the JetStream memory, storage, streams and consumers an account uses on
+each cluster of its operator, next to the account's JetStream limits.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAccountUsage,
+}
+
+var accountStatsCmd = &cobra.Command{
+	Use:   "stats NAME",
+	Short: "Show the traffic statistics of an account",
+	Long: `Show the connections, messages, bytes and slow consumers of an account over
+time, as collected from the operator's clusters every minute. Per-minute buckets are
+kept for 48 hours, hourly buckets for the configured retention.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAccountStats,
+}
+
+var accountAuthFailuresCmd = &cobra.Command{
+	Use:   "auth-failures NAME",
+	Short: "List the NATS authentication failures of an account",
+	Long: `List the failed client authentications of an account's users reported by the
+operator's clusters, aggregated per minute, user key, remote address and reason.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAccountAuthFailures,
+}
+
 var (
 	accountOperatorID   string
 	accountDescription  string
@@ -52,6 +91,12 @@ var (
 	accountMaxStreams   int32
 	accountMaxConsumers int32
 	accountForce        bool
+
+	connectionsCluster string
+	connectionsLimit   int32
+
+	statsSince      time.Duration
+	statsResolution time.Duration
 )
 
 func init() {
@@ -61,6 +106,10 @@ func init() {
 	accountCmd.AddCommand(accountListCmd)
 	accountCmd.AddCommand(accountGetCmd)
 	accountCmd.AddCommand(accountDeleteCmd)
+	accountCmd.AddCommand(accountConnectionsCmd)
+	accountCmd.AddCommand(accountUsageCmd)
+	accountCmd.AddCommand(accountAuthFailuresCmd)
+	accountCmd.AddCommand(accountStatsCmd)
 
 	// Create flags
 	accountCreateCmd.Flags().StringVar(&accountOperatorID, "operator", "", "operator ID or name (required)")
@@ -79,6 +128,31 @@ func init() {
 	accountDeleteCmd.Flags().StringVar(&accountOperatorID, "operator", "", "operator ID or name (required)")
 	accountDeleteCmd.Flags().BoolVarP(&accountForce, "force", "f", false, "skip confirmation prompt")
 	_ = accountDeleteCmd.MarkFlagRequired("operator")
+
+	// Connections flags
+	accountConnectionsCmd.Flags().StringVar(&accountOperatorID, "operator", "", "operator ID or name (required)")
+	accountConnectionsCmd.Flags().StringVar(&connectionsCluster, "cluster", "", "only query this cluster (ID or name)")
+	accountConnectionsCmd.Flags().Int32Var(&connectionsLimit, "limit", 0, "maximum connections per server (default: server setting)")
+	_ = accountConnectionsCmd.MarkFlagRequired("operator")
+
+	// Usage flags
+	accountUsageCmd.Flags().StringVar(&accountOperatorID, "operator", "", "operator ID or name (required)")
+	accountUsageCmd.Flags().StringVar(&connectionsCluster, "cluster", "", "only query this cluster (ID or name)")
+	_ = accountUsageCmd.MarkFlagRequired("operator")
+
+	// Auth failures flags
+	accountAuthFailuresCmd.Flags().StringVar(&accountOperatorID, "operator", "", "operator ID or name (required)")
+	accountAuthFailuresCmd.Flags().StringVar(&connectionsCluster, "cluster", "", "only show failures of this cluster (ID or name)")
+	accountAuthFailuresCmd.Flags().DurationVar(&authFailuresSince, "since", 24*time.Hour, "only show failures seen within this duration (0 for all)")
+	accountAuthFailuresCmd.Flags().Int32Var(&authFailuresLimit, "limit", 100, "number of entries to show")
+	_ = accountAuthFailuresCmd.MarkFlagRequired("operator")
+
+	// Stats flags
+	accountStatsCmd.Flags().StringVar(&accountOperatorID, "operator", "", "operator ID or name (required)")
+	accountStatsCmd.Flags().StringVar(&connectionsCluster, "cluster", "", "only show statistics of this cluster (ID or name)")
+	accountStatsCmd.Flags().DurationVar(&statsSince, "since", 24*time.Hour, "show statistics within this duration")
+	accountStatsCmd.Flags().DurationVar(&statsResolution, "resolution", 0, "bucket length, 1m or 1h (default: 1m when available)")
+	_ = accountStatsCmd.MarkFlagRequired("operator")
 }
 
 func runAccountCreate(cmd *cobra.Command, args []string) error {
@@ -118,6 +192,9 @@ func runAccountCreate(cmd *cobra.Command, args []string) error {
 	}
 
 	printer.PrintSuccess("Account created successfully")
+	for _, warning := range resp.Msg.Warnings {
+		printer.PrintWarning("%s", warning)
+	}
 	return printer.PrintObject(resp.Msg.Account)
 }
 
@@ -148,7 +225,7 @@ func runAccountList(cmd *cobra.Command, args []string) error {
 	}
 
 	if GetOutputFormat() == "table" {
-		headers := []string{"ID", "NAME", "OPERATOR", "CREATED AT"}
+		headers := []string{"ID", "NAME", "OPERATOR", "STATUS", "CREATED AT"}
 		rows := make([][]string, len(resp.Msg.Accounts))
 
 		for i, acc := range resp.Msg.Accounts {
@@ -161,6 +238,7 @@ func runAccountList(cmd *cobra.Command, args []string) error {
 				acc.Id[:8] + "...",
 				acc.Name,
 				acc.OperatorId[:8] + "...",
+				accountStatus(acc),
 				createdAt,
 			}
 		}
@@ -197,7 +275,21 @@ func runAccountGet(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	return printer.PrintObject(resp.Msg.Account)
+	if err := printer.PrintObject(resp.Msg.Account); err != nil {
+		return err
+	}
+	if GetOutputFormat() != "table" {
+		return nil
+	}
+
+	placementResp, err := GetClient().Account.GetAccountPlacement(context.Background(), connect.NewRequest(&nisv1.GetAccountPlacementRequest{
+		AccountId: resp.Msg.Account.Id,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get account placement: %w", err)
+	}
+	printAccountPlacement(printer, placementResp.Msg.Placement)
+	return nil
 }
 
 func runAccountDelete(cmd *cobra.Command, args []string) error {
@@ -248,6 +340,199 @@ func runAccountDelete(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runAccountConnections(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve operator ID
+	operatorID, err := resolveOperatorID(accountOperatorID)
+	if err != nil {
+		return err
+	}
+
+	// Get account by name to get ID
+	getResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: operatorID,
+		Name:       name,
+	}))
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+
+	req := &nisv1.ListConnectionsRequest{
+		AccountId: getResp.Msg.Account.Id,
+		Limit:     connectionsLimit,
+	}
+	if connectionsCluster != "" {
+		if req.ClusterId, err = resolveClusterID(connectionsCluster); err != nil {
+			return err
+		}
+	}
+
+	resp, err := GetClient().Cluster.ListConnections(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to list connections: %w", err)
+	}
+
+	return printConnections(printer, resp.Msg)
+}
+
+func runAccountUsage(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve operator ID
+	operatorID, err := resolveOperatorID(accountOperatorID)
+	if err != nil {
+		return err
+	}
+
+	// Get account by name to get ID
+	getResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: operatorID,
+		Name:       name,
+	}))
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+
+	req := &nisv1.GetAccountUsageRequest{AccountId: getResp.Msg.Account.Id}
+	if connectionsCluster != "" {
+		if req.ClusterId, err = resolveClusterID(connectionsCluster); err != nil {
+			return err
+		}
+	}
+
+	resp, err := GetClient().Account.GetAccountUsage(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to get account usage: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		for _, u := range resp.Msg.Usage {
+			fmt.Println(u.ClusterName)
+		}
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg)
+	}
+
+	for _, warning := range resp.Msg.Warnings {
+		printer.PrintWarning("%s", warning)
+	}
+
+	if len(resp.Msg.Usage) == 0 {
+		printer.PrintMessage("No clusters reported usage for account %s", name)
+		return nil
+	}
+
+	headers := []string{"CLUSTER", "MEMORY", "STORAGE", "STREAMS", "CONSUMERS", "SERVERS"}
+	rows := make([][]string, len(resp.Msg.Usage))
+	for i, u := range resp.Msg.Usage {
+		rows[i] = []string{
+			u.ClusterName,
+			formatUsage(formatBytes(u.MemoryBytes), u.MemoryBytes, formatBytes(u.MemoryLimit), u.MemoryLimit),
+			formatUsage(formatBytes(u.StorageBytes), u.StorageBytes, formatBytes(u.StorageLimit), u.StorageLimit),
+			formatUsage(fmt.Sprintf("%d", u.Streams), u.Streams, fmt.Sprintf("%d", u.StreamsLimit), u.StreamsLimit),
+			formatUsage(fmt.Sprintf("%d", u.Consumers), u.Consumers, fmt.Sprintf("%d", u.ConsumersLimit), u.ConsumersLimit),
+			fmt.Sprintf("%d", u.Servers),
+		}
+	}
+	if err := printer.PrintTable(headers, rows); err != nil {
+		return err
+	}
+
+	if !resp.Msg.Usage[0].JetstreamEnabled {
+		printer.PrintWarning("JetStream is disabled for account %s", name)
+	}
+
+	return nil
+}
+
+func runAccountStats(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	if statsSince <= 0 {
+		return fmt.Errorf("--since must be positive")
+	}
+
+	// Resolve operator ID
+	operatorID, err := resolveOperatorID(accountOperatorID)
+	if err != nil {
+		return err
+	}
+
+	// Get account by name to get ID
+	getResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: operatorID,
+		Name:       name,
+	}))
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+
+	req := &nisv1.GetAccountStatsRequest{
+		AccountId:         getResp.Msg.Account.Id,
+		From:              timestamppb.New(time.Now().Add(-statsSince)),
+		ResolutionSeconds: int32(statsResolution / time.Second),
+	}
+	if connectionsCluster != "" {
+		if req.ClusterId, err = resolveClusterID(connectionsCluster); err != nil {
+			return err
+		}
+	}
+
+	resp, err := GetClient().Account.GetAccountStats(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to get account stats: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		for _, p := range resp.Msg.Points {
+			fmt.Printf("%s %s %d %d\n", p.BucketStart.AsTime().Format(time.RFC3339), p.ClusterId, p.MsgsIn, p.MsgsOut)
+		}
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg)
+	}
+
+	if len(resp.Msg.Points) == 0 {
+		printer.PrintMessage("No traffic recorded for account %s", name)
+		return nil
+	}
+
+	headers := []string{"TIME", "CLUSTER", "CONNS (AVG/MAX)", "MSGS IN", "MSGS OUT", "BYTES IN", "BYTES OUT", "SLOW CONSUMERS"}
+	rows := make([][]string, len(resp.Msg.Points))
+	for i, p := range resp.Msg.Points {
+		rows[i] = []string{
+			p.BucketStart.AsTime().Local().Format("2006-01-02 15:04"),
+			p.ClusterId[:8] + "...",
+			fmt.Sprintf("%.1f / %d", p.AvgConnections, p.MaxConnections),
+			fmt.Sprintf("%d", p.MsgsIn),
+			fmt.Sprintf("%d", p.MsgsOut),
+			formatBytes(p.BytesIn),
+			formatBytes(p.BytesOut),
+			fmt.Sprintf("%d", p.SlowConsumers),
+		}
+	}
+
+	return printer.PrintTable(headers, rows)
+}
+
+// formatUsage renders "used / limit (pct%)", or "used / unlimited" for negative limits
+func formatUsage(used string, usedValue int64, limit string, limitValue int64) string {
+	if limitValue < 0 {
+		return used + " / unlimited"
+	}
+	if limitValue == 0 {
+		return used + " / 0"
+	}
+	return fmt.Sprintf("%s / %s (%.0f%%)", used, limit, float64(usedValue)*100/float64(limitValue))
+}
+
 // Helper function to resolve operator ID from ID or name
 func resolveOperatorID(idOrName string) (string, error) {
 	// Try as ID first
@@ -272,3 +557,38 @@ func resolveOperatorID(idOrName string) (string, error) {
 
 	return nameResp.Msg.Operator.Id, nil
 }
+
+func runAccountAuthFailures(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve operator ID
+	operatorID, err := resolveOperatorID(accountOperatorID)
+	if err != nil {
+		return err
+	}
+
+	// Get account by name to get ID
+	getResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: operatorID,
+		Name:       name,
+	}))
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+
+	req := authFailuresRequest()
+	req.AccountId = getResp.Msg.Account.Id
+	if connectionsCluster != "" {
+		if req.ClusterId, err = resolveClusterID(connectionsCluster); err != nil {
+			return err
+		}
+	}
+
+	resp, err := GetClient().Cluster.ListAuthFailures(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to list auth failures: %w", err)
+	}
+
+	return printAuthFailures(printer, resp.Msg)
+}
@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	authFailuresSince time.Duration
+	authFailuresLimit int32
+)
+
+// authFailuresRequest builds a ListAuthFailures request from the shared flags
+func authFailuresRequest() *nisv1.ListAuthFailuresRequest {
+	req := &nisv1.ListAuthFailuresRequest{
+		Options: &nisv1.ListOptions{Limit: authFailuresLimit},
+	}
+	if authFailuresSince > 0 {
+		req.Since = timestamppb.New(time.Now().Add(-authFailuresSince))
+	}
+	return req
+}
+
+// printAuthFailures renders a ListAuthFailures response for the cluster and account commands
+func printAuthFailures(printer *client.Printer, resp *nisv1.ListAuthFailuresResponse) error {
+	if GetOutputFormat() == "quiet" {
+		for _, failure := range resp.Failures {
+			fmt.Printf("%s %s %d\n", failure.UserPublicKey, failure.RemoteIp, failure.Count)
+		}
+		return nil
+	}
+
+	if GetOutputFormat() != "table" {
+		return printer.PrintObject(resp)
+	}
+
+	if len(resp.Failures) == 0 {
+		printer.PrintMessage("No authentication failures found")
+		return nil
+	}
+
+	headers := []string{"LAST SEEN", "CLUSTER", "USER KEY", "NIS USER", "ACCOUNT", "REMOTE IP", "REASON", "COUNT", "PER MIN"}
+	rows := make([][]string, len(resp.Failures))
+	for i, failure := range resp.Failures {
+		userKey := "-"
+		if failure.UserPublicKey != "" {
+			userKey = truncateKey(failure.UserPublicKey)
+		}
+		user := "-"
+		if failure.UserId != "" {
+			user = failure.UserId[:8] + "..."
+		}
+		account := "-"
+		if failure.AccountId != "" {
+			account = failure.AccountId[:8] + "..."
+		} else if failure.AccountPublicKey != "" {
+			account = truncateKey(failure.AccountPublicKey)
+		}
+
+		// Failures per minute over the span of the window that saw them
+		span := failure.LastSeen.AsTime().Sub(failure.FirstSeen.AsTime())
+		if span < time.Minute {
+			span = time.Minute
+		}
+
+		rows[i] = []string{
+			failure.LastSeen.AsTime().Local().Format("2006-01-02 15:04:05"),
+			failure.ClusterId[:8] + "...",
+			userKey,
+			user,
+			account,
+			failure.RemoteIp,
+			failure.Reason,
+			fmt.Sprintf("%d", failure.Count),
+			fmt.Sprintf("%.1f", float64(failure.Count)/span.Minutes()),
+		}
+	}
+
+	return printer.PrintTable(headers, rows)
+}
+
+// truncateKey shortens an nkey for table output
+func truncateKey(key string) string {
+	if len(key) <= 12 {
+		return key
+	}
+	return key[:12] + "..."
+}
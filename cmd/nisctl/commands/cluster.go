@@ -2,10 +2,16 @@ package commands
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
 	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
 	"github.com/thomas-maurice/nis/internal/client"
 )
@@ -67,6 +73,81 @@ var clusterDeleteResolverAccountCmd = &cobra.Command{
 	RunE:  runClusterDeleteResolverAccount,
 }
 
+var clusterVerifyCmd = &cobra.Command{
+	Use:   "verify ID_OR_NAME ACCOUNT",
+	Short: "Verify an account JWT reached every server",
+	Long: `Check which servers of the cluster hold the current JWT of an account.
+ACCOUNT is an account ID or the name of an account of the cluster's operator.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runClusterVerify,
+}
+
+var clusterServersCmd = &cobra.Command{
+	Use:   "servers ID_OR_NAME",
+	Short: "List the servers of a cluster",
+	Long: `List the NATS servers recorded by the last health checks, with version,
+JetStream, connections and uptime. Servers that missed the last health check and
+version skew between servers are flagged.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runClusterServers,
+}
+
+var clusterHealthHistoryCmd = &cobra.Command{
+	Use:   "health-history ID_OR_NAME",
+	Short: "Show the health check history of a cluster",
+	Long: `List the most recent health checks of a cluster, newest first, with the
+latency and error of each check and the uptime over the last 24 hours.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runClusterHealthHistory,
+}
+
+var clusterCapacityCmd = &cobra.Command{
+	Use:   "capacity ID_OR_NAME",
+	Short: "Compare JetStream capacity with the limits assigned to accounts",
+	Long: `Report the JetStream memory and storage of every server of a cluster, as
+returned by JSZ, next to the sum of the JetStream limits of the operator's accounts
+and what the servers currently reserve and use. Over-committed resources and accounts
+without limits are flagged.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runClusterCapacity,
+}
+
+var clusterAuthFailuresCmd = &cobra.Command{
+	Use:   "auth-failures ID_OR_NAME",
+	Short: "List the NATS authentication failures of a cluster",
+	Long: `List the failed client authentications reported by the servers of a cluster,
+aggregated per minute, presented user key, remote address and reason. Keys that
+belong to a NIS user or account are resolved; revoked or forged credentials show
+the raw key.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runClusterAuthFailures,
+}
+
+var clusterServerConfigCmd = &cobra.Command{
+	Use:   "server-config ID_OR_NAME",
+	Short: "Generate the NATS server configuration of a cluster",
+	Long: `Generate a complete NATS server configuration from the cluster's server
+profile: listeners, TLS, routes, JetStream, the full resolver with the operator JWT
+and the preloaded system account, websocket, MQTT, leafnode and gateway blocks.
+The result is checked with the nats-server config parser.
+
+--profile replaces the stored profile with a YAML or JSON file using the field
+names of the ServerProfile message, for example:
+
+  port: 4222
+  http_port: 8222
+  cluster_port: 6222
+  routes: [nats-route://nats-1:6222, nats-route://nats-2:6222]
+  tls: {cert_file: /etc/nats/tls.crt, key_file: /etc/nats/tls.key}
+  jetstream: {enabled: true, store_dir: /data/jetstream, max_storage: 10737418240}
+  resolver: {dir: /data/resolver, interval_seconds: 120}
+  leafnode_port: 7422
+
+--save stores it with the cluster once the configuration is valid.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runClusterServerConfig,
+}
+
 var (
 	clusterOperatorID   string
 	clusterURLs         []string
@@ -74,6 +155,17 @@ var (
 	clusterForce        bool
 	clusterSyncPrune    bool
 	clusterDeleteForce  bool
+
+	clusterHealthInterval time.Duration
+	clusterHealthTimeout  time.Duration
+	clusterHealthLimit    int
+
+	serverConfigName     string
+	serverConfigPort     int32
+	serverConfigHTTPPort int32
+	serverConfigProfile  string
+	serverConfigSave     bool
+	serverConfigOutput   string
 )
 
 func init() {
@@ -86,10 +178,18 @@ func init() {
 	clusterCmd.AddCommand(clusterSyncCmd)
 	clusterCmd.AddCommand(clusterResolverAccountsCmd)
 	clusterCmd.AddCommand(clusterDeleteResolverAccountCmd)
+	clusterCmd.AddCommand(clusterVerifyCmd)
+	clusterCmd.AddCommand(clusterServersCmd)
+	clusterCmd.AddCommand(clusterHealthHistoryCmd)
+	clusterCmd.AddCommand(clusterCapacityCmd)
+	clusterCmd.AddCommand(clusterAuthFailuresCmd)
+	clusterCmd.AddCommand(clusterServerConfigCmd)
 
 	clusterCreateCmd.Flags().StringVar(&clusterOperatorID, "operator", "", "operator ID or name (required)")
 	clusterCreateCmd.Flags().StringSliceVar(&clusterURLs, "urls", []string{}, "NATS server URLs (required)")
 	clusterCreateCmd.Flags().StringVar(&clusterDescription, "description", "", "cluster description")
+	clusterCreateCmd.Flags().DurationVar(&clusterHealthInterval, "health-interval", 0, "time between health checks (default: server setting)")
+	clusterCreateCmd.Flags().DurationVar(&clusterHealthTimeout, "health-timeout", 0, "timeout of a single health check (default: server setting)")
 	_ = clusterCreateCmd.MarkFlagRequired("operator")
 	_ = clusterCreateCmd.MarkFlagRequired("urls")
 
@@ -98,6 +198,18 @@ func init() {
 	clusterSyncCmd.Flags().BoolVar(&clusterSyncPrune, "prune", false, "remove accounts from resolver that are not in the database")
 
 	clusterDeleteResolverAccountCmd.Flags().BoolVarP(&clusterDeleteForce, "force", "f", false, "skip confirmation prompt")
+
+	clusterHealthHistoryCmd.Flags().IntVar(&clusterHealthLimit, "limit", 20, "number of checks to show")
+
+	clusterAuthFailuresCmd.Flags().DurationVar(&authFailuresSince, "since", 24*time.Hour, "only show failures seen within this duration (0 for all)")
+	clusterAuthFailuresCmd.Flags().Int32Var(&authFailuresLimit, "limit", 100, "number of entries to show")
+
+	clusterServerConfigCmd.Flags().StringVar(&serverConfigName, "server-name", "", "name of the server the configuration is for")
+	clusterServerConfigCmd.Flags().Int32Var(&serverConfigPort, "port", 0, "client port (default: profile setting)")
+	clusterServerConfigCmd.Flags().Int32Var(&serverConfigHTTPPort, "http-port", 0, "monitoring port (default: profile setting)")
+	clusterServerConfigCmd.Flags().StringVar(&serverConfigProfile, "profile", "", "YAML or JSON server profile replacing the stored one")
+	clusterServerConfigCmd.Flags().BoolVar(&serverConfigSave, "save", false, "store the --profile with the cluster")
+	clusterServerConfigCmd.Flags().StringVarP(&serverConfigOutput, "output", "o", "", "output file (default: stdout)")
 }
 
 func runClusterCreate(cmd *cobra.Command, args []string) error {
@@ -115,6 +227,9 @@ func runClusterCreate(cmd *cobra.Command, args []string) error {
 		Name:        name,
 		Description: clusterDescription,
 		ServerUrls:  clusterURLs,
+
+		HealthCheckIntervalSeconds: int64(clusterHealthInterval.Seconds()),
+		HealthCheckTimeoutSeconds:  int64(clusterHealthTimeout.Seconds()),
 	})
 
 	resp, err := GetClient().Cluster.CreateCluster(context.Background(), req)
@@ -182,26 +297,17 @@ func runClusterGet(cmd *cobra.Command, args []string) error {
 	idOrName := args[0]
 	printer := client.NewPrinter(GetOutputFormat())
 
-	req := connect.NewRequest(&nisv1.GetClusterRequest{
-		Id: idOrName,
-	})
-
-	resp, err := GetClient().Cluster.GetCluster(context.Background(), req)
+	// Resolve the name first: only GetCluster fills in the uptime
+	clusterID, err := resolveClusterID(idOrName)
 	if err != nil {
-		nameReq := connect.NewRequest(&nisv1.GetClusterByNameRequest{
-			Name: idOrName,
-		})
-
-		nameResp, nameErr := GetClient().Cluster.GetClusterByName(context.Background(), nameReq)
-		if nameErr != nil {
-			return fmt.Errorf("cluster not found: %w", nameErr)
-		}
+		return err
+	}
 
-		resp = &connect.Response[nisv1.GetClusterResponse]{
-			Msg: &nisv1.GetClusterResponse{
-				Cluster: nameResp.Msg.Cluster,
-			},
-		}
+	resp, err := GetClient().Cluster.GetCluster(context.Background(), connect.NewRequest(&nisv1.GetClusterRequest{
+		Id: clusterID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get cluster: %w", err)
 	}
 
 	if GetOutputFormat() == "quiet" {
@@ -306,6 +412,28 @@ func runClusterSync(cmd *cobra.Command, args []string) error {
 				printer.PrintMessage("  - %s", pubKey)
 			}
 		}
+		if len(resp.Msg.Servers) > 0 {
+			printer.PrintMessage("Servers:")
+			for _, srv := range resp.Msg.Servers {
+				name := srv.ServerName
+				if srv.Cluster != "" {
+					name = srv.Cluster + "/" + name
+				}
+				printer.PrintMessage("  - %s (%s): %d acknowledged, %d rejected, %d missing",
+					name, srv.ServerId, srv.Acknowledged, srv.Rejected, srv.Missing)
+			}
+		}
+		if len(resp.Msg.Peers) > 0 {
+			printer.PrintMessage("Supercluster:")
+			for _, peer := range resp.Msg.Peers {
+				if peer.Error != "" {
+					printer.PrintMessage("  - %s: not verified: %s", peer.ClusterName, peer.Error)
+					continue
+				}
+				printer.PrintMessage("  - %s: %d accounts verified on %d servers, %d missing",
+					peer.ClusterName, peer.Verified, peer.Servers, len(peer.Missing))
+			}
+		}
 		if len(resp.Msg.Errors) > 0 {
 			printer.PrintMessage("Errors encountered:")
 			for _, syncErr := range resp.Msg.Errors {
@@ -401,6 +529,209 @@ func runClusterDeleteResolverAccount(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runClusterVerify(cmd *cobra.Command, args []string) error {
+	idOrName := args[0]
+	accountIDOrName := args[1]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve cluster ID
+	clusterID, err := resolveClusterID(idOrName)
+	if err != nil {
+		return err
+	}
+
+	clusterResp, err := GetClient().Cluster.GetCluster(context.Background(), connect.NewRequest(&nisv1.GetClusterRequest{
+		Id: clusterID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get cluster: %w", err)
+	}
+
+	// Resolve account ID, by name within the cluster's operator if it is not an ID
+	accountID := accountIDOrName
+	accountResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: clusterResp.Msg.Cluster.OperatorId,
+		Name:       accountIDOrName,
+	}))
+	if err == nil {
+		accountID = accountResp.Msg.Account.Id
+	}
+
+	resp, err := GetClient().Cluster.VerifyAccount(context.Background(), connect.NewRequest(&nisv1.VerifyAccountRequest{
+		ClusterId: clusterID,
+		AccountId: accountID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to verify account: %w", err)
+	}
+
+	if GetOutputFormat() == "json" || GetOutputFormat() == "yaml" {
+		return printer.PrintObject(resp.Msg)
+	}
+
+	if GetOutputFormat() == "quiet" {
+		if !resp.Msg.Propagated {
+			return fmt.Errorf("account %s is not propagated to every server", resp.Msg.AccountName)
+		}
+		return nil
+	}
+
+	if GetOutputFormat() == "table" {
+		headers := []string{"SERVER ID", "NAME", "RESPONDED", "HAS ACCOUNT"}
+		rows := make([][]string, len(resp.Msg.Servers))
+		for i, srv := range resp.Msg.Servers {
+			rows[i] = []string{srv.ServerId, srv.ServerName, fmt.Sprintf("%t", srv.Responded), fmt.Sprintf("%t", srv.HasAccount)}
+		}
+		if err := printer.PrintTable(headers, rows); err != nil {
+			return err
+		}
+	}
+
+	printer.PrintMessage("Lookup replies: %d current, %d mismatched, %d missing",
+		resp.Msg.CurrentCopies, resp.Msg.MismatchedCopies, resp.Msg.MissingCopies)
+	if resp.Msg.Propagated {
+		printer.PrintSuccess("Account '%s' is propagated to all %d servers", resp.Msg.AccountName, len(resp.Msg.Servers))
+	} else {
+		printer.PrintError("Account '%s' is not propagated to every server", resp.Msg.AccountName)
+	}
+
+	return nil
+}
+
+func runClusterServers(cmd *cobra.Command, args []string) error {
+	idOrName := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve cluster ID
+	clusterID, err := resolveClusterID(idOrName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := GetClient().Cluster.GetClusterTopology(context.Background(), connect.NewRequest(&nisv1.GetClusterTopologyRequest{
+		Id: clusterID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get cluster topology: %w", err)
+	}
+
+	if GetOutputFormat() == "quiet" {
+		for _, srv := range resp.Msg.Servers {
+			fmt.Println(srv.ServerId)
+		}
+		return nil
+	}
+
+	if GetOutputFormat() != "table" {
+		return printer.PrintObject(resp.Msg)
+	}
+
+	if len(resp.Msg.Servers) == 0 {
+		printer.PrintMessage("No servers recorded yet, the health check has not reached this cluster")
+		return nil
+	}
+
+	headers := []string{"SERVER ID", "NAME", "VERSION", "JETSTREAM", "CLUSTER", "CONNECTIONS", "UPTIME", "LAST SEEN", "STATUS"}
+	rows := make([][]string, len(resp.Msg.Servers))
+	for i, srv := range resp.Msg.Servers {
+		status := "ok"
+		if srv.Missing {
+			status = "missing"
+		}
+		lastSeen := ""
+		if srv.LastSeen != nil {
+			lastSeen = srv.LastSeen.AsTime().Local().Format("2006-01-02 15:04:05")
+		}
+		rows[i] = []string{
+			srv.ServerId,
+			srv.Name,
+			srv.Version,
+			fmt.Sprintf("%t", srv.Jetstream),
+			srv.ClusterName,
+			fmt.Sprintf("%d", srv.Connections),
+			(time.Duration(srv.UptimeSeconds) * time.Second).String(),
+			lastSeen,
+			status,
+		}
+	}
+	if err := printer.PrintTable(headers, rows); err != nil {
+		return err
+	}
+
+	for _, warning := range resp.Msg.Warnings {
+		printer.PrintWarning("%s", warning)
+	}
+
+	return nil
+}
+
+func runClusterHealthHistory(cmd *cobra.Command, args []string) error {
+	idOrName := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve cluster ID
+	clusterID, err := resolveClusterID(idOrName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := GetClient().Cluster.ListClusterHealthChecks(context.Background(), connect.NewRequest(&nisv1.ListClusterHealthChecksRequest{
+		Id: clusterID,
+		Options: &nisv1.ListOptions{
+			Limit: int32(clusterHealthLimit),
+		},
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to list cluster health checks: %w", err)
+	}
+
+	if GetOutputFormat() != "table" {
+		return printer.PrintObject(resp.Msg)
+	}
+
+	if len(resp.Msg.Checks) == 0 {
+		printer.PrintMessage("No health checks recorded yet")
+		return nil
+	}
+
+	headers := []string{"CHECKED AT", "STATUS", "LATENCY", "ERROR"}
+	rows := make([][]string, len(resp.Msg.Checks))
+	for i, check := range resp.Msg.Checks {
+		status := "healthy"
+		latency := (time.Duration(check.LatencyMs) * time.Millisecond).String()
+		if !check.Healthy {
+			status = "unhealthy"
+			latency = "-"
+		}
+		errMsg := check.Error
+		if errMsg == "" {
+			errMsg = "-"
+		}
+		rows[i] = []string{
+			check.CheckedAt.AsTime().Local().Format("2006-01-02 15:04:05"),
+			status,
+			latency,
+			errMsg,
+		}
+	}
+	if err := printer.PrintTable(headers, rows); err != nil {
+		return err
+	}
+
+	// The uptime is only computed by GetCluster
+	clusterResp, err := GetClient().Cluster.GetCluster(context.Background(), connect.NewRequest(&nisv1.GetClusterRequest{
+		Id: clusterID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get cluster: %w", err)
+	}
+	if cluster := clusterResp.Msg.Cluster; cluster.HealthCheckCount > 0 {
+		printer.PrintMessage("Uptime (24h): %.2f%% over %d checks", cluster.UptimePercent, cluster.HealthCheckCount)
+	}
+
+	return nil
+}
+
 func resolveClusterID(idOrName string) (string, error) {
 	req := connect.NewRequest(&nisv1.GetClusterRequest{
 		Id: idOrName,
@@ -422,3 +753,171 @@ func resolveClusterID(idOrName string) (string, error) {
 
 	return nameResp.Msg.Cluster.Id, nil
 }
+
+func runClusterCapacity(cmd *cobra.Command, args []string) error {
+	idOrName := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve cluster ID
+	clusterID, err := resolveClusterID(idOrName)
+	if err != nil {
+		return err
+	}
+
+	resp, err := GetClient().Cluster.GetClusterCapacity(context.Background(), connect.NewRequest(&nisv1.GetClusterCapacityRequest{
+		Id: clusterID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to get cluster capacity: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		for _, warning := range resp.Msg.Warnings {
+			fmt.Println(warning)
+		}
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg)
+	}
+
+	if resp.Msg.JetstreamServers == 0 {
+		printer.PrintMessage("No server of cluster %s has JetStream enabled", resp.Msg.ClusterName)
+		return nil
+	}
+
+	percent := func(v, total int64) string {
+		if total <= 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.0f%%", float64(v)*100/float64(total))
+	}
+
+	headers := []string{"RESOURCE", "CAPACITY", "ASSIGNED", "RESERVED", "USED", "UNLIMITED ACCOUNTS"}
+	rows := [][]string{
+		{
+			"memory",
+			formatBytes(resp.Msg.MemoryCapacity),
+			fmt.Sprintf("%s (%s)", formatBytes(resp.Msg.MemoryAssigned), percent(resp.Msg.MemoryAssigned, resp.Msg.MemoryCapacity)),
+			fmt.Sprintf("%s (%s)", formatBytes(resp.Msg.MemoryReserved), percent(resp.Msg.MemoryReserved, resp.Msg.MemoryCapacity)),
+			fmt.Sprintf("%s (%s)", formatBytes(resp.Msg.MemoryUsed), percent(resp.Msg.MemoryUsed, resp.Msg.MemoryCapacity)),
+			fmt.Sprintf("%d", len(resp.Msg.UnlimitedMemoryAccounts)),
+		},
+		{
+			"storage",
+			formatBytes(resp.Msg.StorageCapacity),
+			fmt.Sprintf("%s (%s)", formatBytes(resp.Msg.StorageAssigned), percent(resp.Msg.StorageAssigned, resp.Msg.StorageCapacity)),
+			fmt.Sprintf("%s (%s)", formatBytes(resp.Msg.StorageReserved), percent(resp.Msg.StorageReserved, resp.Msg.StorageCapacity)),
+			fmt.Sprintf("%s (%s)", formatBytes(resp.Msg.StorageUsed), percent(resp.Msg.StorageUsed, resp.Msg.StorageCapacity)),
+			fmt.Sprintf("%d", len(resp.Msg.UnlimitedStorageAccounts)),
+		},
+	}
+	if err := printer.PrintTable(headers, rows); err != nil {
+		return err
+	}
+
+	printer.PrintMessage("%d JetStream servers, %d JetStream enabled accounts", resp.Msg.JetstreamServers, resp.Msg.Accounts)
+	if len(resp.Msg.UnlimitedMemoryAccounts) > 0 {
+		printer.PrintWarning("accounts without a memory limit: %s", strings.Join(resp.Msg.UnlimitedMemoryAccounts, ", "))
+	}
+	if len(resp.Msg.UnlimitedStorageAccounts) > 0 {
+		printer.PrintWarning("accounts without a storage limit: %s", strings.Join(resp.Msg.UnlimitedStorageAccounts, ", "))
+	}
+	for _, warning := range resp.Msg.Warnings {
+		printer.PrintWarning("%s", warning)
+	}
+
+	return nil
+}
+
+func runClusterAuthFailures(cmd *cobra.Command, args []string) error {
+	idOrName := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve cluster ID
+	clusterID, err := resolveClusterID(idOrName)
+	if err != nil {
+		return err
+	}
+
+	req := authFailuresRequest()
+	req.ClusterId = clusterID
+
+	resp, err := GetClient().Cluster.ListAuthFailures(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to list auth failures: %w", err)
+	}
+
+	return printAuthFailures(printer, resp.Msg)
+}
+
+func runClusterServerConfig(cmd *cobra.Command, args []string) error {
+	idOrName := args[0]
+
+	if serverConfigSave && serverConfigProfile == "" {
+		return fmt.Errorf("--save requires --profile")
+	}
+
+	// Resolve cluster ID
+	clusterID, err := resolveClusterID(idOrName)
+	if err != nil {
+		return err
+	}
+
+	req := &nisv1.GenerateServerConfigRequest{
+		Id:          clusterID,
+		ServerName:  serverConfigName,
+		Port:        serverConfigPort,
+		HttpPort:    serverConfigHTTPPort,
+		SaveProfile: serverConfigSave,
+	}
+	if serverConfigProfile != "" {
+		req.Profile, err = readServerProfile(serverConfigProfile)
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := GetClient().Cluster.GenerateServerConfig(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to generate server config: %w", err)
+	}
+
+	if serverConfigOutput == "" {
+		fmt.Print(resp.Msg.Config)
+		return nil
+	}
+	if err := os.WriteFile(serverConfigOutput, []byte(resp.Msg.Config), 0600); err != nil {
+		return fmt.Errorf("failed to write server config: %w", err)
+	}
+	printer := client.NewPrinter(GetOutputFormat())
+	if serverConfigSave {
+		printer.PrintSuccess("Server profile saved")
+	}
+	printer.PrintSuccess("Server configuration written to %s", serverConfigOutput)
+	return nil
+}
+
+// readServerProfile reads a server profile from a YAML or JSON file. JSON is valid
+// YAML, so both go through the YAML decoder and then protojson for the field names.
+func readServerProfile(filename string) (*nisv1.ServerProfile, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profile: %w", err)
+	}
+
+	var raw map[string]any
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse profile: %w", err)
+	}
+	jsonData, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse profile: %w", err)
+	}
+
+	profile := &nisv1.ServerProfile{}
+	if err := protojson.Unmarshal(jsonData, profile); err != nil {
+		return nil, fmt.Errorf("invalid profile: %w", err)
+	}
+	return profile, nil
+}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"fmt"
+
+	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/client"
+)
+
+// printConnections renders a ListConnections response for the account and user commands
+func printConnections(printer *client.Printer, resp *nisv1.ListConnectionsResponse) error {
+	if GetOutputFormat() == "quiet" {
+		for _, conn := range resp.Connections {
+			fmt.Printf("%s %d\n", conn.ServerId, conn.Cid)
+		}
+		return nil
+	}
+
+	if GetOutputFormat() != "table" {
+		return printer.PrintObject(resp)
+	}
+
+	for _, warning := range resp.Warnings {
+		printer.PrintWarning("%s", warning)
+	}
+
+	if len(resp.Connections) == 0 {
+		printer.PrintMessage("No connections found")
+		return nil
+	}
+
+	headers := []string{"CLUSTER", "SERVER", "CID", "USER", "SCOPED KEY", "IP", "CLIENT", "RTT", "SUBS", "BYTES IN", "BYTES OUT", "UPTIME"}
+	rows := make([][]string, len(resp.Connections))
+	for i, conn := range resp.Connections {
+		user := conn.UserName
+		if user == "" {
+			user = conn.UserPublicKey
+		}
+		if user == "" {
+			user = "-"
+		}
+		scopedKey := conn.ScopedSigningKeyName
+		if scopedKey == "" {
+			scopedKey = "-"
+		}
+		server := conn.ServerName
+		if server == "" {
+			server = conn.ServerId
+		}
+		clientLib := "-"
+		if conn.Lang != "" {
+			clientLib = conn.Lang + " " + conn.Version
+		}
+		if conn.ClientName != "" {
+			clientLib = conn.ClientName + " (" + clientLib + ")"
+		}
+
+		rows[i] = []string{
+			conn.ClusterName,
+			server,
+			fmt.Sprintf("%d", conn.Cid),
+			user,
+			scopedKey,
+			fmt.Sprintf("%s:%d", conn.Ip, conn.Port),
+			clientLib,
+			conn.Rtt,
+			fmt.Sprintf("%d", conn.Subscriptions),
+			formatBytes(conn.InBytes),
+			formatBytes(conn.OutBytes),
+			conn.Uptime,
+		}
+	}
+	if err := printer.PrintTable(headers, rows); err != nil {
+		return err
+	}
+
+	if resp.Truncated {
+		printer.PrintWarning("some servers have more connections than listed, raise --limit to see them all")
+	}
+
+	return nil
+}
+
+// formatBytes renders a byte count with a binary unit suffix
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/client"
+)
+
+var clusterGatewayCmd = &cobra.Command{
+	Use:   "gateway CLUSTER",
+	Short: "Join a cluster to a supercluster, or leave it",
+	Long: `Join a cluster to a supercluster of the operator's clusters. The server
+configurations generated for the members then carry a gateway to each other member,
+and a sync through any member is verified on all of them.
+
+Without --url the other members dial the cluster's server hosts on the gateway port
+of its server profile. The NATS cluster name of each member must be its NIS cluster
+name, as in the generated configurations.`,
+	Example: `  nisctl cluster gateway west --supercluster global
+  nisctl cluster gateway east --supercluster global --url nats://gw.east:7222
+  nisctl cluster gateway east --leave`,
+	Args: cobra.ExactArgs(1),
+	RunE: runClusterGateway,
+}
+
+var (
+	gatewaySupercluster string
+	gatewayURLs         []string
+	gatewayLeave        bool
+)
+
+func init() {
+	clusterCmd.AddCommand(clusterGatewayCmd)
+
+	clusterGatewayCmd.Flags().StringVar(&gatewaySupercluster, "supercluster", "", "supercluster to join")
+	clusterGatewayCmd.Flags().StringSliceVar(&gatewayURLs, "url", nil, "gateway URL the other clusters dial (can be repeated, default: derived from the cluster)")
+	clusterGatewayCmd.Flags().BoolVar(&gatewayLeave, "leave", false, "leave the supercluster")
+	clusterGatewayCmd.MarkFlagsMutuallyExclusive("supercluster", "leave")
+	clusterGatewayCmd.MarkFlagsOneRequired("supercluster", "leave")
+}
+
+func runClusterGateway(cmd *cobra.Command, args []string) error {
+	printer := client.NewPrinter(GetOutputFormat())
+
+	if gatewayLeave && len(gatewayURLs) > 0 {
+		return fmt.Errorf("--url cannot be used with --leave")
+	}
+
+	clusterID, err := resolveClusterID(args[0])
+	if err != nil {
+		return err
+	}
+
+	resp, err := GetClient().Cluster.SetClusterGateway(context.Background(), connect.NewRequest(&nisv1.SetClusterGatewayRequest{
+		Id:           clusterID,
+		Supercluster: gatewaySupercluster,
+		GatewayUrls:  gatewayURLs,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to set cluster gateway: %w", err)
+	}
+
+	if GetOutputFormat() == "quiet" {
+		return nil
+	}
+
+	cluster := resp.Msg.Cluster
+	if cluster.Supercluster == "" {
+		printer.PrintSuccess("Cluster '%s' left its supercluster", cluster.Name)
+		return nil
+	}
+
+	urls := strings.Join(cluster.GatewayUrls, ", ")
+	if urls == "" {
+		urls = "derived from the server URLs"
+	}
+	printer.PrintSuccess("Cluster '%s' joined supercluster '%s' (gateway URLs: %s)", cluster.Name, cluster.Supercluster, urls)
+	printer.PrintMessage("Regenerate the server configurations of every member with 'nisctl cluster server-config'")
+	return nil
+}
@@ -0,0 +1,318 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/client"
+)
+
+var clusterLeafnodeCmd = &cobra.Command{
+	Use:   "leafnode",
+	Short: "Manage the leafnode profiles of a hub cluster",
+	Long: `Leafnode profiles bind a NIS user to a hub cluster. Edge servers open their
+leafnode remote to the hub as that user, so their traffic lands in the user's
+account. Use 'nisctl cluster leafnode-config' to generate the remote block and the
+credentials of a profile.`,
+}
+
+var clusterLeafnodeCreateCmd = &cobra.Command{
+	Use:   "create CLUSTER NAME",
+	Short: "Bind a user to a hub cluster",
+	Long: `Create a leafnode profile binding a user of the cluster's operator to the cluster.
+
+Without --url the hub URLs are derived from the cluster's server URLs and the
+leafnode port of its server profile. --local-account binds the remote to an account
+of the leaf server instead of its default account.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runClusterLeafnodeCreate,
+}
+
+var clusterLeafnodeListCmd = &cobra.Command{
+	Use:   "list CLUSTER",
+	Short: "List the leafnode profiles of a hub cluster",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runClusterLeafnodeList,
+}
+
+var clusterLeafnodeDeleteCmd = &cobra.Command{
+	Use:   "delete CLUSTER NAME",
+	Short: "Delete a leafnode profile",
+	Long:  `Delete a leafnode profile. The user is kept: edge servers holding its credentials keep connecting until it is deleted.`,
+	Args:  cobra.ExactArgs(2),
+	RunE:  runClusterLeafnodeDelete,
+}
+
+var clusterLeafnodeConfigCmd = &cobra.Command{
+	Use:   "leafnode-config CLUSTER NAME",
+	Short: "Generate the leafnode remote configuration and credentials of a profile",
+	Long: `Generate the leafnodes block an edge server needs to join the hub cluster, and
+the .creds file of the profile's user it refers to.
+
+The block is printed, or written to --output; include it in the leaf server
+configuration. The credentials are written to --creds (default: NAME.creds) and go
+to the profile's credentials path on the leaf server.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runClusterLeafnodeConfig,
+}
+
+var (
+	leafnodeAccount         string
+	leafnodeUser            string
+	leafnodeLocalAccount    string
+	leafnodeURLs            []string
+	leafnodeCredentialsPath string
+	leafnodeCAFile          string
+	leafnodeForce           bool
+
+	leafnodeConfigOutput string
+	leafnodeCredsOutput  string
+)
+
+func init() {
+	clusterCmd.AddCommand(clusterLeafnodeCmd)
+	clusterCmd.AddCommand(clusterLeafnodeConfigCmd)
+	clusterLeafnodeCmd.AddCommand(clusterLeafnodeCreateCmd)
+	clusterLeafnodeCmd.AddCommand(clusterLeafnodeListCmd)
+	clusterLeafnodeCmd.AddCommand(clusterLeafnodeDeleteCmd)
+
+	clusterLeafnodeCreateCmd.Flags().StringVar(&leafnodeUser, "user", "", "user ID or name the leafnode connects as (required)")
+	clusterLeafnodeCreateCmd.Flags().StringVar(&leafnodeAccount, "account", "", "account name, required when --user is a name")
+	clusterLeafnodeCreateCmd.Flags().StringVar(&leafnodeLocalAccount, "local-account", "", "account of the leaf server bound to the remote")
+	clusterLeafnodeCreateCmd.Flags().StringSliceVar(&leafnodeURLs, "url", nil, "hub leafnode URL (can be repeated, default: derived from the cluster)")
+	clusterLeafnodeCreateCmd.Flags().StringVar(&leafnodeCredentialsPath, "credentials-path", "", "path of the .creds file on the leaf server (default: /etc/nats/NAME.creds)")
+	clusterLeafnodeCreateCmd.Flags().StringVar(&leafnodeCAFile, "ca-file", "", "CA verifying the hub certificate, on the leaf server")
+	_ = clusterLeafnodeCreateCmd.MarkFlagRequired("user")
+
+	clusterLeafnodeDeleteCmd.Flags().BoolVarP(&leafnodeForce, "force", "f", false, "skip confirmation")
+
+	clusterLeafnodeConfigCmd.Flags().StringVarP(&leafnodeConfigOutput, "output", "o", "", "output file (default: stdout)")
+	clusterLeafnodeConfigCmd.Flags().StringVar(&leafnodeCredsOutput, "creds", "", "credentials output file (default: NAME.creds)")
+}
+
+func runClusterLeafnodeCreate(cmd *cobra.Command, args []string) error {
+	clusterIDOrName, name := args[0], args[1]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	clusterID, err := resolveClusterID(clusterIDOrName)
+	if err != nil {
+		return err
+	}
+	userID, err := resolveLeafnodeUserID(clusterID)
+	if err != nil {
+		return err
+	}
+
+	req := connect.NewRequest(&nisv1.CreateLeafnodeProfileRequest{
+		ClusterId:       clusterID,
+		Name:            name,
+		UserId:          userID,
+		LocalAccount:    leafnodeLocalAccount,
+		Urls:            leafnodeURLs,
+		CredentialsPath: leafnodeCredentialsPath,
+		CaFile:          leafnodeCAFile,
+	})
+
+	resp, err := GetClient().Cluster.CreateLeafnodeProfile(context.Background(), req)
+	if err != nil {
+		return fmt.Errorf("failed to create leafnode profile: %w", err)
+	}
+
+	if GetOutputFormat() == "quiet" {
+		printer.PrintID(resp.Msg.Profile.Id)
+		return nil
+	}
+
+	printer.PrintSuccess("Leafnode profile created successfully")
+	return printer.PrintObject(resp.Msg.Profile)
+}
+
+func runClusterLeafnodeList(cmd *cobra.Command, args []string) error {
+	printer := client.NewPrinter(GetOutputFormat())
+
+	clusterID, err := resolveClusterID(args[0])
+	if err != nil {
+		return err
+	}
+
+	resp, err := GetClient().Cluster.ListLeafnodeProfiles(context.Background(), connect.NewRequest(&nisv1.ListLeafnodeProfilesRequest{
+		ClusterId: clusterID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to list leafnode profiles: %w", err)
+	}
+
+	if len(resp.Msg.Profiles) == 0 {
+		if GetOutputFormat() != "quiet" {
+			printer.PrintMessage("No leafnode profiles found")
+		}
+		return nil
+	}
+
+	if GetOutputFormat() == "table" {
+		headers := []string{"ID", "NAME", "USER ID", "LOCAL ACCOUNT", "URLS", "CREDENTIALS"}
+		rows := make([][]string, len(resp.Msg.Profiles))
+
+		for i, profile := range resp.Msg.Profiles {
+			localAccount := profile.LocalAccount
+			if localAccount == "" {
+				localAccount = "-"
+			}
+			urls := strings.Join(profile.Urls, ", ")
+			if urls == "" {
+				urls = "(from cluster)"
+			}
+
+			rows[i] = []string{
+				profile.Id[:8] + "...",
+				profile.Name,
+				profile.UserId[:8] + "...",
+				localAccount,
+				urls,
+				profile.CredentialsPath,
+			}
+		}
+
+		return printer.PrintTable(headers, rows)
+	}
+
+	return printer.PrintList(resp.Msg.Profiles)
+}
+
+func runClusterLeafnodeDelete(cmd *cobra.Command, args []string) error {
+	name := args[1]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	profile, err := resolveLeafnodeProfile(args[0], name)
+	if err != nil {
+		return err
+	}
+
+	if !leafnodeForce && GetOutputFormat() != "quiet" {
+		if !client.ConfirmDeletion("leafnode profile", name) {
+			printer.PrintMessage("Deletion cancelled")
+			return nil
+		}
+	}
+
+	_, err = GetClient().Cluster.DeleteLeafnodeProfile(context.Background(), connect.NewRequest(&nisv1.DeleteLeafnodeProfileRequest{
+		Id: profile.Id,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to delete leafnode profile: %w", err)
+	}
+
+	if GetOutputFormat() != "quiet" {
+		printer.PrintSuccess("Leafnode profile '%s' deleted successfully", name)
+	}
+
+	return nil
+}
+
+func runClusterLeafnodeConfig(cmd *cobra.Command, args []string) error {
+	name := args[1]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	profile, err := resolveLeafnodeProfile(args[0], name)
+	if err != nil {
+		return err
+	}
+
+	resp, err := GetClient().Cluster.GenerateLeafnodeConfig(context.Background(), connect.NewRequest(&nisv1.GenerateLeafnodeConfigRequest{
+		Id: profile.Id,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to generate leafnode config: %w", err)
+	}
+
+	credsFile := leafnodeCredsOutput
+	if credsFile == "" {
+		credsFile = name + ".creds"
+	}
+	if err := os.WriteFile(credsFile, []byte(resp.Msg.Credentials), 0600); err != nil {
+		return fmt.Errorf("failed to write credentials: %w", err)
+	}
+
+	if leafnodeConfigOutput == "" {
+		// Keep stdout to the configuration so it can be redirected
+		fmt.Print(resp.Msg.Config)
+		fmt.Fprintf(os.Stderr, "Credentials written to %s, install them at %s on the leaf server\n",
+			credsFile, resp.Msg.CredentialsPath)
+		return nil
+	}
+
+	if err := os.WriteFile(leafnodeConfigOutput, []byte(resp.Msg.Config), 0600); err != nil {
+		return fmt.Errorf("failed to write leafnode config: %w", err)
+	}
+	printer.PrintSuccess("Leafnode configuration written to %s", leafnodeConfigOutput)
+	printer.PrintSuccess("Credentials written to %s, install them at %s on the leaf server",
+		credsFile, resp.Msg.CredentialsPath)
+	return nil
+}
+
+// resolveLeafnodeProfile finds a leafnode profile of a hub cluster by name
+func resolveLeafnodeProfile(clusterIDOrName, name string) (*nisv1.LeafnodeProfile, error) {
+	clusterID, err := resolveClusterID(clusterIDOrName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := GetClient().Cluster.ListLeafnodeProfiles(context.Background(), connect.NewRequest(&nisv1.ListLeafnodeProfilesRequest{
+		ClusterId: clusterID,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leafnode profiles: %w", err)
+	}
+	for _, profile := range resp.Msg.Profiles {
+		if profile.Name == name || profile.Id == name {
+			return profile, nil
+		}
+	}
+
+	return nil, fmt.Errorf("leafnode profile %q not found", name)
+}
+
+// resolveLeafnodeUserID resolves --user, by ID or by name within --account of the
+// cluster's operator
+func resolveLeafnodeUserID(clusterID string) (string, error) {
+	userResp, err := GetClient().User.GetUser(context.Background(), connect.NewRequest(&nisv1.GetUserRequest{
+		Id: leafnodeUser,
+	}))
+	if err == nil {
+		return userResp.Msg.User.Id, nil
+	}
+
+	if leafnodeAccount == "" {
+		return "", fmt.Errorf("--account is required when --user is a name")
+	}
+
+	clusterResp, err := GetClient().Cluster.GetCluster(context.Background(), connect.NewRequest(&nisv1.GetClusterRequest{
+		Id: clusterID,
+	}))
+	if err != nil {
+		return "", fmt.Errorf("cluster not found: %w", err)
+	}
+
+	accountResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: clusterResp.Msg.Cluster.OperatorId,
+		Name:       leafnodeAccount,
+	}))
+	if err != nil {
+		return "", fmt.Errorf("account not found: %w", err)
+	}
+
+	nameResp, err := GetClient().User.GetUserByName(context.Background(), connect.NewRequest(&nisv1.GetUserByNameRequest{
+		AccountId: accountResp.Msg.Account.Id,
+		Name:      leafnodeUser,
+	}))
+	if err != nil {
+		return "", fmt.Errorf("user not found: %w", err)
+	}
+
+	return nameResp.Msg.User.Id, nil
+}
@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/client"
+)
+
+var accountMoveCmd = &cobra.Command{
+	Use:   "move NAME",
+	Short: "Move an account to another operator",
+	Long: `Move an account to another operator. The account JWT is re-signed by the new
+operator and the account keeps its identity key, so its users, scoped signing keys
+and credentials keep working.
+
+The account is deleted from the clusters of the old operator and pushed to the
+clusters of the new one. Clients must reconnect to the new operator's clusters.
+JetStream data stays on the old clusters. The account's placement is cleared.`,
+	Example: `  nisctl account move orders --operator team-a --to team-b`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    runAccountMove,
+}
+
+var (
+	moveTo    string
+	moveForce bool
+)
+
+func init() {
+	accountCmd.AddCommand(accountMoveCmd)
+
+	accountMoveCmd.Flags().StringVar(&accountOperatorID, "operator", "", "current operator ID or name (required)")
+	accountMoveCmd.Flags().StringVar(&moveTo, "to", "", "target operator ID or name (required)")
+	accountMoveCmd.Flags().BoolVarP(&moveForce, "force", "f", false, "skip confirmation prompt")
+	_ = accountMoveCmd.MarkFlagRequired("operator")
+	_ = accountMoveCmd.MarkFlagRequired("to")
+}
+
+func runAccountMove(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	fromID, err := resolveOperatorID(accountOperatorID)
+	if err != nil {
+		return err
+	}
+	toID, err := resolveOperatorID(moveTo)
+	if err != nil {
+		return err
+	}
+	accountResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: fromID,
+		Name:       name,
+	}))
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+
+	if !moveForce && !client.Confirm(fmt.Sprintf("Move account '%s' from %s to %s? It is deleted from the clusters of %s.",
+		name, accountOperatorID, moveTo, accountOperatorID)) {
+		printer.PrintMessage("Move cancelled")
+		return nil
+	}
+
+	resp, err := GetClient().Account.MoveAccount(context.Background(), connect.NewRequest(&nisv1.MoveAccountRequest{
+		AccountId:        accountResp.Msg.Account.Id,
+		TargetOperatorId: toID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to move account: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		printer.PrintID(resp.Msg.Account.Id)
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg)
+	}
+
+	printer.PrintSuccess("Account '%s' moved to %s", name, moveTo)
+	for _, removal := range resp.Msg.Removed {
+		if removal.Error != "" {
+			printer.PrintError("Failed to delete the account from cluster %s, the next pruning sync retries: %s",
+				removal.ClusterName, removal.Error)
+			continue
+		}
+		printer.PrintMessage("Deleted from cluster %s", removal.ClusterName)
+	}
+	for _, push := range resp.Msg.Pushed {
+		if push.Error != "" {
+			printer.PrintError("Failed to push the account to cluster %s, sync it to retry: %s",
+				push.ClusterName, push.Error)
+			continue
+		}
+		printer.PrintMessage("Pushed to cluster %s", push.ClusterName)
+	}
+	for _, warning := range resp.Msg.Warnings {
+		printer.PrintWarning("%s", warning)
+	}
+	return nil
+}
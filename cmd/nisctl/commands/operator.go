@@ -128,7 +128,7 @@ func runOperatorList(cmd *cobra.Command, args []string) error {
 	}
 
 	if GetOutputFormat() == "table" {
-		headers := []string{"ID", "NAME", "SYSTEM ACCOUNT", "CREATED AT"}
+		headers := []string{"ID", "NAME", "SYSTEM ACCOUNT", "STATUS", "CREATED AT"}
 		rows := make([][]string, len(resp.Msg.Operators))
 
 		for i, op := range resp.Msg.Operators {
@@ -146,6 +146,7 @@ func runOperatorList(cmd *cobra.Command, args []string) error {
 				op.Id[:8] + "...",
 				op.Name,
 				systemAccount,
+				operatorStatus(op),
 				createdAt,
 			}
 		}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/client"
+)
+
+var accountPlaceCmd = &cobra.Command{
+	Use:   "place NAME",
+	Short: "Place an account on selected clusters",
+	Long: `Place an account on selected clusters of its operator. Sync only pushes the
+account to those clusters, and pruning removes it from the others. Accounts without
+placement go to every cluster.
+
+The account is deleted right away from the clusters it leaves. Sync the clusters it
+joins to push it there. A cluster of a supercluster brings the other members along:
+they receive the account over the gateways.`,
+	Example: `  nisctl account place tenant-eu --operator prod --cluster eu-west --cluster eu-central
+  nisctl account place tenant-eu --operator prod --everywhere`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAccountPlace,
+}
+
+var (
+	placementClusters   []string
+	placementEverywhere bool
+)
+
+func init() {
+	accountCmd.AddCommand(accountPlaceCmd)
+
+	accountPlaceCmd.Flags().StringVar(&accountOperatorID, "operator", "", "operator ID or name (required)")
+	accountPlaceCmd.Flags().StringSliceVar(&placementClusters, "cluster", nil, "cluster ID or name to place the account on (can be repeated)")
+	accountPlaceCmd.Flags().BoolVar(&placementEverywhere, "everywhere", false, "remove the placement, pushing the account to every cluster")
+	_ = accountPlaceCmd.MarkFlagRequired("operator")
+}
+
+func runAccountPlace(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	if placementEverywhere == (len(placementClusters) > 0) {
+		return fmt.Errorf("either --cluster or --everywhere is required")
+	}
+
+	operatorID, err := resolveOperatorID(accountOperatorID)
+	if err != nil {
+		return err
+	}
+	accountResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: operatorID,
+		Name:       name,
+	}))
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+
+	clusterIDs := make([]string, 0, len(placementClusters))
+	for _, idOrName := range placementClusters {
+		clusterID, err := resolveClusterID(idOrName)
+		if err != nil {
+			return err
+		}
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+
+	resp, err := GetClient().Account.SetAccountPlacement(context.Background(), connect.NewRequest(&nisv1.SetAccountPlacementRequest{
+		AccountId:  accountResp.Msg.Account.Id,
+		ClusterIds: clusterIDs,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to place account: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg)
+	}
+
+	printer.PrintSuccess("Account '%s' placed", name)
+	printAccountPlacement(printer, resp.Msg.Placement)
+	for _, removal := range resp.Msg.Removed {
+		if removal.Error != "" {
+			printer.PrintError("Failed to delete the account from cluster %s, the next pruning sync retries: %s",
+				removal.ClusterName, removal.Error)
+			continue
+		}
+		printer.PrintMessage("Deleted from cluster %s", removal.ClusterName)
+	}
+	return nil
+}
+
+// printAccountPlacement prints the clusters an account is pushed to
+func printAccountPlacement(printer *client.Printer, placement *nisv1.AccountPlacement) {
+	if placement.Everywhere {
+		printer.PrintMessage("Placement: every cluster")
+		return
+	}
+
+	names := make([]string, 0, len(placement.Clusters))
+	for _, cluster := range placement.Clusters {
+		if cluster.Assigned {
+			names = append(names, cluster.Name)
+		} else {
+			names = append(names, cluster.Name+" (supercluster)")
+		}
+	}
+	printer.PrintMessage("Placement: %s", strings.Join(names, ", "))
+}
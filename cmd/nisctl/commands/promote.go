@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/client"
+)
+
+var accountPromoteCmd = &cobra.Command{
+	Use:   "promote NAME",
+	Short: "Copy an account's configuration to another operator",
+	Long: `Copy an account's configuration to the account of the same name of another
+operator, e.g. from staging to prod, creating it if needed. The description,
+JetStream limits and scoped signing key templates are copied. Seeds are never copied:
+the target keeps its own keys and gets new ones for the scoped keys it lacks. Scoped
+keys only the target has are kept.
+
+The changes are previewed and confirmed before being applied.`,
+	Example: `  nisctl account promote orders --from staging --to prod
+  nisctl account promote orders --from staging --to prod --dry-run`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAccountPromote,
+}
+
+var (
+	promoteFrom   string
+	promoteTo     string
+	promoteDryRun bool
+	promoteYes    bool
+)
+
+func init() {
+	accountCmd.AddCommand(accountPromoteCmd)
+
+	accountPromoteCmd.Flags().StringVar(&promoteFrom, "from", "", "source operator ID or name (required)")
+	accountPromoteCmd.Flags().StringVar(&promoteTo, "to", "", "target operator ID or name (required)")
+	accountPromoteCmd.Flags().BoolVar(&promoteDryRun, "dry-run", false, "only show the changes")
+	accountPromoteCmd.Flags().BoolVarP(&promoteYes, "yes", "y", false, "skip confirmation prompt")
+	_ = accountPromoteCmd.MarkFlagRequired("from")
+	_ = accountPromoteCmd.MarkFlagRequired("to")
+}
+
+func runAccountPromote(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	fromID, err := resolveOperatorID(promoteFrom)
+	if err != nil {
+		return err
+	}
+	toID, err := resolveOperatorID(promoteTo)
+	if err != nil {
+		return err
+	}
+	accountResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: fromID,
+		Name:       name,
+	}))
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+
+	promote := func(dryRun bool) (*nisv1.PromoteAccountResponse, error) {
+		resp, err := GetClient().Account.PromoteAccount(context.Background(), connect.NewRequest(&nisv1.PromoteAccountRequest{
+			AccountId:        accountResp.Msg.Account.Id,
+			TargetOperatorId: toID,
+			DryRun:           dryRun,
+		}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to promote account: %w", err)
+		}
+		return resp.Msg, nil
+	}
+
+	preview, err := promote(true)
+	if err != nil {
+		return err
+	}
+	if GetOutputFormat() == "table" {
+		printPromotionChanges(printer, name, preview)
+	}
+	if promoteDryRun || (!preview.Created && len(preview.Changes) == 0) {
+		if GetOutputFormat() != "table" {
+			return printer.PrintObject(preview)
+		}
+		return nil
+	}
+
+	if !promoteYes && !client.Confirm(fmt.Sprintf("Promote account '%s' from %s to %s?", name, promoteFrom, promoteTo)) {
+		printer.PrintMessage("Promotion cancelled")
+		return nil
+	}
+
+	resp, err := promote(false)
+	if err != nil {
+		return err
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		printer.PrintID(resp.Account.Id)
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp)
+	}
+
+	if resp.Created {
+		printer.PrintSuccess("Account '%s' created in %s", name, promoteTo)
+	} else {
+		printer.PrintSuccess("Account '%s' updated in %s", name, promoteTo)
+	}
+	printer.PrintMessage("Sync the clusters of %s to push it", promoteTo)
+	return nil
+}
+
+// printPromotionChanges prints the changes a promotion makes to the target account
+func printPromotionChanges(printer *client.Printer, name string, resp *nisv1.PromoteAccountResponse) {
+	switch {
+	case resp.Created:
+		printer.PrintMessage("Account '%s' will be created:", name)
+	case len(resp.Changes) == 0:
+		printer.PrintMessage("Account '%s' is up to date", name)
+		return
+	default:
+		printer.PrintMessage("Account '%s' will be updated:", name)
+	}
+
+	rows := make([][]string, 0, len(resp.Changes))
+	for _, change := range resp.Changes {
+		rows = append(rows, []string{change.Field, change.From, change.To})
+	}
+	_ = printer.PrintTable([]string{"FIELD", "FROM", "TO"}, rows)
+
+	for _, warning := range resp.Warnings {
+		printer.PrintWarning("%s", warning)
+	}
+}
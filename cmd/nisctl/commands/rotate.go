@@ -0,0 +1,177 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/client"
+)
+
+var userRotateCmd = &cobra.Command{
+	Use:   "rotate USER_NAME",
+	Short: "Rotate the credentials of a user",
+	Long: `Rotate the credentials of a user. The user keeps its ID, name and scoped signing
+key, and is given a new key and JWT. The old key keeps working for the grace
+period, so clients can be given the new credentials, then it is revoked in the
+account JWT pushed to the clusters. With a grace period of 0, the old key is
+revoked right away.
+
+Download the new credentials with user creds.`,
+	Example: `  nisctl user rotate app --operator prod --account orders --grace 24h`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    runUserRotate,
+}
+
+var userKeysCmd = &cobra.Command{
+	Use:     "keys USER_NAME",
+	Short:   "List the keys a user held before its credential rotations",
+	Example: `  nisctl user keys app --operator prod --account orders`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    runUserKeys,
+}
+
+var (
+	rotateGrace time.Duration
+	rotateForce bool
+)
+
+func init() {
+	userCmd.AddCommand(userRotateCmd)
+	userCmd.AddCommand(userKeysCmd)
+
+	userRotateCmd.Flags().StringVar(&userOperatorID, "operator", "", "operator ID or name (required)")
+	userRotateCmd.Flags().StringVar(&userAccountID, "account", "", "account name (required)")
+	userRotateCmd.Flags().DurationVar(&rotateGrace, "grace", time.Hour, "how long the old key keeps working (0 revokes it now)")
+	userRotateCmd.Flags().BoolVarP(&rotateForce, "force", "f", false, "skip confirmation prompt")
+	_ = userRotateCmd.MarkFlagRequired("operator")
+	_ = userRotateCmd.MarkFlagRequired("account")
+
+	userKeysCmd.Flags().StringVar(&userOperatorID, "operator", "", "operator ID or name (required)")
+	userKeysCmd.Flags().StringVar(&userAccountID, "account", "", "account name (required)")
+	_ = userKeysCmd.MarkFlagRequired("operator")
+	_ = userKeysCmd.MarkFlagRequired("account")
+}
+
+func runUserRotate(cmd *cobra.Command, args []string) error {
+	userName := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve operator and account IDs
+	accountID, err := resolveAccountForUser()
+	if err != nil {
+		return err
+	}
+
+	// Get user by name to get ID
+	userResp, err := GetClient().User.GetUserByName(context.Background(), connect.NewRequest(&nisv1.GetUserByNameRequest{
+		AccountId: accountID,
+		Name:      userName,
+	}))
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	if rotateGrace == 0 && !rotateForce &&
+		!client.Confirm(fmt.Sprintf("Rotate user '%s' with no grace period? Its current credentials stop working now.", userName)) {
+		printer.PrintMessage("Rotation cancelled")
+		return nil
+	}
+
+	resp, err := GetClient().User.RotateUserCredentials(context.Background(), connect.NewRequest(&nisv1.RotateUserCredentialsRequest{
+		UserId:             userResp.Msg.User.Id,
+		GracePeriodSeconds: int64(rotateGrace / time.Second),
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to rotate user credentials: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		printer.PrintID(resp.Msg.User.PublicKey)
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg)
+	}
+
+	printer.PrintSuccess("Rotated credentials of user '%s', new key %s", resp.Msg.User.Name, resp.Msg.User.PublicKey)
+	retired := resp.Msg.RetiredKey
+	if retired.RevokedAt != nil {
+		printer.PrintMessage("Revoked key %s", retired.PublicKey)
+	} else {
+		printer.PrintMessage("Key %s is revoked at %s",
+			retired.PublicKey, retired.RevokeAt.AsTime().Local().Format("2006-01-02 15:04:05"))
+	}
+	for _, push := range resp.Msg.Pushed {
+		if push.Error != "" {
+			printer.PrintError("Failed to push account to cluster %s, sync it again: %s", push.ClusterName, push.Error)
+			continue
+		}
+		printer.PrintMessage("Pushed account to cluster %s", push.ClusterName)
+	}
+	printer.PrintMessage("Download the new credentials with: nisctl user creds %s --operator %s --account %s",
+		userName, userOperatorID, userAccountID)
+	return nil
+}
+
+func runUserKeys(cmd *cobra.Command, args []string) error {
+	userName := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve operator and account IDs
+	accountID, err := resolveAccountForUser()
+	if err != nil {
+		return err
+	}
+
+	// Get user by name to get ID
+	userResp, err := GetClient().User.GetUserByName(context.Background(), connect.NewRequest(&nisv1.GetUserByNameRequest{
+		AccountId: accountID,
+		Name:      userName,
+	}))
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	resp, err := GetClient().User.ListUserKeys(context.Background(), connect.NewRequest(&nisv1.ListUserKeysRequest{
+		UserId: userResp.Msg.User.Id,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to list user keys: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		for _, key := range resp.Msg.Keys {
+			printer.PrintID(key.PublicKey)
+		}
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg)
+	}
+
+	if len(resp.Msg.Keys) == 0 {
+		printer.PrintMessage("User %s was never rotated, its key is %s", userName, userResp.Msg.User.PublicKey)
+		return nil
+	}
+
+	headers := []string{"PUBLIC KEY", "CREATED", "RETIRED", "REVOKE AT", "STATUS"}
+	rows := make([][]string, len(resp.Msg.Keys))
+	for i, key := range resp.Msg.Keys {
+		status := "grace period"
+		if key.RevokedAt != nil {
+			status = "revoked"
+		}
+		rows[i] = []string{
+			key.PublicKey,
+			key.CreatedAt.AsTime().Local().Format("2006-01-02 15:04:05"),
+			key.RetiredAt.AsTime().Local().Format("2006-01-02 15:04:05"),
+			key.RevokeAt.AsTime().Local().Format("2006-01-02 15:04:05"),
+			status,
+		}
+	}
+	return printer.PrintTable(headers, rows)
+}
@@ -0,0 +1,282 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/client"
+)
+
+var rotationCmd = &cobra.Command{
+	Use:   "rotation",
+	Short: "Manage scheduled credential rotations",
+	Long: `Manage scheduled credential rotations. A rotation policy rotates the credentials
+of the users of an operator, an account or a scoped signing key on a schedule; the
+most specific policy covering a user applies. The leader nis instance rotates the
+users when their rotation is due, keeps their old key working for the grace period,
+and notifies the rotation webhook that new credentials are available.`,
+}
+
+var rotationPolicyCmd = &cobra.Command{
+	Use:   "policy",
+	Short: "Manage credential rotation policies",
+}
+
+var rotationPolicyCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Schedule the credential rotation of an operator, account or scoped key's users",
+	Example: `  nisctl rotation policy create --operator prod --every 720h --grace 24h
+  nisctl rotation policy create --operator prod --account orders --every 168h
+  nisctl rotation policy create --operator prod --account orders --scoped-key readonly --every 24h --grace 1h`,
+	Args: cobra.NoArgs,
+	RunE: runRotationPolicyCreate,
+}
+
+var rotationPolicyListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List credential rotation policies",
+	Args:  cobra.NoArgs,
+	RunE:  runRotationPolicyList,
+}
+
+var rotationPolicyDeleteCmd = &cobra.Command{
+	Use:   "delete ID",
+	Short: "Delete a credential rotation policy",
+	Long: `Delete a credential rotation policy. Users are no longer rotated by it; keys it
+already retired are still revoked at the end of their grace period.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRotationPolicyDelete,
+}
+
+var rotationPlanCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "List the upcoming scheduled credential rotations",
+	Example: `  nisctl rotation plan --within 168h
+  nisctl rotation plan --operator prod`,
+	Args: cobra.NoArgs,
+	RunE: runRotationPlan,
+}
+
+var (
+	rotationOperator  string
+	rotationAccount   string
+	rotationScopedKey string
+	rotationEvery     time.Duration
+	rotationGrace     time.Duration
+	rotationWithin    time.Duration
+	rotationForce     bool
+)
+
+func init() {
+	rootCmd.AddCommand(rotationCmd)
+	rotationCmd.AddCommand(rotationPolicyCmd)
+	rotationCmd.AddCommand(rotationPlanCmd)
+	rotationPolicyCmd.AddCommand(rotationPolicyCreateCmd)
+	rotationPolicyCmd.AddCommand(rotationPolicyListCmd)
+	rotationPolicyCmd.AddCommand(rotationPolicyDeleteCmd)
+
+	rotationPolicyCreateCmd.Flags().StringVar(&rotationOperator, "operator", "", "operator ID or name (required)")
+	rotationPolicyCreateCmd.Flags().StringVar(&rotationAccount, "account", "", "account name, for the users of an account")
+	rotationPolicyCreateCmd.Flags().StringVar(&rotationScopedKey, "scoped-key", "", "scoped signing key name, for the users it signs (requires --account)")
+	rotationPolicyCreateCmd.Flags().DurationVar(&rotationEvery, "every", 0, "how often the users are rotated, at least 1h (required)")
+	rotationPolicyCreateCmd.Flags().DurationVar(&rotationGrace, "grace", time.Hour, "how long the old keys keep working, shorter than --every")
+	_ = rotationPolicyCreateCmd.MarkFlagRequired("operator")
+	_ = rotationPolicyCreateCmd.MarkFlagRequired("every")
+
+	rotationPolicyListCmd.Flags().StringVar(&rotationOperator, "operator", "", "only list the policies of this operator (ID or name)")
+
+	rotationPolicyDeleteCmd.Flags().BoolVarP(&rotationForce, "force", "f", false, "skip confirmation prompt")
+
+	rotationPlanCmd.Flags().StringVar(&rotationOperator, "operator", "", "only plan the rotations of this operator (ID or name)")
+	rotationPlanCmd.Flags().DurationVar(&rotationWithin, "within", 0, "only list the rotations due within this duration (default: all)")
+}
+
+func runRotationPolicyCreate(cmd *cobra.Command, args []string) error {
+	printer := client.NewPrinter(GetOutputFormat())
+
+	if rotationScopedKey != "" && rotationAccount == "" {
+		return fmt.Errorf("--scoped-key requires --account")
+	}
+
+	operatorID, err := resolveOperatorID(rotationOperator)
+	if err != nil {
+		return err
+	}
+	req := &nisv1.CreateRotationPolicyRequest{
+		OperatorId:         operatorID,
+		IntervalSeconds:    int64(rotationEvery / time.Second),
+		GracePeriodSeconds: int64(rotationGrace / time.Second),
+	}
+
+	if rotationAccount != "" {
+		accountResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+			OperatorId: operatorID,
+			Name:       rotationAccount,
+		}))
+		if err != nil {
+			return fmt.Errorf("account not found: %w", err)
+		}
+		req.AccountId = accountResp.Msg.Account.Id
+	}
+	if rotationScopedKey != "" {
+		keyResp, err := GetClient().ScopedSigningKey.GetScopedSigningKeyByName(context.Background(), connect.NewRequest(&nisv1.GetScopedSigningKeyByNameRequest{
+			AccountId: req.AccountId,
+			Name:      rotationScopedKey,
+		}))
+		if err != nil {
+			return fmt.Errorf("scoped signing key not found: %w", err)
+		}
+		req.ScopedSigningKeyId = keyResp.Msg.Key.Id
+	}
+
+	resp, err := GetClient().User.CreateRotationPolicy(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to create rotation policy: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		printer.PrintID(resp.Msg.Policy.Id)
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg.Policy)
+	}
+
+	printer.PrintSuccess("Rotation policy %s created: %s users rotated every %s, old keys revoked after %s",
+		resp.Msg.Policy.Id, resp.Msg.Policy.Scope, rotationEvery, rotationGrace)
+	printer.PrintMessage("Check the upcoming rotations with: nisctl rotation plan")
+	return nil
+}
+
+func runRotationPolicyList(cmd *cobra.Command, args []string) error {
+	printer := client.NewPrinter(GetOutputFormat())
+
+	req := &nisv1.ListRotationPoliciesRequest{}
+	if rotationOperator != "" {
+		operatorID, err := resolveOperatorID(rotationOperator)
+		if err != nil {
+			return err
+		}
+		req.OperatorId = operatorID
+	}
+
+	resp, err := GetClient().User.ListRotationPolicies(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to list rotation policies: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		for _, policy := range resp.Msg.Policies {
+			printer.PrintID(policy.Id)
+		}
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg.Policies)
+	}
+
+	if len(resp.Msg.Policies) == 0 {
+		printer.PrintMessage("No rotation policies found")
+		return nil
+	}
+
+	headers := []string{"ID", "SCOPE", "TARGET", "EVERY", "GRACE", "CREATED BY"}
+	rows := make([][]string, len(resp.Msg.Policies))
+	for i, policy := range resp.Msg.Policies {
+		target := policy.OperatorId
+		switch {
+		case policy.ScopedSigningKeyId != "":
+			target = policy.ScopedSigningKeyId
+		case policy.AccountId != "":
+			target = policy.AccountId
+		}
+		rows[i] = []string{
+			policy.Id,
+			policy.Scope,
+			target,
+			(time.Duration(policy.IntervalSeconds) * time.Second).String(),
+			(time.Duration(policy.GracePeriodSeconds) * time.Second).String(),
+			policy.CreatedBy,
+		}
+	}
+	return printer.PrintTable(headers, rows)
+}
+
+func runRotationPolicyDelete(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	if !rotationForce && !client.Confirm(fmt.Sprintf("Delete rotation policy '%s'?", id)) {
+		printer.PrintMessage("Deletion cancelled")
+		return nil
+	}
+
+	_, err := GetClient().User.DeleteRotationPolicy(context.Background(), connect.NewRequest(&nisv1.DeleteRotationPolicyRequest{
+		Id: id,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to delete rotation policy: %w", err)
+	}
+
+	printer.PrintSuccess("Rotation policy %s deleted", id)
+	return nil
+}
+
+func runRotationPlan(cmd *cobra.Command, args []string) error {
+	printer := client.NewPrinter(GetOutputFormat())
+
+	req := &nisv1.PlanRotationsRequest{
+		WithinSeconds: int64(rotationWithin / time.Second),
+	}
+	if rotationOperator != "" {
+		operatorID, err := resolveOperatorID(rotationOperator)
+		if err != nil {
+			return err
+		}
+		req.OperatorId = operatorID
+	}
+
+	resp, err := GetClient().User.PlanRotations(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to plan rotations: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		for _, rotation := range resp.Msg.Rotations {
+			printer.PrintID(rotation.UserId)
+		}
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg.Rotations)
+	}
+
+	if len(resp.Msg.Rotations) == 0 {
+		printer.PrintMessage("No upcoming rotations")
+		return nil
+	}
+
+	now := time.Now()
+	headers := []string{"OPERATOR", "ACCOUNT", "USER", "POLICY", "LAST ROTATED", "DUE"}
+	rows := make([][]string, len(resp.Msg.Rotations))
+	for i, rotation := range resp.Msg.Rotations {
+		due := rotation.DueAt.AsTime().Local().Format("2006-01-02 15:04:05")
+		if rotation.DueAt.AsTime().Before(now) {
+			due += " (overdue)"
+		}
+		rows[i] = []string{
+			rotation.OperatorName,
+			rotation.AccountName,
+			rotation.UserName,
+			strings.ReplaceAll(rotation.Scope, "_", " "),
+			rotation.LastRotatedAt.AsTime().Local().Format("2006-01-02 15:04:05"),
+			due,
+		}
+	}
+	return printer.PrintTable(headers, rows)
+}
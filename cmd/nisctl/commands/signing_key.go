@@ -3,6 +3,8 @@ package commands
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
@@ -14,7 +16,7 @@ var signingKeyCmd = &cobra.Command{
 	Use:     "signing-key",
 	Aliases: []string{"scoped-key", "sk"},
 	Short:   "Manage scoped signing keys",
-	Long:    `Create, list, and delete scoped signing keys for accounts.`,
+	Long:    `Create, list, rotate, and delete scoped signing keys for accounts.`,
 }
 
 var signingKeyCreateCmd = &cobra.Command{
@@ -45,10 +47,26 @@ var signingKeyDeleteCmd = &cobra.Command{
 	RunE:  runSigningKeyDelete,
 }
 
+var signingKeyRotateCmd = &cobra.Command{
+	Use:   "rotate ID",
+	Short: "Rotate a scoped signing key",
+	Long: `Rotate a scoped signing key. The key keeps its ID, name and template, and is
+given a new key pair. The account JWT trusting the new key is pushed to the
+clusters, then every user signed by the key is given a JWT signed by the new key.
+The old key stays trusted for the grace period, so clients can be given their new
+credentials; with a grace period of 0, they are rejected until they are.
+
+When a step fails, the key, the account and the users are restored.`,
+	Example: `  nisctl signing-key rotate 6f1c2b1e-0c3a-4c55-9d4e-3a1f0b2c4d5e --grace 24h`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    runSigningKeyRotate,
+}
+
 var (
 	signingKeyOperatorID string
 	signingKeyAccountID  string
 	signingKeyForce      bool
+	signingKeyGrace      time.Duration
 )
 
 func init() {
@@ -58,6 +76,7 @@ func init() {
 	signingKeyCmd.AddCommand(signingKeyListCmd)
 	signingKeyCmd.AddCommand(signingKeyGetCmd)
 	signingKeyCmd.AddCommand(signingKeyDeleteCmd)
+	signingKeyCmd.AddCommand(signingKeyRotateCmd)
 
 	signingKeyCreateCmd.Flags().StringVar(&signingKeyOperatorID, "operator", "", "operator ID or name (required)")
 	signingKeyCreateCmd.Flags().StringVar(&signingKeyAccountID, "account", "", "account name (required)")
@@ -68,6 +87,9 @@ func init() {
 	_ = signingKeyListCmd.MarkFlagRequired("operator")
 
 	signingKeyDeleteCmd.Flags().BoolVarP(&signingKeyForce, "force", "f", false, "skip confirmation prompt")
+
+	signingKeyRotateCmd.Flags().DurationVar(&signingKeyGrace, "grace", time.Hour, "how long the old key stays trusted (0 stops trusting it now)")
+	signingKeyRotateCmd.Flags().BoolVarP(&signingKeyForce, "force", "f", false, "skip confirmation prompt")
 }
 
 func runSigningKeyCreate(cmd *cobra.Command, args []string) error {
@@ -227,3 +249,71 @@ func runSigningKeyDelete(cmd *cobra.Command, args []string) error {
 
 	return nil
 }
+
+func runSigningKeyRotate(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	if signingKeyGrace == 0 && !signingKeyForce &&
+		!client.Confirm(fmt.Sprintf("Rotate scoped signing key '%s' with no grace period? Its users are rejected until they get their new credentials.", id)) {
+		printer.PrintMessage("Rotation cancelled")
+		return nil
+	}
+
+	stream, err := GetClient().ScopedSigningKey.RotateScopedSigningKey(context.Background(), connect.NewRequest(&nisv1.RotateScopedSigningKeyRequest{
+		KeyId:              id,
+		GracePeriodSeconds: int64(signingKeyGrace / time.Second),
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to rotate scoped signing key: %w", err)
+	}
+	defer func() { _ = stream.Close() }()
+
+	// The progress of the re-signing comes first, the result last
+	var result *nisv1.RotateScopedSigningKeyResponse
+	for stream.Receive() {
+		msg := stream.Msg()
+		if msg.Progress == nil {
+			result = msg
+			continue
+		}
+		if GetOutputFormat() == "table" {
+			fmt.Fprintf(os.Stderr, "Re-signed %d/%d users\n", msg.Progress.ReissuedUsers+msg.Progress.RevokedUsers, msg.Progress.Users)
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return fmt.Errorf("failed to rotate scoped signing key: %w", err)
+	}
+	if result == nil {
+		return fmt.Errorf("failed to rotate scoped signing key: the server sent no result")
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		printer.PrintID(result.Key.PublicKey)
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(result)
+	}
+
+	printer.PrintSuccess("Rotated scoped signing key '%s', new key %s", result.Key.Name, result.Key.PublicKey)
+	for _, push := range result.Pushed {
+		if push.Error != "" {
+			printer.PrintError("Failed to push account to cluster %s, sync it again: %s", push.ClusterName, push.Error)
+			continue
+		}
+		printer.PrintMessage("Pushed account to cluster %s", push.ClusterName)
+	}
+	printer.PrintMessage("Re-signed %d users", result.ReissuedUsers)
+	if result.RevokedUsers > 0 {
+		printer.PrintMessage("Left %d users revoked by their account", result.RevokedUsers)
+	}
+	if signingKeyGrace == 0 {
+		printer.PrintMessage("Key %s is no longer trusted", result.RetiredPublicKey)
+	} else {
+		printer.PrintMessage("Key %s is trusted until %s",
+			result.RetiredPublicKey, result.RetireAt.AsTime().Local().Format("2006-01-02 15:04:05"))
+	}
+	printer.PrintMessage("Give the users their new credentials with: nisctl user creds")
+	return nil
+}
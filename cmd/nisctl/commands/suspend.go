@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/client"
+)
+
+var accountSuspendCmd = &cobra.Command{
+	Use:   "suspend NAME",
+	Short: "Suspend an account, disconnecting its clients",
+	Long: `Suspend an account. Its JWT is re-signed allowing no connection and pushed to
+its clusters, whose servers disconnect its clients and refuse new ones. The
+suspension is recorded with the API user and the reason, and undone by resume.
+
+--revoke-users also revokes every user JWT issued so far. The revocation survives
+resume: the users need new credentials.`,
+	Example: `  nisctl account suspend tenant-x --operator prod --reason "unpaid invoice"
+  nisctl account suspend tenant-x --operator prod --reason "leaked credentials" --revoke-users`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAccountSuspend,
+}
+
+var accountResumeCmd = &cobra.Command{
+	Use:     "resume NAME",
+	Short:   "Resume a suspended account",
+	Example: `  nisctl account resume tenant-x --operator prod`,
+	Args:    cobra.ExactArgs(1),
+	RunE:    runAccountResume,
+}
+
+var (
+	suspendReason      string
+	suspendRevokeUsers bool
+	suspendForce       bool
+)
+
+func init() {
+	accountCmd.AddCommand(accountSuspendCmd)
+	accountCmd.AddCommand(accountResumeCmd)
+
+	accountSuspendCmd.Flags().StringVar(&accountOperatorID, "operator", "", "operator ID or name (required)")
+	accountSuspendCmd.Flags().StringVar(&suspendReason, "reason", "", "why the account is suspended (required)")
+	accountSuspendCmd.Flags().BoolVar(&suspendRevokeUsers, "revoke-users", false, "revoke every user JWT issued so far")
+	accountSuspendCmd.Flags().BoolVarP(&suspendForce, "force", "f", false, "skip confirmation prompt")
+	_ = accountSuspendCmd.MarkFlagRequired("operator")
+	_ = accountSuspendCmd.MarkFlagRequired("reason")
+
+	accountResumeCmd.Flags().StringVar(&accountOperatorID, "operator", "", "operator ID or name (required)")
+	_ = accountResumeCmd.MarkFlagRequired("operator")
+}
+
+func runAccountSuspend(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	operatorID, err := resolveOperatorID(accountOperatorID)
+	if err != nil {
+		return err
+	}
+	getResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: operatorID,
+		Name:       name,
+	}))
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+	accountID := getResp.Msg.Account.Id
+
+	if !suspendForce {
+		message := fmt.Sprintf("Suspend account '%s'? Its clients are disconnected.", name)
+		if suspendRevokeUsers {
+			message = fmt.Sprintf("Suspend account '%s' and revoke all its users? They will need new credentials.", name)
+		}
+		if !client.Confirm(message) {
+			printer.PrintMessage("Suspension cancelled")
+			return nil
+		}
+	}
+
+	resp, err := GetClient().Account.SuspendAccount(context.Background(), connect.NewRequest(&nisv1.SuspendAccountRequest{
+		AccountId:   accountID,
+		Reason:      suspendReason,
+		RevokeUsers: suspendRevokeUsers,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to suspend account: %w", err)
+	}
+
+	return printAccountPushes(printer, fmt.Sprintf("Account '%s' suspended", name), resp.Msg.Account, resp.Msg.Pushed, resp.Msg)
+}
+
+func runAccountResume(cmd *cobra.Command, args []string) error {
+	name := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	operatorID, err := resolveOperatorID(accountOperatorID)
+	if err != nil {
+		return err
+	}
+	getResp, err := GetClient().Account.GetAccountByName(context.Background(), connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: operatorID,
+		Name:       name,
+	}))
+	if err != nil {
+		return fmt.Errorf("account not found: %w", err)
+	}
+	accountID := getResp.Msg.Account.Id
+
+	resp, err := GetClient().Account.ResumeAccount(context.Background(), connect.NewRequest(&nisv1.ResumeAccountRequest{
+		AccountId: accountID,
+	}))
+	if err != nil {
+		return fmt.Errorf("failed to resume account: %w", err)
+	}
+
+	if err := printAccountPushes(printer, fmt.Sprintf("Account '%s' resumed", name), resp.Msg.Account, resp.Msg.Pushed, resp.Msg); err != nil {
+		return err
+	}
+	if GetOutputFormat() == "table" && resp.Msg.Account.UsersRevokedAt != nil {
+		printer.PrintWarning("User JWTs issued before %s stay revoked",
+			resp.Msg.Account.UsersRevokedAt.AsTime().Format("2006-01-02 15:04:05"))
+	}
+	return nil
+}
+
+// printAccountPushes prints the outcome of pushing a re-signed account JWT to its clusters
+func printAccountPushes(printer *client.Printer, success string, account *nisv1.Account, pushes []*nisv1.AccountPush, msg any) error {
+	switch GetOutputFormat() {
+	case "quiet":
+		printer.PrintID(account.Id)
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(msg)
+	}
+
+	printer.PrintSuccess("%s", success)
+	for _, push := range pushes {
+		if push.Error != "" {
+			printer.PrintError("Failed to push the account to cluster %s, sync it to retry: %s",
+				push.ClusterName, push.Error)
+			continue
+		}
+		printer.PrintMessage("Pushed to cluster %s", push.ClusterName)
+	}
+	return nil
+}
+
+// accountStatus describes whether an account is active or suspended
+func accountStatus(account *nisv1.Account) string {
+	if account.SuspendedAt != nil {
+		return "suspended"
+	}
+	return "active"
+}
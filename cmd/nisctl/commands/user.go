@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
@@ -27,8 +28,13 @@ var userCreateCmd = &cobra.Command{
 var userListCmd = &cobra.Command{
 	Use:   "list ACCOUNT_NAME",
 	Short: "List users for an account",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runUserList,
+	Long: `List users for an account, with the last time each was seen on a cluster.
+
+Activity is recorded from the clusters' connect and disconnect events while
+nis serve is running. Use --stale-days to only list the users whose credentials
+were not seen for that many days, including users that never connected.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runUserList,
 }
 
 var userGetCmd = &cobra.Command{
@@ -53,6 +59,25 @@ var userDeleteCmd = &cobra.Command{
 	RunE:  runUserDelete,
 }
 
+var userConnectionsCmd = &cobra.Command{
+	Use:   "connections NAME",
+	Short: "List the live connections of a user",
+	Long:  `List the client connections authenticated as a user on every server of the operator's clusters.`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  runUserConnections,
+}
+
+var userKickCmd = &cobra.Command{
+	Use:   "kick NAME",
+	Short: "Disconnect every live connection of a user",
+	Long: `Disconnect every live connection of a user on the operator's clusters.
+
+Clients reconnect right away unless their credentials were revoked or rotated
+first, so kick a user after deleting it or rotating its credentials.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runUserKick,
+}
+
 var (
 	userOperatorID      string
 	userAccountID       string
@@ -60,6 +85,7 @@ var (
 	userScopedKeyID     string
 	userCredsOutputFile string
 	userForce           bool
+	userStaleDays       int32
 )
 
 func init() {
@@ -70,6 +96,8 @@ func init() {
 	userCmd.AddCommand(userGetCmd)
 	userCmd.AddCommand(userCredsCmd)
 	userCmd.AddCommand(userDeleteCmd)
+	userCmd.AddCommand(userConnectionsCmd)
+	userCmd.AddCommand(userKickCmd)
 
 	// Create flags
 	userCreateCmd.Flags().StringVar(&userOperatorID, "operator", "", "operator ID or name (required)")
@@ -81,6 +109,7 @@ func init() {
 
 	// List flags
 	userListCmd.Flags().StringVar(&userOperatorID, "operator", "", "operator ID or name (required)")
+	userListCmd.Flags().Int32Var(&userStaleDays, "stale-days", 0, "only list users not seen for this many days")
 	_ = userListCmd.MarkFlagRequired("operator")
 
 	// Get flags
@@ -102,6 +131,21 @@ func init() {
 	userDeleteCmd.Flags().BoolVarP(&userForce, "force", "f", false, "skip confirmation prompt")
 	_ = userDeleteCmd.MarkFlagRequired("operator")
 	_ = userDeleteCmd.MarkFlagRequired("account")
+
+	// Connections flags
+	userConnectionsCmd.Flags().StringVar(&userOperatorID, "operator", "", "operator ID or name (required)")
+	userConnectionsCmd.Flags().StringVar(&userAccountID, "account", "", "account name (required)")
+	userConnectionsCmd.Flags().StringVar(&connectionsCluster, "cluster", "", "only query this cluster (ID or name)")
+	userConnectionsCmd.Flags().Int32Var(&connectionsLimit, "limit", 0, "maximum connections per server (default: server setting)")
+	_ = userConnectionsCmd.MarkFlagRequired("operator")
+	_ = userConnectionsCmd.MarkFlagRequired("account")
+
+	// Kick flags
+	userKickCmd.Flags().StringVar(&userOperatorID, "operator", "", "operator ID or name (required)")
+	userKickCmd.Flags().StringVar(&userAccountID, "account", "", "account name (required)")
+	userKickCmd.Flags().StringVar(&connectionsCluster, "cluster", "", "only disconnect on this cluster (ID or name)")
+	_ = userKickCmd.MarkFlagRequired("operator")
+	_ = userKickCmd.MarkFlagRequired("account")
 }
 
 func runUserCreate(cmd *cobra.Command, args []string) error {
@@ -171,6 +215,7 @@ func runUserList(cmd *cobra.Command, args []string) error {
 
 	req := connect.NewRequest(&nisv1.ListUsersRequest{
 		AccountId: accountResp.Msg.Account.Id,
+		StaleDays: userStaleDays,
 	})
 
 	resp, err := GetClient().User.ListUsers(context.Background(), req)
@@ -186,7 +231,7 @@ func runUserList(cmd *cobra.Command, args []string) error {
 	}
 
 	if GetOutputFormat() == "table" {
-		headers := []string{"ID", "NAME", "ACCOUNT", "SCOPED KEY", "CREATED AT"}
+		headers := []string{"ID", "NAME", "ACCOUNT", "SCOPED KEY", "LAST SEEN", "LAST IP", "CONNECTIONS", "CREATED AT"}
 		rows := make([][]string, len(resp.Msg.Users))
 
 		for i, user := range resp.Msg.Users {
@@ -200,11 +245,24 @@ func runUserList(cmd *cobra.Command, args []string) error {
 				createdAt = user.CreatedAt.AsTime().Format("2006-01-02 15:04:05")
 			}
 
+			lastSeen := "never"
+			if user.LastSeenAt != nil {
+				lastSeen = user.LastSeenAt.AsTime().Local().Format("2006-01-02 15:04:05")
+			}
+
+			lastIP := "-"
+			if user.LastRemoteIp != "" {
+				lastIP = user.LastRemoteIp
+			}
+
 			rows[i] = []string{
 				user.Id[:8] + "...",
 				user.Name,
 				user.AccountId[:8] + "...",
 				scopedKey,
+				lastSeen,
+				lastIP,
+				fmt.Sprintf("%d", user.ConnectionCount),
 				createdAt,
 			}
 		}
@@ -340,6 +398,118 @@ func runUserDelete(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func runUserConnections(cmd *cobra.Command, args []string) error {
+	userName := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve operator and account IDs
+	accountID, err := resolveAccountForUser()
+	if err != nil {
+		return err
+	}
+
+	// Get user by name to get ID
+	userResp, err := GetClient().User.GetUserByName(context.Background(), connect.NewRequest(&nisv1.GetUserByNameRequest{
+		AccountId: accountID,
+		Name:      userName,
+	}))
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	req := &nisv1.ListConnectionsRequest{
+		UserId: userResp.Msg.User.Id,
+		Limit:  connectionsLimit,
+	}
+	if connectionsCluster != "" {
+		if req.ClusterId, err = resolveClusterID(connectionsCluster); err != nil {
+			return err
+		}
+	}
+
+	resp, err := GetClient().Cluster.ListConnections(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to list connections: %w", err)
+	}
+
+	return printConnections(printer, resp.Msg)
+}
+
+func runUserKick(cmd *cobra.Command, args []string) error {
+	userName := args[0]
+	printer := client.NewPrinter(GetOutputFormat())
+
+	// Resolve operator and account IDs
+	accountID, err := resolveAccountForUser()
+	if err != nil {
+		return err
+	}
+
+	// Get user by name to get ID
+	userResp, err := GetClient().User.GetUserByName(context.Background(), connect.NewRequest(&nisv1.GetUserByNameRequest{
+		AccountId: accountID,
+		Name:      userName,
+	}))
+	if err != nil {
+		return fmt.Errorf("user not found: %w", err)
+	}
+
+	req := &nisv1.DisconnectUserRequest{UserId: userResp.Msg.User.Id}
+	if connectionsCluster != "" {
+		if req.ClusterId, err = resolveClusterID(connectionsCluster); err != nil {
+			return err
+		}
+	}
+
+	resp, err := GetClient().Cluster.DisconnectUser(context.Background(), connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("failed to disconnect user: %w", err)
+	}
+
+	switch GetOutputFormat() {
+	case "quiet":
+		fmt.Println(resp.Msg.Disconnected)
+		return nil
+	case "json", "yaml":
+		return printer.PrintObject(resp.Msg)
+	}
+
+	for _, warning := range resp.Msg.Warnings {
+		printer.PrintWarning("%s", warning)
+	}
+
+	if len(resp.Msg.Servers) == 0 {
+		printer.PrintMessage("User %s has no live connections", userName)
+		return nil
+	}
+
+	headers := []string{"CLUSTER", "SERVER", "FOUND", "DISCONNECTED", "ERRORS"}
+	rows := make([][]string, len(resp.Msg.Servers))
+	for i, srv := range resp.Msg.Servers {
+		server := srv.ServerName
+		if server == "" {
+			server = srv.ServerId
+		}
+		errs := "-"
+		if len(srv.Errors) > 0 {
+			errs = strings.Join(srv.Errors, "; ")
+		}
+		rows[i] = []string{
+			srv.ClusterName,
+			server,
+			fmt.Sprintf("%d", srv.Found),
+			fmt.Sprintf("%d", srv.Disconnected),
+			errs,
+		}
+	}
+	if err := printer.PrintTable(headers, rows); err != nil {
+		return err
+	}
+
+	printer.PrintSuccess("Disconnected %d session(s) of user %s", resp.Msg.Disconnected, userName)
+	return nil
+}
+
 // Helper function to resolve account ID for user commands (requires operator and account flags)
 func resolveAccountForUser() (string, error) {
 	// Resolve operator ID
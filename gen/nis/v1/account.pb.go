@@ -34,8 +34,15 @@ type Account struct {
 	JetstreamLimits *JetStreamLimits       `protobuf:"bytes,7,opt,name=jetstream_limits,json=jetstreamLimits,proto3" json:"jetstream_limits,omitempty"`
 	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Set while the account is suspended: its JWT allows no connection
+	SuspendedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"`
+	// API user who suspended the account
+	SuspendedBy   string `protobuf:"bytes,11,opt,name=suspended_by,json=suspendedBy,proto3" json:"suspended_by,omitempty"`
+	SuspendReason string `protobuf:"bytes,12,opt,name=suspend_reason,json=suspendReason,proto3" json:"suspend_reason,omitempty"`
+	// User JWTs issued before are revoked
+	UsersRevokedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=users_revoked_at,json=usersRevokedAt,proto3" json:"users_revoked_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Account) Reset() {
@@ -131,6 +138,34 @@ func (x *Account) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Account) GetSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return nil
+}
+
+func (x *Account) GetSuspendedBy() string {
+	if x != nil {
+		return x.SuspendedBy
+	}
+	return ""
+}
+
+func (x *Account) GetSuspendReason() string {
+	if x != nil {
+		return x.SuspendReason
+	}
+	return ""
+}
+
+func (x *Account) GetUsersRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UsersRevokedAt
+	}
+	return nil
+}
+
 // CreateAccountRequest is the request to create a new account
 type CreateAccountRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -202,8 +237,10 @@ func (x *CreateAccountRequest) GetJetstreamLimits() *JetStreamLimits {
 
 // CreateAccountResponse is the response from creating an account
 type CreateAccountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Account *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Clusters whose JetStream capacity the account's limits over-commit
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -245,6 +282,13 @@ func (x *CreateAccountResponse) GetAccount() *Account {
 	return nil
 }
 
+func (x *CreateAccountResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 // GetAccountRequest is the request to get an account by ID
 type GetAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -692,8 +736,10 @@ func (x *UpdateJetStreamLimitsRequest) GetLimits() *JetStreamLimits {
 
 // UpdateJetStreamLimitsResponse is the response from updating JetStream limits
 type UpdateJetStreamLimitsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Account *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Clusters whose JetStream capacity the account's limits over-commit
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -735,6 +781,13 @@ func (x *UpdateJetStreamLimitsResponse) GetAccount() *Account {
 	return nil
 }
 
+func (x *UpdateJetStreamLimitsResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
 // DeleteAccountRequest is the request to delete an account
 type DeleteAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -899,68 +952,1628 @@ func (*PushAccountJWTResponse) Descriptor() ([]byte, []int) {
 	return file_nis_v1_account_proto_rawDescGZIP(), []int{16}
 }
 
-var File_nis_v1_account_proto protoreflect.FileDescriptor
+// PromotionChange is one configuration value a promotion changes on the target account
+type PromotionChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// e.g. "jetstream.max_memory" or "scoped_keys.app.pub_allow"
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Empty when the target does not have it yet
+	From          string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_nis_v1_account_proto_rawDesc = "" +
-	"\n" +
-	"\x14nis/v1/account.proto\x12\x06nis.v1\x1a\x13nis/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xdb\x02\n" +
-	"\aAccount\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
-	"\voperator_id\x18\x02 \x01(\tR\n" +
-	"operatorId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"public_key\x18\x05 \x01(\tR\tpublicKey\x12\x10\n" +
-	"\x03jwt\x18\x06 \x01(\tR\x03jwt\x12B\n" +
-	"\x10jetstream_limits\x18\a \x01(\v2\x17.nis.v1.JetStreamLimitsR\x0fjetstreamLimits\x129\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb1\x01\n" +
-	"\x14CreateAccountRequest\x12\x1f\n" +
-	"\voperator_id\x18\x01 \x01(\tR\n" +
-	"operatorId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12B\n" +
-	"\x10jetstream_limits\x18\x04 \x01(\v2\x17.nis.v1.JetStreamLimitsR\x0fjetstreamLimits\"B\n" +
-	"\x15CreateAccountResponse\x12)\n" +
-	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\"#\n" +
-	"\x11GetAccountRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
-	"\x12GetAccountResponse\x12)\n" +
-	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\"N\n" +
-	"\x17GetAccountByNameRequest\x12\x1f\n" +
-	"\voperator_id\x18\x01 \x01(\tR\n" +
-	"operatorId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"E\n" +
-	"\x18GetAccountByNameResponse\x12)\n" +
-	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\"e\n" +
-	"\x13ListAccountsRequest\x12\x1f\n" +
-	"\voperator_id\x18\x01 \x01(\tR\n" +
-	"operatorId\x12-\n" +
-	"\aoptions\x18\x02 \x01(\v2\x13.nis.v1.ListOptionsR\aoptions\"C\n" +
-	"\x14ListAccountsResponse\x12+\n" +
-	"\baccounts\x18\x01 \x03(\v2\x0f.nis.v1.AccountR\baccounts\"\x7f\n" +
-	"\x14UpdateAccountRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
-	"\x05_nameB\x0e\n" +
-	"\f_description\"B\n" +
-	"\x15UpdateAccountResponse\x12)\n" +
-	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\"_\n" +
-	"\x1cUpdateJetStreamLimitsRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
-	"\x06limits\x18\x02 \x01(\v2\x17.nis.v1.JetStreamLimitsR\x06limits\"J\n" +
-	"\x1dUpdateJetStreamLimitsResponse\x12)\n" +
-	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\"&\n" +
-	"\x14DeleteAccountRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
-	"\x15DeleteAccountResponse\"'\n" +
-	"\x15PushAccountJWTRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
-	"\x16PushAccountJWTResponse2\x98\x05\n" +
+func (x *PromotionChange) Reset() {
+	*x = PromotionChange{}
+	mi := &file_nis_v1_account_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromotionChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromotionChange) ProtoMessage() {}
+
+func (x *PromotionChange) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromotionChange.ProtoReflect.Descriptor instead.
+func (*PromotionChange) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PromotionChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *PromotionChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PromotionChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// PromoteAccountRequest is the request to copy an account's configuration to another operator
+type PromoteAccountRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	TargetOperatorId string                 `protobuf:"bytes,2,opt,name=target_operator_id,json=targetOperatorId,proto3" json:"target_operator_id,omitempty"`
+	// Only compute the changes
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteAccountRequest) Reset() {
+	*x = PromoteAccountRequest{}
+	mi := &file_nis_v1_account_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteAccountRequest) ProtoMessage() {}
+
+func (x *PromoteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteAccountRequest.ProtoReflect.Descriptor instead.
+func (*PromoteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PromoteAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PromoteAccountRequest) GetTargetOperatorId() string {
+	if x != nil {
+		return x.TargetOperatorId
+	}
+	return ""
+}
+
+func (x *PromoteAccountRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// PromoteAccountResponse is the response from promoting an account
+type PromoteAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Account of the target operator, unset on a dry run creating it
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The target account did not exist
+	Created bool               `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Changes []*PromotionChange `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	// Clusters whose JetStream capacity the promoted limits over-commit
+	Warnings      []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteAccountResponse) Reset() {
+	*x = PromoteAccountResponse{}
+	mi := &file_nis_v1_account_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteAccountResponse) ProtoMessage() {}
+
+func (x *PromoteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteAccountResponse.ProtoReflect.Descriptor instead.
+func (*PromoteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PromoteAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *PromoteAccountResponse) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *PromoteAccountResponse) GetChanges() []*PromotionChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *PromoteAccountResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// GetAccountUsageRequest asks for the JetStream usage of an account
+type GetAccountUsageRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Optional - every cluster of the account's operator when empty
+	ClusterId     string `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountUsageRequest) Reset() {
+	*x = GetAccountUsageRequest{}
+	mi := &file_nis_v1_account_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountUsageRequest) ProtoMessage() {}
+
+func (x *GetAccountUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountUsageRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAccountUsageRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetAccountUsageRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+// GetAccountUsageResponse reports the JetStream usage of an account per cluster
+type GetAccountUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Usage []*AccountUsage        `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	// Clusters that could not be queried
+	Warnings      []string `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountUsageResponse) Reset() {
+	*x = GetAccountUsageResponse{}
+	mi := &file_nis_v1_account_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountUsageResponse) ProtoMessage() {}
+
+func (x *GetAccountUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountUsageResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAccountUsageResponse) GetUsage() []*AccountUsage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
+}
+
+func (x *GetAccountUsageResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// AccountUsage is the JetStream usage of an account on one cluster next to its limits.
+// Limits are -1 when unlimited.
+type AccountUsage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId        string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ClusterName      string                 `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	AccountId        string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountName      string                 `protobuf:"bytes,4,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	JetstreamEnabled bool                   `protobuf:"varint,5,opt,name=jetstream_enabled,json=jetstreamEnabled,proto3" json:"jetstream_enabled,omitempty"`
+	// Bytes, summed over the servers so replicas count in full
+	MemoryBytes    int64 `protobuf:"varint,6,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"`
+	MemoryLimit    int64 `protobuf:"varint,7,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	StorageBytes   int64 `protobuf:"varint,8,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	StorageLimit   int64 `protobuf:"varint,9,opt,name=storage_limit,json=storageLimit,proto3" json:"storage_limit,omitempty"`
+	Streams        int64 `protobuf:"varint,10,opt,name=streams,proto3" json:"streams,omitempty"`
+	StreamsLimit   int64 `protobuf:"varint,11,opt,name=streams_limit,json=streamsLimit,proto3" json:"streams_limit,omitempty"`
+	Consumers      int64 `protobuf:"varint,12,opt,name=consumers,proto3" json:"consumers,omitempty"`
+	ConsumersLimit int64 `protobuf:"varint,13,opt,name=consumers_limit,json=consumersLimit,proto3" json:"consumers_limit,omitempty"`
+	// Servers that hold JetStream state for the account
+	Servers       int32 `protobuf:"varint,14,opt,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountUsage) Reset() {
+	*x = AccountUsage{}
+	mi := &file_nis_v1_account_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountUsage) ProtoMessage() {}
+
+func (x *AccountUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountUsage.ProtoReflect.Descriptor instead.
+func (*AccountUsage) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AccountUsage) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *AccountUsage) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AccountUsage) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountUsage) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *AccountUsage) GetJetstreamEnabled() bool {
+	if x != nil {
+		return x.JetstreamEnabled
+	}
+	return false
+}
+
+func (x *AccountUsage) GetMemoryBytes() int64 {
+	if x != nil {
+		return x.MemoryBytes
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetMemoryLimit() int64 {
+	if x != nil {
+		return x.MemoryLimit
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetStorageLimit() int64 {
+	if x != nil {
+		return x.StorageLimit
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetStreams() int64 {
+	if x != nil {
+		return x.Streams
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetStreamsLimit() int64 {
+	if x != nil {
+		return x.StreamsLimit
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetConsumers() int64 {
+	if x != nil {
+		return x.Consumers
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetConsumersLimit() int64 {
+	if x != nil {
+		return x.ConsumersLimit
+	}
+	return 0
+}
+
+func (x *AccountUsage) GetServers() int32 {
+	if x != nil {
+		return x.Servers
+	}
+	return 0
+}
+
+// GetAccountStatsRequest selects the traffic statistics of an account
+type GetAccountStatsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Restricts the statistics to one cluster, every cluster when empty
+	ClusterId string                 `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	From      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Defaults to now
+	To *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// Bucket length, 60 or 3600. 0 picks per-minute buckets when they cover from, and
+	// hourly ones otherwise.
+	ResolutionSeconds int32 `protobuf:"varint,5,opt,name=resolution_seconds,json=resolutionSeconds,proto3" json:"resolution_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetAccountStatsRequest) Reset() {
+	*x = GetAccountStatsRequest{}
+	mi := &file_nis_v1_account_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountStatsRequest) ProtoMessage() {}
+
+func (x *GetAccountStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountStatsRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAccountStatsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *GetAccountStatsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetAccountStatsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAccountStatsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAccountStatsRequest) GetResolutionSeconds() int32 {
+	if x != nil {
+		return x.ResolutionSeconds
+	}
+	return 0
+}
+
+// AccountStatsPoint is the traffic of an account on a cluster during one bucket, summed
+// over the cluster's servers
+type AccountStatsPoint struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId   string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	BucketStart *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	// Collections that contributed to the bucket
+	Samples        int64   `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
+	AvgConnections float64 `protobuf:"fixed64,4,opt,name=avg_connections,json=avgConnections,proto3" json:"avg_connections,omitempty"`
+	MaxConnections int64   `protobuf:"varint,5,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	// Messages and bytes published by the account's clients
+	MsgsIn  int64 `protobuf:"varint,6,opt,name=msgs_in,json=msgsIn,proto3" json:"msgs_in,omitempty"`
+	BytesIn int64 `protobuf:"varint,7,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	// Messages and bytes delivered to the account's clients
+	MsgsOut       int64 `protobuf:"varint,8,opt,name=msgs_out,json=msgsOut,proto3" json:"msgs_out,omitempty"`
+	BytesOut      int64 `protobuf:"varint,9,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	SlowConsumers int64 `protobuf:"varint,10,opt,name=slow_consumers,json=slowConsumers,proto3" json:"slow_consumers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountStatsPoint) Reset() {
+	*x = AccountStatsPoint{}
+	mi := &file_nis_v1_account_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountStatsPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStatsPoint) ProtoMessage() {}
+
+func (x *AccountStatsPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStatsPoint.ProtoReflect.Descriptor instead.
+func (*AccountStatsPoint) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AccountStatsPoint) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *AccountStatsPoint) GetBucketStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BucketStart
+	}
+	return nil
+}
+
+func (x *AccountStatsPoint) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *AccountStatsPoint) GetAvgConnections() float64 {
+	if x != nil {
+		return x.AvgConnections
+	}
+	return 0
+}
+
+func (x *AccountStatsPoint) GetMaxConnections() int64 {
+	if x != nil {
+		return x.MaxConnections
+	}
+	return 0
+}
+
+func (x *AccountStatsPoint) GetMsgsIn() int64 {
+	if x != nil {
+		return x.MsgsIn
+	}
+	return 0
+}
+
+func (x *AccountStatsPoint) GetBytesIn() int64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *AccountStatsPoint) GetMsgsOut() int64 {
+	if x != nil {
+		return x.MsgsOut
+	}
+	return 0
+}
+
+func (x *AccountStatsPoint) GetBytesOut() int64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *AccountStatsPoint) GetSlowConsumers() int64 {
+	if x != nil {
+		return x.SlowConsumers
+	}
+	return 0
+}
+
+// GetAccountStatsResponse lists the buckets oldest first. Buckets without connections
+// or traffic are omitted.
+type GetAccountStatsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ResolutionSeconds int32                  `protobuf:"varint,1,opt,name=resolution_seconds,json=resolutionSeconds,proto3" json:"resolution_seconds,omitempty"`
+	Points            []*AccountStatsPoint   `protobuf:"bytes,2,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetAccountStatsResponse) Reset() {
+	*x = GetAccountStatsResponse{}
+	mi := &file_nis_v1_account_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountStatsResponse) ProtoMessage() {}
+
+func (x *GetAccountStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountStatsResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetAccountStatsResponse) GetResolutionSeconds() int32 {
+	if x != nil {
+		return x.ResolutionSeconds
+	}
+	return 0
+}
+
+func (x *GetAccountStatsResponse) GetPoints() []*AccountStatsPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// PlacementCluster is a cluster an account is pushed to
+type PlacementCluster struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// False for the members of an assigned cluster's supercluster, reached over the gateways
+	Assigned      bool `protobuf:"varint,3,opt,name=assigned,proto3" json:"assigned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacementCluster) Reset() {
+	*x = PlacementCluster{}
+	mi := &file_nis_v1_account_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementCluster) ProtoMessage() {}
+
+func (x *PlacementCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementCluster.ProtoReflect.Descriptor instead.
+func (*PlacementCluster) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PlacementCluster) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlacementCluster) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlacementCluster) GetAssigned() bool {
+	if x != nil {
+		return x.Assigned
+	}
+	return false
+}
+
+// PlacementRemoval reports the delete claim sent to a cluster the account left
+type PlacementRemoval struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId   string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ClusterName string                 `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Set when the cluster could not be reached, the next pruning sync retries
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlacementRemoval) Reset() {
+	*x = PlacementRemoval{}
+	mi := &file_nis_v1_account_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlacementRemoval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementRemoval) ProtoMessage() {}
+
+func (x *PlacementRemoval) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementRemoval.ProtoReflect.Descriptor instead.
+func (*PlacementRemoval) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PlacementRemoval) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *PlacementRemoval) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *PlacementRemoval) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// AccountPlacement lists the clusters an account is pushed to
+type AccountPlacement struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// True when the account has no placement and goes to every cluster of its operator
+	Everywhere    bool                `protobuf:"varint,2,opt,name=everywhere,proto3" json:"everywhere,omitempty"`
+	Clusters      []*PlacementCluster `protobuf:"bytes,3,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountPlacement) Reset() {
+	*x = AccountPlacement{}
+	mi := &file_nis_v1_account_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountPlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountPlacement) ProtoMessage() {}
+
+func (x *AccountPlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountPlacement.ProtoReflect.Descriptor instead.
+func (*AccountPlacement) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AccountPlacement) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountPlacement) GetEverywhere() bool {
+	if x != nil {
+		return x.Everywhere
+	}
+	return false
+}
+
+func (x *AccountPlacement) GetClusters() []*PlacementCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+// GetAccountPlacementRequest selects the account
+type GetAccountPlacementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountPlacementRequest) Reset() {
+	*x = GetAccountPlacementRequest{}
+	mi := &file_nis_v1_account_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountPlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountPlacementRequest) ProtoMessage() {}
+
+func (x *GetAccountPlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountPlacementRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountPlacementRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetAccountPlacementRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// GetAccountPlacementResponse returns the placement of the account
+type GetAccountPlacementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placement     *AccountPlacement      `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountPlacementResponse) Reset() {
+	*x = GetAccountPlacementResponse{}
+	mi := &file_nis_v1_account_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountPlacementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountPlacementResponse) ProtoMessage() {}
+
+func (x *GetAccountPlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountPlacementResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountPlacementResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAccountPlacementResponse) GetPlacement() *AccountPlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+// SetAccountPlacementRequest places an account on clusters of its operator
+type SetAccountPlacementRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Clusters to place the account on, empty for every cluster
+	ClusterIds    []string `protobuf:"bytes,2,rep,name=cluster_ids,json=clusterIds,proto3" json:"cluster_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountPlacementRequest) Reset() {
+	*x = SetAccountPlacementRequest{}
+	mi := &file_nis_v1_account_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountPlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountPlacementRequest) ProtoMessage() {}
+
+func (x *SetAccountPlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountPlacementRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountPlacementRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetAccountPlacementRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SetAccountPlacementRequest) GetClusterIds() []string {
+	if x != nil {
+		return x.ClusterIds
+	}
+	return nil
+}
+
+// SetAccountPlacementResponse returns the new placement and the clusters the account left
+type SetAccountPlacementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placement     *AccountPlacement      `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	Removed       []*PlacementRemoval    `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountPlacementResponse) Reset() {
+	*x = SetAccountPlacementResponse{}
+	mi := &file_nis_v1_account_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountPlacementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountPlacementResponse) ProtoMessage() {}
+
+func (x *SetAccountPlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountPlacementResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountPlacementResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SetAccountPlacementResponse) GetPlacement() *AccountPlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *SetAccountPlacementResponse) GetRemoved() []*PlacementRemoval {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+// MoveAccountRequest is the request to move an account to another operator
+type MoveAccountRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountId        string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	TargetOperatorId string                 `protobuf:"bytes,2,opt,name=target_operator_id,json=targetOperatorId,proto3" json:"target_operator_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MoveAccountRequest) Reset() {
+	*x = MoveAccountRequest{}
+	mi := &file_nis_v1_account_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAccountRequest) ProtoMessage() {}
+
+func (x *MoveAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAccountRequest.ProtoReflect.Descriptor instead.
+func (*MoveAccountRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *MoveAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *MoveAccountRequest) GetTargetOperatorId() string {
+	if x != nil {
+		return x.TargetOperatorId
+	}
+	return ""
+}
+
+// MoveAccountResponse is the response from moving an account
+type MoveAccountResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Account *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Clusters of the old operator the account was deleted from
+	Removed []*PlacementRemoval `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	// Clusters of the new operator the account was pushed to
+	Pushed []*AccountPush `protobuf:"bytes,3,rep,name=pushed,proto3" json:"pushed,omitempty"`
+	// Clusters whose JetStream capacity the account's limits over-commit
+	Warnings      []string `protobuf:"bytes,4,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveAccountResponse) Reset() {
+	*x = MoveAccountResponse{}
+	mi := &file_nis_v1_account_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveAccountResponse) ProtoMessage() {}
+
+func (x *MoveAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveAccountResponse.ProtoReflect.Descriptor instead.
+func (*MoveAccountResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *MoveAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *MoveAccountResponse) GetRemoved() []*PlacementRemoval {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *MoveAccountResponse) GetPushed() []*AccountPush {
+	if x != nil {
+		return x.Pushed
+	}
+	return nil
+}
+
+func (x *MoveAccountResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// SuspendAccountRequest is the request to suspend an account
+type SuspendAccountRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Reason    string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Revoke every user JWT issued so far, the users need new credentials
+	RevokeUsers   bool `protobuf:"varint,3,opt,name=revoke_users,json=revokeUsers,proto3" json:"revoke_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendAccountRequest) Reset() {
+	*x = SuspendAccountRequest{}
+	mi := &file_nis_v1_account_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendAccountRequest) ProtoMessage() {}
+
+func (x *SuspendAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendAccountRequest.ProtoReflect.Descriptor instead.
+func (*SuspendAccountRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SuspendAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SuspendAccountRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SuspendAccountRequest) GetRevokeUsers() bool {
+	if x != nil {
+		return x.RevokeUsers
+	}
+	return false
+}
+
+// SuspendAccountResponse is the response from suspending an account
+type SuspendAccountResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Account *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Clusters the re-signed account JWT was pushed to
+	Pushed        []*AccountPush `protobuf:"bytes,2,rep,name=pushed,proto3" json:"pushed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendAccountResponse) Reset() {
+	*x = SuspendAccountResponse{}
+	mi := &file_nis_v1_account_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendAccountResponse) ProtoMessage() {}
+
+func (x *SuspendAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendAccountResponse.ProtoReflect.Descriptor instead.
+func (*SuspendAccountResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SuspendAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *SuspendAccountResponse) GetPushed() []*AccountPush {
+	if x != nil {
+		return x.Pushed
+	}
+	return nil
+}
+
+// ResumeAccountRequest is the request to resume a suspended account
+type ResumeAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeAccountRequest) Reset() {
+	*x = ResumeAccountRequest{}
+	mi := &file_nis_v1_account_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeAccountRequest) ProtoMessage() {}
+
+func (x *ResumeAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeAccountRequest.ProtoReflect.Descriptor instead.
+func (*ResumeAccountRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ResumeAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// ResumeAccountResponse is the response from resuming an account
+type ResumeAccountResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Account *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// Clusters the re-signed account JWT was pushed to
+	Pushed        []*AccountPush `protobuf:"bytes,2,rep,name=pushed,proto3" json:"pushed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeAccountResponse) Reset() {
+	*x = ResumeAccountResponse{}
+	mi := &file_nis_v1_account_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeAccountResponse) ProtoMessage() {}
+
+func (x *ResumeAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_account_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeAccountResponse.ProtoReflect.Descriptor instead.
+func (*ResumeAccountResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_account_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ResumeAccountResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *ResumeAccountResponse) GetPushed() []*AccountPush {
+	if x != nil {
+		return x.Pushed
+	}
+	return nil
+}
+
+var File_nis_v1_account_proto protoreflect.FileDescriptor
+
+const file_nis_v1_account_proto_rawDesc = "" +
+	"\n" +
+	"\x14nis/v1/account.proto\x12\x06nis.v1\x1a\x13nis/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xaa\x04\n" +
+	"\aAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x05 \x01(\tR\tpublicKey\x12\x10\n" +
+	"\x03jwt\x18\x06 \x01(\tR\x03jwt\x12B\n" +
+	"\x10jetstream_limits\x18\a \x01(\v2\x17.nis.v1.JetStreamLimitsR\x0fjetstreamLimits\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\fsuspended_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vsuspendedAt\x12!\n" +
+	"\fsuspended_by\x18\v \x01(\tR\vsuspendedBy\x12%\n" +
+	"\x0esuspend_reason\x18\f \x01(\tR\rsuspendReason\x12D\n" +
+	"\x10users_revoked_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x0eusersRevokedAt\"\xb1\x01\n" +
+	"\x14CreateAccountRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12B\n" +
+	"\x10jetstream_limits\x18\x04 \x01(\v2\x17.nis.v1.JetStreamLimitsR\x0fjetstreamLimits\"^\n" +
+	"\x15CreateAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"#\n" +
+	"\x11GetAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x12GetAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\"N\n" +
+	"\x17GetAccountByNameRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"E\n" +
+	"\x18GetAccountByNameResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\"e\n" +
+	"\x13ListAccountsRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\x12-\n" +
+	"\aoptions\x18\x02 \x01(\v2\x13.nis.v1.ListOptionsR\aoptions\"C\n" +
+	"\x14ListAccountsResponse\x12+\n" +
+	"\baccounts\x18\x01 \x03(\v2\x0f.nis.v1.AccountR\baccounts\"\x7f\n" +
+	"\x14UpdateAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"B\n" +
+	"\x15UpdateAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\"_\n" +
+	"\x1cUpdateJetStreamLimitsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\x06limits\x18\x02 \x01(\v2\x17.nis.v1.JetStreamLimitsR\x06limits\"f\n" +
+	"\x1dUpdateJetStreamLimitsResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"&\n" +
+	"\x14DeleteAccountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteAccountResponse\"'\n" +
+	"\x15PushAccountJWTRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16PushAccountJWTResponse\"K\n" +
+	"\x0fPromotionChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"}\n" +
+	"\x15PromoteAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12,\n" +
+	"\x12target_operator_id\x18\x02 \x01(\tR\x10targetOperatorId\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\xac\x01\n" +
+	"\x16PromoteAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\bR\acreated\x121\n" +
+	"\achanges\x18\x03 \x03(\v2\x17.nis.v1.PromotionChangeR\achanges\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\"V\n" +
+	"\x16GetAccountUsageRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x02 \x01(\tR\tclusterId\"a\n" +
+	"\x17GetAccountUsageResponse\x12*\n" +
+	"\x05usage\x18\x01 \x03(\v2\x14.nis.v1.AccountUsageR\x05usage\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\xef\x03\n" +
+	"\fAccountUsage\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12!\n" +
+	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12!\n" +
+	"\faccount_name\x18\x04 \x01(\tR\vaccountName\x12+\n" +
+	"\x11jetstream_enabled\x18\x05 \x01(\bR\x10jetstreamEnabled\x12!\n" +
+	"\fmemory_bytes\x18\x06 \x01(\x03R\vmemoryBytes\x12!\n" +
+	"\fmemory_limit\x18\a \x01(\x03R\vmemoryLimit\x12#\n" +
+	"\rstorage_bytes\x18\b \x01(\x03R\fstorageBytes\x12#\n" +
+	"\rstorage_limit\x18\t \x01(\x03R\fstorageLimit\x12\x18\n" +
+	"\astreams\x18\n" +
+	" \x01(\x03R\astreams\x12#\n" +
+	"\rstreams_limit\x18\v \x01(\x03R\fstreamsLimit\x12\x1c\n" +
+	"\tconsumers\x18\f \x01(\x03R\tconsumers\x12'\n" +
+	"\x0fconsumers_limit\x18\r \x01(\x03R\x0econsumersLimit\x12\x18\n" +
+	"\aservers\x18\x0e \x01(\x05R\aservers\"\xe1\x01\n" +
+	"\x16GetAccountStatsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x02 \x01(\tR\tclusterId\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12-\n" +
+	"\x12resolution_seconds\x18\x05 \x01(\x05R\x11resolutionSeconds\"\xf0\x02\n" +
+	"\x11AccountStatsPoint\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12=\n" +
+	"\fbucket_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vbucketStart\x12\x18\n" +
+	"\asamples\x18\x03 \x01(\x03R\asamples\x12'\n" +
+	"\x0favg_connections\x18\x04 \x01(\x01R\x0eavgConnections\x12'\n" +
+	"\x0fmax_connections\x18\x05 \x01(\x03R\x0emaxConnections\x12\x17\n" +
+	"\amsgs_in\x18\x06 \x01(\x03R\x06msgsIn\x12\x19\n" +
+	"\bbytes_in\x18\a \x01(\x03R\abytesIn\x12\x19\n" +
+	"\bmsgs_out\x18\b \x01(\x03R\amsgsOut\x12\x1b\n" +
+	"\tbytes_out\x18\t \x01(\x03R\bbytesOut\x12%\n" +
+	"\x0eslow_consumers\x18\n" +
+	" \x01(\x03R\rslowConsumers\"{\n" +
+	"\x17GetAccountStatsResponse\x12-\n" +
+	"\x12resolution_seconds\x18\x01 \x01(\x05R\x11resolutionSeconds\x121\n" +
+	"\x06points\x18\x02 \x03(\v2\x19.nis.v1.AccountStatsPointR\x06points\"R\n" +
+	"\x10PlacementCluster\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bassigned\x18\x03 \x01(\bR\bassigned\"j\n" +
+	"\x10PlacementRemoval\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12!\n" +
+	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x87\x01\n" +
+	"\x10AccountPlacement\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1e\n" +
+	"\n" +
+	"everywhere\x18\x02 \x01(\bR\n" +
+	"everywhere\x124\n" +
+	"\bclusters\x18\x03 \x03(\v2\x18.nis.v1.PlacementClusterR\bclusters\";\n" +
+	"\x1aGetAccountPlacementRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"U\n" +
+	"\x1bGetAccountPlacementResponse\x126\n" +
+	"\tplacement\x18\x01 \x01(\v2\x18.nis.v1.AccountPlacementR\tplacement\"\\\n" +
+	"\x1aSetAccountPlacementRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x1f\n" +
+	"\vcluster_ids\x18\x02 \x03(\tR\n" +
+	"clusterIds\"\x89\x01\n" +
+	"\x1bSetAccountPlacementResponse\x126\n" +
+	"\tplacement\x18\x01 \x01(\v2\x18.nis.v1.AccountPlacementR\tplacement\x122\n" +
+	"\aremoved\x18\x02 \x03(\v2\x18.nis.v1.PlacementRemovalR\aremoved\"a\n" +
+	"\x12MoveAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12,\n" +
+	"\x12target_operator_id\x18\x02 \x01(\tR\x10targetOperatorId\"\xbd\x01\n" +
+	"\x13MoveAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\x122\n" +
+	"\aremoved\x18\x02 \x03(\v2\x18.nis.v1.PlacementRemovalR\aremoved\x12+\n" +
+	"\x06pushed\x18\x03 \x03(\v2\x13.nis.v1.AccountPushR\x06pushed\x12\x1a\n" +
+	"\bwarnings\x18\x04 \x03(\tR\bwarnings\"q\n" +
+	"\x15SuspendAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12!\n" +
+	"\frevoke_users\x18\x03 \x01(\bR\vrevokeUsers\"p\n" +
+	"\x16SuspendAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\x12+\n" +
+	"\x06pushed\x18\x02 \x03(\v2\x13.nis.v1.AccountPushR\x06pushed\"5\n" +
+	"\x14ResumeAccountRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\"o\n" +
+	"\x15ResumeAccountResponse\x12)\n" +
+	"\aaccount\x18\x01 \x01(\v2\x0f.nis.v1.AccountR\aaccount\x12+\n" +
+	"\x06pushed\x18\x02 \x03(\v2\x13.nis.v1.AccountPushR\x06pushed2\xb8\n" +
+	"\n" +
 	"\x0eAccountService\x12L\n" +
 	"\rCreateAccount\x12\x1c.nis.v1.CreateAccountRequest\x1a\x1d.nis.v1.CreateAccountResponse\x12C\n" +
 	"\n" +
@@ -970,7 +2583,15 @@ const file_nis_v1_account_proto_rawDesc = "" +
 	"\rUpdateAccount\x12\x1c.nis.v1.UpdateAccountRequest\x1a\x1d.nis.v1.UpdateAccountResponse\x12d\n" +
 	"\x15UpdateJetStreamLimits\x12$.nis.v1.UpdateJetStreamLimitsRequest\x1a%.nis.v1.UpdateJetStreamLimitsResponse\x12L\n" +
 	"\rDeleteAccount\x12\x1c.nis.v1.DeleteAccountRequest\x1a\x1d.nis.v1.DeleteAccountResponse\x12O\n" +
-	"\x0ePushAccountJWT\x12\x1d.nis.v1.PushAccountJWTRequest\x1a\x1e.nis.v1.PushAccountJWTResponseB\x83\x01\n" +
+	"\x0ePushAccountJWT\x12\x1d.nis.v1.PushAccountJWTRequest\x1a\x1e.nis.v1.PushAccountJWTResponse\x12O\n" +
+	"\x0ePromoteAccount\x12\x1d.nis.v1.PromoteAccountRequest\x1a\x1e.nis.v1.PromoteAccountResponse\x12R\n" +
+	"\x0fGetAccountUsage\x12\x1e.nis.v1.GetAccountUsageRequest\x1a\x1f.nis.v1.GetAccountUsageResponse\x12R\n" +
+	"\x0fGetAccountStats\x12\x1e.nis.v1.GetAccountStatsRequest\x1a\x1f.nis.v1.GetAccountStatsResponse\x12^\n" +
+	"\x13GetAccountPlacement\x12\".nis.v1.GetAccountPlacementRequest\x1a#.nis.v1.GetAccountPlacementResponse\x12^\n" +
+	"\x13SetAccountPlacement\x12\".nis.v1.SetAccountPlacementRequest\x1a#.nis.v1.SetAccountPlacementResponse\x12F\n" +
+	"\vMoveAccount\x12\x1a.nis.v1.MoveAccountRequest\x1a\x1b.nis.v1.MoveAccountResponse\x12O\n" +
+	"\x0eSuspendAccount\x12\x1d.nis.v1.SuspendAccountRequest\x1a\x1e.nis.v1.SuspendAccountResponse\x12L\n" +
+	"\rResumeAccount\x12\x1c.nis.v1.ResumeAccountRequest\x1a\x1d.nis.v1.ResumeAccountResponseB\x83\x01\n" +
 	"\n" +
 	"com.nis.v1B\fAccountProtoP\x01Z.github.com/thomas-maurice/nis/gen/nis/v1;nisv1\xa2\x02\x03NXX\xaa\x02\x06Nis.V1\xca\x02\x06Nis\\V1\xe2\x02\x12Nis\\V1\\GPBMetadata\xea\x02\aNis::V1b\x06proto3"
 
@@ -986,7 +2607,7 @@ func file_nis_v1_account_proto_rawDescGZIP() []byte {
 	return file_nis_v1_account_proto_rawDescData
 }
 
-var file_nis_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_nis_v1_account_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_nis_v1_account_proto_goTypes = []any{
 	(*Account)(nil),                       // 0: nis.v1.Account
 	(*CreateAccountRequest)(nil),          // 1: nis.v1.CreateAccountRequest
@@ -1005,44 +2626,103 @@ var file_nis_v1_account_proto_goTypes = []any{
 	(*DeleteAccountResponse)(nil),         // 14: nis.v1.DeleteAccountResponse
 	(*PushAccountJWTRequest)(nil),         // 15: nis.v1.PushAccountJWTRequest
 	(*PushAccountJWTResponse)(nil),        // 16: nis.v1.PushAccountJWTResponse
-	(*JetStreamLimits)(nil),               // 17: nis.v1.JetStreamLimits
-	(*timestamppb.Timestamp)(nil),         // 18: google.protobuf.Timestamp
-	(*ListOptions)(nil),                   // 19: nis.v1.ListOptions
+	(*PromotionChange)(nil),               // 17: nis.v1.PromotionChange
+	(*PromoteAccountRequest)(nil),         // 18: nis.v1.PromoteAccountRequest
+	(*PromoteAccountResponse)(nil),        // 19: nis.v1.PromoteAccountResponse
+	(*GetAccountUsageRequest)(nil),        // 20: nis.v1.GetAccountUsageRequest
+	(*GetAccountUsageResponse)(nil),       // 21: nis.v1.GetAccountUsageResponse
+	(*AccountUsage)(nil),                  // 22: nis.v1.AccountUsage
+	(*GetAccountStatsRequest)(nil),        // 23: nis.v1.GetAccountStatsRequest
+	(*AccountStatsPoint)(nil),             // 24: nis.v1.AccountStatsPoint
+	(*GetAccountStatsResponse)(nil),       // 25: nis.v1.GetAccountStatsResponse
+	(*PlacementCluster)(nil),              // 26: nis.v1.PlacementCluster
+	(*PlacementRemoval)(nil),              // 27: nis.v1.PlacementRemoval
+	(*AccountPlacement)(nil),              // 28: nis.v1.AccountPlacement
+	(*GetAccountPlacementRequest)(nil),    // 29: nis.v1.GetAccountPlacementRequest
+	(*GetAccountPlacementResponse)(nil),   // 30: nis.v1.GetAccountPlacementResponse
+	(*SetAccountPlacementRequest)(nil),    // 31: nis.v1.SetAccountPlacementRequest
+	(*SetAccountPlacementResponse)(nil),   // 32: nis.v1.SetAccountPlacementResponse
+	(*MoveAccountRequest)(nil),            // 33: nis.v1.MoveAccountRequest
+	(*MoveAccountResponse)(nil),           // 34: nis.v1.MoveAccountResponse
+	(*SuspendAccountRequest)(nil),         // 35: nis.v1.SuspendAccountRequest
+	(*SuspendAccountResponse)(nil),        // 36: nis.v1.SuspendAccountResponse
+	(*ResumeAccountRequest)(nil),          // 37: nis.v1.ResumeAccountRequest
+	(*ResumeAccountResponse)(nil),         // 38: nis.v1.ResumeAccountResponse
+	(*JetStreamLimits)(nil),               // 39: nis.v1.JetStreamLimits
+	(*timestamppb.Timestamp)(nil),         // 40: google.protobuf.Timestamp
+	(*ListOptions)(nil),                   // 41: nis.v1.ListOptions
+	(*AccountPush)(nil),                   // 42: nis.v1.AccountPush
 }
 var file_nis_v1_account_proto_depIdxs = []int32{
-	17, // 0: nis.v1.Account.jetstream_limits:type_name -> nis.v1.JetStreamLimits
-	18, // 1: nis.v1.Account.created_at:type_name -> google.protobuf.Timestamp
-	18, // 2: nis.v1.Account.updated_at:type_name -> google.protobuf.Timestamp
-	17, // 3: nis.v1.CreateAccountRequest.jetstream_limits:type_name -> nis.v1.JetStreamLimits
-	0,  // 4: nis.v1.CreateAccountResponse.account:type_name -> nis.v1.Account
-	0,  // 5: nis.v1.GetAccountResponse.account:type_name -> nis.v1.Account
-	0,  // 6: nis.v1.GetAccountByNameResponse.account:type_name -> nis.v1.Account
-	19, // 7: nis.v1.ListAccountsRequest.options:type_name -> nis.v1.ListOptions
-	0,  // 8: nis.v1.ListAccountsResponse.accounts:type_name -> nis.v1.Account
-	0,  // 9: nis.v1.UpdateAccountResponse.account:type_name -> nis.v1.Account
-	17, // 10: nis.v1.UpdateJetStreamLimitsRequest.limits:type_name -> nis.v1.JetStreamLimits
-	0,  // 11: nis.v1.UpdateJetStreamLimitsResponse.account:type_name -> nis.v1.Account
-	1,  // 12: nis.v1.AccountService.CreateAccount:input_type -> nis.v1.CreateAccountRequest
-	3,  // 13: nis.v1.AccountService.GetAccount:input_type -> nis.v1.GetAccountRequest
-	5,  // 14: nis.v1.AccountService.GetAccountByName:input_type -> nis.v1.GetAccountByNameRequest
-	7,  // 15: nis.v1.AccountService.ListAccounts:input_type -> nis.v1.ListAccountsRequest
-	9,  // 16: nis.v1.AccountService.UpdateAccount:input_type -> nis.v1.UpdateAccountRequest
-	11, // 17: nis.v1.AccountService.UpdateJetStreamLimits:input_type -> nis.v1.UpdateJetStreamLimitsRequest
-	13, // 18: nis.v1.AccountService.DeleteAccount:input_type -> nis.v1.DeleteAccountRequest
-	15, // 19: nis.v1.AccountService.PushAccountJWT:input_type -> nis.v1.PushAccountJWTRequest
-	2,  // 20: nis.v1.AccountService.CreateAccount:output_type -> nis.v1.CreateAccountResponse
-	4,  // 21: nis.v1.AccountService.GetAccount:output_type -> nis.v1.GetAccountResponse
-	6,  // 22: nis.v1.AccountService.GetAccountByName:output_type -> nis.v1.GetAccountByNameResponse
-	8,  // 23: nis.v1.AccountService.ListAccounts:output_type -> nis.v1.ListAccountsResponse
-	10, // 24: nis.v1.AccountService.UpdateAccount:output_type -> nis.v1.UpdateAccountResponse
-	12, // 25: nis.v1.AccountService.UpdateJetStreamLimits:output_type -> nis.v1.UpdateJetStreamLimitsResponse
-	14, // 26: nis.v1.AccountService.DeleteAccount:output_type -> nis.v1.DeleteAccountResponse
-	16, // 27: nis.v1.AccountService.PushAccountJWT:output_type -> nis.v1.PushAccountJWTResponse
-	20, // [20:28] is the sub-list for method output_type
-	12, // [12:20] is the sub-list for method input_type
-	12, // [12:12] is the sub-list for extension type_name
-	12, // [12:12] is the sub-list for extension extendee
-	0,  // [0:12] is the sub-list for field type_name
+	39, // 0: nis.v1.Account.jetstream_limits:type_name -> nis.v1.JetStreamLimits
+	40, // 1: nis.v1.Account.created_at:type_name -> google.protobuf.Timestamp
+	40, // 2: nis.v1.Account.updated_at:type_name -> google.protobuf.Timestamp
+	40, // 3: nis.v1.Account.suspended_at:type_name -> google.protobuf.Timestamp
+	40, // 4: nis.v1.Account.users_revoked_at:type_name -> google.protobuf.Timestamp
+	39, // 5: nis.v1.CreateAccountRequest.jetstream_limits:type_name -> nis.v1.JetStreamLimits
+	0,  // 6: nis.v1.CreateAccountResponse.account:type_name -> nis.v1.Account
+	0,  // 7: nis.v1.GetAccountResponse.account:type_name -> nis.v1.Account
+	0,  // 8: nis.v1.GetAccountByNameResponse.account:type_name -> nis.v1.Account
+	41, // 9: nis.v1.ListAccountsRequest.options:type_name -> nis.v1.ListOptions
+	0,  // 10: nis.v1.ListAccountsResponse.accounts:type_name -> nis.v1.Account
+	0,  // 11: nis.v1.UpdateAccountResponse.account:type_name -> nis.v1.Account
+	39, // 12: nis.v1.UpdateJetStreamLimitsRequest.limits:type_name -> nis.v1.JetStreamLimits
+	0,  // 13: nis.v1.UpdateJetStreamLimitsResponse.account:type_name -> nis.v1.Account
+	0,  // 14: nis.v1.PromoteAccountResponse.account:type_name -> nis.v1.Account
+	17, // 15: nis.v1.PromoteAccountResponse.changes:type_name -> nis.v1.PromotionChange
+	22, // 16: nis.v1.GetAccountUsageResponse.usage:type_name -> nis.v1.AccountUsage
+	40, // 17: nis.v1.GetAccountStatsRequest.from:type_name -> google.protobuf.Timestamp
+	40, // 18: nis.v1.GetAccountStatsRequest.to:type_name -> google.protobuf.Timestamp
+	40, // 19: nis.v1.AccountStatsPoint.bucket_start:type_name -> google.protobuf.Timestamp
+	24, // 20: nis.v1.GetAccountStatsResponse.points:type_name -> nis.v1.AccountStatsPoint
+	26, // 21: nis.v1.AccountPlacement.clusters:type_name -> nis.v1.PlacementCluster
+	28, // 22: nis.v1.GetAccountPlacementResponse.placement:type_name -> nis.v1.AccountPlacement
+	28, // 23: nis.v1.SetAccountPlacementResponse.placement:type_name -> nis.v1.AccountPlacement
+	27, // 24: nis.v1.SetAccountPlacementResponse.removed:type_name -> nis.v1.PlacementRemoval
+	0,  // 25: nis.v1.MoveAccountResponse.account:type_name -> nis.v1.Account
+	27, // 26: nis.v1.MoveAccountResponse.removed:type_name -> nis.v1.PlacementRemoval
+	42, // 27: nis.v1.MoveAccountResponse.pushed:type_name -> nis.v1.AccountPush
+	0,  // 28: nis.v1.SuspendAccountResponse.account:type_name -> nis.v1.Account
+	42, // 29: nis.v1.SuspendAccountResponse.pushed:type_name -> nis.v1.AccountPush
+	0,  // 30: nis.v1.ResumeAccountResponse.account:type_name -> nis.v1.Account
+	42, // 31: nis.v1.ResumeAccountResponse.pushed:type_name -> nis.v1.AccountPush
+	1,  // 32: nis.v1.AccountService.CreateAccount:input_type -> nis.v1.CreateAccountRequest
+	3,  // 33: nis.v1.AccountService.GetAccount:input_type -> nis.v1.GetAccountRequest
+	5,  // 34: nis.v1.AccountService.GetAccountByName:input_type -> nis.v1.GetAccountByNameRequest
+	7,  // 35: nis.v1.AccountService.ListAccounts:input_type -> nis.v1.ListAccountsRequest
+	9,  // 36: nis.v1.AccountService.UpdateAccount:input_type -> nis.v1.UpdateAccountRequest
+	11, // 37: nis.v1.AccountService.UpdateJetStreamLimits:input_type -> nis.v1.UpdateJetStreamLimitsRequest
+	13, // 38: nis.v1.AccountService.DeleteAccount:input_type -> nis.v1.DeleteAccountRequest
+	15, // 39: nis.v1.AccountService.PushAccountJWT:input_type -> nis.v1.PushAccountJWTRequest
+	18, // 40: nis.v1.AccountService.PromoteAccount:input_type -> nis.v1.PromoteAccountRequest
+	20, // 41: nis.v1.AccountService.GetAccountUsage:input_type -> nis.v1.GetAccountUsageRequest
+	23, // 42: nis.v1.AccountService.GetAccountStats:input_type -> nis.v1.GetAccountStatsRequest
+	29, // 43: nis.v1.AccountService.GetAccountPlacement:input_type -> nis.v1.GetAccountPlacementRequest
+	31, // 44: nis.v1.AccountService.SetAccountPlacement:input_type -> nis.v1.SetAccountPlacementRequest
+	33, // 45: nis.v1.AccountService.MoveAccount:input_type -> nis.v1.MoveAccountRequest
+	35, // 46: nis.v1.AccountService.SuspendAccount:input_type -> nis.v1.SuspendAccountRequest
+	37, // 47: nis.v1.AccountService.ResumeAccount:input_type -> nis.v1.ResumeAccountRequest
+	2,  // 48: nis.v1.AccountService.CreateAccount:output_type -> nis.v1.CreateAccountResponse
+	4,  // 49: nis.v1.AccountService.GetAccount:output_type -> nis.v1.GetAccountResponse
+	6,  // 50: nis.v1.AccountService.GetAccountByName:output_type -> nis.v1.GetAccountByNameResponse
+	8,  // 51: nis.v1.AccountService.ListAccounts:output_type -> nis.v1.ListAccountsResponse
+	10, // 52: nis.v1.AccountService.UpdateAccount:output_type -> nis.v1.UpdateAccountResponse
+	12, // 53: nis.v1.AccountService.UpdateJetStreamLimits:output_type -> nis.v1.UpdateJetStreamLimitsResponse
+	14, // 54: nis.v1.AccountService.DeleteAccount:output_type -> nis.v1.DeleteAccountResponse
+	16, // 55: nis.v1.AccountService.PushAccountJWT:output_type -> nis.v1.PushAccountJWTResponse
+	19, // 56: nis.v1.AccountService.PromoteAccount:output_type -> nis.v1.PromoteAccountResponse
+	21, // 57: nis.v1.AccountService.GetAccountUsage:output_type -> nis.v1.GetAccountUsageResponse
+	25, // 58: nis.v1.AccountService.GetAccountStats:output_type -> nis.v1.GetAccountStatsResponse
+	30, // 59: nis.v1.AccountService.GetAccountPlacement:output_type -> nis.v1.GetAccountPlacementResponse
+	32, // 60: nis.v1.AccountService.SetAccountPlacement:output_type -> nis.v1.SetAccountPlacementResponse
+	34, // 61: nis.v1.AccountService.MoveAccount:output_type -> nis.v1.MoveAccountResponse
+	36, // 62: nis.v1.AccountService.SuspendAccount:output_type -> nis.v1.SuspendAccountResponse
+	38, // 63: nis.v1.AccountService.ResumeAccount:output_type -> nis.v1.ResumeAccountResponse
+	48, // [48:64] is the sub-list for method output_type
+	32, // [32:48] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_nis_v1_account_proto_init() }
@@ -1058,7 +2738,7 @@ func file_nis_v1_account_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nis_v1_account_proto_rawDesc), len(file_nis_v1_account_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
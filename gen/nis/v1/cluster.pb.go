@@ -37,8 +37,23 @@ type Cluster struct {
 	LastHealthCheck     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_health_check,json=lastHealthCheck,proto3" json:"last_health_check,omitempty"`
 	HealthCheckError    string                 `protobuf:"bytes,11,opt,name=health_check_error,json=healthCheckError,proto3" json:"health_check_error,omitempty"`
 	SkipVerifyTls       bool                   `protobuf:"varint,12,opt,name=skip_verify_tls,json=skipVerifyTls,proto3" json:"skip_verify_tls,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// Seconds between health checks, 0 for the server default
+	HealthCheckIntervalSeconds int64 `protobuf:"varint,13,opt,name=health_check_interval_seconds,json=healthCheckIntervalSeconds,proto3" json:"health_check_interval_seconds,omitempty"`
+	// Seconds a single health check may take, 0 for the server default
+	HealthCheckTimeoutSeconds int64 `protobuf:"varint,14,opt,name=health_check_timeout_seconds,json=healthCheckTimeoutSeconds,proto3" json:"health_check_timeout_seconds,omitempty"`
+	// Share of healthy checks over the last 24 hours, only set by GetCluster
+	UptimePercent float64 `protobuf:"fixed64,15,opt,name=uptime_percent,json=uptimePercent,proto3" json:"uptime_percent,omitempty"`
+	// Number of checks the uptime percentage is based on
+	HealthCheckCount int64                  `protobuf:"varint,16,opt,name=health_check_count,json=healthCheckCount,proto3" json:"health_check_count,omitempty"`
+	NextHealthCheck  *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=next_health_check,json=nextHealthCheck,proto3" json:"next_health_check,omitempty"`
+	// Configuration profile of the cluster's servers, unset for the defaults
+	ServerProfile *ServerProfile `protobuf:"bytes,18,opt,name=server_profile,json=serverProfile,proto3" json:"server_profile,omitempty"`
+	// Supercluster the cluster joins through its gateways, empty for a standalone cluster
+	Supercluster string `protobuf:"bytes,19,opt,name=supercluster,proto3" json:"supercluster,omitempty"`
+	// URLs the other clusters' gateways dial, derived from the server URLs when empty
+	GatewayUrls   []string `protobuf:"bytes,20,rep,name=gateway_urls,json=gatewayUrls,proto3" json:"gateway_urls,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Cluster) Reset() {
@@ -155,34 +170,99 @@ func (x *Cluster) GetSkipVerifyTls() bool {
 	return false
 }
 
-// CreateClusterRequest is the request to create a new cluster
-type CreateClusterRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	OperatorId          string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
-	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description         string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	ServerUrls          []string               `protobuf:"bytes,4,rep,name=server_urls,json=serverUrls,proto3" json:"server_urls,omitempty"`
-	SystemAccountPubKey string                 `protobuf:"bytes,5,opt,name=system_account_pub_key,json=systemAccountPubKey,proto3" json:"system_account_pub_key,omitempty"`
-	SystemAccountCreds  string                 `protobuf:"bytes,6,opt,name=system_account_creds,json=systemAccountCreds,proto3" json:"system_account_creds,omitempty"`
-	SkipVerifyTls       bool                   `protobuf:"varint,7,opt,name=skip_verify_tls,json=skipVerifyTls,proto3" json:"skip_verify_tls,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *Cluster) GetHealthCheckIntervalSeconds() int64 {
+	if x != nil {
+		return x.HealthCheckIntervalSeconds
+	}
+	return 0
 }
 
-func (x *CreateClusterRequest) Reset() {
-	*x = CreateClusterRequest{}
+func (x *Cluster) GetHealthCheckTimeoutSeconds() int64 {
+	if x != nil {
+		return x.HealthCheckTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Cluster) GetUptimePercent() float64 {
+	if x != nil {
+		return x.UptimePercent
+	}
+	return 0
+}
+
+func (x *Cluster) GetHealthCheckCount() int64 {
+	if x != nil {
+		return x.HealthCheckCount
+	}
+	return 0
+}
+
+func (x *Cluster) GetNextHealthCheck() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextHealthCheck
+	}
+	return nil
+}
+
+func (x *Cluster) GetServerProfile() *ServerProfile {
+	if x != nil {
+		return x.ServerProfile
+	}
+	return nil
+}
+
+func (x *Cluster) GetSupercluster() string {
+	if x != nil {
+		return x.Supercluster
+	}
+	return ""
+}
+
+func (x *Cluster) GetGatewayUrls() []string {
+	if x != nil {
+		return x.GatewayUrls
+	}
+	return nil
+}
+
+// ServerProfile describes how the NATS servers of a cluster are configured. Zero
+// ports disable the corresponding listener.
+type ServerProfile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Client listen host, empty for all interfaces
+	Host     string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port     int32  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	HttpPort int32  `protobuf:"varint,3,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
+	// Route port, 0 for a single server
+	ClusterPort int32            `protobuf:"varint,4,opt,name=cluster_port,json=clusterPort,proto3" json:"cluster_port,omitempty"`
+	Routes      []string         `protobuf:"bytes,5,rep,name=routes,proto3" json:"routes,omitempty"`
+	Tls         *ServerTLS       `protobuf:"bytes,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	Jetstream   *ServerJetStream `protobuf:"bytes,7,opt,name=jetstream,proto3" json:"jetstream,omitempty"`
+	Resolver    *ServerResolver  `protobuf:"bytes,8,opt,name=resolver,proto3" json:"resolver,omitempty"`
+	Websocket   *ServerWebsocket `protobuf:"bytes,9,opt,name=websocket,proto3" json:"websocket,omitempty"`
+	// MQTT requires JetStream and a server name
+	MqttPort      int32 `protobuf:"varint,10,opt,name=mqtt_port,json=mqttPort,proto3" json:"mqtt_port,omitempty"`
+	LeafnodePort  int32 `protobuf:"varint,11,opt,name=leafnode_port,json=leafnodePort,proto3" json:"leafnode_port,omitempty"`
+	GatewayPort   int32 `protobuf:"varint,12,opt,name=gateway_port,json=gatewayPort,proto3" json:"gateway_port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerProfile) Reset() {
+	*x = ServerProfile{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateClusterRequest) String() string {
+func (x *ServerProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateClusterRequest) ProtoMessage() {}
+func (*ServerProfile) ProtoMessage() {}
 
-func (x *CreateClusterRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerProfile) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -194,82 +274,122 @@ func (x *CreateClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateClusterRequest.ProtoReflect.Descriptor instead.
-func (*CreateClusterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerProfile.ProtoReflect.Descriptor instead.
+func (*ServerProfile) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *CreateClusterRequest) GetOperatorId() string {
+func (x *ServerProfile) GetHost() string {
 	if x != nil {
-		return x.OperatorId
+		return x.Host
 	}
 	return ""
 }
 
-func (x *CreateClusterRequest) GetName() string {
+func (x *ServerProfile) GetPort() int32 {
 	if x != nil {
-		return x.Name
+		return x.Port
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateClusterRequest) GetDescription() string {
+func (x *ServerProfile) GetHttpPort() int32 {
 	if x != nil {
-		return x.Description
+		return x.HttpPort
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateClusterRequest) GetServerUrls() []string {
+func (x *ServerProfile) GetClusterPort() int32 {
 	if x != nil {
-		return x.ServerUrls
+		return x.ClusterPort
+	}
+	return 0
+}
+
+func (x *ServerProfile) GetRoutes() []string {
+	if x != nil {
+		return x.Routes
 	}
 	return nil
 }
 
-func (x *CreateClusterRequest) GetSystemAccountPubKey() string {
+func (x *ServerProfile) GetTls() *ServerTLS {
 	if x != nil {
-		return x.SystemAccountPubKey
+		return x.Tls
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateClusterRequest) GetSystemAccountCreds() string {
+func (x *ServerProfile) GetJetstream() *ServerJetStream {
 	if x != nil {
-		return x.SystemAccountCreds
+		return x.Jetstream
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateClusterRequest) GetSkipVerifyTls() bool {
+func (x *ServerProfile) GetResolver() *ServerResolver {
 	if x != nil {
-		return x.SkipVerifyTls
+		return x.Resolver
 	}
-	return false
+	return nil
 }
 
-// CreateClusterResponse is the response from creating a cluster
-type CreateClusterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cluster       *Cluster               `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+func (x *ServerProfile) GetWebsocket() *ServerWebsocket {
+	if x != nil {
+		return x.Websocket
+	}
+	return nil
+}
+
+func (x *ServerProfile) GetMqttPort() int32 {
+	if x != nil {
+		return x.MqttPort
+	}
+	return 0
+}
+
+func (x *ServerProfile) GetLeafnodePort() int32 {
+	if x != nil {
+		return x.LeafnodePort
+	}
+	return 0
+}
+
+func (x *ServerProfile) GetGatewayPort() int32 {
+	if x != nil {
+		return x.GatewayPort
+	}
+	return 0
+}
+
+// ServerTLS holds the certificate paths on the NATS servers, the other listeners
+// reuse the client certificate
+type ServerTLS struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CertFile string                 `protobuf:"bytes,1,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	KeyFile  string                 `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	CaFile   string                 `protobuf:"bytes,3,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
+	// Require client certificates
+	Verify        bool `protobuf:"varint,4,opt,name=verify,proto3" json:"verify,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateClusterResponse) Reset() {
-	*x = CreateClusterResponse{}
+func (x *ServerTLS) Reset() {
+	*x = ServerTLS{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateClusterResponse) String() string {
+func (x *ServerTLS) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateClusterResponse) ProtoMessage() {}
+func (*ServerTLS) ProtoMessage() {}
 
-func (x *CreateClusterResponse) ProtoReflect() protoreflect.Message {
+func (x *ServerTLS) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,40 +401,65 @@ func (x *CreateClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateClusterResponse.ProtoReflect.Descriptor instead.
-func (*CreateClusterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerTLS.ProtoReflect.Descriptor instead.
+func (*ServerTLS) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CreateClusterResponse) GetCluster() *Cluster {
+func (x *ServerTLS) GetCertFile() string {
 	if x != nil {
-		return x.Cluster
+		return x.CertFile
 	}
-	return nil
+	return ""
 }
 
-// GetClusterRequest is the request to get a cluster by ID
-type GetClusterRequest struct {
+func (x *ServerTLS) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *ServerTLS) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+func (x *ServerTLS) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
+// ServerJetStream configures JetStream, zero limits let the server size them
+type ServerJetStream struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	StoreDir      string                 `protobuf:"bytes,2,opt,name=store_dir,json=storeDir,proto3" json:"store_dir,omitempty"`
+	MaxMemory     int64                  `protobuf:"varint,3,opt,name=max_memory,json=maxMemory,proto3" json:"max_memory,omitempty"`
+	MaxStorage    int64                  `protobuf:"varint,4,opt,name=max_storage,json=maxStorage,proto3" json:"max_storage,omitempty"`
+	Domain        string                 `protobuf:"bytes,5,opt,name=domain,proto3" json:"domain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClusterRequest) Reset() {
-	*x = GetClusterRequest{}
+func (x *ServerJetStream) Reset() {
+	*x = ServerJetStream{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClusterRequest) String() string {
+func (x *ServerJetStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterRequest) ProtoMessage() {}
+func (*ServerJetStream) ProtoMessage() {}
 
-func (x *GetClusterRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerJetStream) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -326,40 +471,70 @@ func (x *GetClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterRequest.ProtoReflect.Descriptor instead.
-func (*GetClusterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerJetStream.ProtoReflect.Descriptor instead.
+func (*ServerJetStream) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetClusterRequest) GetId() string {
+func (x *ServerJetStream) GetEnabled() bool {
 	if x != nil {
-		return x.Id
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ServerJetStream) GetStoreDir() string {
+	if x != nil {
+		return x.StoreDir
 	}
 	return ""
 }
 
-// GetClusterResponse is the response from getting a cluster
-type GetClusterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cluster       *Cluster               `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ServerJetStream) GetMaxMemory() int64 {
+	if x != nil {
+		return x.MaxMemory
+	}
+	return 0
 }
 
-func (x *GetClusterResponse) Reset() {
-	*x = GetClusterResponse{}
+func (x *ServerJetStream) GetMaxStorage() int64 {
+	if x != nil {
+		return x.MaxStorage
+	}
+	return 0
+}
+
+func (x *ServerJetStream) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// ServerResolver configures the full account resolver
+type ServerResolver struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Dir   string                 `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	// Seconds between resolver syncs, 0 for the server default
+	IntervalSeconds int64 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ServerResolver) Reset() {
+	*x = ServerResolver{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClusterResponse) String() string {
+func (x *ServerResolver) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterResponse) ProtoMessage() {}
+func (*ServerResolver) ProtoMessage() {}
 
-func (x *GetClusterResponse) ProtoReflect() protoreflect.Message {
+func (x *ServerResolver) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -371,41 +546,50 @@ func (x *GetClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterResponse.ProtoReflect.Descriptor instead.
-func (*GetClusterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerResolver.ProtoReflect.Descriptor instead.
+func (*ServerResolver) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetClusterResponse) GetCluster() *Cluster {
+func (x *ServerResolver) GetDir() string {
 	if x != nil {
-		return x.Cluster
+		return x.Dir
 	}
-	return nil
+	return ""
 }
 
-// GetClusterByNameRequest is the request to get a cluster by name
-type GetClusterByNameRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperatorId    string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+func (x *ServerResolver) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+// ServerWebsocket configures the websocket listener
+type ServerWebsocket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Port  int32                  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	// Serve plain websocket even when TLS is configured
+	NoTls         bool `protobuf:"varint,2,opt,name=no_tls,json=noTls,proto3" json:"no_tls,omitempty"`
+	Compression   bool `protobuf:"varint,3,opt,name=compression,proto3" json:"compression,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClusterByNameRequest) Reset() {
-	*x = GetClusterByNameRequest{}
+func (x *ServerWebsocket) Reset() {
+	*x = ServerWebsocket{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClusterByNameRequest) String() string {
+func (x *ServerWebsocket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterByNameRequest) ProtoMessage() {}
+func (*ServerWebsocket) ProtoMessage() {}
 
-func (x *GetClusterByNameRequest) ProtoReflect() protoreflect.Message {
+func (x *ServerWebsocket) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -417,47 +601,62 @@ func (x *GetClusterByNameRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterByNameRequest.ProtoReflect.Descriptor instead.
-func (*GetClusterByNameRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ServerWebsocket.ProtoReflect.Descriptor instead.
+func (*ServerWebsocket) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetClusterByNameRequest) GetOperatorId() string {
+func (x *ServerWebsocket) GetPort() int32 {
 	if x != nil {
-		return x.OperatorId
+		return x.Port
 	}
-	return ""
+	return 0
 }
 
-func (x *GetClusterByNameRequest) GetName() string {
+func (x *ServerWebsocket) GetNoTls() bool {
 	if x != nil {
-		return x.Name
+		return x.NoTls
 	}
-	return ""
+	return false
 }
 
-// GetClusterByNameResponse is the response from getting a cluster by name
-type GetClusterByNameResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cluster       *Cluster               `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ServerWebsocket) GetCompression() bool {
+	if x != nil {
+		return x.Compression
+	}
+	return false
 }
 
-func (x *GetClusterByNameResponse) Reset() {
-	*x = GetClusterByNameResponse{}
+// CreateClusterRequest is the request to create a new cluster
+type CreateClusterRequest struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId                 string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Name                       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description                string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ServerUrls                 []string               `protobuf:"bytes,4,rep,name=server_urls,json=serverUrls,proto3" json:"server_urls,omitempty"`
+	SystemAccountPubKey        string                 `protobuf:"bytes,5,opt,name=system_account_pub_key,json=systemAccountPubKey,proto3" json:"system_account_pub_key,omitempty"`
+	SystemAccountCreds         string                 `protobuf:"bytes,6,opt,name=system_account_creds,json=systemAccountCreds,proto3" json:"system_account_creds,omitempty"`
+	SkipVerifyTls              bool                   `protobuf:"varint,7,opt,name=skip_verify_tls,json=skipVerifyTls,proto3" json:"skip_verify_tls,omitempty"`
+	HealthCheckIntervalSeconds int64                  `protobuf:"varint,8,opt,name=health_check_interval_seconds,json=healthCheckIntervalSeconds,proto3" json:"health_check_interval_seconds,omitempty"`
+	HealthCheckTimeoutSeconds  int64                  `protobuf:"varint,9,opt,name=health_check_timeout_seconds,json=healthCheckTimeoutSeconds,proto3" json:"health_check_timeout_seconds,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *CreateClusterRequest) Reset() {
+	*x = CreateClusterRequest{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClusterByNameResponse) String() string {
+func (x *CreateClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterByNameResponse) ProtoMessage() {}
+func (*CreateClusterRequest) ProtoMessage() {}
 
-func (x *GetClusterByNameResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateClusterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -469,94 +668,97 @@ func (x *GetClusterByNameResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterByNameResponse.ProtoReflect.Descriptor instead.
-func (*GetClusterByNameResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateClusterRequest.ProtoReflect.Descriptor instead.
+func (*CreateClusterRequest) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetClusterByNameResponse) GetCluster() *Cluster {
+func (x *CreateClusterRequest) GetOperatorId() string {
 	if x != nil {
-		return x.Cluster
+		return x.OperatorId
 	}
-	return nil
-}
-
-// ListClustersRequest is the request to list clusters
-type ListClustersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperatorId    string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
-	Options       *ListOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *ListClustersRequest) Reset() {
-	*x = ListClustersRequest{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CreateClusterRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *ListClustersRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CreateClusterRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (*ListClustersRequest) ProtoMessage() {}
-
-func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[7]
+func (x *CreateClusterRequest) GetServerUrls() []string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ServerUrls
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
-func (*ListClustersRequest) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{7}
+func (x *CreateClusterRequest) GetSystemAccountPubKey() string {
+	if x != nil {
+		return x.SystemAccountPubKey
+	}
+	return ""
 }
 
-func (x *ListClustersRequest) GetOperatorId() string {
+func (x *CreateClusterRequest) GetSystemAccountCreds() string {
 	if x != nil {
-		return x.OperatorId
+		return x.SystemAccountCreds
 	}
 	return ""
 }
 
-func (x *ListClustersRequest) GetOptions() *ListOptions {
+func (x *CreateClusterRequest) GetSkipVerifyTls() bool {
 	if x != nil {
-		return x.Options
+		return x.SkipVerifyTls
 	}
-	return nil
+	return false
 }
 
-// ListClustersResponse is the response from listing clusters
-type ListClustersResponse struct {
+func (x *CreateClusterRequest) GetHealthCheckIntervalSeconds() int64 {
+	if x != nil {
+		return x.HealthCheckIntervalSeconds
+	}
+	return 0
+}
+
+func (x *CreateClusterRequest) GetHealthCheckTimeoutSeconds() int64 {
+	if x != nil {
+		return x.HealthCheckTimeoutSeconds
+	}
+	return 0
+}
+
+// CreateClusterResponse is the response from creating a cluster
+type CreateClusterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Clusters      []*Cluster             `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	Cluster       *Cluster               `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListClustersResponse) Reset() {
-	*x = ListClustersResponse{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[8]
+func (x *CreateClusterResponse) Reset() {
+	*x = CreateClusterResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListClustersResponse) String() string {
+func (x *CreateClusterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListClustersResponse) ProtoMessage() {}
+func (*CreateClusterResponse) ProtoMessage() {}
 
-func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[8]
+func (x *CreateClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,45 +769,41 @@ func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
-func (*ListClustersResponse) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CreateClusterResponse.ProtoReflect.Descriptor instead.
+func (*CreateClusterResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListClustersResponse) GetClusters() []*Cluster {
+func (x *CreateClusterResponse) GetCluster() *Cluster {
 	if x != nil {
-		return x.Clusters
+		return x.Cluster
 	}
 	return nil
 }
 
-// UpdateClusterRequest is the request to update a cluster
-type UpdateClusterRequest struct {
+// GetClusterRequest is the request to get a cluster by ID
+type GetClusterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	ServerUrls    []string               `protobuf:"bytes,4,rep,name=server_urls,json=serverUrls,proto3" json:"server_urls,omitempty"`
-	SkipVerifyTls *bool                  `protobuf:"varint,5,opt,name=skip_verify_tls,json=skipVerifyTls,proto3,oneof" json:"skip_verify_tls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateClusterRequest) Reset() {
-	*x = UpdateClusterRequest{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[9]
+func (x *GetClusterRequest) Reset() {
+	*x = GetClusterRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateClusterRequest) String() string {
+func (x *GetClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateClusterRequest) ProtoMessage() {}
+func (*GetClusterRequest) ProtoMessage() {}
 
-func (x *UpdateClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[9]
+func (x *GetClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -616,69 +814,41 @@ func (x *UpdateClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateClusterRequest.ProtoReflect.Descriptor instead.
-func (*UpdateClusterRequest) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetClusterRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *UpdateClusterRequest) GetId() string {
+func (x *GetClusterRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateClusterRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
-}
-
-func (x *UpdateClusterRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
-}
-
-func (x *UpdateClusterRequest) GetServerUrls() []string {
-	if x != nil {
-		return x.ServerUrls
-	}
-	return nil
-}
-
-func (x *UpdateClusterRequest) GetSkipVerifyTls() bool {
-	if x != nil && x.SkipVerifyTls != nil {
-		return *x.SkipVerifyTls
-	}
-	return false
-}
-
-// UpdateClusterResponse is the response from updating a cluster
-type UpdateClusterResponse struct {
+// GetClusterResponse is the response from getting a cluster
+type GetClusterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cluster       *Cluster               `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateClusterResponse) Reset() {
-	*x = UpdateClusterResponse{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[10]
+func (x *GetClusterResponse) Reset() {
+	*x = GetClusterResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateClusterResponse) String() string {
+func (x *GetClusterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateClusterResponse) ProtoMessage() {}
+func (*GetClusterResponse) ProtoMessage() {}
 
-func (x *UpdateClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[10]
+func (x *GetClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,42 +859,42 @@ func (x *UpdateClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateClusterResponse.ProtoReflect.Descriptor instead.
-func (*UpdateClusterResponse) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetClusterResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *UpdateClusterResponse) GetCluster() *Cluster {
+func (x *GetClusterResponse) GetCluster() *Cluster {
 	if x != nil {
 		return x.Cluster
 	}
 	return nil
 }
 
-// UpdateClusterCredentialsRequest is the request to update cluster credentials
-type UpdateClusterCredentialsRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SystemAccountCreds string                 `protobuf:"bytes,2,opt,name=system_account_creds,json=systemAccountCreds,proto3" json:"system_account_creds,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+// GetClusterByNameRequest is the request to get a cluster by name
+type GetClusterByNameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateClusterCredentialsRequest) Reset() {
-	*x = UpdateClusterCredentialsRequest{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[11]
+func (x *GetClusterByNameRequest) Reset() {
+	*x = GetClusterByNameRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateClusterCredentialsRequest) String() string {
+func (x *GetClusterByNameRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateClusterCredentialsRequest) ProtoMessage() {}
+func (*GetClusterByNameRequest) ProtoMessage() {}
 
-func (x *UpdateClusterCredentialsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[11]
+func (x *GetClusterByNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,48 +905,48 @@ func (x *UpdateClusterCredentialsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateClusterCredentialsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateClusterCredentialsRequest) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetClusterByNameRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterByNameRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateClusterCredentialsRequest) GetId() string {
+func (x *GetClusterByNameRequest) GetOperatorId() string {
 	if x != nil {
-		return x.Id
+		return x.OperatorId
 	}
 	return ""
 }
 
-func (x *UpdateClusterCredentialsRequest) GetSystemAccountCreds() string {
+func (x *GetClusterByNameRequest) GetName() string {
 	if x != nil {
-		return x.SystemAccountCreds
+		return x.Name
 	}
 	return ""
 }
 
-// UpdateClusterCredentialsResponse is the response from updating cluster credentials
-type UpdateClusterCredentialsResponse struct {
+// GetClusterByNameResponse is the response from getting a cluster by name
+type GetClusterByNameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Cluster       *Cluster               `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateClusterCredentialsResponse) Reset() {
-	*x = UpdateClusterCredentialsResponse{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[12]
+func (x *GetClusterByNameResponse) Reset() {
+	*x = GetClusterByNameResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateClusterCredentialsResponse) String() string {
+func (x *GetClusterByNameResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateClusterCredentialsResponse) ProtoMessage() {}
+func (*GetClusterByNameResponse) ProtoMessage() {}
 
-func (x *UpdateClusterCredentialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[12]
+func (x *GetClusterByNameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,41 +957,42 @@ func (x *UpdateClusterCredentialsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateClusterCredentialsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateClusterCredentialsResponse) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetClusterByNameResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterByNameResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateClusterCredentialsResponse) GetCluster() *Cluster {
+func (x *GetClusterByNameResponse) GetCluster() *Cluster {
 	if x != nil {
 		return x.Cluster
 	}
 	return nil
 }
 
-// DeleteClusterRequest is the request to delete a cluster
-type DeleteClusterRequest struct {
+// ListClustersRequest is the request to list clusters
+type ListClustersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorId    string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Options       *ListOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteClusterRequest) Reset() {
-	*x = DeleteClusterRequest{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[13]
+func (x *ListClustersRequest) Reset() {
+	*x = ListClustersRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteClusterRequest) String() string {
+func (x *ListClustersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteClusterRequest) ProtoMessage() {}
+func (*ListClustersRequest) ProtoMessage() {}
 
-func (x *DeleteClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[13]
+func (x *ListClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,39 +1003,98 @@ func (x *DeleteClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteClusterRequest.ProtoReflect.Descriptor instead.
-func (*DeleteClusterRequest) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ListClustersRequest.ProtoReflect.Descriptor instead.
+func (*ListClustersRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DeleteClusterRequest) GetId() string {
+func (x *ListClustersRequest) GetOperatorId() string {
 	if x != nil {
-		return x.Id
+		return x.OperatorId
 	}
 	return ""
 }
 
-// DeleteClusterResponse is the response from deleting a cluster
-type DeleteClusterResponse struct {
+func (x *ListClustersRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// ListClustersResponse is the response from listing clusters
+type ListClustersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clusters      []*Cluster             `protobuf:"bytes,1,rep,name=clusters,proto3" json:"clusters,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteClusterResponse) Reset() {
-	*x = DeleteClusterResponse{}
+func (x *ListClustersResponse) Reset() {
+	*x = ListClustersResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClustersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClustersResponse) ProtoMessage() {}
+
+func (x *ListClustersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClustersResponse.ProtoReflect.Descriptor instead.
+func (*ListClustersResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListClustersResponse) GetClusters() []*Cluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+// UpdateClusterRequest is the request to update a cluster
+type UpdateClusterRequest struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	Id                         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                       *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description                *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	ServerUrls                 []string               `protobuf:"bytes,4,rep,name=server_urls,json=serverUrls,proto3" json:"server_urls,omitempty"`
+	SkipVerifyTls              *bool                  `protobuf:"varint,5,opt,name=skip_verify_tls,json=skipVerifyTls,proto3,oneof" json:"skip_verify_tls,omitempty"`
+	HealthCheckIntervalSeconds *int64                 `protobuf:"varint,6,opt,name=health_check_interval_seconds,json=healthCheckIntervalSeconds,proto3,oneof" json:"health_check_interval_seconds,omitempty"`
+	HealthCheckTimeoutSeconds  *int64                 `protobuf:"varint,7,opt,name=health_check_timeout_seconds,json=healthCheckTimeoutSeconds,proto3,oneof" json:"health_check_timeout_seconds,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *UpdateClusterRequest) Reset() {
+	*x = UpdateClusterRequest{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteClusterResponse) String() string {
+func (x *UpdateClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteClusterResponse) ProtoMessage() {}
+func (*UpdateClusterRequest) ProtoMessage() {}
 
-func (x *DeleteClusterResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateClusterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -876,33 +1106,82 @@ func (x *DeleteClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteClusterResponse.ProtoReflect.Descriptor instead.
-func (*DeleteClusterResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateClusterRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClusterRequest) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{14}
 }
 
-// GetClusterCredentialsRequest is the request to get cluster credentials
-type GetClusterCredentialsRequest struct {
+func (x *UpdateClusterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateClusterRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateClusterRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateClusterRequest) GetServerUrls() []string {
+	if x != nil {
+		return x.ServerUrls
+	}
+	return nil
+}
+
+func (x *UpdateClusterRequest) GetSkipVerifyTls() bool {
+	if x != nil && x.SkipVerifyTls != nil {
+		return *x.SkipVerifyTls
+	}
+	return false
+}
+
+func (x *UpdateClusterRequest) GetHealthCheckIntervalSeconds() int64 {
+	if x != nil && x.HealthCheckIntervalSeconds != nil {
+		return *x.HealthCheckIntervalSeconds
+	}
+	return 0
+}
+
+func (x *UpdateClusterRequest) GetHealthCheckTimeoutSeconds() int64 {
+	if x != nil && x.HealthCheckTimeoutSeconds != nil {
+		return *x.HealthCheckTimeoutSeconds
+	}
+	return 0
+}
+
+// UpdateClusterResponse is the response from updating a cluster
+type UpdateClusterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Cluster       *Cluster               `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClusterCredentialsRequest) Reset() {
-	*x = GetClusterCredentialsRequest{}
+func (x *UpdateClusterResponse) Reset() {
+	*x = UpdateClusterResponse{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClusterCredentialsRequest) String() string {
+func (x *UpdateClusterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterCredentialsRequest) ProtoMessage() {}
+func (*UpdateClusterResponse) ProtoMessage() {}
 
-func (x *GetClusterCredentialsRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateClusterResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -914,40 +1193,41 @@ func (x *GetClusterCredentialsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterCredentialsRequest.ProtoReflect.Descriptor instead.
-func (*GetClusterCredentialsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateClusterResponse.ProtoReflect.Descriptor instead.
+func (*UpdateClusterResponse) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetClusterCredentialsRequest) GetId() string {
+func (x *UpdateClusterResponse) GetCluster() *Cluster {
 	if x != nil {
-		return x.Id
+		return x.Cluster
 	}
-	return ""
+	return nil
 }
 
-// GetClusterCredentialsResponse is the response from getting cluster credentials
-type GetClusterCredentialsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Credentials   string                 `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// UpdateClusterCredentialsRequest is the request to update cluster credentials
+type UpdateClusterCredentialsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SystemAccountCreds string                 `protobuf:"bytes,2,opt,name=system_account_creds,json=systemAccountCreds,proto3" json:"system_account_creds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetClusterCredentialsResponse) Reset() {
-	*x = GetClusterCredentialsResponse{}
+func (x *UpdateClusterCredentialsRequest) Reset() {
+	*x = UpdateClusterCredentialsRequest{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClusterCredentialsResponse) String() string {
+func (x *UpdateClusterCredentialsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClusterCredentialsResponse) ProtoMessage() {}
+func (*UpdateClusterCredentialsRequest) ProtoMessage() {}
 
-func (x *GetClusterCredentialsResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateClusterCredentialsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -959,42 +1239,47 @@ func (x *GetClusterCredentialsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClusterCredentialsResponse.ProtoReflect.Descriptor instead.
-func (*GetClusterCredentialsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateClusterCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateClusterCredentialsRequest) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetClusterCredentialsResponse) GetCredentials() string {
+func (x *UpdateClusterCredentialsRequest) GetId() string {
 	if x != nil {
-		return x.Credentials
+		return x.Id
 	}
 	return ""
 }
 
-// GenerateServerConfigRequest is the request to generate a server config
-type GenerateServerConfigRequest struct {
+func (x *UpdateClusterCredentialsRequest) GetSystemAccountCreds() string {
+	if x != nil {
+		return x.SystemAccountCreds
+	}
+	return ""
+}
+
+// UpdateClusterCredentialsResponse is the response from updating cluster credentials
+type UpdateClusterCredentialsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
-	HttpPort      int32                  `protobuf:"varint,3,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
+	Cluster       *Cluster               `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateServerConfigRequest) Reset() {
-	*x = GenerateServerConfigRequest{}
+func (x *UpdateClusterCredentialsResponse) Reset() {
+	*x = UpdateClusterCredentialsResponse{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateServerConfigRequest) String() string {
+func (x *UpdateClusterCredentialsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateServerConfigRequest) ProtoMessage() {}
+func (*UpdateClusterCredentialsResponse) ProtoMessage() {}
 
-func (x *GenerateServerConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateClusterCredentialsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1006,54 +1291,40 @@ func (x *GenerateServerConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateServerConfigRequest.ProtoReflect.Descriptor instead.
-func (*GenerateServerConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateClusterCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateClusterCredentialsResponse) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GenerateServerConfigRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *GenerateServerConfigRequest) GetPort() int32 {
-	if x != nil {
-		return x.Port
-	}
-	return 0
-}
-
-func (x *GenerateServerConfigRequest) GetHttpPort() int32 {
+func (x *UpdateClusterCredentialsResponse) GetCluster() *Cluster {
 	if x != nil {
-		return x.HttpPort
+		return x.Cluster
 	}
-	return 0
+	return nil
 }
 
-// GenerateServerConfigResponse is the response from generating a server config
-type GenerateServerConfigResponse struct {
+// DeleteClusterRequest is the request to delete a cluster
+type DeleteClusterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateServerConfigResponse) Reset() {
-	*x = GenerateServerConfigResponse{}
+func (x *DeleteClusterRequest) Reset() {
+	*x = DeleteClusterRequest{}
 	mi := &file_nis_v1_cluster_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateServerConfigResponse) String() string {
+func (x *DeleteClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateServerConfigResponse) ProtoMessage() {}
+func (*DeleteClusterRequest) ProtoMessage() {}
 
-func (x *GenerateServerConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteClusterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_nis_v1_cluster_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1065,43 +1336,2846 @@ func (x *GenerateServerConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateServerConfigResponse.ProtoReflect.Descriptor instead.
-func (*GenerateServerConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteClusterRequest.ProtoReflect.Descriptor instead.
+func (*DeleteClusterRequest) Descriptor() ([]byte, []int) {
 	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GenerateServerConfigResponse) GetConfig() string {
+func (x *DeleteClusterRequest) GetId() string {
 	if x != nil {
-		return x.Config
+		return x.Id
 	}
 	return ""
 }
 
-// SyncClusterRequest is the request to sync accounts to a cluster
-type SyncClusterRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// If true, remove accounts from the resolver that are not in the database
-	Prune         bool `protobuf:"varint,2,opt,name=prune,proto3" json:"prune,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// DeleteClusterResponse is the response from deleting a cluster
+type DeleteClusterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteClusterResponse) Reset() {
+	*x = DeleteClusterResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClusterResponse) ProtoMessage() {}
+
+func (x *DeleteClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClusterResponse.ProtoReflect.Descriptor instead.
+func (*DeleteClusterResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{19}
+}
+
+// GetClusterCredentialsRequest is the request to get cluster credentials
+type GetClusterCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterCredentialsRequest) Reset() {
+	*x = GetClusterCredentialsRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterCredentialsRequest) ProtoMessage() {}
+
+func (x *GetClusterCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetClusterCredentialsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetClusterCredentialsResponse is the response from getting cluster credentials
+type GetClusterCredentialsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Credentials   string                 `protobuf:"bytes,1,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterCredentialsResponse) Reset() {
+	*x = GetClusterCredentialsResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterCredentialsResponse) ProtoMessage() {}
+
+func (x *GetClusterCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetClusterCredentialsResponse) GetCredentials() string {
+	if x != nil {
+		return x.Credentials
+	}
+	return ""
+}
+
+// GenerateServerConfigRequest is the request to generate a server config
+type GenerateServerConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Override the profile's client and monitoring ports when set
+	Port     int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	HttpPort int32 `protobuf:"varint,3,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
+	// Name of the server the configuration is for
+	ServerName string `protobuf:"bytes,4,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// Replaces the cluster's stored profile when set
+	Profile *ServerProfile `protobuf:"bytes,5,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Store the profile with the cluster once the configuration is valid
+	SaveProfile   bool `protobuf:"varint,6,opt,name=save_profile,json=saveProfile,proto3" json:"save_profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateServerConfigRequest) Reset() {
+	*x = GenerateServerConfigRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateServerConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateServerConfigRequest) ProtoMessage() {}
+
+func (x *GenerateServerConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateServerConfigRequest.ProtoReflect.Descriptor instead.
+func (*GenerateServerConfigRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GenerateServerConfigRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GenerateServerConfigRequest) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *GenerateServerConfigRequest) GetHttpPort() int32 {
+	if x != nil {
+		return x.HttpPort
+	}
+	return 0
+}
+
+func (x *GenerateServerConfigRequest) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *GenerateServerConfigRequest) GetProfile() *ServerProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GenerateServerConfigRequest) GetSaveProfile() bool {
+	if x != nil {
+		return x.SaveProfile
+	}
+	return false
+}
+
+// GenerateServerConfigResponse is the response from generating a server config
+type GenerateServerConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        string                 `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateServerConfigResponse) Reset() {
+	*x = GenerateServerConfigResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateServerConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateServerConfigResponse) ProtoMessage() {}
+
+func (x *GenerateServerConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateServerConfigResponse.ProtoReflect.Descriptor instead.
+func (*GenerateServerConfigResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GenerateServerConfigResponse) GetConfig() string {
+	if x != nil {
+		return x.Config
+	}
+	return ""
+}
+
+// SyncClusterRequest is the request to sync accounts to a cluster
+type SyncClusterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// If true, remove accounts from the resolver that are not in the database
+	Prune         bool `protobuf:"varint,2,opt,name=prune,proto3" json:"prune,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SyncClusterRequest) Reset() {
 	*x = SyncClusterRequest{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[19]
+	mi := &file_nis_v1_cluster_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncClusterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncClusterRequest) ProtoMessage() {}
+
+func (x *SyncClusterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncClusterRequest.ProtoReflect.Descriptor instead.
+func (*SyncClusterRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SyncClusterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SyncClusterRequest) GetPrune() bool {
+	if x != nil {
+		return x.Prune
+	}
+	return false
+}
+
+// SyncClusterResponse is the response from syncing accounts
+type SyncClusterResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AccountCount int32                  `protobuf:"varint,1,opt,name=account_count,json=accountCount,proto3" json:"account_count,omitempty"`
+	Accounts     []string               `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// Number of accounts added to the resolver
+	AccountsAdded int32 `protobuf:"varint,3,opt,name=accounts_added,json=accountsAdded,proto3" json:"accounts_added,omitempty"`
+	// Number of accounts removed from the resolver (only if prune=true)
+	AccountsRemoved int32 `protobuf:"varint,4,opt,name=accounts_removed,json=accountsRemoved,proto3" json:"accounts_removed,omitempty"`
+	// Number of accounts updated in the resolver
+	AccountsUpdated int32 `protobuf:"varint,5,opt,name=accounts_updated,json=accountsUpdated,proto3" json:"accounts_updated,omitempty"`
+	// Accounts that were removed from the resolver
+	RemovedAccounts []string `protobuf:"bytes,6,rep,name=removed_accounts,json=removedAccounts,proto3" json:"removed_accounts,omitempty"`
+	// Errors encountered during sync (non-fatal)
+	Errors []*SyncError `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	// How each NATS server answered the account JWT pushes
+	Servers []*ServerSyncStatus `protobuf:"bytes,8,rep,name=servers,proto3" json:"servers,omitempty"`
+	// Whether the pushed accounts reached the other clusters of the supercluster
+	Peers         []*SuperclusterPeerSync `protobuf:"bytes,9,rep,name=peers,proto3" json:"peers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncClusterResponse) Reset() {
+	*x = SyncClusterResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncClusterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncClusterResponse) ProtoMessage() {}
+
+func (x *SyncClusterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncClusterResponse.ProtoReflect.Descriptor instead.
+func (*SyncClusterResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SyncClusterResponse) GetAccountCount() int32 {
+	if x != nil {
+		return x.AccountCount
+	}
+	return 0
+}
+
+func (x *SyncClusterResponse) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *SyncClusterResponse) GetAccountsAdded() int32 {
+	if x != nil {
+		return x.AccountsAdded
+	}
+	return 0
+}
+
+func (x *SyncClusterResponse) GetAccountsRemoved() int32 {
+	if x != nil {
+		return x.AccountsRemoved
+	}
+	return 0
+}
+
+func (x *SyncClusterResponse) GetAccountsUpdated() int32 {
+	if x != nil {
+		return x.AccountsUpdated
+	}
+	return 0
+}
+
+func (x *SyncClusterResponse) GetRemovedAccounts() []string {
+	if x != nil {
+		return x.RemovedAccounts
+	}
+	return nil
+}
+
+func (x *SyncClusterResponse) GetErrors() []*SyncError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *SyncClusterResponse) GetServers() []*ServerSyncStatus {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *SyncClusterResponse) GetPeers() []*SuperclusterPeerSync {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+// ServerSyncStatus counts how one NATS server answered the pushes of a sync
+type ServerSyncStatus struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ServerId     string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ServerName   string                 `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Acknowledged int32                  `protobuf:"varint,3,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	Rejected     int32                  `protobuf:"varint,4,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// Pushes the server did not acknowledge within the reply window
+	Missing int32 `protobuf:"varint,5,opt,name=missing,proto3" json:"missing,omitempty"`
+	// Cluster of the server, another member's in a supercluster
+	Cluster       string `protobuf:"bytes,6,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerSyncStatus) Reset() {
+	*x = ServerSyncStatus{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerSyncStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerSyncStatus) ProtoMessage() {}
+
+func (x *ServerSyncStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerSyncStatus.ProtoReflect.Descriptor instead.
+func (*ServerSyncStatus) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ServerSyncStatus) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ServerSyncStatus) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ServerSyncStatus) GetAcknowledged() int32 {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return 0
+}
+
+func (x *ServerSyncStatus) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *ServerSyncStatus) GetMissing() int32 {
+	if x != nil {
+		return x.Missing
+	}
+	return 0
+}
+
+func (x *ServerSyncStatus) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+// SuperclusterPeerSync checks the pushed accounts on another cluster of the supercluster
+type SuperclusterPeerSync struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId   string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ClusterName string                 `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Servers of the cluster that answered the account listing
+	Servers int32 `protobuf:"varint,3,opt,name=servers,proto3" json:"servers,omitempty"`
+	// Pushed accounts held by all of them
+	Verified int32 `protobuf:"varint,4,opt,name=verified,proto3" json:"verified,omitempty"`
+	// Names of the pushed accounts missing on at least one of them
+	Missing []string `protobuf:"bytes,5,rep,name=missing,proto3" json:"missing,omitempty"`
+	// Set when the cluster could not be checked
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuperclusterPeerSync) Reset() {
+	*x = SuperclusterPeerSync{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuperclusterPeerSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperclusterPeerSync) ProtoMessage() {}
+
+func (x *SuperclusterPeerSync) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperclusterPeerSync.ProtoReflect.Descriptor instead.
+func (*SuperclusterPeerSync) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SuperclusterPeerSync) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *SuperclusterPeerSync) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *SuperclusterPeerSync) GetServers() int32 {
+	if x != nil {
+		return x.Servers
+	}
+	return 0
+}
+
+func (x *SuperclusterPeerSync) GetVerified() int32 {
+	if x != nil {
+		return x.Verified
+	}
+	return 0
+}
+
+func (x *SuperclusterPeerSync) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *SuperclusterPeerSync) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// SyncError represents an error encountered during sync
+type SyncError struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountPublicKey string                 `protobuf:"bytes,1,opt,name=account_public_key,json=accountPublicKey,proto3" json:"account_public_key,omitempty"`
+	AccountName      string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Error            string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SyncError) Reset() {
+	*x = SyncError{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncError) ProtoMessage() {}
+
+func (x *SyncError) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncError.ProtoReflect.Descriptor instead.
+func (*SyncError) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SyncError) GetAccountPublicKey() string {
+	if x != nil {
+		return x.AccountPublicKey
+	}
+	return ""
+}
+
+func (x *SyncError) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *SyncError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListResolverAccountsRequest is the request to list accounts on the NATS resolver
+type ListResolverAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResolverAccountsRequest) Reset() {
+	*x = ListResolverAccountsRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResolverAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResolverAccountsRequest) ProtoMessage() {}
+
+func (x *ListResolverAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResolverAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListResolverAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListResolverAccountsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+// ListResolverAccountsResponse is the response from listing resolver accounts
+type ListResolverAccountsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Public keys of accounts on the resolver
+	PublicKeys    []string `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResolverAccountsResponse) Reset() {
+	*x = ListResolverAccountsResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResolverAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResolverAccountsResponse) ProtoMessage() {}
+
+func (x *ListResolverAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResolverAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListResolverAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListResolverAccountsResponse) GetPublicKeys() []string {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+// DeleteResolverAccountRequest is the request to delete an account from the NATS resolver
+type DeleteResolverAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResolverAccountRequest) Reset() {
+	*x = DeleteResolverAccountRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResolverAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResolverAccountRequest) ProtoMessage() {}
+
+func (x *DeleteResolverAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResolverAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteResolverAccountRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteResolverAccountRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *DeleteResolverAccountRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+// DeleteResolverAccountResponse is the response from deleting a resolver account
+type DeleteResolverAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResolverAccountResponse) Reset() {
+	*x = DeleteResolverAccountResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResolverAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResolverAccountResponse) ProtoMessage() {}
+
+func (x *DeleteResolverAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResolverAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteResolverAccountResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{32}
+}
+
+// VerifyAccountRequest is the request to verify an account JWT reached every server
+type VerifyAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAccountRequest) Reset() {
+	*x = VerifyAccountRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAccountRequest) ProtoMessage() {}
+
+func (x *VerifyAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAccountRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAccountRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VerifyAccountRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *VerifyAccountRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+// VerifyAccountResponse reports the propagation of an account JWT across a cluster
+type VerifyAccountResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AccountPublicKey string                 `protobuf:"bytes,1,opt,name=account_public_key,json=accountPublicKey,proto3" json:"account_public_key,omitempty"`
+	AccountName      string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	// True when every server holds the JWT stored in the database
+	Propagated bool `protobuf:"varint,3,opt,name=propagated,proto3" json:"propagated,omitempty"`
+	// Lookup replies holding the current JWT
+	CurrentCopies int32 `protobuf:"varint,4,opt,name=current_copies,json=currentCopies,proto3" json:"current_copies,omitempty"`
+	// Lookup replies holding a different JWT
+	MismatchedCopies int32 `protobuf:"varint,5,opt,name=mismatched_copies,json=mismatchedCopies,proto3" json:"mismatched_copies,omitempty"`
+	// Lookup replies from servers without the account
+	MissingCopies int32                 `protobuf:"varint,6,opt,name=missing_copies,json=missingCopies,proto3" json:"missing_copies,omitempty"`
+	Servers       []*ServerVerification `protobuf:"bytes,7,rep,name=servers,proto3" json:"servers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAccountResponse) Reset() {
+	*x = VerifyAccountResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAccountResponse) ProtoMessage() {}
+
+func (x *VerifyAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAccountResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAccountResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *VerifyAccountResponse) GetAccountPublicKey() string {
+	if x != nil {
+		return x.AccountPublicKey
+	}
+	return ""
+}
+
+func (x *VerifyAccountResponse) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *VerifyAccountResponse) GetPropagated() bool {
+	if x != nil {
+		return x.Propagated
+	}
+	return false
+}
+
+func (x *VerifyAccountResponse) GetCurrentCopies() int32 {
+	if x != nil {
+		return x.CurrentCopies
+	}
+	return 0
+}
+
+func (x *VerifyAccountResponse) GetMismatchedCopies() int32 {
+	if x != nil {
+		return x.MismatchedCopies
+	}
+	return 0
+}
+
+func (x *VerifyAccountResponse) GetMissingCopies() int32 {
+	if x != nil {
+		return x.MissingCopies
+	}
+	return 0
+}
+
+func (x *VerifyAccountResponse) GetServers() []*ServerVerification {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+// ServerVerification is the state of an account on a single NATS server
+type ServerVerification struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ServerId   string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ServerName string                 `protobuf:"bytes,2,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// False when the server answered the ping but not the resolver listing
+	Responded     bool `protobuf:"varint,3,opt,name=responded,proto3" json:"responded,omitempty"`
+	HasAccount    bool `protobuf:"varint,4,opt,name=has_account,json=hasAccount,proto3" json:"has_account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerVerification) Reset() {
+	*x = ServerVerification{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerVerification) ProtoMessage() {}
+
+func (x *ServerVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerVerification.ProtoReflect.Descriptor instead.
+func (*ServerVerification) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ServerVerification) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ServerVerification) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ServerVerification) GetResponded() bool {
+	if x != nil {
+		return x.Responded
+	}
+	return false
+}
+
+func (x *ServerVerification) GetHasAccount() bool {
+	if x != nil {
+		return x.HasAccount
+	}
+	return false
+}
+
+// ClusterServer is a NATS server of a cluster as recorded by the health check
+type ClusterServer struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ServerId  string                 `protobuf:"bytes,1,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Host      string                 `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Version   string                 `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Jetstream bool                   `protobuf:"varint,5,opt,name=jetstream,proto3" json:"jetstream,omitempty"`
+	// Name of the NATS cluster the server is routed into
+	ClusterName string `protobuf:"bytes,6,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Names of the gateways the server is connected to
+	Gateways      []string               `protobuf:"bytes,7,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	Connections   int32                  `protobuf:"varint,8,opt,name=connections,proto3" json:"connections,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	UptimeSeconds int64                  `protobuf:"varint,10,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// True when the server did not answer the last health check
+	Missing       bool `protobuf:"varint,12,opt,name=missing,proto3" json:"missing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterServer) Reset() {
+	*x = ClusterServer{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterServer) ProtoMessage() {}
+
+func (x *ClusterServer) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterServer.ProtoReflect.Descriptor instead.
+func (*ClusterServer) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ClusterServer) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ClusterServer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClusterServer) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ClusterServer) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ClusterServer) GetJetstream() bool {
+	if x != nil {
+		return x.Jetstream
+	}
+	return false
+}
+
+func (x *ClusterServer) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ClusterServer) GetGateways() []string {
+	if x != nil {
+		return x.Gateways
+	}
+	return nil
+}
+
+func (x *ClusterServer) GetConnections() int32 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+func (x *ClusterServer) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ClusterServer) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *ClusterServer) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *ClusterServer) GetMissing() bool {
+	if x != nil {
+		return x.Missing
+	}
+	return false
+}
+
+// GetClusterTopologyRequest is the request to get the servers of a cluster
+type GetClusterTopologyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterTopologyRequest) Reset() {
+	*x = GetClusterTopologyRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterTopologyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterTopologyRequest) ProtoMessage() {}
+
+func (x *GetClusterTopologyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterTopologyRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterTopologyRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetClusterTopologyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetClusterTopologyResponse is the response from getting the servers of a cluster
+type GetClusterTopologyResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Servers []*ClusterServer       `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// Version skew and missing servers
+	Warnings        []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	LastHealthCheck *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_health_check,json=lastHealthCheck,proto3" json:"last_health_check,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetClusterTopologyResponse) Reset() {
+	*x = GetClusterTopologyResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterTopologyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterTopologyResponse) ProtoMessage() {}
+
+func (x *GetClusterTopologyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterTopologyResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterTopologyResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetClusterTopologyResponse) GetServers() []*ClusterServer {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *GetClusterTopologyResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *GetClusterTopologyResponse) GetLastHealthCheck() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastHealthCheck
+	}
+	return nil
+}
+
+// ClusterHealthCheck is the outcome of a single health check of a cluster
+type ClusterHealthCheck struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CheckedAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+	Healthy   bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Time to connect and complete a round trip, 0 when the check failed
+	LatencyMs     int64  `protobuf:"varint,3,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClusterHealthCheck) Reset() {
+	*x = ClusterHealthCheck{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterHealthCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterHealthCheck) ProtoMessage() {}
+
+func (x *ClusterHealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterHealthCheck.ProtoReflect.Descriptor instead.
+func (*ClusterHealthCheck) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ClusterHealthCheck) GetCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return nil
+}
+
+func (x *ClusterHealthCheck) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *ClusterHealthCheck) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *ClusterHealthCheck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ListClusterHealthChecksRequest is the request to list the health history of a cluster
+type ListClusterHealthChecksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Options       *ListOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClusterHealthChecksRequest) Reset() {
+	*x = ListClusterHealthChecksRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClusterHealthChecksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterHealthChecksRequest) ProtoMessage() {}
+
+func (x *ListClusterHealthChecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterHealthChecksRequest.ProtoReflect.Descriptor instead.
+func (*ListClusterHealthChecksRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListClusterHealthChecksRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListClusterHealthChecksRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// ListClusterHealthChecksResponse is the response from listing the health history, newest first
+type ListClusterHealthChecksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checks        []*ClusterHealthCheck  `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListClusterHealthChecksResponse) Reset() {
+	*x = ListClusterHealthChecksResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListClusterHealthChecksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListClusterHealthChecksResponse) ProtoMessage() {}
+
+func (x *ListClusterHealthChecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListClusterHealthChecksResponse.ProtoReflect.Descriptor instead.
+func (*ListClusterHealthChecksResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListClusterHealthChecksResponse) GetChecks() []*ClusterHealthCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+// ListConnectionsRequest is the request to list the live connections of an account or user
+type ListConnectionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required unless user_id is set
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Only list this user's connections
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only query this cluster, every cluster of the account's operator if empty
+	ClusterId string `protobuf:"bytes,3,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// Maximum connections returned per server, 0 for the default
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConnectionsRequest) Reset() {
+	*x = ListConnectionsRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsRequest) ProtoMessage() {}
+
+func (x *ListConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListConnectionsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListConnectionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListConnectionsRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ListConnectionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ListConnectionsResponse is the response from listing live connections
+type ListConnectionsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Connections []*ClientConnection    `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	// True when a server had more matching connections than the limit
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Clusters and servers that could not be queried
+	Warnings      []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListConnectionsResponse) Reset() {
+	*x = ListConnectionsResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConnectionsResponse) ProtoMessage() {}
+
+func (x *ListConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListConnectionsResponse) GetConnections() []*ClientConnection {
+	if x != nil {
+		return x.Connections
+	}
+	return nil
+}
+
+func (x *ListConnectionsResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *ListConnectionsResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// ClientConnection is a live client connection reported by a NATS server
+type ClientConnection struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId   string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ClusterName string                 `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	ServerId    string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ServerName  string                 `protobuf:"bytes,4,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	// Client ID, unique per server
+	Cid           int64                  `protobuf:"varint,5,opt,name=cid,proto3" json:"cid,omitempty"`
+	Ip            string                 `protobuf:"bytes,6,opt,name=ip,proto3" json:"ip,omitempty"`
+	Port          int32                  `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=start,proto3" json:"start,omitempty"`
+	LastActivity  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_activity,json=lastActivity,proto3" json:"last_activity,omitempty"`
+	Rtt           string                 `protobuf:"bytes,10,opt,name=rtt,proto3" json:"rtt,omitempty"`
+	Uptime        string                 `protobuf:"bytes,11,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	InMsgs        int64                  `protobuf:"varint,12,opt,name=in_msgs,json=inMsgs,proto3" json:"in_msgs,omitempty"`
+	OutMsgs       int64                  `protobuf:"varint,13,opt,name=out_msgs,json=outMsgs,proto3" json:"out_msgs,omitempty"`
+	InBytes       int64                  `protobuf:"varint,14,opt,name=in_bytes,json=inBytes,proto3" json:"in_bytes,omitempty"`
+	OutBytes      int64                  `protobuf:"varint,15,opt,name=out_bytes,json=outBytes,proto3" json:"out_bytes,omitempty"`
+	Subscriptions int32                  `protobuf:"varint,16,opt,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	// Name the client library connected with
+	ClientName    string `protobuf:"bytes,17,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+	Lang          string `protobuf:"bytes,18,opt,name=lang,proto3" json:"lang,omitempty"`
+	Version       string `protobuf:"bytes,19,opt,name=version,proto3" json:"version,omitempty"`
+	UserPublicKey string `protobuf:"bytes,20,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+	// Empty when the public key is not a NIS user of the account
+	UserId               string `protobuf:"bytes,21,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName             string `protobuf:"bytes,22,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	ScopedSigningKeyId   string `protobuf:"bytes,23,opt,name=scoped_signing_key_id,json=scopedSigningKeyId,proto3" json:"scoped_signing_key_id,omitempty"`
+	ScopedSigningKeyName string `protobuf:"bytes,24,opt,name=scoped_signing_key_name,json=scopedSigningKeyName,proto3" json:"scoped_signing_key_name,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ClientConnection) Reset() {
+	*x = ClientConnection{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientConnection) ProtoMessage() {}
+
+func (x *ClientConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientConnection.ProtoReflect.Descriptor instead.
+func (*ClientConnection) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ClientConnection) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetCid() int64 {
+	if x != nil {
+		return x.Cid
+	}
+	return 0
+}
+
+func (x *ClientConnection) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ClientConnection) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ClientConnection) GetLastActivity() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastActivity
+	}
+	return nil
+}
+
+func (x *ClientConnection) GetRtt() string {
+	if x != nil {
+		return x.Rtt
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetUptime() string {
+	if x != nil {
+		return x.Uptime
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetInMsgs() int64 {
+	if x != nil {
+		return x.InMsgs
+	}
+	return 0
+}
+
+func (x *ClientConnection) GetOutMsgs() int64 {
+	if x != nil {
+		return x.OutMsgs
+	}
+	return 0
+}
+
+func (x *ClientConnection) GetInBytes() int64 {
+	if x != nil {
+		return x.InBytes
+	}
+	return 0
+}
+
+func (x *ClientConnection) GetOutBytes() int64 {
+	if x != nil {
+		return x.OutBytes
+	}
+	return 0
+}
+
+func (x *ClientConnection) GetSubscriptions() int32 {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return 0
+}
+
+func (x *ClientConnection) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetUserPublicKey() string {
+	if x != nil {
+		return x.UserPublicKey
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetScopedSigningKeyId() string {
+	if x != nil {
+		return x.ScopedSigningKeyId
+	}
+	return ""
+}
+
+func (x *ClientConnection) GetScopedSigningKeyName() string {
+	if x != nil {
+		return x.ScopedSigningKeyName
+	}
+	return ""
+}
+
+// DisconnectUserRequest kicks every live connection of a user
+type DisconnectUserRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Optional - every cluster of the user's operator when empty
+	ClusterId     string `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectUserRequest) Reset() {
+	*x = DisconnectUserRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectUserRequest) ProtoMessage() {}
+
+func (x *DisconnectUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectUserRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectUserRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DisconnectUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DisconnectUserRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+// DisconnectUserResponse reports the connections terminated per server
+type DisconnectUserResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only servers where the user was connected are listed
+	Servers []*ServerDisconnect `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// Total connections terminated
+	Disconnected int32 `protobuf:"varint,2,opt,name=disconnected,proto3" json:"disconnected,omitempty"`
+	// Clusters and servers that could not be queried
+	Warnings      []string `protobuf:"bytes,3,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectUserResponse) Reset() {
+	*x = DisconnectUserResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectUserResponse) ProtoMessage() {}
+
+func (x *DisconnectUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectUserResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectUserResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DisconnectUserResponse) GetServers() []*ServerDisconnect {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *DisconnectUserResponse) GetDisconnected() int32 {
+	if x != nil {
+		return x.Disconnected
+	}
+	return 0
+}
+
+func (x *DisconnectUserResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// ServerDisconnect reports the connections of a user terminated on one server
+type ServerDisconnect struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId    string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ClusterName  string                 `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	ServerId     string                 `protobuf:"bytes,3,opt,name=server_id,json=serverId,proto3" json:"server_id,omitempty"`
+	ServerName   string                 `protobuf:"bytes,4,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	Found        int32                  `protobuf:"varint,5,opt,name=found,proto3" json:"found,omitempty"`
+	Disconnected int32                  `protobuf:"varint,6,opt,name=disconnected,proto3" json:"disconnected,omitempty"`
+	// One entry per connection that could not be kicked
+	Errors        []string `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerDisconnect) Reset() {
+	*x = ServerDisconnect{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerDisconnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerDisconnect) ProtoMessage() {}
+
+func (x *ServerDisconnect) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerDisconnect.ProtoReflect.Descriptor instead.
+func (*ServerDisconnect) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ServerDisconnect) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ServerDisconnect) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *ServerDisconnect) GetServerId() string {
+	if x != nil {
+		return x.ServerId
+	}
+	return ""
+}
+
+func (x *ServerDisconnect) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *ServerDisconnect) GetFound() int32 {
+	if x != nil {
+		return x.Found
+	}
+	return 0
+}
+
+func (x *ServerDisconnect) GetDisconnected() int32 {
+	if x != nil {
+		return x.Disconnected
+	}
+	return 0
+}
+
+func (x *ServerDisconnect) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// GetClusterCapacityRequest asks for the JetStream capacity report of a cluster
+type GetClusterCapacityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterCapacityRequest) Reset() {
+	*x = GetClusterCapacityRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterCapacityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterCapacityRequest) ProtoMessage() {}
+
+func (x *GetClusterCapacityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterCapacityRequest.ProtoReflect.Descriptor instead.
+func (*GetClusterCapacityRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetClusterCapacityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetClusterCapacityResponse compares the JetStream capacity of a cluster, reported by
+// JSZ, with the limits assigned to the operator's accounts. Sizes are in bytes.
+type GetClusterCapacityResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId        string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ClusterName      string                 `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	JetstreamServers int32                  `protobuf:"varint,3,opt,name=jetstream_servers,json=jetstreamServers,proto3" json:"jetstream_servers,omitempty"`
+	MemoryCapacity   int64                  `protobuf:"varint,4,opt,name=memory_capacity,json=memoryCapacity,proto3" json:"memory_capacity,omitempty"`
+	StorageCapacity  int64                  `protobuf:"varint,5,opt,name=storage_capacity,json=storageCapacity,proto3" json:"storage_capacity,omitempty"`
+	// Sum of the account limits, unlimited accounts excluded
+	MemoryAssigned  int64 `protobuf:"varint,6,opt,name=memory_assigned,json=memoryAssigned,proto3" json:"memory_assigned,omitempty"`
+	StorageAssigned int64 `protobuf:"varint,7,opt,name=storage_assigned,json=storageAssigned,proto3" json:"storage_assigned,omitempty"`
+	// Reserved by streams and used, as reported by the servers
+	MemoryReserved  int64 `protobuf:"varint,8,opt,name=memory_reserved,json=memoryReserved,proto3" json:"memory_reserved,omitempty"`
+	StorageReserved int64 `protobuf:"varint,9,opt,name=storage_reserved,json=storageReserved,proto3" json:"storage_reserved,omitempty"`
+	MemoryUsed      int64 `protobuf:"varint,10,opt,name=memory_used,json=memoryUsed,proto3" json:"memory_used,omitempty"`
+	StorageUsed     int64 `protobuf:"varint,11,opt,name=storage_used,json=storageUsed,proto3" json:"storage_used,omitempty"`
+	// JetStream enabled accounts of the operator
+	Accounts                 int32    `protobuf:"varint,12,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	UnlimitedMemoryAccounts  []string `protobuf:"bytes,13,rep,name=unlimited_memory_accounts,json=unlimitedMemoryAccounts,proto3" json:"unlimited_memory_accounts,omitempty"`
+	UnlimitedStorageAccounts []string `protobuf:"bytes,14,rep,name=unlimited_storage_accounts,json=unlimitedStorageAccounts,proto3" json:"unlimited_storage_accounts,omitempty"`
+	// Set when assigned limits exceed the capacity
+	Warnings      []string `protobuf:"bytes,15,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClusterCapacityResponse) Reset() {
+	*x = GetClusterCapacityResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClusterCapacityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClusterCapacityResponse) ProtoMessage() {}
+
+func (x *GetClusterCapacityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClusterCapacityResponse.ProtoReflect.Descriptor instead.
+func (*GetClusterCapacityResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetClusterCapacityResponse) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *GetClusterCapacityResponse) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *GetClusterCapacityResponse) GetJetstreamServers() int32 {
+	if x != nil {
+		return x.JetstreamServers
+	}
+	return 0
+}
+
+func (x *GetClusterCapacityResponse) GetMemoryCapacity() int64 {
+	if x != nil {
+		return x.MemoryCapacity
+	}
+	return 0
+}
+
+func (x *GetClusterCapacityResponse) GetStorageCapacity() int64 {
+	if x != nil {
+		return x.StorageCapacity
+	}
+	return 0
+}
+
+func (x *GetClusterCapacityResponse) GetMemoryAssigned() int64 {
+	if x != nil {
+		return x.MemoryAssigned
+	}
+	return 0
+}
+
+func (x *GetClusterCapacityResponse) GetStorageAssigned() int64 {
+	if x != nil {
+		return x.StorageAssigned
+	}
+	return 0
+}
+
+func (x *GetClusterCapacityResponse) GetMemoryReserved() int64 {
+	if x != nil {
+		return x.MemoryReserved
+	}
+	return 0
+}
+
+func (x *GetClusterCapacityResponse) GetStorageReserved() int64 {
+	if x != nil {
+		return x.StorageReserved
+	}
+	return 0
+}
+
+func (x *GetClusterCapacityResponse) GetMemoryUsed() int64 {
+	if x != nil {
+		return x.MemoryUsed
+	}
+	return 0
+}
+
+func (x *GetClusterCapacityResponse) GetStorageUsed() int64 {
+	if x != nil {
+		return x.StorageUsed
+	}
+	return 0
+}
+
+func (x *GetClusterCapacityResponse) GetAccounts() int32 {
+	if x != nil {
+		return x.Accounts
+	}
+	return 0
+}
+
+func (x *GetClusterCapacityResponse) GetUnlimitedMemoryAccounts() []string {
+	if x != nil {
+		return x.UnlimitedMemoryAccounts
+	}
+	return nil
+}
+
+func (x *GetClusterCapacityResponse) GetUnlimitedStorageAccounts() []string {
+	if x != nil {
+		return x.UnlimitedStorageAccounts
+	}
+	return nil
+}
+
+func (x *GetClusterCapacityResponse) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// AuthFailure aggregates the failed NATS client authentications of a cluster sharing
+// the presented user key, remote address and reason within one minute
+type AuthFailure struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClusterId   string                 `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	WindowStart *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// Key the client presented, empty if none
+	UserPublicKey    string `protobuf:"bytes,4,opt,name=user_public_key,json=userPublicKey,proto3" json:"user_public_key,omitempty"`
+	AccountPublicKey string `protobuf:"bytes,5,opt,name=account_public_key,json=accountPublicKey,proto3" json:"account_public_key,omitempty"`
+	// NIS user and account the keys belong to, empty for unknown keys
+	UserId        string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccountId     string                 `protobuf:"bytes,7,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	RemoteIp      string                 `protobuf:"bytes,8,opt,name=remote_ip,json=remoteIp,proto3" json:"remote_ip,omitempty"`
+	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	ServerName    string                 `protobuf:"bytes,10,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	ClientName    string                 `protobuf:"bytes,11,opt,name=client_name,json=clientName,proto3" json:"client_name,omitempty"`
+	Count         int64                  `protobuf:"varint,12,opt,name=count,proto3" json:"count,omitempty"`
+	FirstSeen     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthFailure) Reset() {
+	*x = AuthFailure{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthFailure) ProtoMessage() {}
+
+func (x *AuthFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthFailure.ProtoReflect.Descriptor instead.
+func (*AuthFailure) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AuthFailure) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuthFailure) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *AuthFailure) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *AuthFailure) GetUserPublicKey() string {
+	if x != nil {
+		return x.UserPublicKey
+	}
+	return ""
+}
+
+func (x *AuthFailure) GetAccountPublicKey() string {
+	if x != nil {
+		return x.AccountPublicKey
+	}
+	return ""
+}
+
+func (x *AuthFailure) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthFailure) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AuthFailure) GetRemoteIp() string {
+	if x != nil {
+		return x.RemoteIp
+	}
+	return ""
+}
+
+func (x *AuthFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuthFailure) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+func (x *AuthFailure) GetClientName() string {
+	if x != nil {
+		return x.ClientName
+	}
+	return ""
+}
+
+func (x *AuthFailure) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AuthFailure) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *AuthFailure) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+// ListAuthFailuresRequest filters the NATS authentication failure log. At least one of
+// cluster_id, account_id and user_id is required.
+type ListAuthFailuresRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	AccountId string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Only failures last seen at or after this time
+	Since         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Options       *ListOptions           `protobuf:"bytes,5,opt,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthFailuresRequest) Reset() {
+	*x = ListAuthFailuresRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthFailuresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthFailuresRequest) ProtoMessage() {}
+
+func (x *ListAuthFailuresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthFailuresRequest.ProtoReflect.Descriptor instead.
+func (*ListAuthFailuresRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListAuthFailuresRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *ListAuthFailuresRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListAuthFailuresRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListAuthFailuresRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuthFailuresRequest) GetOptions() *ListOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// ListAuthFailuresResponse lists the matching failures, most recently seen first
+type ListAuthFailuresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Failures      []*AuthFailure         `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuthFailuresResponse) Reset() {
+	*x = ListAuthFailuresResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuthFailuresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuthFailuresResponse) ProtoMessage() {}
+
+func (x *ListAuthFailuresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuthFailuresResponse.ProtoReflect.Descriptor instead.
+func (*ListAuthFailuresResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListAuthFailuresResponse) GetFailures() []*AuthFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// LeafnodeProfile binds a NIS user to a hub cluster: edge servers open their leafnode
+// remote to the hub as that user
+type LeafnodeProfile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Hub cluster
+	ClusterId string `protobuf:"bytes,2,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Name      string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	UserId    string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Account of the leaf server bound to the remote, empty for its default account
+	LocalAccount string `protobuf:"bytes,5,opt,name=local_account,json=localAccount,proto3" json:"local_account,omitempty"`
+	// Leafnode URLs of the hub, derived from its server URLs and leafnode port when empty
+	Urls []string `protobuf:"bytes,6,rep,name=urls,proto3" json:"urls,omitempty"`
+	// Path of the .creds file on the leaf server
+	CredentialsPath string `protobuf:"bytes,7,opt,name=credentials_path,json=credentialsPath,proto3" json:"credentials_path,omitempty"`
+	// CA verifying the hub's certificate, on the leaf server
+	CaFile        string                 `protobuf:"bytes,8,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeafnodeProfile) Reset() {
+	*x = LeafnodeProfile{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeafnodeProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeafnodeProfile) ProtoMessage() {}
+
+func (x *LeafnodeProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeafnodeProfile.ProtoReflect.Descriptor instead.
+func (*LeafnodeProfile) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LeafnodeProfile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LeafnodeProfile) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *LeafnodeProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LeafnodeProfile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LeafnodeProfile) GetLocalAccount() string {
+	if x != nil {
+		return x.LocalAccount
+	}
+	return ""
+}
+
+func (x *LeafnodeProfile) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *LeafnodeProfile) GetCredentialsPath() string {
+	if x != nil {
+		return x.CredentialsPath
+	}
+	return ""
+}
+
+func (x *LeafnodeProfile) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+func (x *LeafnodeProfile) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *LeafnodeProfile) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateLeafnodeProfileRequest binds a user of the cluster's operator to the cluster
+type CreateLeafnodeProfileRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId    string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	UserId       string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LocalAccount string                 `protobuf:"bytes,4,opt,name=local_account,json=localAccount,proto3" json:"local_account,omitempty"`
+	Urls         []string               `protobuf:"bytes,5,rep,name=urls,proto3" json:"urls,omitempty"`
+	// Defaults to /etc/nats/<name>.creds
+	CredentialsPath string `protobuf:"bytes,6,opt,name=credentials_path,json=credentialsPath,proto3" json:"credentials_path,omitempty"`
+	CaFile          string `protobuf:"bytes,7,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateLeafnodeProfileRequest) Reset() {
+	*x = CreateLeafnodeProfileRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLeafnodeProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLeafnodeProfileRequest) ProtoMessage() {}
+
+func (x *CreateLeafnodeProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLeafnodeProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateLeafnodeProfileRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateLeafnodeProfileRequest) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *CreateLeafnodeProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLeafnodeProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateLeafnodeProfileRequest) GetLocalAccount() string {
+	if x != nil {
+		return x.LocalAccount
+	}
+	return ""
+}
+
+func (x *CreateLeafnodeProfileRequest) GetUrls() []string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *CreateLeafnodeProfileRequest) GetCredentialsPath() string {
+	if x != nil {
+		return x.CredentialsPath
+	}
+	return ""
+}
+
+func (x *CreateLeafnodeProfileRequest) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+// CreateLeafnodeProfileResponse returns the stored profile
+type CreateLeafnodeProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *LeafnodeProfile       `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLeafnodeProfileResponse) Reset() {
+	*x = CreateLeafnodeProfileResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLeafnodeProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLeafnodeProfileResponse) ProtoMessage() {}
+
+func (x *CreateLeafnodeProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLeafnodeProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateLeafnodeProfileResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateLeafnodeProfileResponse) GetProfile() *LeafnodeProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// ListLeafnodeProfilesRequest lists the leafnode profiles of a hub cluster
+type ListLeafnodeProfilesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeafnodeProfilesRequest) Reset() {
+	*x = ListLeafnodeProfilesRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncClusterRequest) String() string {
+func (x *ListLeafnodeProfilesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncClusterRequest) ProtoMessage() {}
+func (*ListLeafnodeProfilesRequest) ProtoMessage() {}
 
-func (x *SyncClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[19]
+func (x *ListLeafnodeProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1112,59 +4186,41 @@ func (x *SyncClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncClusterRequest.ProtoReflect.Descriptor instead.
-func (*SyncClusterRequest) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListLeafnodeProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListLeafnodeProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *SyncClusterRequest) GetId() string {
+func (x *ListLeafnodeProfilesRequest) GetClusterId() string {
 	if x != nil {
-		return x.Id
+		return x.ClusterId
 	}
 	return ""
 }
 
-func (x *SyncClusterRequest) GetPrune() bool {
-	if x != nil {
-		return x.Prune
-	}
-	return false
-}
-
-// SyncClusterResponse is the response from syncing accounts
-type SyncClusterResponse struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	AccountCount int32                  `protobuf:"varint,1,opt,name=account_count,json=accountCount,proto3" json:"account_count,omitempty"`
-	Accounts     []string               `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
-	// Number of accounts added to the resolver
-	AccountsAdded int32 `protobuf:"varint,3,opt,name=accounts_added,json=accountsAdded,proto3" json:"accounts_added,omitempty"`
-	// Number of accounts removed from the resolver (only if prune=true)
-	AccountsRemoved int32 `protobuf:"varint,4,opt,name=accounts_removed,json=accountsRemoved,proto3" json:"accounts_removed,omitempty"`
-	// Number of accounts updated in the resolver
-	AccountsUpdated int32 `protobuf:"varint,5,opt,name=accounts_updated,json=accountsUpdated,proto3" json:"accounts_updated,omitempty"`
-	// Accounts that were removed from the resolver
-	RemovedAccounts []string `protobuf:"bytes,6,rep,name=removed_accounts,json=removedAccounts,proto3" json:"removed_accounts,omitempty"`
-	// Errors encountered during sync (non-fatal)
-	Errors        []*SyncError `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"`
+// ListLeafnodeProfilesResponse lists the profiles ordered by name
+type ListLeafnodeProfilesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profiles      []*LeafnodeProfile     `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncClusterResponse) Reset() {
-	*x = SyncClusterResponse{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[20]
+func (x *ListLeafnodeProfilesResponse) Reset() {
+	*x = ListLeafnodeProfilesResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncClusterResponse) String() string {
+func (x *ListLeafnodeProfilesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncClusterResponse) ProtoMessage() {}
+func (*ListLeafnodeProfilesResponse) ProtoMessage() {}
 
-func (x *SyncClusterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[20]
+func (x *ListLeafnodeProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1175,85 +4231,85 @@ func (x *SyncClusterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncClusterResponse.ProtoReflect.Descriptor instead.
-func (*SyncClusterResponse) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ListLeafnodeProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListLeafnodeProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *SyncClusterResponse) GetAccountCount() int32 {
+func (x *ListLeafnodeProfilesResponse) GetProfiles() []*LeafnodeProfile {
 	if x != nil {
-		return x.AccountCount
+		return x.Profiles
 	}
-	return 0
+	return nil
 }
 
-func (x *SyncClusterResponse) GetAccounts() []string {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
+// DeleteLeafnodeProfileRequest deletes a leafnode profile
+type DeleteLeafnodeProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncClusterResponse) GetAccountsAdded() int32 {
-	if x != nil {
-		return x.AccountsAdded
-	}
-	return 0
+func (x *DeleteLeafnodeProfileRequest) Reset() {
+	*x = DeleteLeafnodeProfileRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncClusterResponse) GetAccountsRemoved() int32 {
-	if x != nil {
-		return x.AccountsRemoved
-	}
-	return 0
+func (x *DeleteLeafnodeProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SyncClusterResponse) GetAccountsUpdated() int32 {
+func (*DeleteLeafnodeProfileRequest) ProtoMessage() {}
+
+func (x *DeleteLeafnodeProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[58]
 	if x != nil {
-		return x.AccountsUpdated
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SyncClusterResponse) GetRemovedAccounts() []string {
-	if x != nil {
-		return x.RemovedAccounts
-	}
-	return nil
+// Deprecated: Use DeleteLeafnodeProfileRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLeafnodeProfileRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *SyncClusterResponse) GetErrors() []*SyncError {
+func (x *DeleteLeafnodeProfileRequest) GetId() string {
 	if x != nil {
-		return x.Errors
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// SyncError represents an error encountered during sync
-type SyncError struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	AccountPublicKey string                 `protobuf:"bytes,1,opt,name=account_public_key,json=accountPublicKey,proto3" json:"account_public_key,omitempty"`
-	AccountName      string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	Error            string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// DeleteLeafnodeProfileResponse is the response from deleting a leafnode profile
+type DeleteLeafnodeProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncError) Reset() {
-	*x = SyncError{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[21]
+func (x *DeleteLeafnodeProfileResponse) Reset() {
+	*x = DeleteLeafnodeProfileResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncError) String() string {
+func (x *DeleteLeafnodeProfileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncError) ProtoMessage() {}
+func (*DeleteLeafnodeProfileResponse) ProtoMessage() {}
 
-func (x *SyncError) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[21]
+func (x *DeleteLeafnodeProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1264,55 +4320,34 @@ func (x *SyncError) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncError.ProtoReflect.Descriptor instead.
-func (*SyncError) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *SyncError) GetAccountPublicKey() string {
-	if x != nil {
-		return x.AccountPublicKey
-	}
-	return ""
-}
-
-func (x *SyncError) GetAccountName() string {
-	if x != nil {
-		return x.AccountName
-	}
-	return ""
-}
-
-func (x *SyncError) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
+// Deprecated: Use DeleteLeafnodeProfileResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLeafnodeProfileResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{59}
 }
 
-// ListResolverAccountsRequest is the request to list accounts on the NATS resolver
-type ListResolverAccountsRequest struct {
+// GenerateLeafnodeConfigRequest selects the leafnode profile to generate for
+type GenerateLeafnodeConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListResolverAccountsRequest) Reset() {
-	*x = ListResolverAccountsRequest{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[22]
+func (x *GenerateLeafnodeConfigRequest) Reset() {
+	*x = GenerateLeafnodeConfigRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResolverAccountsRequest) String() string {
+func (x *GenerateLeafnodeConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResolverAccountsRequest) ProtoMessage() {}
+func (*GenerateLeafnodeConfigRequest) ProtoMessage() {}
 
-func (x *ListResolverAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[22]
+func (x *GenerateLeafnodeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1323,42 +4358,45 @@ func (x *ListResolverAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResolverAccountsRequest.ProtoReflect.Descriptor instead.
-func (*ListResolverAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GenerateLeafnodeConfigRequest.ProtoReflect.Descriptor instead.
+func (*GenerateLeafnodeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ListResolverAccountsRequest) GetClusterId() string {
+func (x *GenerateLeafnodeConfigRequest) GetId() string {
 	if x != nil {
-		return x.ClusterId
+		return x.Id
 	}
 	return ""
 }
 
-// ListResolverAccountsResponse is the response from listing resolver accounts
-type ListResolverAccountsResponse struct {
+// GenerateLeafnodeConfigResponse carries what an edge server needs to join the hub
+type GenerateLeafnodeConfigResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Public keys of accounts on the resolver
-	PublicKeys    []string `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListResolverAccountsResponse) Reset() {
-	*x = ListResolverAccountsResponse{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[23]
+	// leafnodes block of the leaf server configuration
+	Config string `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	// .creds file of the profile's user, to install at credentials_path
+	Credentials     string `protobuf:"bytes,2,opt,name=credentials,proto3" json:"credentials,omitempty"`
+	CredentialsPath string `protobuf:"bytes,3,opt,name=credentials_path,json=credentialsPath,proto3" json:"credentials_path,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GenerateLeafnodeConfigResponse) Reset() {
+	*x = GenerateLeafnodeConfigResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListResolverAccountsResponse) String() string {
+func (x *GenerateLeafnodeConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListResolverAccountsResponse) ProtoMessage() {}
+func (*GenerateLeafnodeConfigResponse) ProtoMessage() {}
 
-func (x *ListResolverAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[23]
+func (x *GenerateLeafnodeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1369,42 +4407,60 @@ func (x *ListResolverAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListResolverAccountsResponse.ProtoReflect.Descriptor instead.
-func (*ListResolverAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GenerateLeafnodeConfigResponse.ProtoReflect.Descriptor instead.
+func (*GenerateLeafnodeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ListResolverAccountsResponse) GetPublicKeys() []string {
+func (x *GenerateLeafnodeConfigResponse) GetConfig() string {
 	if x != nil {
-		return x.PublicKeys
+		return x.Config
 	}
-	return nil
+	return ""
 }
 
-// DeleteResolverAccountRequest is the request to delete an account from the NATS resolver
-type DeleteResolverAccountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ClusterId     string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
-	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+func (x *GenerateLeafnodeConfigResponse) GetCredentials() string {
+	if x != nil {
+		return x.Credentials
+	}
+	return ""
+}
+
+func (x *GenerateLeafnodeConfigResponse) GetCredentialsPath() string {
+	if x != nil {
+		return x.CredentialsPath
+	}
+	return ""
+}
+
+// SetClusterGatewayRequest makes a cluster join or leave a supercluster
+type SetClusterGatewayRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Supercluster to join, empty to leave it
+	Supercluster string `protobuf:"bytes,2,opt,name=supercluster,proto3" json:"supercluster,omitempty"`
+	// URLs the other clusters' gateways dial, derived from the server URLs and the
+	// gateway port of the server profile when empty
+	GatewayUrls   []string `protobuf:"bytes,3,rep,name=gateway_urls,json=gatewayUrls,proto3" json:"gateway_urls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResolverAccountRequest) Reset() {
-	*x = DeleteResolverAccountRequest{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[24]
+func (x *SetClusterGatewayRequest) Reset() {
+	*x = SetClusterGatewayRequest{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResolverAccountRequest) String() string {
+func (x *SetClusterGatewayRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResolverAccountRequest) ProtoMessage() {}
+func (*SetClusterGatewayRequest) ProtoMessage() {}
 
-func (x *DeleteResolverAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[24]
+func (x *SetClusterGatewayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,47 +4471,55 @@ func (x *DeleteResolverAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResolverAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteResolverAccountRequest) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use SetClusterGatewayRequest.ProtoReflect.Descriptor instead.
+func (*SetClusterGatewayRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *DeleteResolverAccountRequest) GetClusterId() string {
+func (x *SetClusterGatewayRequest) GetId() string {
 	if x != nil {
-		return x.ClusterId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *DeleteResolverAccountRequest) GetPublicKey() string {
+func (x *SetClusterGatewayRequest) GetSupercluster() string {
 	if x != nil {
-		return x.PublicKey
+		return x.Supercluster
 	}
 	return ""
 }
 
-// DeleteResolverAccountResponse is the response from deleting a resolver account
-type DeleteResolverAccountResponse struct {
+func (x *SetClusterGatewayRequest) GetGatewayUrls() []string {
+	if x != nil {
+		return x.GatewayUrls
+	}
+	return nil
+}
+
+// SetClusterGatewayResponse returns the updated cluster
+type SetClusterGatewayResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cluster       *Cluster               `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteResolverAccountResponse) Reset() {
-	*x = DeleteResolverAccountResponse{}
-	mi := &file_nis_v1_cluster_proto_msgTypes[25]
+func (x *SetClusterGatewayResponse) Reset() {
+	*x = SetClusterGatewayResponse{}
+	mi := &file_nis_v1_cluster_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteResolverAccountResponse) String() string {
+func (x *SetClusterGatewayResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteResolverAccountResponse) ProtoMessage() {}
+func (*SetClusterGatewayResponse) ProtoMessage() {}
 
-func (x *DeleteResolverAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_nis_v1_cluster_proto_msgTypes[25]
+func (x *SetClusterGatewayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_cluster_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1466,16 +4530,23 @@ func (x *DeleteResolverAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteResolverAccountResponse.ProtoReflect.Descriptor instead.
-func (*DeleteResolverAccountResponse) Descriptor() ([]byte, []int) {
-	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SetClusterGatewayResponse.ProtoReflect.Descriptor instead.
+func (*SetClusterGatewayResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_cluster_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetClusterGatewayResponse) GetCluster() *Cluster {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
 }
 
 var File_nis_v1_cluster_proto protoreflect.FileDescriptor
 
 const file_nis_v1_cluster_proto_rawDesc = "" +
 	"\n" +
-	"\x14nis/v1/cluster.proto\x12\x06nis.v1\x1a\x13nis/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf4\x03\n" +
+	"\x14nis/v1/cluster.proto\x12\x06nis.v1\x1a\x14nis/v1/account.proto\x1a\x15nis/v1/operator.proto\x1a\x11nis/v1/user.proto\x1a\x13nis/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9a\a\n" +
 	"\aCluster\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\voperator_id\x18\x02 \x01(\tR\n" +
@@ -1493,7 +4564,49 @@ const file_nis_v1_cluster_proto_rawDesc = "" +
 	"\x11last_health_check\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\x0flastHealthCheck\x12,\n" +
 	"\x12health_check_error\x18\v \x01(\tR\x10healthCheckError\x12&\n" +
-	"\x0fskip_verify_tls\x18\f \x01(\bR\rskipVerifyTls\"\x9d\x02\n" +
+	"\x0fskip_verify_tls\x18\f \x01(\bR\rskipVerifyTls\x12A\n" +
+	"\x1dhealth_check_interval_seconds\x18\r \x01(\x03R\x1ahealthCheckIntervalSeconds\x12?\n" +
+	"\x1chealth_check_timeout_seconds\x18\x0e \x01(\x03R\x19healthCheckTimeoutSeconds\x12%\n" +
+	"\x0euptime_percent\x18\x0f \x01(\x01R\ruptimePercent\x12,\n" +
+	"\x12health_check_count\x18\x10 \x01(\x03R\x10healthCheckCount\x12F\n" +
+	"\x11next_health_check\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\x0fnextHealthCheck\x12<\n" +
+	"\x0eserver_profile\x18\x12 \x01(\v2\x15.nis.v1.ServerProfileR\rserverProfile\x12\"\n" +
+	"\fsupercluster\x18\x13 \x01(\tR\fsupercluster\x12!\n" +
+	"\fgateway_urls\x18\x14 \x03(\tR\vgatewayUrls\"\xbb\x03\n" +
+	"\rServerProfile\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1b\n" +
+	"\thttp_port\x18\x03 \x01(\x05R\bhttpPort\x12!\n" +
+	"\fcluster_port\x18\x04 \x01(\x05R\vclusterPort\x12\x16\n" +
+	"\x06routes\x18\x05 \x03(\tR\x06routes\x12#\n" +
+	"\x03tls\x18\x06 \x01(\v2\x11.nis.v1.ServerTLSR\x03tls\x125\n" +
+	"\tjetstream\x18\a \x01(\v2\x17.nis.v1.ServerJetStreamR\tjetstream\x122\n" +
+	"\bresolver\x18\b \x01(\v2\x16.nis.v1.ServerResolverR\bresolver\x125\n" +
+	"\twebsocket\x18\t \x01(\v2\x17.nis.v1.ServerWebsocketR\twebsocket\x12\x1b\n" +
+	"\tmqtt_port\x18\n" +
+	" \x01(\x05R\bmqttPort\x12#\n" +
+	"\rleafnode_port\x18\v \x01(\x05R\fleafnodePort\x12!\n" +
+	"\fgateway_port\x18\f \x01(\x05R\vgatewayPort\"t\n" +
+	"\tServerTLS\x12\x1b\n" +
+	"\tcert_file\x18\x01 \x01(\tR\bcertFile\x12\x19\n" +
+	"\bkey_file\x18\x02 \x01(\tR\akeyFile\x12\x17\n" +
+	"\aca_file\x18\x03 \x01(\tR\x06caFile\x12\x16\n" +
+	"\x06verify\x18\x04 \x01(\bR\x06verify\"\xa0\x01\n" +
+	"\x0fServerJetStream\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1b\n" +
+	"\tstore_dir\x18\x02 \x01(\tR\bstoreDir\x12\x1d\n" +
+	"\n" +
+	"max_memory\x18\x03 \x01(\x03R\tmaxMemory\x12\x1f\n" +
+	"\vmax_storage\x18\x04 \x01(\x03R\n" +
+	"maxStorage\x12\x16\n" +
+	"\x06domain\x18\x05 \x01(\tR\x06domain\"M\n" +
+	"\x0eServerResolver\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\x12)\n" +
+	"\x10interval_seconds\x18\x02 \x01(\x03R\x0fintervalSeconds\"^\n" +
+	"\x0fServerWebsocket\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\x05R\x04port\x12\x15\n" +
+	"\x06no_tls\x18\x02 \x01(\bR\x05noTls\x12 \n" +
+	"\vcompression\x18\x03 \x01(\bR\vcompression\"\xa1\x03\n" +
 	"\x14CreateClusterRequest\x12\x1f\n" +
 	"\voperator_id\x18\x01 \x01(\tR\n" +
 	"operatorId\x12\x12\n" +
@@ -1503,7 +4616,9 @@ const file_nis_v1_cluster_proto_rawDesc = "" +
 	"serverUrls\x123\n" +
 	"\x16system_account_pub_key\x18\x05 \x01(\tR\x13systemAccountPubKey\x120\n" +
 	"\x14system_account_creds\x18\x06 \x01(\tR\x12systemAccountCreds\x12&\n" +
-	"\x0fskip_verify_tls\x18\a \x01(\bR\rskipVerifyTls\"B\n" +
+	"\x0fskip_verify_tls\x18\a \x01(\bR\rskipVerifyTls\x12A\n" +
+	"\x1dhealth_check_interval_seconds\x18\b \x01(\x03R\x1ahealthCheckIntervalSeconds\x12?\n" +
+	"\x1chealth_check_timeout_seconds\x18\t \x01(\x03R\x19healthCheckTimeoutSeconds\"B\n" +
 	"\x15CreateClusterResponse\x12)\n" +
 	"\acluster\x18\x01 \x01(\v2\x0f.nis.v1.ClusterR\acluster\"#\n" +
 	"\x11GetClusterRequest\x12\x0e\n" +
@@ -1521,17 +4636,21 @@ const file_nis_v1_cluster_proto_rawDesc = "" +
 	"operatorId\x12-\n" +
 	"\aoptions\x18\x02 \x01(\v2\x13.nis.v1.ListOptionsR\aoptions\"C\n" +
 	"\x14ListClustersResponse\x12+\n" +
-	"\bclusters\x18\x01 \x03(\v2\x0f.nis.v1.ClusterR\bclusters\"\xe1\x01\n" +
+	"\bclusters\x18\x01 \x03(\v2\x0f.nis.v1.ClusterR\bclusters\"\xb2\x03\n" +
 	"\x14UpdateClusterRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
 	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12\x1f\n" +
 	"\vserver_urls\x18\x04 \x03(\tR\n" +
 	"serverUrls\x12+\n" +
-	"\x0fskip_verify_tls\x18\x05 \x01(\bH\x02R\rskipVerifyTls\x88\x01\x01B\a\n" +
+	"\x0fskip_verify_tls\x18\x05 \x01(\bH\x02R\rskipVerifyTls\x88\x01\x01\x12F\n" +
+	"\x1dhealth_check_interval_seconds\x18\x06 \x01(\x03H\x03R\x1ahealthCheckIntervalSeconds\x88\x01\x01\x12D\n" +
+	"\x1chealth_check_timeout_seconds\x18\a \x01(\x03H\x04R\x19healthCheckTimeoutSeconds\x88\x01\x01B\a\n" +
 	"\x05_nameB\x0e\n" +
 	"\f_descriptionB\x12\n" +
-	"\x10_skip_verify_tls\"B\n" +
+	"\x10_skip_verify_tlsB \n" +
+	"\x1e_health_check_interval_secondsB\x1f\n" +
+	"\x1d_health_check_timeout_seconds\"B\n" +
 	"\x15UpdateClusterResponse\x12)\n" +
 	"\acluster\x18\x01 \x01(\v2\x0f.nis.v1.ClusterR\acluster\"c\n" +
 	"\x1fUpdateClusterCredentialsRequest\x12\x0e\n" +
@@ -1545,16 +4664,20 @@ const file_nis_v1_cluster_proto_rawDesc = "" +
 	"\x1cGetClusterCredentialsRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"A\n" +
 	"\x1dGetClusterCredentialsResponse\x12 \n" +
-	"\vcredentials\x18\x01 \x01(\tR\vcredentials\"^\n" +
+	"\vcredentials\x18\x01 \x01(\tR\vcredentials\"\xd3\x01\n" +
 	"\x1bGenerateServerConfigRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1b\n" +
-	"\thttp_port\x18\x03 \x01(\x05R\bhttpPort\"6\n" +
+	"\thttp_port\x18\x03 \x01(\x05R\bhttpPort\x12\x1f\n" +
+	"\vserver_name\x18\x04 \x01(\tR\n" +
+	"serverName\x12/\n" +
+	"\aprofile\x18\x05 \x01(\v2\x15.nis.v1.ServerProfileR\aprofile\x12!\n" +
+	"\fsave_profile\x18\x06 \x01(\bR\vsaveProfile\"6\n" +
 	"\x1cGenerateServerConfigResponse\x12\x16\n" +
 	"\x06config\x18\x01 \x01(\tR\x06config\":\n" +
 	"\x12SyncClusterRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05prune\x18\x02 \x01(\bR\x05prune\"\xa9\x02\n" +
+	"\x05prune\x18\x02 \x01(\bR\x05prune\"\x91\x03\n" +
 	"\x13SyncClusterResponse\x12#\n" +
 	"\raccount_count\x18\x01 \x01(\x05R\faccountCount\x12\x1a\n" +
 	"\baccounts\x18\x02 \x03(\tR\baccounts\x12%\n" +
@@ -1562,7 +4685,25 @@ const file_nis_v1_cluster_proto_rawDesc = "" +
 	"\x10accounts_removed\x18\x04 \x01(\x05R\x0faccountsRemoved\x12)\n" +
 	"\x10accounts_updated\x18\x05 \x01(\x05R\x0faccountsUpdated\x12)\n" +
 	"\x10removed_accounts\x18\x06 \x03(\tR\x0fremovedAccounts\x12)\n" +
-	"\x06errors\x18\a \x03(\v2\x11.nis.v1.SyncErrorR\x06errors\"r\n" +
+	"\x06errors\x18\a \x03(\v2\x11.nis.v1.SyncErrorR\x06errors\x122\n" +
+	"\aservers\x18\b \x03(\v2\x18.nis.v1.ServerSyncStatusR\aservers\x122\n" +
+	"\x05peers\x18\t \x03(\v2\x1c.nis.v1.SuperclusterPeerSyncR\x05peers\"\xc4\x01\n" +
+	"\x10ServerSyncStatus\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1f\n" +
+	"\vserver_name\x18\x02 \x01(\tR\n" +
+	"serverName\x12\"\n" +
+	"\facknowledged\x18\x03 \x01(\x05R\facknowledged\x12\x1a\n" +
+	"\brejected\x18\x04 \x01(\x05R\brejected\x12\x18\n" +
+	"\amissing\x18\x05 \x01(\x05R\amissing\x12\x18\n" +
+	"\acluster\x18\x06 \x01(\tR\acluster\"\xbe\x01\n" +
+	"\x14SuperclusterPeerSync\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12!\n" +
+	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\x12\x18\n" +
+	"\aservers\x18\x03 \x01(\x05R\aservers\x12\x1a\n" +
+	"\bverified\x18\x04 \x01(\x05R\bverified\x12\x18\n" +
+	"\amissing\x18\x05 \x03(\tR\amissing\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"r\n" +
 	"\tSyncError\x12,\n" +
 	"\x12account_public_key\x18\x01 \x01(\tR\x10accountPublicKey\x12!\n" +
 	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12\x14\n" +
@@ -1578,7 +4719,218 @@ const file_nis_v1_cluster_proto_rawDesc = "" +
 	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1d\n" +
 	"\n" +
 	"public_key\x18\x02 \x01(\tR\tpublicKey\"\x1f\n" +
-	"\x1dDeleteResolverAccountResponse2\xaa\b\n" +
+	"\x1dDeleteResolverAccountResponse\"T\n" +
+	"\x14VerifyAccountRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\"\xb9\x02\n" +
+	"\x15VerifyAccountResponse\x12,\n" +
+	"\x12account_public_key\x18\x01 \x01(\tR\x10accountPublicKey\x12!\n" +
+	"\faccount_name\x18\x02 \x01(\tR\vaccountName\x12\x1e\n" +
+	"\n" +
+	"propagated\x18\x03 \x01(\bR\n" +
+	"propagated\x12%\n" +
+	"\x0ecurrent_copies\x18\x04 \x01(\x05R\rcurrentCopies\x12+\n" +
+	"\x11mismatched_copies\x18\x05 \x01(\x05R\x10mismatchedCopies\x12%\n" +
+	"\x0emissing_copies\x18\x06 \x01(\x05R\rmissingCopies\x124\n" +
+	"\aservers\x18\a \x03(\v2\x1a.nis.v1.ServerVerificationR\aservers\"\x91\x01\n" +
+	"\x12ServerVerification\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x1f\n" +
+	"\vserver_name\x18\x02 \x01(\tR\n" +
+	"serverName\x12\x1c\n" +
+	"\tresponded\x18\x03 \x01(\bR\tresponded\x12\x1f\n" +
+	"\vhas_account\x18\x04 \x01(\bR\n" +
+	"hasAccount\"\xa2\x03\n" +
+	"\rClusterServer\x12\x1b\n" +
+	"\tserver_id\x18\x01 \x01(\tR\bserverId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04host\x18\x03 \x01(\tR\x04host\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x12\x1c\n" +
+	"\tjetstream\x18\x05 \x01(\bR\tjetstream\x12!\n" +
+	"\fcluster_name\x18\x06 \x01(\tR\vclusterName\x12\x1a\n" +
+	"\bgateways\x18\a \x03(\tR\bgateways\x12 \n" +
+	"\vconnections\x18\b \x01(\x05R\vconnections\x129\n" +
+	"\n" +
+	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12%\n" +
+	"\x0euptime_seconds\x18\n" +
+	" \x01(\x03R\ruptimeSeconds\x127\n" +
+	"\tlast_seen\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12\x18\n" +
+	"\amissing\x18\f \x01(\bR\amissing\"+\n" +
+	"\x19GetClusterTopologyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb1\x01\n" +
+	"\x1aGetClusterTopologyResponse\x12/\n" +
+	"\aservers\x18\x01 \x03(\v2\x15.nis.v1.ClusterServerR\aservers\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\x12F\n" +
+	"\x11last_health_check\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastHealthCheck\"\x9e\x01\n" +
+	"\x12ClusterHealthCheck\x129\n" +
+	"\n" +
+	"checked_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckedAt\x12\x18\n" +
+	"\ahealthy\x18\x02 \x01(\bR\ahealthy\x12\x1d\n" +
+	"\n" +
+	"latency_ms\x18\x03 \x01(\x03R\tlatencyMs\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"_\n" +
+	"\x1eListClusterHealthChecksRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\aoptions\x18\x02 \x01(\v2\x13.nis.v1.ListOptionsR\aoptions\"U\n" +
+	"\x1fListClusterHealthChecksResponse\x122\n" +
+	"\x06checks\x18\x01 \x03(\v2\x1a.nis.v1.ClusterHealthCheckR\x06checks\"\x85\x01\n" +
+	"\x16ListConnectionsRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x03 \x01(\tR\tclusterId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x8f\x01\n" +
+	"\x17ListConnectionsResponse\x12:\n" +
+	"\vconnections\x18\x01 \x03(\v2\x18.nis.v1.ClientConnectionR\vconnections\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"\x8e\x06\n" +
+	"\x10ClientConnection\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12!\n" +
+	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\x12\x1b\n" +
+	"\tserver_id\x18\x03 \x01(\tR\bserverId\x12\x1f\n" +
+	"\vserver_name\x18\x04 \x01(\tR\n" +
+	"serverName\x12\x10\n" +
+	"\x03cid\x18\x05 \x01(\x03R\x03cid\x12\x0e\n" +
+	"\x02ip\x18\x06 \x01(\tR\x02ip\x12\x12\n" +
+	"\x04port\x18\a \x01(\x05R\x04port\x120\n" +
+	"\x05start\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12?\n" +
+	"\rlast_activity\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\flastActivity\x12\x10\n" +
+	"\x03rtt\x18\n" +
+	" \x01(\tR\x03rtt\x12\x16\n" +
+	"\x06uptime\x18\v \x01(\tR\x06uptime\x12\x17\n" +
+	"\ain_msgs\x18\f \x01(\x03R\x06inMsgs\x12\x19\n" +
+	"\bout_msgs\x18\r \x01(\x03R\aoutMsgs\x12\x19\n" +
+	"\bin_bytes\x18\x0e \x01(\x03R\ainBytes\x12\x1b\n" +
+	"\tout_bytes\x18\x0f \x01(\x03R\boutBytes\x12$\n" +
+	"\rsubscriptions\x18\x10 \x01(\x05R\rsubscriptions\x12\x1f\n" +
+	"\vclient_name\x18\x11 \x01(\tR\n" +
+	"clientName\x12\x12\n" +
+	"\x04lang\x18\x12 \x01(\tR\x04lang\x12\x18\n" +
+	"\aversion\x18\x13 \x01(\tR\aversion\x12&\n" +
+	"\x0fuser_public_key\x18\x14 \x01(\tR\ruserPublicKey\x12\x17\n" +
+	"\auser_id\x18\x15 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x16 \x01(\tR\buserName\x121\n" +
+	"\x15scoped_signing_key_id\x18\x17 \x01(\tR\x12scopedSigningKeyId\x125\n" +
+	"\x17scoped_signing_key_name\x18\x18 \x01(\tR\x14scopedSigningKeyName\"O\n" +
+	"\x15DisconnectUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x02 \x01(\tR\tclusterId\"\x8c\x01\n" +
+	"\x16DisconnectUserResponse\x122\n" +
+	"\aservers\x18\x01 \x03(\v2\x18.nis.v1.ServerDisconnectR\aservers\x12\"\n" +
+	"\fdisconnected\x18\x02 \x01(\x05R\fdisconnected\x12\x1a\n" +
+	"\bwarnings\x18\x03 \x03(\tR\bwarnings\"\xe4\x01\n" +
+	"\x10ServerDisconnect\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12!\n" +
+	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\x12\x1b\n" +
+	"\tserver_id\x18\x03 \x01(\tR\bserverId\x12\x1f\n" +
+	"\vserver_name\x18\x04 \x01(\tR\n" +
+	"serverName\x12\x14\n" +
+	"\x05found\x18\x05 \x01(\x05R\x05found\x12\"\n" +
+	"\fdisconnected\x18\x06 \x01(\x05R\fdisconnected\x12\x16\n" +
+	"\x06errors\x18\a \x03(\tR\x06errors\"+\n" +
+	"\x19GetClusterCapacityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xfd\x04\n" +
+	"\x1aGetClusterCapacityResponse\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12!\n" +
+	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\x12+\n" +
+	"\x11jetstream_servers\x18\x03 \x01(\x05R\x10jetstreamServers\x12'\n" +
+	"\x0fmemory_capacity\x18\x04 \x01(\x03R\x0ememoryCapacity\x12)\n" +
+	"\x10storage_capacity\x18\x05 \x01(\x03R\x0fstorageCapacity\x12'\n" +
+	"\x0fmemory_assigned\x18\x06 \x01(\x03R\x0ememoryAssigned\x12)\n" +
+	"\x10storage_assigned\x18\a \x01(\x03R\x0fstorageAssigned\x12'\n" +
+	"\x0fmemory_reserved\x18\b \x01(\x03R\x0ememoryReserved\x12)\n" +
+	"\x10storage_reserved\x18\t \x01(\x03R\x0fstorageReserved\x12\x1f\n" +
+	"\vmemory_used\x18\n" +
+	" \x01(\x03R\n" +
+	"memoryUsed\x12!\n" +
+	"\fstorage_used\x18\v \x01(\x03R\vstorageUsed\x12\x1a\n" +
+	"\baccounts\x18\f \x01(\x05R\baccounts\x12:\n" +
+	"\x19unlimited_memory_accounts\x18\r \x03(\tR\x17unlimitedMemoryAccounts\x12<\n" +
+	"\x1aunlimited_storage_accounts\x18\x0e \x03(\tR\x18unlimitedStorageAccounts\x12\x1a\n" +
+	"\bwarnings\x18\x0f \x03(\tR\bwarnings\"\x8a\x04\n" +
+	"\vAuthFailure\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x02 \x01(\tR\tclusterId\x12=\n" +
+	"\fwindow_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x12&\n" +
+	"\x0fuser_public_key\x18\x04 \x01(\tR\ruserPublicKey\x12,\n" +
+	"\x12account_public_key\x18\x05 \x01(\tR\x10accountPublicKey\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\a \x01(\tR\taccountId\x12\x1b\n" +
+	"\tremote_ip\x18\b \x01(\tR\bremoteIp\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12\x1f\n" +
+	"\vserver_name\x18\n" +
+	" \x01(\tR\n" +
+	"serverName\x12\x1f\n" +
+	"\vclient_name\x18\v \x01(\tR\n" +
+	"clientName\x12\x14\n" +
+	"\x05count\x18\f \x01(\x03R\x05count\x129\n" +
+	"\n" +
+	"first_seen\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tfirstSeen\x127\n" +
+	"\tlast_seen\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\"\xd1\x01\n" +
+	"\x17ListAuthFailuresRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x120\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12-\n" +
+	"\aoptions\x18\x05 \x01(\v2\x13.nis.v1.ListOptionsR\aoptions\"K\n" +
+	"\x18ListAuthFailuresResponse\x12/\n" +
+	"\bfailures\x18\x01 \x03(\v2\x13.nis.v1.AuthFailureR\bfailures\"\xe0\x02\n" +
+	"\x0fLeafnodeProfile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x02 \x01(\tR\tclusterId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12#\n" +
+	"\rlocal_account\x18\x05 \x01(\tR\flocalAccount\x12\x12\n" +
+	"\x04urls\x18\x06 \x03(\tR\x04urls\x12)\n" +
+	"\x10credentials_path\x18\a \x01(\tR\x0fcredentialsPath\x12\x17\n" +
+	"\aca_file\x18\b \x01(\tR\x06caFile\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe7\x01\n" +
+	"\x1cCreateLeafnodeProfileRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12#\n" +
+	"\rlocal_account\x18\x04 \x01(\tR\flocalAccount\x12\x12\n" +
+	"\x04urls\x18\x05 \x03(\tR\x04urls\x12)\n" +
+	"\x10credentials_path\x18\x06 \x01(\tR\x0fcredentialsPath\x12\x17\n" +
+	"\aca_file\x18\a \x01(\tR\x06caFile\"R\n" +
+	"\x1dCreateLeafnodeProfileResponse\x121\n" +
+	"\aprofile\x18\x01 \x01(\v2\x17.nis.v1.LeafnodeProfileR\aprofile\"<\n" +
+	"\x1bListLeafnodeProfilesRequest\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\"S\n" +
+	"\x1cListLeafnodeProfilesResponse\x123\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x17.nis.v1.LeafnodeProfileR\bprofiles\".\n" +
+	"\x1cDeleteLeafnodeProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1f\n" +
+	"\x1dDeleteLeafnodeProfileResponse\"/\n" +
+	"\x1dGenerateLeafnodeConfigRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x85\x01\n" +
+	"\x1eGenerateLeafnodeConfigResponse\x12\x16\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\x12 \n" +
+	"\vcredentials\x18\x02 \x01(\tR\vcredentials\x12)\n" +
+	"\x10credentials_path\x18\x03 \x01(\tR\x0fcredentialsPath\"q\n" +
+	"\x18SetClusterGatewayRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\fsupercluster\x18\x02 \x01(\tR\fsupercluster\x12!\n" +
+	"\fgateway_urls\x18\x03 \x03(\tR\vgatewayUrls\"F\n" +
+	"\x19SetClusterGatewayResponse\x12)\n" +
+	"\acluster\x18\x01 \x01(\v2\x0f.nis.v1.ClusterR\acluster2\x8c\x11\n" +
 	"\x0eClusterService\x12L\n" +
 	"\rCreateCluster\x12\x1c.nis.v1.CreateClusterRequest\x1a\x1d.nis.v1.CreateClusterResponse\x12C\n" +
 	"\n" +
@@ -1592,7 +4944,19 @@ const file_nis_v1_cluster_proto_rawDesc = "" +
 	"\x14GenerateServerConfig\x12#.nis.v1.GenerateServerConfigRequest\x1a$.nis.v1.GenerateServerConfigResponse\x12F\n" +
 	"\vSyncCluster\x12\x1a.nis.v1.SyncClusterRequest\x1a\x1b.nis.v1.SyncClusterResponse\x12a\n" +
 	"\x14ListResolverAccounts\x12#.nis.v1.ListResolverAccountsRequest\x1a$.nis.v1.ListResolverAccountsResponse\x12d\n" +
-	"\x15DeleteResolverAccount\x12$.nis.v1.DeleteResolverAccountRequest\x1a%.nis.v1.DeleteResolverAccountResponseB\x83\x01\n" +
+	"\x15DeleteResolverAccount\x12$.nis.v1.DeleteResolverAccountRequest\x1a%.nis.v1.DeleteResolverAccountResponse\x12L\n" +
+	"\rVerifyAccount\x12\x1c.nis.v1.VerifyAccountRequest\x1a\x1d.nis.v1.VerifyAccountResponse\x12[\n" +
+	"\x12GetClusterTopology\x12!.nis.v1.GetClusterTopologyRequest\x1a\".nis.v1.GetClusterTopologyResponse\x12j\n" +
+	"\x17ListClusterHealthChecks\x12&.nis.v1.ListClusterHealthChecksRequest\x1a'.nis.v1.ListClusterHealthChecksResponse\x12R\n" +
+	"\x0fListConnections\x12\x1e.nis.v1.ListConnectionsRequest\x1a\x1f.nis.v1.ListConnectionsResponse\x12O\n" +
+	"\x0eDisconnectUser\x12\x1d.nis.v1.DisconnectUserRequest\x1a\x1e.nis.v1.DisconnectUserResponse\x12[\n" +
+	"\x12GetClusterCapacity\x12!.nis.v1.GetClusterCapacityRequest\x1a\".nis.v1.GetClusterCapacityResponse\x12U\n" +
+	"\x10ListAuthFailures\x12\x1f.nis.v1.ListAuthFailuresRequest\x1a .nis.v1.ListAuthFailuresResponse\x12d\n" +
+	"\x15CreateLeafnodeProfile\x12$.nis.v1.CreateLeafnodeProfileRequest\x1a%.nis.v1.CreateLeafnodeProfileResponse\x12a\n" +
+	"\x14ListLeafnodeProfiles\x12#.nis.v1.ListLeafnodeProfilesRequest\x1a$.nis.v1.ListLeafnodeProfilesResponse\x12d\n" +
+	"\x15DeleteLeafnodeProfile\x12$.nis.v1.DeleteLeafnodeProfileRequest\x1a%.nis.v1.DeleteLeafnodeProfileResponse\x12g\n" +
+	"\x16GenerateLeafnodeConfig\x12%.nis.v1.GenerateLeafnodeConfigRequest\x1a&.nis.v1.GenerateLeafnodeConfigResponse\x12X\n" +
+	"\x11SetClusterGateway\x12 .nis.v1.SetClusterGatewayRequest\x1a!.nis.v1.SetClusterGatewayResponseB\x83\x01\n" +
 	"\n" +
 	"com.nis.v1B\fClusterProtoP\x01Z.github.com/thomas-maurice/nis/gen/nis/v1;nisv1\xa2\x02\x03NXX\xaa\x02\x06Nis.V1\xca\x02\x06Nis\\V1\xe2\x02\x12Nis\\V1\\GPBMetadata\xea\x02\aNis::V1b\x06proto3"
 
@@ -1608,78 +4972,172 @@ func file_nis_v1_cluster_proto_rawDescGZIP() []byte {
 	return file_nis_v1_cluster_proto_rawDescData
 }
 
-var file_nis_v1_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_nis_v1_cluster_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_nis_v1_cluster_proto_goTypes = []any{
 	(*Cluster)(nil),                          // 0: nis.v1.Cluster
-	(*CreateClusterRequest)(nil),             // 1: nis.v1.CreateClusterRequest
-	(*CreateClusterResponse)(nil),            // 2: nis.v1.CreateClusterResponse
-	(*GetClusterRequest)(nil),                // 3: nis.v1.GetClusterRequest
-	(*GetClusterResponse)(nil),               // 4: nis.v1.GetClusterResponse
-	(*GetClusterByNameRequest)(nil),          // 5: nis.v1.GetClusterByNameRequest
-	(*GetClusterByNameResponse)(nil),         // 6: nis.v1.GetClusterByNameResponse
-	(*ListClustersRequest)(nil),              // 7: nis.v1.ListClustersRequest
-	(*ListClustersResponse)(nil),             // 8: nis.v1.ListClustersResponse
-	(*UpdateClusterRequest)(nil),             // 9: nis.v1.UpdateClusterRequest
-	(*UpdateClusterResponse)(nil),            // 10: nis.v1.UpdateClusterResponse
-	(*UpdateClusterCredentialsRequest)(nil),  // 11: nis.v1.UpdateClusterCredentialsRequest
-	(*UpdateClusterCredentialsResponse)(nil), // 12: nis.v1.UpdateClusterCredentialsResponse
-	(*DeleteClusterRequest)(nil),             // 13: nis.v1.DeleteClusterRequest
-	(*DeleteClusterResponse)(nil),            // 14: nis.v1.DeleteClusterResponse
-	(*GetClusterCredentialsRequest)(nil),     // 15: nis.v1.GetClusterCredentialsRequest
-	(*GetClusterCredentialsResponse)(nil),    // 16: nis.v1.GetClusterCredentialsResponse
-	(*GenerateServerConfigRequest)(nil),      // 17: nis.v1.GenerateServerConfigRequest
-	(*GenerateServerConfigResponse)(nil),     // 18: nis.v1.GenerateServerConfigResponse
-	(*SyncClusterRequest)(nil),               // 19: nis.v1.SyncClusterRequest
-	(*SyncClusterResponse)(nil),              // 20: nis.v1.SyncClusterResponse
-	(*SyncError)(nil),                        // 21: nis.v1.SyncError
-	(*ListResolverAccountsRequest)(nil),      // 22: nis.v1.ListResolverAccountsRequest
-	(*ListResolverAccountsResponse)(nil),     // 23: nis.v1.ListResolverAccountsResponse
-	(*DeleteResolverAccountRequest)(nil),     // 24: nis.v1.DeleteResolverAccountRequest
-	(*DeleteResolverAccountResponse)(nil),    // 25: nis.v1.DeleteResolverAccountResponse
-	(*timestamppb.Timestamp)(nil),            // 26: google.protobuf.Timestamp
-	(*ListOptions)(nil),                      // 27: nis.v1.ListOptions
+	(*ServerProfile)(nil),                    // 1: nis.v1.ServerProfile
+	(*ServerTLS)(nil),                        // 2: nis.v1.ServerTLS
+	(*ServerJetStream)(nil),                  // 3: nis.v1.ServerJetStream
+	(*ServerResolver)(nil),                   // 4: nis.v1.ServerResolver
+	(*ServerWebsocket)(nil),                  // 5: nis.v1.ServerWebsocket
+	(*CreateClusterRequest)(nil),             // 6: nis.v1.CreateClusterRequest
+	(*CreateClusterResponse)(nil),            // 7: nis.v1.CreateClusterResponse
+	(*GetClusterRequest)(nil),                // 8: nis.v1.GetClusterRequest
+	(*GetClusterResponse)(nil),               // 9: nis.v1.GetClusterResponse
+	(*GetClusterByNameRequest)(nil),          // 10: nis.v1.GetClusterByNameRequest
+	(*GetClusterByNameResponse)(nil),         // 11: nis.v1.GetClusterByNameResponse
+	(*ListClustersRequest)(nil),              // 12: nis.v1.ListClustersRequest
+	(*ListClustersResponse)(nil),             // 13: nis.v1.ListClustersResponse
+	(*UpdateClusterRequest)(nil),             // 14: nis.v1.UpdateClusterRequest
+	(*UpdateClusterResponse)(nil),            // 15: nis.v1.UpdateClusterResponse
+	(*UpdateClusterCredentialsRequest)(nil),  // 16: nis.v1.UpdateClusterCredentialsRequest
+	(*UpdateClusterCredentialsResponse)(nil), // 17: nis.v1.UpdateClusterCredentialsResponse
+	(*DeleteClusterRequest)(nil),             // 18: nis.v1.DeleteClusterRequest
+	(*DeleteClusterResponse)(nil),            // 19: nis.v1.DeleteClusterResponse
+	(*GetClusterCredentialsRequest)(nil),     // 20: nis.v1.GetClusterCredentialsRequest
+	(*GetClusterCredentialsResponse)(nil),    // 21: nis.v1.GetClusterCredentialsResponse
+	(*GenerateServerConfigRequest)(nil),      // 22: nis.v1.GenerateServerConfigRequest
+	(*GenerateServerConfigResponse)(nil),     // 23: nis.v1.GenerateServerConfigResponse
+	(*SyncClusterRequest)(nil),               // 24: nis.v1.SyncClusterRequest
+	(*SyncClusterResponse)(nil),              // 25: nis.v1.SyncClusterResponse
+	(*ServerSyncStatus)(nil),                 // 26: nis.v1.ServerSyncStatus
+	(*SuperclusterPeerSync)(nil),             // 27: nis.v1.SuperclusterPeerSync
+	(*SyncError)(nil),                        // 28: nis.v1.SyncError
+	(*ListResolverAccountsRequest)(nil),      // 29: nis.v1.ListResolverAccountsRequest
+	(*ListResolverAccountsResponse)(nil),     // 30: nis.v1.ListResolverAccountsResponse
+	(*DeleteResolverAccountRequest)(nil),     // 31: nis.v1.DeleteResolverAccountRequest
+	(*DeleteResolverAccountResponse)(nil),    // 32: nis.v1.DeleteResolverAccountResponse
+	(*VerifyAccountRequest)(nil),             // 33: nis.v1.VerifyAccountRequest
+	(*VerifyAccountResponse)(nil),            // 34: nis.v1.VerifyAccountResponse
+	(*ServerVerification)(nil),               // 35: nis.v1.ServerVerification
+	(*ClusterServer)(nil),                    // 36: nis.v1.ClusterServer
+	(*GetClusterTopologyRequest)(nil),        // 37: nis.v1.GetClusterTopologyRequest
+	(*GetClusterTopologyResponse)(nil),       // 38: nis.v1.GetClusterTopologyResponse
+	(*ClusterHealthCheck)(nil),               // 39: nis.v1.ClusterHealthCheck
+	(*ListClusterHealthChecksRequest)(nil),   // 40: nis.v1.ListClusterHealthChecksRequest
+	(*ListClusterHealthChecksResponse)(nil),  // 41: nis.v1.ListClusterHealthChecksResponse
+	(*ListConnectionsRequest)(nil),           // 42: nis.v1.ListConnectionsRequest
+	(*ListConnectionsResponse)(nil),          // 43: nis.v1.ListConnectionsResponse
+	(*ClientConnection)(nil),                 // 44: nis.v1.ClientConnection
+	(*DisconnectUserRequest)(nil),            // 45: nis.v1.DisconnectUserRequest
+	(*DisconnectUserResponse)(nil),           // 46: nis.v1.DisconnectUserResponse
+	(*ServerDisconnect)(nil),                 // 47: nis.v1.ServerDisconnect
+	(*GetClusterCapacityRequest)(nil),        // 48: nis.v1.GetClusterCapacityRequest
+	(*GetClusterCapacityResponse)(nil),       // 49: nis.v1.GetClusterCapacityResponse
+	(*AuthFailure)(nil),                      // 50: nis.v1.AuthFailure
+	(*ListAuthFailuresRequest)(nil),          // 51: nis.v1.ListAuthFailuresRequest
+	(*ListAuthFailuresResponse)(nil),         // 52: nis.v1.ListAuthFailuresResponse
+	(*LeafnodeProfile)(nil),                  // 53: nis.v1.LeafnodeProfile
+	(*CreateLeafnodeProfileRequest)(nil),     // 54: nis.v1.CreateLeafnodeProfileRequest
+	(*CreateLeafnodeProfileResponse)(nil),    // 55: nis.v1.CreateLeafnodeProfileResponse
+	(*ListLeafnodeProfilesRequest)(nil),      // 56: nis.v1.ListLeafnodeProfilesRequest
+	(*ListLeafnodeProfilesResponse)(nil),     // 57: nis.v1.ListLeafnodeProfilesResponse
+	(*DeleteLeafnodeProfileRequest)(nil),     // 58: nis.v1.DeleteLeafnodeProfileRequest
+	(*DeleteLeafnodeProfileResponse)(nil),    // 59: nis.v1.DeleteLeafnodeProfileResponse
+	(*GenerateLeafnodeConfigRequest)(nil),    // 60: nis.v1.GenerateLeafnodeConfigRequest
+	(*GenerateLeafnodeConfigResponse)(nil),   // 61: nis.v1.GenerateLeafnodeConfigResponse
+	(*SetClusterGatewayRequest)(nil),         // 62: nis.v1.SetClusterGatewayRequest
+	(*SetClusterGatewayResponse)(nil),        // 63: nis.v1.SetClusterGatewayResponse
+	(*timestamppb.Timestamp)(nil),            // 64: google.protobuf.Timestamp
+	(*ListOptions)(nil),                      // 65: nis.v1.ListOptions
 }
 var file_nis_v1_cluster_proto_depIdxs = []int32{
-	26, // 0: nis.v1.Cluster.created_at:type_name -> google.protobuf.Timestamp
-	26, // 1: nis.v1.Cluster.updated_at:type_name -> google.protobuf.Timestamp
-	26, // 2: nis.v1.Cluster.last_health_check:type_name -> google.protobuf.Timestamp
-	0,  // 3: nis.v1.CreateClusterResponse.cluster:type_name -> nis.v1.Cluster
-	0,  // 4: nis.v1.GetClusterResponse.cluster:type_name -> nis.v1.Cluster
-	0,  // 5: nis.v1.GetClusterByNameResponse.cluster:type_name -> nis.v1.Cluster
-	27, // 6: nis.v1.ListClustersRequest.options:type_name -> nis.v1.ListOptions
-	0,  // 7: nis.v1.ListClustersResponse.clusters:type_name -> nis.v1.Cluster
-	0,  // 8: nis.v1.UpdateClusterResponse.cluster:type_name -> nis.v1.Cluster
-	0,  // 9: nis.v1.UpdateClusterCredentialsResponse.cluster:type_name -> nis.v1.Cluster
-	21, // 10: nis.v1.SyncClusterResponse.errors:type_name -> nis.v1.SyncError
-	1,  // 11: nis.v1.ClusterService.CreateCluster:input_type -> nis.v1.CreateClusterRequest
-	3,  // 12: nis.v1.ClusterService.GetCluster:input_type -> nis.v1.GetClusterRequest
-	5,  // 13: nis.v1.ClusterService.GetClusterByName:input_type -> nis.v1.GetClusterByNameRequest
-	7,  // 14: nis.v1.ClusterService.ListClusters:input_type -> nis.v1.ListClustersRequest
-	9,  // 15: nis.v1.ClusterService.UpdateCluster:input_type -> nis.v1.UpdateClusterRequest
-	11, // 16: nis.v1.ClusterService.UpdateClusterCredentials:input_type -> nis.v1.UpdateClusterCredentialsRequest
-	13, // 17: nis.v1.ClusterService.DeleteCluster:input_type -> nis.v1.DeleteClusterRequest
-	15, // 18: nis.v1.ClusterService.GetClusterCredentials:input_type -> nis.v1.GetClusterCredentialsRequest
-	17, // 19: nis.v1.ClusterService.GenerateServerConfig:input_type -> nis.v1.GenerateServerConfigRequest
-	19, // 20: nis.v1.ClusterService.SyncCluster:input_type -> nis.v1.SyncClusterRequest
-	22, // 21: nis.v1.ClusterService.ListResolverAccounts:input_type -> nis.v1.ListResolverAccountsRequest
-	24, // 22: nis.v1.ClusterService.DeleteResolverAccount:input_type -> nis.v1.DeleteResolverAccountRequest
-	2,  // 23: nis.v1.ClusterService.CreateCluster:output_type -> nis.v1.CreateClusterResponse
-	4,  // 24: nis.v1.ClusterService.GetCluster:output_type -> nis.v1.GetClusterResponse
-	6,  // 25: nis.v1.ClusterService.GetClusterByName:output_type -> nis.v1.GetClusterByNameResponse
-	8,  // 26: nis.v1.ClusterService.ListClusters:output_type -> nis.v1.ListClustersResponse
-	10, // 27: nis.v1.ClusterService.UpdateCluster:output_type -> nis.v1.UpdateClusterResponse
-	12, // 28: nis.v1.ClusterService.UpdateClusterCredentials:output_type -> nis.v1.UpdateClusterCredentialsResponse
-	14, // 29: nis.v1.ClusterService.DeleteCluster:output_type -> nis.v1.DeleteClusterResponse
-	16, // 30: nis.v1.ClusterService.GetClusterCredentials:output_type -> nis.v1.GetClusterCredentialsResponse
-	18, // 31: nis.v1.ClusterService.GenerateServerConfig:output_type -> nis.v1.GenerateServerConfigResponse
-	20, // 32: nis.v1.ClusterService.SyncCluster:output_type -> nis.v1.SyncClusterResponse
-	23, // 33: nis.v1.ClusterService.ListResolverAccounts:output_type -> nis.v1.ListResolverAccountsResponse
-	25, // 34: nis.v1.ClusterService.DeleteResolverAccount:output_type -> nis.v1.DeleteResolverAccountResponse
-	23, // [23:35] is the sub-list for method output_type
-	11, // [11:23] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	64, // 0: nis.v1.Cluster.created_at:type_name -> google.protobuf.Timestamp
+	64, // 1: nis.v1.Cluster.updated_at:type_name -> google.protobuf.Timestamp
+	64, // 2: nis.v1.Cluster.last_health_check:type_name -> google.protobuf.Timestamp
+	64, // 3: nis.v1.Cluster.next_health_check:type_name -> google.protobuf.Timestamp
+	1,  // 4: nis.v1.Cluster.server_profile:type_name -> nis.v1.ServerProfile
+	2,  // 5: nis.v1.ServerProfile.tls:type_name -> nis.v1.ServerTLS
+	3,  // 6: nis.v1.ServerProfile.jetstream:type_name -> nis.v1.ServerJetStream
+	4,  // 7: nis.v1.ServerProfile.resolver:type_name -> nis.v1.ServerResolver
+	5,  // 8: nis.v1.ServerProfile.websocket:type_name -> nis.v1.ServerWebsocket
+	0,  // 9: nis.v1.CreateClusterResponse.cluster:type_name -> nis.v1.Cluster
+	0,  // 10: nis.v1.GetClusterResponse.cluster:type_name -> nis.v1.Cluster
+	0,  // 11: nis.v1.GetClusterByNameResponse.cluster:type_name -> nis.v1.Cluster
+	65, // 12: nis.v1.ListClustersRequest.options:type_name -> nis.v1.ListOptions
+	0,  // 13: nis.v1.ListClustersResponse.clusters:type_name -> nis.v1.Cluster
+	0,  // 14: nis.v1.UpdateClusterResponse.cluster:type_name -> nis.v1.Cluster
+	0,  // 15: nis.v1.UpdateClusterCredentialsResponse.cluster:type_name -> nis.v1.Cluster
+	1,  // 16: nis.v1.GenerateServerConfigRequest.profile:type_name -> nis.v1.ServerProfile
+	28, // 17: nis.v1.SyncClusterResponse.errors:type_name -> nis.v1.SyncError
+	26, // 18: nis.v1.SyncClusterResponse.servers:type_name -> nis.v1.ServerSyncStatus
+	27, // 19: nis.v1.SyncClusterResponse.peers:type_name -> nis.v1.SuperclusterPeerSync
+	35, // 20: nis.v1.VerifyAccountResponse.servers:type_name -> nis.v1.ServerVerification
+	64, // 21: nis.v1.ClusterServer.started_at:type_name -> google.protobuf.Timestamp
+	64, // 22: nis.v1.ClusterServer.last_seen:type_name -> google.protobuf.Timestamp
+	36, // 23: nis.v1.GetClusterTopologyResponse.servers:type_name -> nis.v1.ClusterServer
+	64, // 24: nis.v1.GetClusterTopologyResponse.last_health_check:type_name -> google.protobuf.Timestamp
+	64, // 25: nis.v1.ClusterHealthCheck.checked_at:type_name -> google.protobuf.Timestamp
+	65, // 26: nis.v1.ListClusterHealthChecksRequest.options:type_name -> nis.v1.ListOptions
+	39, // 27: nis.v1.ListClusterHealthChecksResponse.checks:type_name -> nis.v1.ClusterHealthCheck
+	44, // 28: nis.v1.ListConnectionsResponse.connections:type_name -> nis.v1.ClientConnection
+	64, // 29: nis.v1.ClientConnection.start:type_name -> google.protobuf.Timestamp
+	64, // 30: nis.v1.ClientConnection.last_activity:type_name -> google.protobuf.Timestamp
+	47, // 31: nis.v1.DisconnectUserResponse.servers:type_name -> nis.v1.ServerDisconnect
+	64, // 32: nis.v1.AuthFailure.window_start:type_name -> google.protobuf.Timestamp
+	64, // 33: nis.v1.AuthFailure.first_seen:type_name -> google.protobuf.Timestamp
+	64, // 34: nis.v1.AuthFailure.last_seen:type_name -> google.protobuf.Timestamp
+	64, // 35: nis.v1.ListAuthFailuresRequest.since:type_name -> google.protobuf.Timestamp
+	65, // 36: nis.v1.ListAuthFailuresRequest.options:type_name -> nis.v1.ListOptions
+	50, // 37: nis.v1.ListAuthFailuresResponse.failures:type_name -> nis.v1.AuthFailure
+	64, // 38: nis.v1.LeafnodeProfile.created_at:type_name -> google.protobuf.Timestamp
+	64, // 39: nis.v1.LeafnodeProfile.updated_at:type_name -> google.protobuf.Timestamp
+	53, // 40: nis.v1.CreateLeafnodeProfileResponse.profile:type_name -> nis.v1.LeafnodeProfile
+	53, // 41: nis.v1.ListLeafnodeProfilesResponse.profiles:type_name -> nis.v1.LeafnodeProfile
+	0,  // 42: nis.v1.SetClusterGatewayResponse.cluster:type_name -> nis.v1.Cluster
+	6,  // 43: nis.v1.ClusterService.CreateCluster:input_type -> nis.v1.CreateClusterRequest
+	8,  // 44: nis.v1.ClusterService.GetCluster:input_type -> nis.v1.GetClusterRequest
+	10, // 45: nis.v1.ClusterService.GetClusterByName:input_type -> nis.v1.GetClusterByNameRequest
+	12, // 46: nis.v1.ClusterService.ListClusters:input_type -> nis.v1.ListClustersRequest
+	14, // 47: nis.v1.ClusterService.UpdateCluster:input_type -> nis.v1.UpdateClusterRequest
+	16, // 48: nis.v1.ClusterService.UpdateClusterCredentials:input_type -> nis.v1.UpdateClusterCredentialsRequest
+	18, // 49: nis.v1.ClusterService.DeleteCluster:input_type -> nis.v1.DeleteClusterRequest
+	20, // 50: nis.v1.ClusterService.GetClusterCredentials:input_type -> nis.v1.GetClusterCredentialsRequest
+	22, // 51: nis.v1.ClusterService.GenerateServerConfig:input_type -> nis.v1.GenerateServerConfigRequest
+	24, // 52: nis.v1.ClusterService.SyncCluster:input_type -> nis.v1.SyncClusterRequest
+	29, // 53: nis.v1.ClusterService.ListResolverAccounts:input_type -> nis.v1.ListResolverAccountsRequest
+	31, // 54: nis.v1.ClusterService.DeleteResolverAccount:input_type -> nis.v1.DeleteResolverAccountRequest
+	33, // 55: nis.v1.ClusterService.VerifyAccount:input_type -> nis.v1.VerifyAccountRequest
+	37, // 56: nis.v1.ClusterService.GetClusterTopology:input_type -> nis.v1.GetClusterTopologyRequest
+	40, // 57: nis.v1.ClusterService.ListClusterHealthChecks:input_type -> nis.v1.ListClusterHealthChecksRequest
+	42, // 58: nis.v1.ClusterService.ListConnections:input_type -> nis.v1.ListConnectionsRequest
+	45, // 59: nis.v1.ClusterService.DisconnectUser:input_type -> nis.v1.DisconnectUserRequest
+	48, // 60: nis.v1.ClusterService.GetClusterCapacity:input_type -> nis.v1.GetClusterCapacityRequest
+	51, // 61: nis.v1.ClusterService.ListAuthFailures:input_type -> nis.v1.ListAuthFailuresRequest
+	54, // 62: nis.v1.ClusterService.CreateLeafnodeProfile:input_type -> nis.v1.CreateLeafnodeProfileRequest
+	56, // 63: nis.v1.ClusterService.ListLeafnodeProfiles:input_type -> nis.v1.ListLeafnodeProfilesRequest
+	58, // 64: nis.v1.ClusterService.DeleteLeafnodeProfile:input_type -> nis.v1.DeleteLeafnodeProfileRequest
+	60, // 65: nis.v1.ClusterService.GenerateLeafnodeConfig:input_type -> nis.v1.GenerateLeafnodeConfigRequest
+	62, // 66: nis.v1.ClusterService.SetClusterGateway:input_type -> nis.v1.SetClusterGatewayRequest
+	7,  // 67: nis.v1.ClusterService.CreateCluster:output_type -> nis.v1.CreateClusterResponse
+	9,  // 68: nis.v1.ClusterService.GetCluster:output_type -> nis.v1.GetClusterResponse
+	11, // 69: nis.v1.ClusterService.GetClusterByName:output_type -> nis.v1.GetClusterByNameResponse
+	13, // 70: nis.v1.ClusterService.ListClusters:output_type -> nis.v1.ListClustersResponse
+	15, // 71: nis.v1.ClusterService.UpdateCluster:output_type -> nis.v1.UpdateClusterResponse
+	17, // 72: nis.v1.ClusterService.UpdateClusterCredentials:output_type -> nis.v1.UpdateClusterCredentialsResponse
+	19, // 73: nis.v1.ClusterService.DeleteCluster:output_type -> nis.v1.DeleteClusterResponse
+	21, // 74: nis.v1.ClusterService.GetClusterCredentials:output_type -> nis.v1.GetClusterCredentialsResponse
+	23, // 75: nis.v1.ClusterService.GenerateServerConfig:output_type -> nis.v1.GenerateServerConfigResponse
+	25, // 76: nis.v1.ClusterService.SyncCluster:output_type -> nis.v1.SyncClusterResponse
+	30, // 77: nis.v1.ClusterService.ListResolverAccounts:output_type -> nis.v1.ListResolverAccountsResponse
+	32, // 78: nis.v1.ClusterService.DeleteResolverAccount:output_type -> nis.v1.DeleteResolverAccountResponse
+	34, // 79: nis.v1.ClusterService.VerifyAccount:output_type -> nis.v1.VerifyAccountResponse
+	38, // 80: nis.v1.ClusterService.GetClusterTopology:output_type -> nis.v1.GetClusterTopologyResponse
+	41, // 81: nis.v1.ClusterService.ListClusterHealthChecks:output_type -> nis.v1.ListClusterHealthChecksResponse
+	43, // 82: nis.v1.ClusterService.ListConnections:output_type -> nis.v1.ListConnectionsResponse
+	46, // 83: nis.v1.ClusterService.DisconnectUser:output_type -> nis.v1.DisconnectUserResponse
+	49, // 84: nis.v1.ClusterService.GetClusterCapacity:output_type -> nis.v1.GetClusterCapacityResponse
+	52, // 85: nis.v1.ClusterService.ListAuthFailures:output_type -> nis.v1.ListAuthFailuresResponse
+	55, // 86: nis.v1.ClusterService.CreateLeafnodeProfile:output_type -> nis.v1.CreateLeafnodeProfileResponse
+	57, // 87: nis.v1.ClusterService.ListLeafnodeProfiles:output_type -> nis.v1.ListLeafnodeProfilesResponse
+	59, // 88: nis.v1.ClusterService.DeleteLeafnodeProfile:output_type -> nis.v1.DeleteLeafnodeProfileResponse
+	61, // 89: nis.v1.ClusterService.GenerateLeafnodeConfig:output_type -> nis.v1.GenerateLeafnodeConfigResponse
+	63, // 90: nis.v1.ClusterService.SetClusterGateway:output_type -> nis.v1.SetClusterGatewayResponse
+	67, // [67:91] is the sub-list for method output_type
+	43, // [43:67] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_nis_v1_cluster_proto_init() }
@@ -1687,15 +5145,18 @@ func file_nis_v1_cluster_proto_init() {
 	if File_nis_v1_cluster_proto != nil {
 		return
 	}
+	file_nis_v1_account_proto_init()
+	file_nis_v1_operator_proto_init()
+	file_nis_v1_user_proto_init()
 	file_nis_v1_common_proto_init()
-	file_nis_v1_cluster_proto_msgTypes[9].OneofWrappers = []any{}
+	file_nis_v1_cluster_proto_msgTypes[14].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nis_v1_cluster_proto_rawDesc), len(file_nis_v1_cluster_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -428,6 +428,68 @@ func (x *Metadata) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// AccountPush reports the push of an account JWT to a cluster
+type AccountPush struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ClusterId   string                 `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	ClusterName string                 `protobuf:"bytes,2,opt,name=cluster_name,json=clusterName,proto3" json:"cluster_name,omitempty"`
+	// Set when the cluster could not be reached, its next sync retries
+	Error         string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountPush) Reset() {
+	*x = AccountPush{}
+	mi := &file_nis_v1_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountPush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountPush) ProtoMessage() {}
+
+func (x *AccountPush) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_common_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountPush.ProtoReflect.Descriptor instead.
+func (*AccountPush) Descriptor() ([]byte, []int) {
+	return file_nis_v1_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AccountPush) GetClusterId() string {
+	if x != nil {
+		return x.ClusterId
+	}
+	return ""
+}
+
+func (x *AccountPush) GetClusterName() string {
+	if x != nil {
+		return x.ClusterName
+	}
+	return ""
+}
+
+func (x *AccountPush) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 var File_nis_v1_common_proto protoreflect.FileDescriptor
 
 const file_nis_v1_common_proto_rawDesc = "" +
@@ -469,7 +531,12 @@ const file_nis_v1_common_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x82\x01\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"e\n" +
+	"\vAccountPush\x12\x1d\n" +
+	"\n" +
+	"cluster_id\x18\x01 \x01(\tR\tclusterId\x12!\n" +
+	"\fcluster_name\x18\x02 \x01(\tR\vclusterName\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05errorB\x82\x01\n" +
 	"\n" +
 	"com.nis.v1B\vCommonProtoP\x01Z.github.com/thomas-maurice/nis/gen/nis/v1;nisv1\xa2\x02\x03NXX\xaa\x02\x06Nis.V1\xca\x02\x06Nis\\V1\xe2\x02\x12Nis\\V1\\GPBMetadata\xea\x02\aNis::V1b\x06proto3"
 
@@ -485,7 +552,7 @@ func file_nis_v1_common_proto_rawDescGZIP() []byte {
 	return file_nis_v1_common_proto_rawDescData
 }
 
-var file_nis_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_nis_v1_common_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_nis_v1_common_proto_goTypes = []any{
 	(*ListOptions)(nil),           // 0: nis.v1.ListOptions
 	(*Error)(nil),                 // 1: nis.v1.Error
@@ -493,13 +560,14 @@ var file_nis_v1_common_proto_goTypes = []any{
 	(*UserPermissions)(nil),       // 3: nis.v1.UserPermissions
 	(*ResponsePermission)(nil),    // 4: nis.v1.ResponsePermission
 	(*Metadata)(nil),              // 5: nis.v1.Metadata
-	nil,                           // 6: nis.v1.Error.DetailsEntry
-	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+	(*AccountPush)(nil),           // 6: nis.v1.AccountPush
+	nil,                           // 7: nis.v1.Error.DetailsEntry
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
 }
 var file_nis_v1_common_proto_depIdxs = []int32{
-	6, // 0: nis.v1.Error.details:type_name -> nis.v1.Error.DetailsEntry
-	7, // 1: nis.v1.Metadata.created_at:type_name -> google.protobuf.Timestamp
-	7, // 2: nis.v1.Metadata.updated_at:type_name -> google.protobuf.Timestamp
+	7, // 0: nis.v1.Error.details:type_name -> nis.v1.Error.DetailsEntry
+	8, // 1: nis.v1.Metadata.created_at:type_name -> google.protobuf.Timestamp
+	8, // 2: nis.v1.Metadata.updated_at:type_name -> google.protobuf.Timestamp
 	3, // [3:3] is the sub-list for method output_type
 	3, // [3:3] is the sub-list for method input_type
 	3, // [3:3] is the sub-list for extension type_name
@@ -518,7 +586,7 @@ func file_nis_v1_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nis_v1_common_proto_rawDesc), len(file_nis_v1_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -57,6 +57,30 @@ const (
 	// AccountServicePushAccountJWTProcedure is the fully-qualified name of the AccountService's
 	// PushAccountJWT RPC.
 	AccountServicePushAccountJWTProcedure = "/nis.v1.AccountService/PushAccountJWT"
+	// AccountServicePromoteAccountProcedure is the fully-qualified name of the AccountService's
+	// PromoteAccount RPC.
+	AccountServicePromoteAccountProcedure = "/nis.v1.AccountService/PromoteAccount"
+	// AccountServiceGetAccountUsageProcedure is the fully-qualified name of the AccountService's
+	// GetAccountUsage RPC.
+	AccountServiceGetAccountUsageProcedure = "/nis.v1.AccountService/GetAccountUsage"
+	// AccountServiceGetAccountStatsProcedure is the fully-qualified name of the AccountService's
+	// GetAccountStats RPC.
+	AccountServiceGetAccountStatsProcedure = "/nis.v1.AccountService/GetAccountStats"
+	// AccountServiceGetAccountPlacementProcedure is the fully-qualified name of the AccountService's
+	// GetAccountPlacement RPC.
+	AccountServiceGetAccountPlacementProcedure = "/nis.v1.AccountService/GetAccountPlacement"
+	// AccountServiceSetAccountPlacementProcedure is the fully-qualified name of the AccountService's
+	// SetAccountPlacement RPC.
+	AccountServiceSetAccountPlacementProcedure = "/nis.v1.AccountService/SetAccountPlacement"
+	// AccountServiceMoveAccountProcedure is the fully-qualified name of the AccountService's
+	// MoveAccount RPC.
+	AccountServiceMoveAccountProcedure = "/nis.v1.AccountService/MoveAccount"
+	// AccountServiceSuspendAccountProcedure is the fully-qualified name of the AccountService's
+	// SuspendAccount RPC.
+	AccountServiceSuspendAccountProcedure = "/nis.v1.AccountService/SuspendAccount"
+	// AccountServiceResumeAccountProcedure is the fully-qualified name of the AccountService's
+	// ResumeAccount RPC.
+	AccountServiceResumeAccountProcedure = "/nis.v1.AccountService/ResumeAccount"
 )
 
 // AccountServiceClient is a client for the nis.v1.AccountService service.
@@ -69,6 +93,18 @@ type AccountServiceClient interface {
 	UpdateJetStreamLimits(context.Context, *connect.Request[v1.UpdateJetStreamLimitsRequest]) (*connect.Response[v1.UpdateJetStreamLimitsResponse], error)
 	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
 	PushAccountJWT(context.Context, *connect.Request[v1.PushAccountJWTRequest]) (*connect.Response[v1.PushAccountJWTResponse], error)
+	PromoteAccount(context.Context, *connect.Request[v1.PromoteAccountRequest]) (*connect.Response[v1.PromoteAccountResponse], error)
+	// GetAccountUsage reports the JetStream usage of an account against its limits via JSZ
+	GetAccountUsage(context.Context, *connect.Request[v1.GetAccountUsageRequest]) (*connect.Response[v1.GetAccountUsageResponse], error)
+	// GetAccountStats returns the account traffic collected from the clusters via STATZ
+	GetAccountStats(context.Context, *connect.Request[v1.GetAccountStatsRequest]) (*connect.Response[v1.GetAccountStatsResponse], error)
+	// GetAccountPlacement lists the clusters an account is pushed to
+	GetAccountPlacement(context.Context, *connect.Request[v1.GetAccountPlacementRequest]) (*connect.Response[v1.GetAccountPlacementResponse], error)
+	// SetAccountPlacement places an account on clusters and deletes it from the clusters it leaves
+	SetAccountPlacement(context.Context, *connect.Request[v1.SetAccountPlacementRequest]) (*connect.Response[v1.SetAccountPlacementResponse], error)
+	MoveAccount(context.Context, *connect.Request[v1.MoveAccountRequest]) (*connect.Response[v1.MoveAccountResponse], error)
+	SuspendAccount(context.Context, *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error)
+	ResumeAccount(context.Context, *connect.Request[v1.ResumeAccountRequest]) (*connect.Response[v1.ResumeAccountResponse], error)
 }
 
 // NewAccountServiceClient constructs a client for the nis.v1.AccountService service. By default, it
@@ -130,6 +166,54 @@ func NewAccountServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(accountServiceMethods.ByName("PushAccountJWT")),
 			connect.WithClientOptions(opts...),
 		),
+		promoteAccount: connect.NewClient[v1.PromoteAccountRequest, v1.PromoteAccountResponse](
+			httpClient,
+			baseURL+AccountServicePromoteAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("PromoteAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountUsage: connect.NewClient[v1.GetAccountUsageRequest, v1.GetAccountUsageResponse](
+			httpClient,
+			baseURL+AccountServiceGetAccountUsageProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetAccountUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountStats: connect.NewClient[v1.GetAccountStatsRequest, v1.GetAccountStatsResponse](
+			httpClient,
+			baseURL+AccountServiceGetAccountStatsProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetAccountStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountPlacement: connect.NewClient[v1.GetAccountPlacementRequest, v1.GetAccountPlacementResponse](
+			httpClient,
+			baseURL+AccountServiceGetAccountPlacementProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("GetAccountPlacement")),
+			connect.WithClientOptions(opts...),
+		),
+		setAccountPlacement: connect.NewClient[v1.SetAccountPlacementRequest, v1.SetAccountPlacementResponse](
+			httpClient,
+			baseURL+AccountServiceSetAccountPlacementProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("SetAccountPlacement")),
+			connect.WithClientOptions(opts...),
+		),
+		moveAccount: connect.NewClient[v1.MoveAccountRequest, v1.MoveAccountResponse](
+			httpClient,
+			baseURL+AccountServiceMoveAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("MoveAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendAccount: connect.NewClient[v1.SuspendAccountRequest, v1.SuspendAccountResponse](
+			httpClient,
+			baseURL+AccountServiceSuspendAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("SuspendAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeAccount: connect.NewClient[v1.ResumeAccountRequest, v1.ResumeAccountResponse](
+			httpClient,
+			baseURL+AccountServiceResumeAccountProcedure,
+			connect.WithSchema(accountServiceMethods.ByName("ResumeAccount")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -143,6 +227,14 @@ type accountServiceClient struct {
 	updateJetStreamLimits *connect.Client[v1.UpdateJetStreamLimitsRequest, v1.UpdateJetStreamLimitsResponse]
 	deleteAccount         *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
 	pushAccountJWT        *connect.Client[v1.PushAccountJWTRequest, v1.PushAccountJWTResponse]
+	promoteAccount        *connect.Client[v1.PromoteAccountRequest, v1.PromoteAccountResponse]
+	getAccountUsage       *connect.Client[v1.GetAccountUsageRequest, v1.GetAccountUsageResponse]
+	getAccountStats       *connect.Client[v1.GetAccountStatsRequest, v1.GetAccountStatsResponse]
+	getAccountPlacement   *connect.Client[v1.GetAccountPlacementRequest, v1.GetAccountPlacementResponse]
+	setAccountPlacement   *connect.Client[v1.SetAccountPlacementRequest, v1.SetAccountPlacementResponse]
+	moveAccount           *connect.Client[v1.MoveAccountRequest, v1.MoveAccountResponse]
+	suspendAccount        *connect.Client[v1.SuspendAccountRequest, v1.SuspendAccountResponse]
+	resumeAccount         *connect.Client[v1.ResumeAccountRequest, v1.ResumeAccountResponse]
 }
 
 // CreateAccount calls nis.v1.AccountService.CreateAccount.
@@ -185,6 +277,46 @@ func (c *accountServiceClient) PushAccountJWT(ctx context.Context, req *connect.
 	return c.pushAccountJWT.CallUnary(ctx, req)
 }
 
+// PromoteAccount calls nis.v1.AccountService.PromoteAccount.
+func (c *accountServiceClient) PromoteAccount(ctx context.Context, req *connect.Request[v1.PromoteAccountRequest]) (*connect.Response[v1.PromoteAccountResponse], error) {
+	return c.promoteAccount.CallUnary(ctx, req)
+}
+
+// GetAccountUsage calls nis.v1.AccountService.GetAccountUsage.
+func (c *accountServiceClient) GetAccountUsage(ctx context.Context, req *connect.Request[v1.GetAccountUsageRequest]) (*connect.Response[v1.GetAccountUsageResponse], error) {
+	return c.getAccountUsage.CallUnary(ctx, req)
+}
+
+// GetAccountStats calls nis.v1.AccountService.GetAccountStats.
+func (c *accountServiceClient) GetAccountStats(ctx context.Context, req *connect.Request[v1.GetAccountStatsRequest]) (*connect.Response[v1.GetAccountStatsResponse], error) {
+	return c.getAccountStats.CallUnary(ctx, req)
+}
+
+// GetAccountPlacement calls nis.v1.AccountService.GetAccountPlacement.
+func (c *accountServiceClient) GetAccountPlacement(ctx context.Context, req *connect.Request[v1.GetAccountPlacementRequest]) (*connect.Response[v1.GetAccountPlacementResponse], error) {
+	return c.getAccountPlacement.CallUnary(ctx, req)
+}
+
+// SetAccountPlacement calls nis.v1.AccountService.SetAccountPlacement.
+func (c *accountServiceClient) SetAccountPlacement(ctx context.Context, req *connect.Request[v1.SetAccountPlacementRequest]) (*connect.Response[v1.SetAccountPlacementResponse], error) {
+	return c.setAccountPlacement.CallUnary(ctx, req)
+}
+
+// MoveAccount calls nis.v1.AccountService.MoveAccount.
+func (c *accountServiceClient) MoveAccount(ctx context.Context, req *connect.Request[v1.MoveAccountRequest]) (*connect.Response[v1.MoveAccountResponse], error) {
+	return c.moveAccount.CallUnary(ctx, req)
+}
+
+// SuspendAccount calls nis.v1.AccountService.SuspendAccount.
+func (c *accountServiceClient) SuspendAccount(ctx context.Context, req *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error) {
+	return c.suspendAccount.CallUnary(ctx, req)
+}
+
+// ResumeAccount calls nis.v1.AccountService.ResumeAccount.
+func (c *accountServiceClient) ResumeAccount(ctx context.Context, req *connect.Request[v1.ResumeAccountRequest]) (*connect.Response[v1.ResumeAccountResponse], error) {
+	return c.resumeAccount.CallUnary(ctx, req)
+}
+
 // AccountServiceHandler is an implementation of the nis.v1.AccountService service.
 type AccountServiceHandler interface {
 	CreateAccount(context.Context, *connect.Request[v1.CreateAccountRequest]) (*connect.Response[v1.CreateAccountResponse], error)
@@ -195,6 +327,18 @@ type AccountServiceHandler interface {
 	UpdateJetStreamLimits(context.Context, *connect.Request[v1.UpdateJetStreamLimitsRequest]) (*connect.Response[v1.UpdateJetStreamLimitsResponse], error)
 	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
 	PushAccountJWT(context.Context, *connect.Request[v1.PushAccountJWTRequest]) (*connect.Response[v1.PushAccountJWTResponse], error)
+	PromoteAccount(context.Context, *connect.Request[v1.PromoteAccountRequest]) (*connect.Response[v1.PromoteAccountResponse], error)
+	// GetAccountUsage reports the JetStream usage of an account against its limits via JSZ
+	GetAccountUsage(context.Context, *connect.Request[v1.GetAccountUsageRequest]) (*connect.Response[v1.GetAccountUsageResponse], error)
+	// GetAccountStats returns the account traffic collected from the clusters via STATZ
+	GetAccountStats(context.Context, *connect.Request[v1.GetAccountStatsRequest]) (*connect.Response[v1.GetAccountStatsResponse], error)
+	// GetAccountPlacement lists the clusters an account is pushed to
+	GetAccountPlacement(context.Context, *connect.Request[v1.GetAccountPlacementRequest]) (*connect.Response[v1.GetAccountPlacementResponse], error)
+	// SetAccountPlacement places an account on clusters and deletes it from the clusters it leaves
+	SetAccountPlacement(context.Context, *connect.Request[v1.SetAccountPlacementRequest]) (*connect.Response[v1.SetAccountPlacementResponse], error)
+	MoveAccount(context.Context, *connect.Request[v1.MoveAccountRequest]) (*connect.Response[v1.MoveAccountResponse], error)
+	SuspendAccount(context.Context, *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error)
+	ResumeAccount(context.Context, *connect.Request[v1.ResumeAccountRequest]) (*connect.Response[v1.ResumeAccountResponse], error)
 }
 
 // NewAccountServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -252,6 +396,54 @@ func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.Handler
 		connect.WithSchema(accountServiceMethods.ByName("PushAccountJWT")),
 		connect.WithHandlerOptions(opts...),
 	)
+	accountServicePromoteAccountHandler := connect.NewUnaryHandler(
+		AccountServicePromoteAccountProcedure,
+		svc.PromoteAccount,
+		connect.WithSchema(accountServiceMethods.ByName("PromoteAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetAccountUsageHandler := connect.NewUnaryHandler(
+		AccountServiceGetAccountUsageProcedure,
+		svc.GetAccountUsage,
+		connect.WithSchema(accountServiceMethods.ByName("GetAccountUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetAccountStatsHandler := connect.NewUnaryHandler(
+		AccountServiceGetAccountStatsProcedure,
+		svc.GetAccountStats,
+		connect.WithSchema(accountServiceMethods.ByName("GetAccountStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceGetAccountPlacementHandler := connect.NewUnaryHandler(
+		AccountServiceGetAccountPlacementProcedure,
+		svc.GetAccountPlacement,
+		connect.WithSchema(accountServiceMethods.ByName("GetAccountPlacement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceSetAccountPlacementHandler := connect.NewUnaryHandler(
+		AccountServiceSetAccountPlacementProcedure,
+		svc.SetAccountPlacement,
+		connect.WithSchema(accountServiceMethods.ByName("SetAccountPlacement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceMoveAccountHandler := connect.NewUnaryHandler(
+		AccountServiceMoveAccountProcedure,
+		svc.MoveAccount,
+		connect.WithSchema(accountServiceMethods.ByName("MoveAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceSuspendAccountHandler := connect.NewUnaryHandler(
+		AccountServiceSuspendAccountProcedure,
+		svc.SuspendAccount,
+		connect.WithSchema(accountServiceMethods.ByName("SuspendAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	accountServiceResumeAccountHandler := connect.NewUnaryHandler(
+		AccountServiceResumeAccountProcedure,
+		svc.ResumeAccount,
+		connect.WithSchema(accountServiceMethods.ByName("ResumeAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/nis.v1.AccountService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AccountServiceCreateAccountProcedure:
@@ -270,6 +462,22 @@ func NewAccountServiceHandler(svc AccountServiceHandler, opts ...connect.Handler
 			accountServiceDeleteAccountHandler.ServeHTTP(w, r)
 		case AccountServicePushAccountJWTProcedure:
 			accountServicePushAccountJWTHandler.ServeHTTP(w, r)
+		case AccountServicePromoteAccountProcedure:
+			accountServicePromoteAccountHandler.ServeHTTP(w, r)
+		case AccountServiceGetAccountUsageProcedure:
+			accountServiceGetAccountUsageHandler.ServeHTTP(w, r)
+		case AccountServiceGetAccountStatsProcedure:
+			accountServiceGetAccountStatsHandler.ServeHTTP(w, r)
+		case AccountServiceGetAccountPlacementProcedure:
+			accountServiceGetAccountPlacementHandler.ServeHTTP(w, r)
+		case AccountServiceSetAccountPlacementProcedure:
+			accountServiceSetAccountPlacementHandler.ServeHTTP(w, r)
+		case AccountServiceMoveAccountProcedure:
+			accountServiceMoveAccountHandler.ServeHTTP(w, r)
+		case AccountServiceSuspendAccountProcedure:
+			accountServiceSuspendAccountHandler.ServeHTTP(w, r)
+		case AccountServiceResumeAccountProcedure:
+			accountServiceResumeAccountHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -310,3 +518,35 @@ func (UnimplementedAccountServiceHandler) DeleteAccount(context.Context, *connec
 func (UnimplementedAccountServiceHandler) PushAccountJWT(context.Context, *connect.Request[v1.PushAccountJWTRequest]) (*connect.Response[v1.PushAccountJWTResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.AccountService.PushAccountJWT is not implemented"))
 }
+
+func (UnimplementedAccountServiceHandler) PromoteAccount(context.Context, *connect.Request[v1.PromoteAccountRequest]) (*connect.Response[v1.PromoteAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.AccountService.PromoteAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetAccountUsage(context.Context, *connect.Request[v1.GetAccountUsageRequest]) (*connect.Response[v1.GetAccountUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.AccountService.GetAccountUsage is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetAccountStats(context.Context, *connect.Request[v1.GetAccountStatsRequest]) (*connect.Response[v1.GetAccountStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.AccountService.GetAccountStats is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) GetAccountPlacement(context.Context, *connect.Request[v1.GetAccountPlacementRequest]) (*connect.Response[v1.GetAccountPlacementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.AccountService.GetAccountPlacement is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) SetAccountPlacement(context.Context, *connect.Request[v1.SetAccountPlacementRequest]) (*connect.Response[v1.SetAccountPlacementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.AccountService.SetAccountPlacement is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) MoveAccount(context.Context, *connect.Request[v1.MoveAccountRequest]) (*connect.Response[v1.MoveAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.AccountService.MoveAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) SuspendAccount(context.Context, *connect.Request[v1.SuspendAccountRequest]) (*connect.Response[v1.SuspendAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.AccountService.SuspendAccount is not implemented"))
+}
+
+func (UnimplementedAccountServiceHandler) ResumeAccount(context.Context, *connect.Request[v1.ResumeAccountRequest]) (*connect.Response[v1.ResumeAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.AccountService.ResumeAccount is not implemented"))
+}
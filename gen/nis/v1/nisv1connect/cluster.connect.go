@@ -69,6 +69,42 @@ const (
 	// ClusterServiceDeleteResolverAccountProcedure is the fully-qualified name of the ClusterService's
 	// DeleteResolverAccount RPC.
 	ClusterServiceDeleteResolverAccountProcedure = "/nis.v1.ClusterService/DeleteResolverAccount"
+	// ClusterServiceVerifyAccountProcedure is the fully-qualified name of the ClusterService's
+	// VerifyAccount RPC.
+	ClusterServiceVerifyAccountProcedure = "/nis.v1.ClusterService/VerifyAccount"
+	// ClusterServiceGetClusterTopologyProcedure is the fully-qualified name of the ClusterService's
+	// GetClusterTopology RPC.
+	ClusterServiceGetClusterTopologyProcedure = "/nis.v1.ClusterService/GetClusterTopology"
+	// ClusterServiceListClusterHealthChecksProcedure is the fully-qualified name of the
+	// ClusterService's ListClusterHealthChecks RPC.
+	ClusterServiceListClusterHealthChecksProcedure = "/nis.v1.ClusterService/ListClusterHealthChecks"
+	// ClusterServiceListConnectionsProcedure is the fully-qualified name of the ClusterService's
+	// ListConnections RPC.
+	ClusterServiceListConnectionsProcedure = "/nis.v1.ClusterService/ListConnections"
+	// ClusterServiceDisconnectUserProcedure is the fully-qualified name of the ClusterService's
+	// DisconnectUser RPC.
+	ClusterServiceDisconnectUserProcedure = "/nis.v1.ClusterService/DisconnectUser"
+	// ClusterServiceGetClusterCapacityProcedure is the fully-qualified name of the ClusterService's
+	// GetClusterCapacity RPC.
+	ClusterServiceGetClusterCapacityProcedure = "/nis.v1.ClusterService/GetClusterCapacity"
+	// ClusterServiceListAuthFailuresProcedure is the fully-qualified name of the ClusterService's
+	// ListAuthFailures RPC.
+	ClusterServiceListAuthFailuresProcedure = "/nis.v1.ClusterService/ListAuthFailures"
+	// ClusterServiceCreateLeafnodeProfileProcedure is the fully-qualified name of the ClusterService's
+	// CreateLeafnodeProfile RPC.
+	ClusterServiceCreateLeafnodeProfileProcedure = "/nis.v1.ClusterService/CreateLeafnodeProfile"
+	// ClusterServiceListLeafnodeProfilesProcedure is the fully-qualified name of the ClusterService's
+	// ListLeafnodeProfiles RPC.
+	ClusterServiceListLeafnodeProfilesProcedure = "/nis.v1.ClusterService/ListLeafnodeProfiles"
+	// ClusterServiceDeleteLeafnodeProfileProcedure is the fully-qualified name of the ClusterService's
+	// DeleteLeafnodeProfile RPC.
+	ClusterServiceDeleteLeafnodeProfileProcedure = "/nis.v1.ClusterService/DeleteLeafnodeProfile"
+	// ClusterServiceGenerateLeafnodeConfigProcedure is the fully-qualified name of the ClusterService's
+	// GenerateLeafnodeConfig RPC.
+	ClusterServiceGenerateLeafnodeConfigProcedure = "/nis.v1.ClusterService/GenerateLeafnodeConfig"
+	// ClusterServiceSetClusterGatewayProcedure is the fully-qualified name of the ClusterService's
+	// SetClusterGateway RPC.
+	ClusterServiceSetClusterGatewayProcedure = "/nis.v1.ClusterService/SetClusterGateway"
 )
 
 // ClusterServiceClient is a client for the nis.v1.ClusterService service.
@@ -89,6 +125,30 @@ type ClusterServiceClient interface {
 	ListResolverAccounts(context.Context, *connect.Request[v1.ListResolverAccountsRequest]) (*connect.Response[v1.ListResolverAccountsResponse], error)
 	// DeleteResolverAccount removes an account from the NATS resolver
 	DeleteResolverAccount(context.Context, *connect.Request[v1.DeleteResolverAccountRequest]) (*connect.Response[v1.DeleteResolverAccountResponse], error)
+	// VerifyAccount reports which servers of the cluster hold the account's current JWT
+	VerifyAccount(context.Context, *connect.Request[v1.VerifyAccountRequest]) (*connect.Response[v1.VerifyAccountResponse], error)
+	// GetClusterTopology lists the servers recorded for the cluster by the health check
+	GetClusterTopology(context.Context, *connect.Request[v1.GetClusterTopologyRequest]) (*connect.Response[v1.GetClusterTopologyResponse], error)
+	// ListClusterHealthChecks returns the recorded health checks of the cluster
+	ListClusterHealthChecks(context.Context, *connect.Request[v1.ListClusterHealthChecksRequest]) (*connect.Response[v1.ListClusterHealthChecksResponse], error)
+	// ListConnections lists the live client connections of an account or user via CONNZ
+	ListConnections(context.Context, *connect.Request[v1.ListConnectionsRequest]) (*connect.Response[v1.ListConnectionsResponse], error)
+	// DisconnectUser kicks every live connection of a user via $SYS.REQ.SERVER.<id>.KICK
+	DisconnectUser(context.Context, *connect.Request[v1.DisconnectUserRequest]) (*connect.Response[v1.DisconnectUserResponse], error)
+	// GetClusterCapacity reports JetStream capacity against the limits assigned to accounts
+	GetClusterCapacity(context.Context, *connect.Request[v1.GetClusterCapacityRequest]) (*connect.Response[v1.GetClusterCapacityResponse], error)
+	// ListAuthFailures lists the NATS authentication failures reported by the clusters
+	ListAuthFailures(context.Context, *connect.Request[v1.ListAuthFailuresRequest]) (*connect.Response[v1.ListAuthFailuresResponse], error)
+	// CreateLeafnodeProfile binds a user to a hub cluster for the leafnode remotes of edge servers
+	CreateLeafnodeProfile(context.Context, *connect.Request[v1.CreateLeafnodeProfileRequest]) (*connect.Response[v1.CreateLeafnodeProfileResponse], error)
+	// ListLeafnodeProfiles lists the leafnode profiles of a hub cluster
+	ListLeafnodeProfiles(context.Context, *connect.Request[v1.ListLeafnodeProfilesRequest]) (*connect.Response[v1.ListLeafnodeProfilesResponse], error)
+	// DeleteLeafnodeProfile deletes a leafnode profile, the user is kept
+	DeleteLeafnodeProfile(context.Context, *connect.Request[v1.DeleteLeafnodeProfileRequest]) (*connect.Response[v1.DeleteLeafnodeProfileResponse], error)
+	// GenerateLeafnodeConfig generates the leafnode remote block and the matching credentials
+	GenerateLeafnodeConfig(context.Context, *connect.Request[v1.GenerateLeafnodeConfigRequest]) (*connect.Response[v1.GenerateLeafnodeConfigResponse], error)
+	// SetClusterGateway makes a cluster join or leave a supercluster of the operator's clusters
+	SetClusterGateway(context.Context, *connect.Request[v1.SetClusterGatewayRequest]) (*connect.Response[v1.SetClusterGatewayResponse], error)
 }
 
 // NewClusterServiceClient constructs a client for the nis.v1.ClusterService service. By default, it
@@ -174,6 +234,78 @@ func NewClusterServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(clusterServiceMethods.ByName("DeleteResolverAccount")),
 			connect.WithClientOptions(opts...),
 		),
+		verifyAccount: connect.NewClient[v1.VerifyAccountRequest, v1.VerifyAccountResponse](
+			httpClient,
+			baseURL+ClusterServiceVerifyAccountProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("VerifyAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		getClusterTopology: connect.NewClient[v1.GetClusterTopologyRequest, v1.GetClusterTopologyResponse](
+			httpClient,
+			baseURL+ClusterServiceGetClusterTopologyProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("GetClusterTopology")),
+			connect.WithClientOptions(opts...),
+		),
+		listClusterHealthChecks: connect.NewClient[v1.ListClusterHealthChecksRequest, v1.ListClusterHealthChecksResponse](
+			httpClient,
+			baseURL+ClusterServiceListClusterHealthChecksProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("ListClusterHealthChecks")),
+			connect.WithClientOptions(opts...),
+		),
+		listConnections: connect.NewClient[v1.ListConnectionsRequest, v1.ListConnectionsResponse](
+			httpClient,
+			baseURL+ClusterServiceListConnectionsProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("ListConnections")),
+			connect.WithClientOptions(opts...),
+		),
+		disconnectUser: connect.NewClient[v1.DisconnectUserRequest, v1.DisconnectUserResponse](
+			httpClient,
+			baseURL+ClusterServiceDisconnectUserProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("DisconnectUser")),
+			connect.WithClientOptions(opts...),
+		),
+		getClusterCapacity: connect.NewClient[v1.GetClusterCapacityRequest, v1.GetClusterCapacityResponse](
+			httpClient,
+			baseURL+ClusterServiceGetClusterCapacityProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("GetClusterCapacity")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuthFailures: connect.NewClient[v1.ListAuthFailuresRequest, v1.ListAuthFailuresResponse](
+			httpClient,
+			baseURL+ClusterServiceListAuthFailuresProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("ListAuthFailures")),
+			connect.WithClientOptions(opts...),
+		),
+		createLeafnodeProfile: connect.NewClient[v1.CreateLeafnodeProfileRequest, v1.CreateLeafnodeProfileResponse](
+			httpClient,
+			baseURL+ClusterServiceCreateLeafnodeProfileProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("CreateLeafnodeProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		listLeafnodeProfiles: connect.NewClient[v1.ListLeafnodeProfilesRequest, v1.ListLeafnodeProfilesResponse](
+			httpClient,
+			baseURL+ClusterServiceListLeafnodeProfilesProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("ListLeafnodeProfiles")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteLeafnodeProfile: connect.NewClient[v1.DeleteLeafnodeProfileRequest, v1.DeleteLeafnodeProfileResponse](
+			httpClient,
+			baseURL+ClusterServiceDeleteLeafnodeProfileProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("DeleteLeafnodeProfile")),
+			connect.WithClientOptions(opts...),
+		),
+		generateLeafnodeConfig: connect.NewClient[v1.GenerateLeafnodeConfigRequest, v1.GenerateLeafnodeConfigResponse](
+			httpClient,
+			baseURL+ClusterServiceGenerateLeafnodeConfigProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("GenerateLeafnodeConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		setClusterGateway: connect.NewClient[v1.SetClusterGatewayRequest, v1.SetClusterGatewayResponse](
+			httpClient,
+			baseURL+ClusterServiceSetClusterGatewayProcedure,
+			connect.WithSchema(clusterServiceMethods.ByName("SetClusterGateway")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -191,6 +323,18 @@ type clusterServiceClient struct {
 	syncCluster              *connect.Client[v1.SyncClusterRequest, v1.SyncClusterResponse]
 	listResolverAccounts     *connect.Client[v1.ListResolverAccountsRequest, v1.ListResolverAccountsResponse]
 	deleteResolverAccount    *connect.Client[v1.DeleteResolverAccountRequest, v1.DeleteResolverAccountResponse]
+	verifyAccount            *connect.Client[v1.VerifyAccountRequest, v1.VerifyAccountResponse]
+	getClusterTopology       *connect.Client[v1.GetClusterTopologyRequest, v1.GetClusterTopologyResponse]
+	listClusterHealthChecks  *connect.Client[v1.ListClusterHealthChecksRequest, v1.ListClusterHealthChecksResponse]
+	listConnections          *connect.Client[v1.ListConnectionsRequest, v1.ListConnectionsResponse]
+	disconnectUser           *connect.Client[v1.DisconnectUserRequest, v1.DisconnectUserResponse]
+	getClusterCapacity       *connect.Client[v1.GetClusterCapacityRequest, v1.GetClusterCapacityResponse]
+	listAuthFailures         *connect.Client[v1.ListAuthFailuresRequest, v1.ListAuthFailuresResponse]
+	createLeafnodeProfile    *connect.Client[v1.CreateLeafnodeProfileRequest, v1.CreateLeafnodeProfileResponse]
+	listLeafnodeProfiles     *connect.Client[v1.ListLeafnodeProfilesRequest, v1.ListLeafnodeProfilesResponse]
+	deleteLeafnodeProfile    *connect.Client[v1.DeleteLeafnodeProfileRequest, v1.DeleteLeafnodeProfileResponse]
+	generateLeafnodeConfig   *connect.Client[v1.GenerateLeafnodeConfigRequest, v1.GenerateLeafnodeConfigResponse]
+	setClusterGateway        *connect.Client[v1.SetClusterGatewayRequest, v1.SetClusterGatewayResponse]
 }
 
 // CreateCluster calls nis.v1.ClusterService.CreateCluster.
@@ -253,6 +397,66 @@ func (c *clusterServiceClient) DeleteResolverAccount(ctx context.Context, req *c
 	return c.deleteResolverAccount.CallUnary(ctx, req)
 }
 
+// VerifyAccount calls nis.v1.ClusterService.VerifyAccount.
+func (c *clusterServiceClient) VerifyAccount(ctx context.Context, req *connect.Request[v1.VerifyAccountRequest]) (*connect.Response[v1.VerifyAccountResponse], error) {
+	return c.verifyAccount.CallUnary(ctx, req)
+}
+
+// GetClusterTopology calls nis.v1.ClusterService.GetClusterTopology.
+func (c *clusterServiceClient) GetClusterTopology(ctx context.Context, req *connect.Request[v1.GetClusterTopologyRequest]) (*connect.Response[v1.GetClusterTopologyResponse], error) {
+	return c.getClusterTopology.CallUnary(ctx, req)
+}
+
+// ListClusterHealthChecks calls nis.v1.ClusterService.ListClusterHealthChecks.
+func (c *clusterServiceClient) ListClusterHealthChecks(ctx context.Context, req *connect.Request[v1.ListClusterHealthChecksRequest]) (*connect.Response[v1.ListClusterHealthChecksResponse], error) {
+	return c.listClusterHealthChecks.CallUnary(ctx, req)
+}
+
+// ListConnections calls nis.v1.ClusterService.ListConnections.
+func (c *clusterServiceClient) ListConnections(ctx context.Context, req *connect.Request[v1.ListConnectionsRequest]) (*connect.Response[v1.ListConnectionsResponse], error) {
+	return c.listConnections.CallUnary(ctx, req)
+}
+
+// DisconnectUser calls nis.v1.ClusterService.DisconnectUser.
+func (c *clusterServiceClient) DisconnectUser(ctx context.Context, req *connect.Request[v1.DisconnectUserRequest]) (*connect.Response[v1.DisconnectUserResponse], error) {
+	return c.disconnectUser.CallUnary(ctx, req)
+}
+
+// GetClusterCapacity calls nis.v1.ClusterService.GetClusterCapacity.
+func (c *clusterServiceClient) GetClusterCapacity(ctx context.Context, req *connect.Request[v1.GetClusterCapacityRequest]) (*connect.Response[v1.GetClusterCapacityResponse], error) {
+	return c.getClusterCapacity.CallUnary(ctx, req)
+}
+
+// ListAuthFailures calls nis.v1.ClusterService.ListAuthFailures.
+func (c *clusterServiceClient) ListAuthFailures(ctx context.Context, req *connect.Request[v1.ListAuthFailuresRequest]) (*connect.Response[v1.ListAuthFailuresResponse], error) {
+	return c.listAuthFailures.CallUnary(ctx, req)
+}
+
+// CreateLeafnodeProfile calls nis.v1.ClusterService.CreateLeafnodeProfile.
+func (c *clusterServiceClient) CreateLeafnodeProfile(ctx context.Context, req *connect.Request[v1.CreateLeafnodeProfileRequest]) (*connect.Response[v1.CreateLeafnodeProfileResponse], error) {
+	return c.createLeafnodeProfile.CallUnary(ctx, req)
+}
+
+// ListLeafnodeProfiles calls nis.v1.ClusterService.ListLeafnodeProfiles.
+func (c *clusterServiceClient) ListLeafnodeProfiles(ctx context.Context, req *connect.Request[v1.ListLeafnodeProfilesRequest]) (*connect.Response[v1.ListLeafnodeProfilesResponse], error) {
+	return c.listLeafnodeProfiles.CallUnary(ctx, req)
+}
+
+// DeleteLeafnodeProfile calls nis.v1.ClusterService.DeleteLeafnodeProfile.
+func (c *clusterServiceClient) DeleteLeafnodeProfile(ctx context.Context, req *connect.Request[v1.DeleteLeafnodeProfileRequest]) (*connect.Response[v1.DeleteLeafnodeProfileResponse], error) {
+	return c.deleteLeafnodeProfile.CallUnary(ctx, req)
+}
+
+// GenerateLeafnodeConfig calls nis.v1.ClusterService.GenerateLeafnodeConfig.
+func (c *clusterServiceClient) GenerateLeafnodeConfig(ctx context.Context, req *connect.Request[v1.GenerateLeafnodeConfigRequest]) (*connect.Response[v1.GenerateLeafnodeConfigResponse], error) {
+	return c.generateLeafnodeConfig.CallUnary(ctx, req)
+}
+
+// SetClusterGateway calls nis.v1.ClusterService.SetClusterGateway.
+func (c *clusterServiceClient) SetClusterGateway(ctx context.Context, req *connect.Request[v1.SetClusterGatewayRequest]) (*connect.Response[v1.SetClusterGatewayResponse], error) {
+	return c.setClusterGateway.CallUnary(ctx, req)
+}
+
 // ClusterServiceHandler is an implementation of the nis.v1.ClusterService service.
 type ClusterServiceHandler interface {
 	CreateCluster(context.Context, *connect.Request[v1.CreateClusterRequest]) (*connect.Response[v1.CreateClusterResponse], error)
@@ -271,6 +475,30 @@ type ClusterServiceHandler interface {
 	ListResolverAccounts(context.Context, *connect.Request[v1.ListResolverAccountsRequest]) (*connect.Response[v1.ListResolverAccountsResponse], error)
 	// DeleteResolverAccount removes an account from the NATS resolver
 	DeleteResolverAccount(context.Context, *connect.Request[v1.DeleteResolverAccountRequest]) (*connect.Response[v1.DeleteResolverAccountResponse], error)
+	// VerifyAccount reports which servers of the cluster hold the account's current JWT
+	VerifyAccount(context.Context, *connect.Request[v1.VerifyAccountRequest]) (*connect.Response[v1.VerifyAccountResponse], error)
+	// GetClusterTopology lists the servers recorded for the cluster by the health check
+	GetClusterTopology(context.Context, *connect.Request[v1.GetClusterTopologyRequest]) (*connect.Response[v1.GetClusterTopologyResponse], error)
+	// ListClusterHealthChecks returns the recorded health checks of the cluster
+	ListClusterHealthChecks(context.Context, *connect.Request[v1.ListClusterHealthChecksRequest]) (*connect.Response[v1.ListClusterHealthChecksResponse], error)
+	// ListConnections lists the live client connections of an account or user via CONNZ
+	ListConnections(context.Context, *connect.Request[v1.ListConnectionsRequest]) (*connect.Response[v1.ListConnectionsResponse], error)
+	// DisconnectUser kicks every live connection of a user via $SYS.REQ.SERVER.<id>.KICK
+	DisconnectUser(context.Context, *connect.Request[v1.DisconnectUserRequest]) (*connect.Response[v1.DisconnectUserResponse], error)
+	// GetClusterCapacity reports JetStream capacity against the limits assigned to accounts
+	GetClusterCapacity(context.Context, *connect.Request[v1.GetClusterCapacityRequest]) (*connect.Response[v1.GetClusterCapacityResponse], error)
+	// ListAuthFailures lists the NATS authentication failures reported by the clusters
+	ListAuthFailures(context.Context, *connect.Request[v1.ListAuthFailuresRequest]) (*connect.Response[v1.ListAuthFailuresResponse], error)
+	// CreateLeafnodeProfile binds a user to a hub cluster for the leafnode remotes of edge servers
+	CreateLeafnodeProfile(context.Context, *connect.Request[v1.CreateLeafnodeProfileRequest]) (*connect.Response[v1.CreateLeafnodeProfileResponse], error)
+	// ListLeafnodeProfiles lists the leafnode profiles of a hub cluster
+	ListLeafnodeProfiles(context.Context, *connect.Request[v1.ListLeafnodeProfilesRequest]) (*connect.Response[v1.ListLeafnodeProfilesResponse], error)
+	// DeleteLeafnodeProfile deletes a leafnode profile, the user is kept
+	DeleteLeafnodeProfile(context.Context, *connect.Request[v1.DeleteLeafnodeProfileRequest]) (*connect.Response[v1.DeleteLeafnodeProfileResponse], error)
+	// GenerateLeafnodeConfig generates the leafnode remote block and the matching credentials
+	GenerateLeafnodeConfig(context.Context, *connect.Request[v1.GenerateLeafnodeConfigRequest]) (*connect.Response[v1.GenerateLeafnodeConfigResponse], error)
+	// SetClusterGateway makes a cluster join or leave a supercluster of the operator's clusters
+	SetClusterGateway(context.Context, *connect.Request[v1.SetClusterGatewayRequest]) (*connect.Response[v1.SetClusterGatewayResponse], error)
 }
 
 // NewClusterServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -352,6 +580,78 @@ func NewClusterServiceHandler(svc ClusterServiceHandler, opts ...connect.Handler
 		connect.WithSchema(clusterServiceMethods.ByName("DeleteResolverAccount")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clusterServiceVerifyAccountHandler := connect.NewUnaryHandler(
+		ClusterServiceVerifyAccountProcedure,
+		svc.VerifyAccount,
+		connect.WithSchema(clusterServiceMethods.ByName("VerifyAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceGetClusterTopologyHandler := connect.NewUnaryHandler(
+		ClusterServiceGetClusterTopologyProcedure,
+		svc.GetClusterTopology,
+		connect.WithSchema(clusterServiceMethods.ByName("GetClusterTopology")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceListClusterHealthChecksHandler := connect.NewUnaryHandler(
+		ClusterServiceListClusterHealthChecksProcedure,
+		svc.ListClusterHealthChecks,
+		connect.WithSchema(clusterServiceMethods.ByName("ListClusterHealthChecks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceListConnectionsHandler := connect.NewUnaryHandler(
+		ClusterServiceListConnectionsProcedure,
+		svc.ListConnections,
+		connect.WithSchema(clusterServiceMethods.ByName("ListConnections")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceDisconnectUserHandler := connect.NewUnaryHandler(
+		ClusterServiceDisconnectUserProcedure,
+		svc.DisconnectUser,
+		connect.WithSchema(clusterServiceMethods.ByName("DisconnectUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceGetClusterCapacityHandler := connect.NewUnaryHandler(
+		ClusterServiceGetClusterCapacityProcedure,
+		svc.GetClusterCapacity,
+		connect.WithSchema(clusterServiceMethods.ByName("GetClusterCapacity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceListAuthFailuresHandler := connect.NewUnaryHandler(
+		ClusterServiceListAuthFailuresProcedure,
+		svc.ListAuthFailures,
+		connect.WithSchema(clusterServiceMethods.ByName("ListAuthFailures")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceCreateLeafnodeProfileHandler := connect.NewUnaryHandler(
+		ClusterServiceCreateLeafnodeProfileProcedure,
+		svc.CreateLeafnodeProfile,
+		connect.WithSchema(clusterServiceMethods.ByName("CreateLeafnodeProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceListLeafnodeProfilesHandler := connect.NewUnaryHandler(
+		ClusterServiceListLeafnodeProfilesProcedure,
+		svc.ListLeafnodeProfiles,
+		connect.WithSchema(clusterServiceMethods.ByName("ListLeafnodeProfiles")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceDeleteLeafnodeProfileHandler := connect.NewUnaryHandler(
+		ClusterServiceDeleteLeafnodeProfileProcedure,
+		svc.DeleteLeafnodeProfile,
+		connect.WithSchema(clusterServiceMethods.ByName("DeleteLeafnodeProfile")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceGenerateLeafnodeConfigHandler := connect.NewUnaryHandler(
+		ClusterServiceGenerateLeafnodeConfigProcedure,
+		svc.GenerateLeafnodeConfig,
+		connect.WithSchema(clusterServiceMethods.ByName("GenerateLeafnodeConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clusterServiceSetClusterGatewayHandler := connect.NewUnaryHandler(
+		ClusterServiceSetClusterGatewayProcedure,
+		svc.SetClusterGateway,
+		connect.WithSchema(clusterServiceMethods.ByName("SetClusterGateway")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/nis.v1.ClusterService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ClusterServiceCreateClusterProcedure:
@@ -378,6 +678,30 @@ func NewClusterServiceHandler(svc ClusterServiceHandler, opts ...connect.Handler
 			clusterServiceListResolverAccountsHandler.ServeHTTP(w, r)
 		case ClusterServiceDeleteResolverAccountProcedure:
 			clusterServiceDeleteResolverAccountHandler.ServeHTTP(w, r)
+		case ClusterServiceVerifyAccountProcedure:
+			clusterServiceVerifyAccountHandler.ServeHTTP(w, r)
+		case ClusterServiceGetClusterTopologyProcedure:
+			clusterServiceGetClusterTopologyHandler.ServeHTTP(w, r)
+		case ClusterServiceListClusterHealthChecksProcedure:
+			clusterServiceListClusterHealthChecksHandler.ServeHTTP(w, r)
+		case ClusterServiceListConnectionsProcedure:
+			clusterServiceListConnectionsHandler.ServeHTTP(w, r)
+		case ClusterServiceDisconnectUserProcedure:
+			clusterServiceDisconnectUserHandler.ServeHTTP(w, r)
+		case ClusterServiceGetClusterCapacityProcedure:
+			clusterServiceGetClusterCapacityHandler.ServeHTTP(w, r)
+		case ClusterServiceListAuthFailuresProcedure:
+			clusterServiceListAuthFailuresHandler.ServeHTTP(w, r)
+		case ClusterServiceCreateLeafnodeProfileProcedure:
+			clusterServiceCreateLeafnodeProfileHandler.ServeHTTP(w, r)
+		case ClusterServiceListLeafnodeProfilesProcedure:
+			clusterServiceListLeafnodeProfilesHandler.ServeHTTP(w, r)
+		case ClusterServiceDeleteLeafnodeProfileProcedure:
+			clusterServiceDeleteLeafnodeProfileHandler.ServeHTTP(w, r)
+		case ClusterServiceGenerateLeafnodeConfigProcedure:
+			clusterServiceGenerateLeafnodeConfigHandler.ServeHTTP(w, r)
+		case ClusterServiceSetClusterGatewayProcedure:
+			clusterServiceSetClusterGatewayHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -434,3 +758,51 @@ func (UnimplementedClusterServiceHandler) ListResolverAccounts(context.Context,
 func (UnimplementedClusterServiceHandler) DeleteResolverAccount(context.Context, *connect.Request[v1.DeleteResolverAccountRequest]) (*connect.Response[v1.DeleteResolverAccountResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.DeleteResolverAccount is not implemented"))
 }
+
+func (UnimplementedClusterServiceHandler) VerifyAccount(context.Context, *connect.Request[v1.VerifyAccountRequest]) (*connect.Response[v1.VerifyAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.VerifyAccount is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) GetClusterTopology(context.Context, *connect.Request[v1.GetClusterTopologyRequest]) (*connect.Response[v1.GetClusterTopologyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.GetClusterTopology is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) ListClusterHealthChecks(context.Context, *connect.Request[v1.ListClusterHealthChecksRequest]) (*connect.Response[v1.ListClusterHealthChecksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.ListClusterHealthChecks is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) ListConnections(context.Context, *connect.Request[v1.ListConnectionsRequest]) (*connect.Response[v1.ListConnectionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.ListConnections is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) DisconnectUser(context.Context, *connect.Request[v1.DisconnectUserRequest]) (*connect.Response[v1.DisconnectUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.DisconnectUser is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) GetClusterCapacity(context.Context, *connect.Request[v1.GetClusterCapacityRequest]) (*connect.Response[v1.GetClusterCapacityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.GetClusterCapacity is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) ListAuthFailures(context.Context, *connect.Request[v1.ListAuthFailuresRequest]) (*connect.Response[v1.ListAuthFailuresResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.ListAuthFailures is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) CreateLeafnodeProfile(context.Context, *connect.Request[v1.CreateLeafnodeProfileRequest]) (*connect.Response[v1.CreateLeafnodeProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.CreateLeafnodeProfile is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) ListLeafnodeProfiles(context.Context, *connect.Request[v1.ListLeafnodeProfilesRequest]) (*connect.Response[v1.ListLeafnodeProfilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.ListLeafnodeProfiles is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) DeleteLeafnodeProfile(context.Context, *connect.Request[v1.DeleteLeafnodeProfileRequest]) (*connect.Response[v1.DeleteLeafnodeProfileResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.DeleteLeafnodeProfile is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) GenerateLeafnodeConfig(context.Context, *connect.Request[v1.GenerateLeafnodeConfigRequest]) (*connect.Response[v1.GenerateLeafnodeConfigResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.GenerateLeafnodeConfig is not implemented"))
+}
+
+func (UnimplementedClusterServiceHandler) SetClusterGateway(context.Context, *connect.Request[v1.SetClusterGatewayRequest]) (*connect.Response[v1.SetClusterGatewayResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ClusterService.SetClusterGateway is not implemented"))
+}
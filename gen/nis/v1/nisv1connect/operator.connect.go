@@ -57,6 +57,12 @@ const (
 	// OperatorServiceGenerateIncludeProcedure is the fully-qualified name of the OperatorService's
 	// GenerateInclude RPC.
 	OperatorServiceGenerateIncludeProcedure = "/nis.v1.OperatorService/GenerateInclude"
+	// OperatorServiceLockdownOperatorProcedure is the fully-qualified name of the OperatorService's
+	// LockdownOperator RPC.
+	OperatorServiceLockdownOperatorProcedure = "/nis.v1.OperatorService/LockdownOperator"
+	// OperatorServiceLiftOperatorLockdownProcedure is the fully-qualified name of the OperatorService's
+	// LiftOperatorLockdown RPC.
+	OperatorServiceLiftOperatorLockdownProcedure = "/nis.v1.OperatorService/LiftOperatorLockdown"
 )
 
 // OperatorServiceClient is a client for the nis.v1.OperatorService service.
@@ -70,6 +76,8 @@ type OperatorServiceClient interface {
 	DeleteOperator(context.Context, *connect.Request[v1.DeleteOperatorRequest]) (*connect.Response[v1.DeleteOperatorResponse], error)
 	// GenerateInclude generates NATS server configuration for the operator
 	GenerateInclude(context.Context, *connect.Request[v1.GenerateIncludeRequest]) (*connect.Response[v1.GenerateIncludeResponse], error)
+	LockdownOperator(context.Context, *connect.Request[v1.LockdownOperatorRequest]) (*connect.Response[v1.LockdownOperatorResponse], error)
+	LiftOperatorLockdown(context.Context, *connect.Request[v1.LiftOperatorLockdownRequest]) (*connect.Response[v1.LiftOperatorLockdownResponse], error)
 }
 
 // NewOperatorServiceClient constructs a client for the nis.v1.OperatorService service. By default,
@@ -131,19 +139,33 @@ func NewOperatorServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			connect.WithSchema(operatorServiceMethods.ByName("GenerateInclude")),
 			connect.WithClientOptions(opts...),
 		),
+		lockdownOperator: connect.NewClient[v1.LockdownOperatorRequest, v1.LockdownOperatorResponse](
+			httpClient,
+			baseURL+OperatorServiceLockdownOperatorProcedure,
+			connect.WithSchema(operatorServiceMethods.ByName("LockdownOperator")),
+			connect.WithClientOptions(opts...),
+		),
+		liftOperatorLockdown: connect.NewClient[v1.LiftOperatorLockdownRequest, v1.LiftOperatorLockdownResponse](
+			httpClient,
+			baseURL+OperatorServiceLiftOperatorLockdownProcedure,
+			connect.WithSchema(operatorServiceMethods.ByName("LiftOperatorLockdown")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // operatorServiceClient implements OperatorServiceClient.
 type operatorServiceClient struct {
-	createOperator    *connect.Client[v1.CreateOperatorRequest, v1.CreateOperatorResponse]
-	getOperator       *connect.Client[v1.GetOperatorRequest, v1.GetOperatorResponse]
-	getOperatorByName *connect.Client[v1.GetOperatorByNameRequest, v1.GetOperatorByNameResponse]
-	listOperators     *connect.Client[v1.ListOperatorsRequest, v1.ListOperatorsResponse]
-	updateOperator    *connect.Client[v1.UpdateOperatorRequest, v1.UpdateOperatorResponse]
-	setSystemAccount  *connect.Client[v1.SetSystemAccountRequest, v1.SetSystemAccountResponse]
-	deleteOperator    *connect.Client[v1.DeleteOperatorRequest, v1.DeleteOperatorResponse]
-	generateInclude   *connect.Client[v1.GenerateIncludeRequest, v1.GenerateIncludeResponse]
+	createOperator       *connect.Client[v1.CreateOperatorRequest, v1.CreateOperatorResponse]
+	getOperator          *connect.Client[v1.GetOperatorRequest, v1.GetOperatorResponse]
+	getOperatorByName    *connect.Client[v1.GetOperatorByNameRequest, v1.GetOperatorByNameResponse]
+	listOperators        *connect.Client[v1.ListOperatorsRequest, v1.ListOperatorsResponse]
+	updateOperator       *connect.Client[v1.UpdateOperatorRequest, v1.UpdateOperatorResponse]
+	setSystemAccount     *connect.Client[v1.SetSystemAccountRequest, v1.SetSystemAccountResponse]
+	deleteOperator       *connect.Client[v1.DeleteOperatorRequest, v1.DeleteOperatorResponse]
+	generateInclude      *connect.Client[v1.GenerateIncludeRequest, v1.GenerateIncludeResponse]
+	lockdownOperator     *connect.Client[v1.LockdownOperatorRequest, v1.LockdownOperatorResponse]
+	liftOperatorLockdown *connect.Client[v1.LiftOperatorLockdownRequest, v1.LiftOperatorLockdownResponse]
 }
 
 // CreateOperator calls nis.v1.OperatorService.CreateOperator.
@@ -186,6 +208,16 @@ func (c *operatorServiceClient) GenerateInclude(ctx context.Context, req *connec
 	return c.generateInclude.CallUnary(ctx, req)
 }
 
+// LockdownOperator calls nis.v1.OperatorService.LockdownOperator.
+func (c *operatorServiceClient) LockdownOperator(ctx context.Context, req *connect.Request[v1.LockdownOperatorRequest]) (*connect.Response[v1.LockdownOperatorResponse], error) {
+	return c.lockdownOperator.CallUnary(ctx, req)
+}
+
+// LiftOperatorLockdown calls nis.v1.OperatorService.LiftOperatorLockdown.
+func (c *operatorServiceClient) LiftOperatorLockdown(ctx context.Context, req *connect.Request[v1.LiftOperatorLockdownRequest]) (*connect.Response[v1.LiftOperatorLockdownResponse], error) {
+	return c.liftOperatorLockdown.CallUnary(ctx, req)
+}
+
 // OperatorServiceHandler is an implementation of the nis.v1.OperatorService service.
 type OperatorServiceHandler interface {
 	CreateOperator(context.Context, *connect.Request[v1.CreateOperatorRequest]) (*connect.Response[v1.CreateOperatorResponse], error)
@@ -197,6 +229,8 @@ type OperatorServiceHandler interface {
 	DeleteOperator(context.Context, *connect.Request[v1.DeleteOperatorRequest]) (*connect.Response[v1.DeleteOperatorResponse], error)
 	// GenerateInclude generates NATS server configuration for the operator
 	GenerateInclude(context.Context, *connect.Request[v1.GenerateIncludeRequest]) (*connect.Response[v1.GenerateIncludeResponse], error)
+	LockdownOperator(context.Context, *connect.Request[v1.LockdownOperatorRequest]) (*connect.Response[v1.LockdownOperatorResponse], error)
+	LiftOperatorLockdown(context.Context, *connect.Request[v1.LiftOperatorLockdownRequest]) (*connect.Response[v1.LiftOperatorLockdownResponse], error)
 }
 
 // NewOperatorServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -254,6 +288,18 @@ func NewOperatorServiceHandler(svc OperatorServiceHandler, opts ...connect.Handl
 		connect.WithSchema(operatorServiceMethods.ByName("GenerateInclude")),
 		connect.WithHandlerOptions(opts...),
 	)
+	operatorServiceLockdownOperatorHandler := connect.NewUnaryHandler(
+		OperatorServiceLockdownOperatorProcedure,
+		svc.LockdownOperator,
+		connect.WithSchema(operatorServiceMethods.ByName("LockdownOperator")),
+		connect.WithHandlerOptions(opts...),
+	)
+	operatorServiceLiftOperatorLockdownHandler := connect.NewUnaryHandler(
+		OperatorServiceLiftOperatorLockdownProcedure,
+		svc.LiftOperatorLockdown,
+		connect.WithSchema(operatorServiceMethods.ByName("LiftOperatorLockdown")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/nis.v1.OperatorService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case OperatorServiceCreateOperatorProcedure:
@@ -272,6 +318,10 @@ func NewOperatorServiceHandler(svc OperatorServiceHandler, opts ...connect.Handl
 			operatorServiceDeleteOperatorHandler.ServeHTTP(w, r)
 		case OperatorServiceGenerateIncludeProcedure:
 			operatorServiceGenerateIncludeHandler.ServeHTTP(w, r)
+		case OperatorServiceLockdownOperatorProcedure:
+			operatorServiceLockdownOperatorHandler.ServeHTTP(w, r)
+		case OperatorServiceLiftOperatorLockdownProcedure:
+			operatorServiceLiftOperatorLockdownHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -312,3 +362,11 @@ func (UnimplementedOperatorServiceHandler) DeleteOperator(context.Context, *conn
 func (UnimplementedOperatorServiceHandler) GenerateInclude(context.Context, *connect.Request[v1.GenerateIncludeRequest]) (*connect.Response[v1.GenerateIncludeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.OperatorService.GenerateInclude is not implemented"))
 }
+
+func (UnimplementedOperatorServiceHandler) LockdownOperator(context.Context, *connect.Request[v1.LockdownOperatorRequest]) (*connect.Response[v1.LockdownOperatorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.OperatorService.LockdownOperator is not implemented"))
+}
+
+func (UnimplementedOperatorServiceHandler) LiftOperatorLockdown(context.Context, *connect.Request[v1.LiftOperatorLockdownRequest]) (*connect.Response[v1.LiftOperatorLockdownResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.OperatorService.LiftOperatorLockdown is not implemented"))
+}
@@ -54,6 +54,9 @@ const (
 	// ScopedSigningKeyServiceDeleteScopedSigningKeyProcedure is the fully-qualified name of the
 	// ScopedSigningKeyService's DeleteScopedSigningKey RPC.
 	ScopedSigningKeyServiceDeleteScopedSigningKeyProcedure = "/nis.v1.ScopedSigningKeyService/DeleteScopedSigningKey"
+	// ScopedSigningKeyServiceRotateScopedSigningKeyProcedure is the fully-qualified name of the
+	// ScopedSigningKeyService's RotateScopedSigningKey RPC.
+	ScopedSigningKeyServiceRotateScopedSigningKeyProcedure = "/nis.v1.ScopedSigningKeyService/RotateScopedSigningKey"
 )
 
 // ScopedSigningKeyServiceClient is a client for the nis.v1.ScopedSigningKeyService service.
@@ -65,6 +68,8 @@ type ScopedSigningKeyServiceClient interface {
 	UpdateScopedSigningKey(context.Context, *connect.Request[v1.UpdateScopedSigningKeyRequest]) (*connect.Response[v1.UpdateScopedSigningKeyResponse], error)
 	UpdatePermissions(context.Context, *connect.Request[v1.UpdatePermissionsRequest]) (*connect.Response[v1.UpdatePermissionsResponse], error)
 	DeleteScopedSigningKey(context.Context, *connect.Request[v1.DeleteScopedSigningKeyRequest]) (*connect.Response[v1.DeleteScopedSigningKeyResponse], error)
+	// RotateScopedSigningKey streams the progress of the rotation, then its result
+	RotateScopedSigningKey(context.Context, *connect.Request[v1.RotateScopedSigningKeyRequest]) (*connect.ServerStreamForClient[v1.RotateScopedSigningKeyResponse], error)
 }
 
 // NewScopedSigningKeyServiceClient constructs a client for the nis.v1.ScopedSigningKeyService
@@ -120,6 +125,12 @@ func NewScopedSigningKeyServiceClient(httpClient connect.HTTPClient, baseURL str
 			connect.WithSchema(scopedSigningKeyServiceMethods.ByName("DeleteScopedSigningKey")),
 			connect.WithClientOptions(opts...),
 		),
+		rotateScopedSigningKey: connect.NewClient[v1.RotateScopedSigningKeyRequest, v1.RotateScopedSigningKeyResponse](
+			httpClient,
+			baseURL+ScopedSigningKeyServiceRotateScopedSigningKeyProcedure,
+			connect.WithSchema(scopedSigningKeyServiceMethods.ByName("RotateScopedSigningKey")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -132,6 +143,7 @@ type scopedSigningKeyServiceClient struct {
 	updateScopedSigningKey    *connect.Client[v1.UpdateScopedSigningKeyRequest, v1.UpdateScopedSigningKeyResponse]
 	updatePermissions         *connect.Client[v1.UpdatePermissionsRequest, v1.UpdatePermissionsResponse]
 	deleteScopedSigningKey    *connect.Client[v1.DeleteScopedSigningKeyRequest, v1.DeleteScopedSigningKeyResponse]
+	rotateScopedSigningKey    *connect.Client[v1.RotateScopedSigningKeyRequest, v1.RotateScopedSigningKeyResponse]
 }
 
 // CreateScopedSigningKey calls nis.v1.ScopedSigningKeyService.CreateScopedSigningKey.
@@ -169,6 +181,11 @@ func (c *scopedSigningKeyServiceClient) DeleteScopedSigningKey(ctx context.Conte
 	return c.deleteScopedSigningKey.CallUnary(ctx, req)
 }
 
+// RotateScopedSigningKey calls nis.v1.ScopedSigningKeyService.RotateScopedSigningKey.
+func (c *scopedSigningKeyServiceClient) RotateScopedSigningKey(ctx context.Context, req *connect.Request[v1.RotateScopedSigningKeyRequest]) (*connect.ServerStreamForClient[v1.RotateScopedSigningKeyResponse], error) {
+	return c.rotateScopedSigningKey.CallServerStream(ctx, req)
+}
+
 // ScopedSigningKeyServiceHandler is an implementation of the nis.v1.ScopedSigningKeyService
 // service.
 type ScopedSigningKeyServiceHandler interface {
@@ -179,6 +196,8 @@ type ScopedSigningKeyServiceHandler interface {
 	UpdateScopedSigningKey(context.Context, *connect.Request[v1.UpdateScopedSigningKeyRequest]) (*connect.Response[v1.UpdateScopedSigningKeyResponse], error)
 	UpdatePermissions(context.Context, *connect.Request[v1.UpdatePermissionsRequest]) (*connect.Response[v1.UpdatePermissionsResponse], error)
 	DeleteScopedSigningKey(context.Context, *connect.Request[v1.DeleteScopedSigningKeyRequest]) (*connect.Response[v1.DeleteScopedSigningKeyResponse], error)
+	// RotateScopedSigningKey streams the progress of the rotation, then its result
+	RotateScopedSigningKey(context.Context, *connect.Request[v1.RotateScopedSigningKeyRequest], *connect.ServerStream[v1.RotateScopedSigningKeyResponse]) error
 }
 
 // NewScopedSigningKeyServiceHandler builds an HTTP handler from the service implementation. It
@@ -230,6 +249,12 @@ func NewScopedSigningKeyServiceHandler(svc ScopedSigningKeyServiceHandler, opts
 		connect.WithSchema(scopedSigningKeyServiceMethods.ByName("DeleteScopedSigningKey")),
 		connect.WithHandlerOptions(opts...),
 	)
+	scopedSigningKeyServiceRotateScopedSigningKeyHandler := connect.NewServerStreamHandler(
+		ScopedSigningKeyServiceRotateScopedSigningKeyProcedure,
+		svc.RotateScopedSigningKey,
+		connect.WithSchema(scopedSigningKeyServiceMethods.ByName("RotateScopedSigningKey")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/nis.v1.ScopedSigningKeyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ScopedSigningKeyServiceCreateScopedSigningKeyProcedure:
@@ -246,6 +271,8 @@ func NewScopedSigningKeyServiceHandler(svc ScopedSigningKeyServiceHandler, opts
 			scopedSigningKeyServiceUpdatePermissionsHandler.ServeHTTP(w, r)
 		case ScopedSigningKeyServiceDeleteScopedSigningKeyProcedure:
 			scopedSigningKeyServiceDeleteScopedSigningKeyHandler.ServeHTTP(w, r)
+		case ScopedSigningKeyServiceRotateScopedSigningKeyProcedure:
+			scopedSigningKeyServiceRotateScopedSigningKeyHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -282,3 +309,7 @@ func (UnimplementedScopedSigningKeyServiceHandler) UpdatePermissions(context.Con
 func (UnimplementedScopedSigningKeyServiceHandler) DeleteScopedSigningKey(context.Context, *connect.Request[v1.DeleteScopedSigningKeyRequest]) (*connect.Response[v1.DeleteScopedSigningKeyResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ScopedSigningKeyService.DeleteScopedSigningKey is not implemented"))
 }
+
+func (UnimplementedScopedSigningKeyServiceHandler) RotateScopedSigningKey(context.Context, *connect.Request[v1.RotateScopedSigningKeyRequest], *connect.ServerStream[v1.RotateScopedSigningKeyResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.ScopedSigningKeyService.RotateScopedSigningKey is not implemented"))
+}
@@ -49,6 +49,24 @@ const (
 	// UserServiceGetUserCredentialsProcedure is the fully-qualified name of the UserService's
 	// GetUserCredentials RPC.
 	UserServiceGetUserCredentialsProcedure = "/nis.v1.UserService/GetUserCredentials"
+	// UserServiceRotateUserCredentialsProcedure is the fully-qualified name of the UserService's
+	// RotateUserCredentials RPC.
+	UserServiceRotateUserCredentialsProcedure = "/nis.v1.UserService/RotateUserCredentials"
+	// UserServiceListUserKeysProcedure is the fully-qualified name of the UserService's ListUserKeys
+	// RPC.
+	UserServiceListUserKeysProcedure = "/nis.v1.UserService/ListUserKeys"
+	// UserServiceCreateRotationPolicyProcedure is the fully-qualified name of the UserService's
+	// CreateRotationPolicy RPC.
+	UserServiceCreateRotationPolicyProcedure = "/nis.v1.UserService/CreateRotationPolicy"
+	// UserServiceListRotationPoliciesProcedure is the fully-qualified name of the UserService's
+	// ListRotationPolicies RPC.
+	UserServiceListRotationPoliciesProcedure = "/nis.v1.UserService/ListRotationPolicies"
+	// UserServiceDeleteRotationPolicyProcedure is the fully-qualified name of the UserService's
+	// DeleteRotationPolicy RPC.
+	UserServiceDeleteRotationPolicyProcedure = "/nis.v1.UserService/DeleteRotationPolicy"
+	// UserServicePlanRotationsProcedure is the fully-qualified name of the UserService's PlanRotations
+	// RPC.
+	UserServicePlanRotationsProcedure = "/nis.v1.UserService/PlanRotations"
 )
 
 // UserServiceClient is a client for the nis.v1.UserService service.
@@ -60,6 +78,12 @@ type UserServiceClient interface {
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
 	GetUserCredentials(context.Context, *connect.Request[v1.GetUserCredentialsRequest]) (*connect.Response[v1.GetUserCredentialsResponse], error)
+	RotateUserCredentials(context.Context, *connect.Request[v1.RotateUserCredentialsRequest]) (*connect.Response[v1.RotateUserCredentialsResponse], error)
+	ListUserKeys(context.Context, *connect.Request[v1.ListUserKeysRequest]) (*connect.Response[v1.ListUserKeysResponse], error)
+	CreateRotationPolicy(context.Context, *connect.Request[v1.CreateRotationPolicyRequest]) (*connect.Response[v1.CreateRotationPolicyResponse], error)
+	ListRotationPolicies(context.Context, *connect.Request[v1.ListRotationPoliciesRequest]) (*connect.Response[v1.ListRotationPoliciesResponse], error)
+	DeleteRotationPolicy(context.Context, *connect.Request[v1.DeleteRotationPolicyRequest]) (*connect.Response[v1.DeleteRotationPolicyResponse], error)
+	PlanRotations(context.Context, *connect.Request[v1.PlanRotationsRequest]) (*connect.Response[v1.PlanRotationsResponse], error)
 }
 
 // NewUserServiceClient constructs a client for the nis.v1.UserService service. By default, it uses
@@ -115,18 +139,60 @@ func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(userServiceMethods.ByName("GetUserCredentials")),
 			connect.WithClientOptions(opts...),
 		),
+		rotateUserCredentials: connect.NewClient[v1.RotateUserCredentialsRequest, v1.RotateUserCredentialsResponse](
+			httpClient,
+			baseURL+UserServiceRotateUserCredentialsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("RotateUserCredentials")),
+			connect.WithClientOptions(opts...),
+		),
+		listUserKeys: connect.NewClient[v1.ListUserKeysRequest, v1.ListUserKeysResponse](
+			httpClient,
+			baseURL+UserServiceListUserKeysProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUserKeys")),
+			connect.WithClientOptions(opts...),
+		),
+		createRotationPolicy: connect.NewClient[v1.CreateRotationPolicyRequest, v1.CreateRotationPolicyResponse](
+			httpClient,
+			baseURL+UserServiceCreateRotationPolicyProcedure,
+			connect.WithSchema(userServiceMethods.ByName("CreateRotationPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		listRotationPolicies: connect.NewClient[v1.ListRotationPoliciesRequest, v1.ListRotationPoliciesResponse](
+			httpClient,
+			baseURL+UserServiceListRotationPoliciesProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListRotationPolicies")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRotationPolicy: connect.NewClient[v1.DeleteRotationPolicyRequest, v1.DeleteRotationPolicyResponse](
+			httpClient,
+			baseURL+UserServiceDeleteRotationPolicyProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeleteRotationPolicy")),
+			connect.WithClientOptions(opts...),
+		),
+		planRotations: connect.NewClient[v1.PlanRotationsRequest, v1.PlanRotationsResponse](
+			httpClient,
+			baseURL+UserServicePlanRotationsProcedure,
+			connect.WithSchema(userServiceMethods.ByName("PlanRotations")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // userServiceClient implements UserServiceClient.
 type userServiceClient struct {
-	createUser         *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
-	getUser            *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
-	getUserByName      *connect.Client[v1.GetUserByNameRequest, v1.GetUserByNameResponse]
-	listUsers          *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
-	updateUser         *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
-	deleteUser         *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
-	getUserCredentials *connect.Client[v1.GetUserCredentialsRequest, v1.GetUserCredentialsResponse]
+	createUser            *connect.Client[v1.CreateUserRequest, v1.CreateUserResponse]
+	getUser               *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	getUserByName         *connect.Client[v1.GetUserByNameRequest, v1.GetUserByNameResponse]
+	listUsers             *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	updateUser            *connect.Client[v1.UpdateUserRequest, v1.UpdateUserResponse]
+	deleteUser            *connect.Client[v1.DeleteUserRequest, v1.DeleteUserResponse]
+	getUserCredentials    *connect.Client[v1.GetUserCredentialsRequest, v1.GetUserCredentialsResponse]
+	rotateUserCredentials *connect.Client[v1.RotateUserCredentialsRequest, v1.RotateUserCredentialsResponse]
+	listUserKeys          *connect.Client[v1.ListUserKeysRequest, v1.ListUserKeysResponse]
+	createRotationPolicy  *connect.Client[v1.CreateRotationPolicyRequest, v1.CreateRotationPolicyResponse]
+	listRotationPolicies  *connect.Client[v1.ListRotationPoliciesRequest, v1.ListRotationPoliciesResponse]
+	deleteRotationPolicy  *connect.Client[v1.DeleteRotationPolicyRequest, v1.DeleteRotationPolicyResponse]
+	planRotations         *connect.Client[v1.PlanRotationsRequest, v1.PlanRotationsResponse]
 }
 
 // CreateUser calls nis.v1.UserService.CreateUser.
@@ -164,6 +230,36 @@ func (c *userServiceClient) GetUserCredentials(ctx context.Context, req *connect
 	return c.getUserCredentials.CallUnary(ctx, req)
 }
 
+// RotateUserCredentials calls nis.v1.UserService.RotateUserCredentials.
+func (c *userServiceClient) RotateUserCredentials(ctx context.Context, req *connect.Request[v1.RotateUserCredentialsRequest]) (*connect.Response[v1.RotateUserCredentialsResponse], error) {
+	return c.rotateUserCredentials.CallUnary(ctx, req)
+}
+
+// ListUserKeys calls nis.v1.UserService.ListUserKeys.
+func (c *userServiceClient) ListUserKeys(ctx context.Context, req *connect.Request[v1.ListUserKeysRequest]) (*connect.Response[v1.ListUserKeysResponse], error) {
+	return c.listUserKeys.CallUnary(ctx, req)
+}
+
+// CreateRotationPolicy calls nis.v1.UserService.CreateRotationPolicy.
+func (c *userServiceClient) CreateRotationPolicy(ctx context.Context, req *connect.Request[v1.CreateRotationPolicyRequest]) (*connect.Response[v1.CreateRotationPolicyResponse], error) {
+	return c.createRotationPolicy.CallUnary(ctx, req)
+}
+
+// ListRotationPolicies calls nis.v1.UserService.ListRotationPolicies.
+func (c *userServiceClient) ListRotationPolicies(ctx context.Context, req *connect.Request[v1.ListRotationPoliciesRequest]) (*connect.Response[v1.ListRotationPoliciesResponse], error) {
+	return c.listRotationPolicies.CallUnary(ctx, req)
+}
+
+// DeleteRotationPolicy calls nis.v1.UserService.DeleteRotationPolicy.
+func (c *userServiceClient) DeleteRotationPolicy(ctx context.Context, req *connect.Request[v1.DeleteRotationPolicyRequest]) (*connect.Response[v1.DeleteRotationPolicyResponse], error) {
+	return c.deleteRotationPolicy.CallUnary(ctx, req)
+}
+
+// PlanRotations calls nis.v1.UserService.PlanRotations.
+func (c *userServiceClient) PlanRotations(ctx context.Context, req *connect.Request[v1.PlanRotationsRequest]) (*connect.Response[v1.PlanRotationsResponse], error) {
+	return c.planRotations.CallUnary(ctx, req)
+}
+
 // UserServiceHandler is an implementation of the nis.v1.UserService service.
 type UserServiceHandler interface {
 	CreateUser(context.Context, *connect.Request[v1.CreateUserRequest]) (*connect.Response[v1.CreateUserResponse], error)
@@ -173,6 +269,12 @@ type UserServiceHandler interface {
 	UpdateUser(context.Context, *connect.Request[v1.UpdateUserRequest]) (*connect.Response[v1.UpdateUserResponse], error)
 	DeleteUser(context.Context, *connect.Request[v1.DeleteUserRequest]) (*connect.Response[v1.DeleteUserResponse], error)
 	GetUserCredentials(context.Context, *connect.Request[v1.GetUserCredentialsRequest]) (*connect.Response[v1.GetUserCredentialsResponse], error)
+	RotateUserCredentials(context.Context, *connect.Request[v1.RotateUserCredentialsRequest]) (*connect.Response[v1.RotateUserCredentialsResponse], error)
+	ListUserKeys(context.Context, *connect.Request[v1.ListUserKeysRequest]) (*connect.Response[v1.ListUserKeysResponse], error)
+	CreateRotationPolicy(context.Context, *connect.Request[v1.CreateRotationPolicyRequest]) (*connect.Response[v1.CreateRotationPolicyResponse], error)
+	ListRotationPolicies(context.Context, *connect.Request[v1.ListRotationPoliciesRequest]) (*connect.Response[v1.ListRotationPoliciesResponse], error)
+	DeleteRotationPolicy(context.Context, *connect.Request[v1.DeleteRotationPolicyRequest]) (*connect.Response[v1.DeleteRotationPolicyResponse], error)
+	PlanRotations(context.Context, *connect.Request[v1.PlanRotationsRequest]) (*connect.Response[v1.PlanRotationsResponse], error)
 }
 
 // NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -224,6 +326,42 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(userServiceMethods.ByName("GetUserCredentials")),
 		connect.WithHandlerOptions(opts...),
 	)
+	userServiceRotateUserCredentialsHandler := connect.NewUnaryHandler(
+		UserServiceRotateUserCredentialsProcedure,
+		svc.RotateUserCredentials,
+		connect.WithSchema(userServiceMethods.ByName("RotateUserCredentials")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListUserKeysHandler := connect.NewUnaryHandler(
+		UserServiceListUserKeysProcedure,
+		svc.ListUserKeys,
+		connect.WithSchema(userServiceMethods.ByName("ListUserKeys")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceCreateRotationPolicyHandler := connect.NewUnaryHandler(
+		UserServiceCreateRotationPolicyProcedure,
+		svc.CreateRotationPolicy,
+		connect.WithSchema(userServiceMethods.ByName("CreateRotationPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListRotationPoliciesHandler := connect.NewUnaryHandler(
+		UserServiceListRotationPoliciesProcedure,
+		svc.ListRotationPolicies,
+		connect.WithSchema(userServiceMethods.ByName("ListRotationPolicies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteRotationPolicyHandler := connect.NewUnaryHandler(
+		UserServiceDeleteRotationPolicyProcedure,
+		svc.DeleteRotationPolicy,
+		connect.WithSchema(userServiceMethods.ByName("DeleteRotationPolicy")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServicePlanRotationsHandler := connect.NewUnaryHandler(
+		UserServicePlanRotationsProcedure,
+		svc.PlanRotations,
+		connect.WithSchema(userServiceMethods.ByName("PlanRotations")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/nis.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case UserServiceCreateUserProcedure:
@@ -240,6 +378,18 @@ func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption
 			userServiceDeleteUserHandler.ServeHTTP(w, r)
 		case UserServiceGetUserCredentialsProcedure:
 			userServiceGetUserCredentialsHandler.ServeHTTP(w, r)
+		case UserServiceRotateUserCredentialsProcedure:
+			userServiceRotateUserCredentialsHandler.ServeHTTP(w, r)
+		case UserServiceListUserKeysProcedure:
+			userServiceListUserKeysHandler.ServeHTTP(w, r)
+		case UserServiceCreateRotationPolicyProcedure:
+			userServiceCreateRotationPolicyHandler.ServeHTTP(w, r)
+		case UserServiceListRotationPoliciesProcedure:
+			userServiceListRotationPoliciesHandler.ServeHTTP(w, r)
+		case UserServiceDeleteRotationPolicyProcedure:
+			userServiceDeleteRotationPolicyHandler.ServeHTTP(w, r)
+		case UserServicePlanRotationsProcedure:
+			userServicePlanRotationsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -276,3 +426,27 @@ func (UnimplementedUserServiceHandler) DeleteUser(context.Context, *connect.Requ
 func (UnimplementedUserServiceHandler) GetUserCredentials(context.Context, *connect.Request[v1.GetUserCredentialsRequest]) (*connect.Response[v1.GetUserCredentialsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.UserService.GetUserCredentials is not implemented"))
 }
+
+func (UnimplementedUserServiceHandler) RotateUserCredentials(context.Context, *connect.Request[v1.RotateUserCredentialsRequest]) (*connect.Response[v1.RotateUserCredentialsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.UserService.RotateUserCredentials is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListUserKeys(context.Context, *connect.Request[v1.ListUserKeysRequest]) (*connect.Response[v1.ListUserKeysResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.UserService.ListUserKeys is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) CreateRotationPolicy(context.Context, *connect.Request[v1.CreateRotationPolicyRequest]) (*connect.Response[v1.CreateRotationPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.UserService.CreateRotationPolicy is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) ListRotationPolicies(context.Context, *connect.Request[v1.ListRotationPoliciesRequest]) (*connect.Response[v1.ListRotationPoliciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.UserService.ListRotationPolicies is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeleteRotationPolicy(context.Context, *connect.Request[v1.DeleteRotationPolicyRequest]) (*connect.Response[v1.DeleteRotationPolicyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.UserService.DeleteRotationPolicy is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) PlanRotations(context.Context, *connect.Request[v1.PlanRotationsRequest]) (*connect.Response[v1.PlanRotationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nis.v1.UserService.PlanRotations is not implemented"))
+}
@@ -33,8 +33,16 @@ type Operator struct {
 	SystemAccountPubKey string                 `protobuf:"bytes,6,opt,name=system_account_pub_key,json=systemAccountPubKey,proto3" json:"system_account_pub_key,omitempty"`
 	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// Last emergency lockdown, kept once lifted
+	LockedDownAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=locked_down_at,json=lockedDownAt,proto3" json:"locked_down_at,omitempty"`
+	// API user who locked the operator down
+	LockedDownBy   string `protobuf:"bytes,10,opt,name=locked_down_by,json=lockedDownBy,proto3" json:"locked_down_by,omitempty"`
+	LockdownReason string `protobuf:"bytes,11,opt,name=lockdown_reason,json=lockdownReason,proto3" json:"lockdown_reason,omitempty"`
+	// Set once the lockdown is lifted
+	LockdownLiftedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=lockdown_lifted_at,json=lockdownLiftedAt,proto3" json:"lockdown_lifted_at,omitempty"`
+	LockdownLiftedBy string                 `protobuf:"bytes,13,opt,name=lockdown_lifted_by,json=lockdownLiftedBy,proto3" json:"lockdown_lifted_by,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Operator) Reset() {
@@ -123,6 +131,41 @@ func (x *Operator) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Operator) GetLockedDownAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockedDownAt
+	}
+	return nil
+}
+
+func (x *Operator) GetLockedDownBy() string {
+	if x != nil {
+		return x.LockedDownBy
+	}
+	return ""
+}
+
+func (x *Operator) GetLockdownReason() string {
+	if x != nil {
+		return x.LockdownReason
+	}
+	return ""
+}
+
+func (x *Operator) GetLockdownLiftedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LockdownLiftedAt
+	}
+	return nil
+}
+
+func (x *Operator) GetLockdownLiftedBy() string {
+	if x != nil {
+		return x.LockdownLiftedBy
+	}
+	return ""
+}
+
 // CreateOperatorRequest is the request to create a new operator
 type CreateOperatorRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
@@ -875,11 +918,252 @@ func (x *GenerateIncludeResponse) GetConfig() string {
 	return ""
 }
 
+// LockdownOperatorRequest is the request to lock an operator down
+type LockdownOperatorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockdownOperatorRequest) Reset() {
+	*x = LockdownOperatorRequest{}
+	mi := &file_nis_v1_operator_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockdownOperatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockdownOperatorRequest) ProtoMessage() {}
+
+func (x *LockdownOperatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_operator_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockdownOperatorRequest.ProtoReflect.Descriptor instead.
+func (*LockdownOperatorRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_operator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LockdownOperatorRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *LockdownOperatorRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// LockdownOperatorResponse is the response from locking an operator down
+type LockdownOperatorResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Operator *Operator              `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Accounts whose users were revoked
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// Clusters of the operator synced with the re-signed accounts
+	Pushed        []*AccountPush `protobuf:"bytes,3,rep,name=pushed,proto3" json:"pushed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockdownOperatorResponse) Reset() {
+	*x = LockdownOperatorResponse{}
+	mi := &file_nis_v1_operator_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockdownOperatorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockdownOperatorResponse) ProtoMessage() {}
+
+func (x *LockdownOperatorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_operator_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockdownOperatorResponse.ProtoReflect.Descriptor instead.
+func (*LockdownOperatorResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_operator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LockdownOperatorResponse) GetOperator() *Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+func (x *LockdownOperatorResponse) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *LockdownOperatorResponse) GetPushed() []*AccountPush {
+	if x != nil {
+		return x.Pushed
+	}
+	return nil
+}
+
+// LiftOperatorLockdownRequest is the request to lift the lockdown of an operator
+type LiftOperatorLockdownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiftOperatorLockdownRequest) Reset() {
+	*x = LiftOperatorLockdownRequest{}
+	mi := &file_nis_v1_operator_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiftOperatorLockdownRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiftOperatorLockdownRequest) ProtoMessage() {}
+
+func (x *LiftOperatorLockdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_operator_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiftOperatorLockdownRequest.ProtoReflect.Descriptor instead.
+func (*LiftOperatorLockdownRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_operator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *LiftOperatorLockdownRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+// LiftOperatorLockdownResponse is the response from lifting the lockdown of an operator
+type LiftOperatorLockdownResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Operator *Operator              `protobuf:"bytes,1,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Accounts whose users were given a new JWT
+	Accounts      []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	ReissuedUsers int32    `protobuf:"varint,3,opt,name=reissued_users,json=reissuedUsers,proto3" json:"reissued_users,omitempty"`
+	// Suspended accounts whose users were left revoked
+	Skipped []string `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	// Users left revoked by a revocation of their account
+	RevokedUsers  int32 `protobuf:"varint,5,opt,name=revoked_users,json=revokedUsers,proto3" json:"revoked_users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LiftOperatorLockdownResponse) Reset() {
+	*x = LiftOperatorLockdownResponse{}
+	mi := &file_nis_v1_operator_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LiftOperatorLockdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiftOperatorLockdownResponse) ProtoMessage() {}
+
+func (x *LiftOperatorLockdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_operator_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiftOperatorLockdownResponse.ProtoReflect.Descriptor instead.
+func (*LiftOperatorLockdownResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_operator_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LiftOperatorLockdownResponse) GetOperator() *Operator {
+	if x != nil {
+		return x.Operator
+	}
+	return nil
+}
+
+func (x *LiftOperatorLockdownResponse) GetAccounts() []string {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *LiftOperatorLockdownResponse) GetReissuedUsers() int32 {
+	if x != nil {
+		return x.ReissuedUsers
+	}
+	return 0
+}
+
+func (x *LiftOperatorLockdownResponse) GetSkipped() []string {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *LiftOperatorLockdownResponse) GetRevokedUsers() int32 {
+	if x != nil {
+		return x.RevokedUsers
+	}
+	return 0
+}
+
 var File_nis_v1_operator_proto protoreflect.FileDescriptor
 
 const file_nis_v1_operator_proto_rawDesc = "" +
 	"\n" +
-	"\x15nis/v1/operator.proto\x12\x06nis.v1\x1a\x13nis/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xac\x02\n" +
+	"\x15nis/v1/operator.proto\x12\x06nis.v1\x1a\x13nis/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb5\x04\n" +
 	"\bOperator\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -891,7 +1175,13 @@ const file_nis_v1_operator_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x82\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12@\n" +
+	"\x0elocked_down_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\flockedDownAt\x12$\n" +
+	"\x0elocked_down_by\x18\n" +
+	" \x01(\tR\flockedDownBy\x12'\n" +
+	"\x0flockdown_reason\x18\v \x01(\tR\x0elockdownReason\x12H\n" +
+	"\x12lockdown_lifted_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x10lockdownLiftedAt\x12,\n" +
+	"\x12lockdown_lifted_by\x18\r \x01(\tR\x10lockdownLiftedBy\"\x82\x01\n" +
 	"\x15CreateOperatorRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x123\n" +
@@ -929,7 +1219,24 @@ const file_nis_v1_operator_proto_rawDesc = "" +
 	"\x16GenerateIncludeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
 	"\x17GenerateIncludeResponse\x12\x16\n" +
-	"\x06config\x18\x01 \x01(\tR\x06config2\x9f\x05\n" +
+	"\x06config\x18\x01 \x01(\tR\x06config\"R\n" +
+	"\x17LockdownOperatorRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x91\x01\n" +
+	"\x18LockdownOperatorResponse\x12,\n" +
+	"\boperator\x18\x01 \x01(\v2\x10.nis.v1.OperatorR\boperator\x12\x1a\n" +
+	"\baccounts\x18\x02 \x03(\tR\baccounts\x12+\n" +
+	"\x06pushed\x18\x03 \x03(\v2\x13.nis.v1.AccountPushR\x06pushed\">\n" +
+	"\x1bLiftOperatorLockdownRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\"\xce\x01\n" +
+	"\x1cLiftOperatorLockdownResponse\x12,\n" +
+	"\boperator\x18\x01 \x01(\v2\x10.nis.v1.OperatorR\boperator\x12\x1a\n" +
+	"\baccounts\x18\x02 \x03(\tR\baccounts\x12%\n" +
+	"\x0ereissued_users\x18\x03 \x01(\x05R\rreissuedUsers\x12\x18\n" +
+	"\askipped\x18\x04 \x03(\tR\askipped\x12#\n" +
+	"\rrevoked_users\x18\x05 \x01(\x05R\frevokedUsers2\xd9\x06\n" +
 	"\x0fOperatorService\x12O\n" +
 	"\x0eCreateOperator\x12\x1d.nis.v1.CreateOperatorRequest\x1a\x1e.nis.v1.CreateOperatorResponse\x12F\n" +
 	"\vGetOperator\x12\x1a.nis.v1.GetOperatorRequest\x1a\x1b.nis.v1.GetOperatorResponse\x12X\n" +
@@ -938,7 +1245,9 @@ const file_nis_v1_operator_proto_rawDesc = "" +
 	"\x0eUpdateOperator\x12\x1d.nis.v1.UpdateOperatorRequest\x1a\x1e.nis.v1.UpdateOperatorResponse\x12U\n" +
 	"\x10SetSystemAccount\x12\x1f.nis.v1.SetSystemAccountRequest\x1a .nis.v1.SetSystemAccountResponse\x12O\n" +
 	"\x0eDeleteOperator\x12\x1d.nis.v1.DeleteOperatorRequest\x1a\x1e.nis.v1.DeleteOperatorResponse\x12R\n" +
-	"\x0fGenerateInclude\x12\x1e.nis.v1.GenerateIncludeRequest\x1a\x1f.nis.v1.GenerateIncludeResponseB\x84\x01\n" +
+	"\x0fGenerateInclude\x12\x1e.nis.v1.GenerateIncludeRequest\x1a\x1f.nis.v1.GenerateIncludeResponse\x12U\n" +
+	"\x10LockdownOperator\x12\x1f.nis.v1.LockdownOperatorRequest\x1a .nis.v1.LockdownOperatorResponse\x12a\n" +
+	"\x14LiftOperatorLockdown\x12#.nis.v1.LiftOperatorLockdownRequest\x1a$.nis.v1.LiftOperatorLockdownResponseB\x84\x01\n" +
 	"\n" +
 	"com.nis.v1B\rOperatorProtoP\x01Z.github.com/thomas-maurice/nis/gen/nis/v1;nisv1\xa2\x02\x03NXX\xaa\x02\x06Nis.V1\xca\x02\x06Nis\\V1\xe2\x02\x12Nis\\V1\\GPBMetadata\xea\x02\aNis::V1b\x06proto3"
 
@@ -954,59 +1263,73 @@ func file_nis_v1_operator_proto_rawDescGZIP() []byte {
 	return file_nis_v1_operator_proto_rawDescData
 }
 
-var file_nis_v1_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_nis_v1_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_nis_v1_operator_proto_goTypes = []any{
-	(*Operator)(nil),                  // 0: nis.v1.Operator
-	(*CreateOperatorRequest)(nil),     // 1: nis.v1.CreateOperatorRequest
-	(*CreateOperatorResponse)(nil),    // 2: nis.v1.CreateOperatorResponse
-	(*GetOperatorRequest)(nil),        // 3: nis.v1.GetOperatorRequest
-	(*GetOperatorResponse)(nil),       // 4: nis.v1.GetOperatorResponse
-	(*GetOperatorByNameRequest)(nil),  // 5: nis.v1.GetOperatorByNameRequest
-	(*GetOperatorByNameResponse)(nil), // 6: nis.v1.GetOperatorByNameResponse
-	(*ListOperatorsRequest)(nil),      // 7: nis.v1.ListOperatorsRequest
-	(*ListOperatorsResponse)(nil),     // 8: nis.v1.ListOperatorsResponse
-	(*UpdateOperatorRequest)(nil),     // 9: nis.v1.UpdateOperatorRequest
-	(*UpdateOperatorResponse)(nil),    // 10: nis.v1.UpdateOperatorResponse
-	(*SetSystemAccountRequest)(nil),   // 11: nis.v1.SetSystemAccountRequest
-	(*SetSystemAccountResponse)(nil),  // 12: nis.v1.SetSystemAccountResponse
-	(*DeleteOperatorRequest)(nil),     // 13: nis.v1.DeleteOperatorRequest
-	(*DeleteOperatorResponse)(nil),    // 14: nis.v1.DeleteOperatorResponse
-	(*GenerateIncludeRequest)(nil),    // 15: nis.v1.GenerateIncludeRequest
-	(*GenerateIncludeResponse)(nil),   // 16: nis.v1.GenerateIncludeResponse
-	(*timestamppb.Timestamp)(nil),     // 17: google.protobuf.Timestamp
-	(*ListOptions)(nil),               // 18: nis.v1.ListOptions
+	(*Operator)(nil),                     // 0: nis.v1.Operator
+	(*CreateOperatorRequest)(nil),        // 1: nis.v1.CreateOperatorRequest
+	(*CreateOperatorResponse)(nil),       // 2: nis.v1.CreateOperatorResponse
+	(*GetOperatorRequest)(nil),           // 3: nis.v1.GetOperatorRequest
+	(*GetOperatorResponse)(nil),          // 4: nis.v1.GetOperatorResponse
+	(*GetOperatorByNameRequest)(nil),     // 5: nis.v1.GetOperatorByNameRequest
+	(*GetOperatorByNameResponse)(nil),    // 6: nis.v1.GetOperatorByNameResponse
+	(*ListOperatorsRequest)(nil),         // 7: nis.v1.ListOperatorsRequest
+	(*ListOperatorsResponse)(nil),        // 8: nis.v1.ListOperatorsResponse
+	(*UpdateOperatorRequest)(nil),        // 9: nis.v1.UpdateOperatorRequest
+	(*UpdateOperatorResponse)(nil),       // 10: nis.v1.UpdateOperatorResponse
+	(*SetSystemAccountRequest)(nil),      // 11: nis.v1.SetSystemAccountRequest
+	(*SetSystemAccountResponse)(nil),     // 12: nis.v1.SetSystemAccountResponse
+	(*DeleteOperatorRequest)(nil),        // 13: nis.v1.DeleteOperatorRequest
+	(*DeleteOperatorResponse)(nil),       // 14: nis.v1.DeleteOperatorResponse
+	(*GenerateIncludeRequest)(nil),       // 15: nis.v1.GenerateIncludeRequest
+	(*GenerateIncludeResponse)(nil),      // 16: nis.v1.GenerateIncludeResponse
+	(*LockdownOperatorRequest)(nil),      // 17: nis.v1.LockdownOperatorRequest
+	(*LockdownOperatorResponse)(nil),     // 18: nis.v1.LockdownOperatorResponse
+	(*LiftOperatorLockdownRequest)(nil),  // 19: nis.v1.LiftOperatorLockdownRequest
+	(*LiftOperatorLockdownResponse)(nil), // 20: nis.v1.LiftOperatorLockdownResponse
+	(*timestamppb.Timestamp)(nil),        // 21: google.protobuf.Timestamp
+	(*ListOptions)(nil),                  // 22: nis.v1.ListOptions
+	(*AccountPush)(nil),                  // 23: nis.v1.AccountPush
 }
 var file_nis_v1_operator_proto_depIdxs = []int32{
-	17, // 0: nis.v1.Operator.created_at:type_name -> google.protobuf.Timestamp
-	17, // 1: nis.v1.Operator.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: nis.v1.CreateOperatorResponse.operator:type_name -> nis.v1.Operator
-	0,  // 3: nis.v1.GetOperatorResponse.operator:type_name -> nis.v1.Operator
-	0,  // 4: nis.v1.GetOperatorByNameResponse.operator:type_name -> nis.v1.Operator
-	18, // 5: nis.v1.ListOperatorsRequest.options:type_name -> nis.v1.ListOptions
-	0,  // 6: nis.v1.ListOperatorsResponse.operators:type_name -> nis.v1.Operator
-	0,  // 7: nis.v1.UpdateOperatorResponse.operator:type_name -> nis.v1.Operator
-	0,  // 8: nis.v1.SetSystemAccountResponse.operator:type_name -> nis.v1.Operator
-	1,  // 9: nis.v1.OperatorService.CreateOperator:input_type -> nis.v1.CreateOperatorRequest
-	3,  // 10: nis.v1.OperatorService.GetOperator:input_type -> nis.v1.GetOperatorRequest
-	5,  // 11: nis.v1.OperatorService.GetOperatorByName:input_type -> nis.v1.GetOperatorByNameRequest
-	7,  // 12: nis.v1.OperatorService.ListOperators:input_type -> nis.v1.ListOperatorsRequest
-	9,  // 13: nis.v1.OperatorService.UpdateOperator:input_type -> nis.v1.UpdateOperatorRequest
-	11, // 14: nis.v1.OperatorService.SetSystemAccount:input_type -> nis.v1.SetSystemAccountRequest
-	13, // 15: nis.v1.OperatorService.DeleteOperator:input_type -> nis.v1.DeleteOperatorRequest
-	15, // 16: nis.v1.OperatorService.GenerateInclude:input_type -> nis.v1.GenerateIncludeRequest
-	2,  // 17: nis.v1.OperatorService.CreateOperator:output_type -> nis.v1.CreateOperatorResponse
-	4,  // 18: nis.v1.OperatorService.GetOperator:output_type -> nis.v1.GetOperatorResponse
-	6,  // 19: nis.v1.OperatorService.GetOperatorByName:output_type -> nis.v1.GetOperatorByNameResponse
-	8,  // 20: nis.v1.OperatorService.ListOperators:output_type -> nis.v1.ListOperatorsResponse
-	10, // 21: nis.v1.OperatorService.UpdateOperator:output_type -> nis.v1.UpdateOperatorResponse
-	12, // 22: nis.v1.OperatorService.SetSystemAccount:output_type -> nis.v1.SetSystemAccountResponse
-	14, // 23: nis.v1.OperatorService.DeleteOperator:output_type -> nis.v1.DeleteOperatorResponse
-	16, // 24: nis.v1.OperatorService.GenerateInclude:output_type -> nis.v1.GenerateIncludeResponse
-	17, // [17:25] is the sub-list for method output_type
-	9,  // [9:17] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	21, // 0: nis.v1.Operator.created_at:type_name -> google.protobuf.Timestamp
+	21, // 1: nis.v1.Operator.updated_at:type_name -> google.protobuf.Timestamp
+	21, // 2: nis.v1.Operator.locked_down_at:type_name -> google.protobuf.Timestamp
+	21, // 3: nis.v1.Operator.lockdown_lifted_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: nis.v1.CreateOperatorResponse.operator:type_name -> nis.v1.Operator
+	0,  // 5: nis.v1.GetOperatorResponse.operator:type_name -> nis.v1.Operator
+	0,  // 6: nis.v1.GetOperatorByNameResponse.operator:type_name -> nis.v1.Operator
+	22, // 7: nis.v1.ListOperatorsRequest.options:type_name -> nis.v1.ListOptions
+	0,  // 8: nis.v1.ListOperatorsResponse.operators:type_name -> nis.v1.Operator
+	0,  // 9: nis.v1.UpdateOperatorResponse.operator:type_name -> nis.v1.Operator
+	0,  // 10: nis.v1.SetSystemAccountResponse.operator:type_name -> nis.v1.Operator
+	0,  // 11: nis.v1.LockdownOperatorResponse.operator:type_name -> nis.v1.Operator
+	23, // 12: nis.v1.LockdownOperatorResponse.pushed:type_name -> nis.v1.AccountPush
+	0,  // 13: nis.v1.LiftOperatorLockdownResponse.operator:type_name -> nis.v1.Operator
+	1,  // 14: nis.v1.OperatorService.CreateOperator:input_type -> nis.v1.CreateOperatorRequest
+	3,  // 15: nis.v1.OperatorService.GetOperator:input_type -> nis.v1.GetOperatorRequest
+	5,  // 16: nis.v1.OperatorService.GetOperatorByName:input_type -> nis.v1.GetOperatorByNameRequest
+	7,  // 17: nis.v1.OperatorService.ListOperators:input_type -> nis.v1.ListOperatorsRequest
+	9,  // 18: nis.v1.OperatorService.UpdateOperator:input_type -> nis.v1.UpdateOperatorRequest
+	11, // 19: nis.v1.OperatorService.SetSystemAccount:input_type -> nis.v1.SetSystemAccountRequest
+	13, // 20: nis.v1.OperatorService.DeleteOperator:input_type -> nis.v1.DeleteOperatorRequest
+	15, // 21: nis.v1.OperatorService.GenerateInclude:input_type -> nis.v1.GenerateIncludeRequest
+	17, // 22: nis.v1.OperatorService.LockdownOperator:input_type -> nis.v1.LockdownOperatorRequest
+	19, // 23: nis.v1.OperatorService.LiftOperatorLockdown:input_type -> nis.v1.LiftOperatorLockdownRequest
+	2,  // 24: nis.v1.OperatorService.CreateOperator:output_type -> nis.v1.CreateOperatorResponse
+	4,  // 25: nis.v1.OperatorService.GetOperator:output_type -> nis.v1.GetOperatorResponse
+	6,  // 26: nis.v1.OperatorService.GetOperatorByName:output_type -> nis.v1.GetOperatorByNameResponse
+	8,  // 27: nis.v1.OperatorService.ListOperators:output_type -> nis.v1.ListOperatorsResponse
+	10, // 28: nis.v1.OperatorService.UpdateOperator:output_type -> nis.v1.UpdateOperatorResponse
+	12, // 29: nis.v1.OperatorService.SetSystemAccount:output_type -> nis.v1.SetSystemAccountResponse
+	14, // 30: nis.v1.OperatorService.DeleteOperator:output_type -> nis.v1.DeleteOperatorResponse
+	16, // 31: nis.v1.OperatorService.GenerateInclude:output_type -> nis.v1.GenerateIncludeResponse
+	18, // 32: nis.v1.OperatorService.LockdownOperator:output_type -> nis.v1.LockdownOperatorResponse
+	20, // 33: nis.v1.OperatorService.LiftOperatorLockdown:output_type -> nis.v1.LiftOperatorLockdownResponse
+	24, // [24:34] is the sub-list for method output_type
+	14, // [14:24] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_nis_v1_operator_proto_init() }
@@ -1022,7 +1345,7 @@ func file_nis_v1_operator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nis_v1_operator_proto_rawDesc), len(file_nis_v1_operator_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,8 +34,10 @@ type ScopedSigningKey struct {
 	ResponsePermission *ResponsePermission    `protobuf:"bytes,7,opt,name=response_permission,json=responsePermission,proto3" json:"response_permission,omitempty"`
 	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Public keys replaced by a rotation, trusted until the end of their grace period
+	RetiredKeys   map[string]*timestamppb.Timestamp `protobuf:"bytes,10,rep,name=retired_keys,json=retiredKeys,proto3" json:"retired_keys,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ScopedSigningKey) Reset() {
@@ -131,6 +133,13 @@ func (x *ScopedSigningKey) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *ScopedSigningKey) GetRetiredKeys() map[string]*timestamppb.Timestamp {
+	if x != nil {
+		return x.RetiredKeys
+	}
+	return nil
+}
+
 // CreateScopedSigningKeyRequest is the request to create a new scoped signing key
 type CreateScopedSigningKeyRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -833,11 +842,226 @@ func (*DeleteScopedSigningKeyResponse) Descriptor() ([]byte, []int) {
 	return file_nis_v1_scoped_key_proto_rawDescGZIP(), []int{14}
 }
 
+// RotateScopedSigningKeyRequest is the request to give a scoped signing key a new key pair
+type RotateScopedSigningKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KeyId string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// How long the retired key stays trusted, 0 stops trusting it right away
+	GracePeriodSeconds int64 `protobuf:"varint,2,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateScopedSigningKeyRequest) Reset() {
+	*x = RotateScopedSigningKeyRequest{}
+	mi := &file_nis_v1_scoped_key_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateScopedSigningKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateScopedSigningKeyRequest) ProtoMessage() {}
+
+func (x *RotateScopedSigningKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_scoped_key_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateScopedSigningKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateScopedSigningKeyRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_scoped_key_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RotateScopedSigningKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *RotateScopedSigningKeyRequest) GetGracePeriodSeconds() int64 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+// RotateScopedSigningKeyProgress reports how far the re-signing of the users signed by
+// a rotated scoped signing key went
+type RotateScopedSigningKeyProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReissuedUsers int32                  `protobuf:"varint,1,opt,name=reissued_users,json=reissuedUsers,proto3" json:"reissued_users,omitempty"`
+	RevokedUsers  int32                  `protobuf:"varint,2,opt,name=revoked_users,json=revokedUsers,proto3" json:"revoked_users,omitempty"`
+	Users         int32                  `protobuf:"varint,3,opt,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateScopedSigningKeyProgress) Reset() {
+	*x = RotateScopedSigningKeyProgress{}
+	mi := &file_nis_v1_scoped_key_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateScopedSigningKeyProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateScopedSigningKeyProgress) ProtoMessage() {}
+
+func (x *RotateScopedSigningKeyProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_scoped_key_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateScopedSigningKeyProgress.ProtoReflect.Descriptor instead.
+func (*RotateScopedSigningKeyProgress) Descriptor() ([]byte, []int) {
+	return file_nis_v1_scoped_key_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RotateScopedSigningKeyProgress) GetReissuedUsers() int32 {
+	if x != nil {
+		return x.ReissuedUsers
+	}
+	return 0
+}
+
+func (x *RotateScopedSigningKeyProgress) GetRevokedUsers() int32 {
+	if x != nil {
+		return x.RevokedUsers
+	}
+	return 0
+}
+
+func (x *RotateScopedSigningKeyProgress) GetUsers() int32 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+// RotateScopedSigningKeyResponse is a message of a scoped signing key rotation stream.
+// Messages with progress set are sent while the users are re-signed, the last message
+// carries the result.
+type RotateScopedSigningKeyResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Key              *ScopedSigningKey      `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	RetiredPublicKey string                 `protobuf:"bytes,2,opt,name=retired_public_key,json=retiredPublicKey,proto3" json:"retired_public_key,omitempty"`
+	// When the account JWT stops trusting the retired key
+	RetireAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=retire_at,json=retireAt,proto3" json:"retire_at,omitempty"`
+	// Users signed by the key that were given a JWT signed by the new key
+	ReissuedUsers int32 `protobuf:"varint,4,opt,name=reissued_users,json=reissuedUsers,proto3" json:"reissued_users,omitempty"`
+	// Clusters the account JWT trusting the new key was pushed to
+	Pushed []*AccountPush `protobuf:"bytes,5,rep,name=pushed,proto3" json:"pushed,omitempty"`
+	// Users signed by the key but revoked by their account, left revoked
+	RevokedUsers  int32                           `protobuf:"varint,6,opt,name=revoked_users,json=revokedUsers,proto3" json:"revoked_users,omitempty"`
+	Progress      *RotateScopedSigningKeyProgress `protobuf:"bytes,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateScopedSigningKeyResponse) Reset() {
+	*x = RotateScopedSigningKeyResponse{}
+	mi := &file_nis_v1_scoped_key_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateScopedSigningKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateScopedSigningKeyResponse) ProtoMessage() {}
+
+func (x *RotateScopedSigningKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_scoped_key_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateScopedSigningKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateScopedSigningKeyResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_scoped_key_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RotateScopedSigningKeyResponse) GetKey() *ScopedSigningKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *RotateScopedSigningKeyResponse) GetRetiredPublicKey() string {
+	if x != nil {
+		return x.RetiredPublicKey
+	}
+	return ""
+}
+
+func (x *RotateScopedSigningKeyResponse) GetRetireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetireAt
+	}
+	return nil
+}
+
+func (x *RotateScopedSigningKeyResponse) GetReissuedUsers() int32 {
+	if x != nil {
+		return x.ReissuedUsers
+	}
+	return 0
+}
+
+func (x *RotateScopedSigningKeyResponse) GetPushed() []*AccountPush {
+	if x != nil {
+		return x.Pushed
+	}
+	return nil
+}
+
+func (x *RotateScopedSigningKeyResponse) GetRevokedUsers() int32 {
+	if x != nil {
+		return x.RevokedUsers
+	}
+	return 0
+}
+
+func (x *RotateScopedSigningKeyResponse) GetProgress() *RotateScopedSigningKeyProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
 var File_nis_v1_scoped_key_proto protoreflect.FileDescriptor
 
 const file_nis_v1_scoped_key_proto_rawDesc = "" +
 	"\n" +
-	"\x17nis/v1/scoped_key.proto\x12\x06nis.v1\x1a\x13nis/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x94\x03\n" +
+	"\x17nis/v1/scoped_key.proto\x12\x06nis.v1\x1a\x13nis/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xbe\x04\n" +
 	"\x10ScopedSigningKey\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -851,7 +1075,12 @@ const file_nis_v1_scoped_key_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xfc\x01\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12L\n" +
+	"\fretired_keys\x18\n" +
+	" \x03(\v2).nis.v1.ScopedSigningKey.RetiredKeysEntryR\vretiredKeys\x1aZ\n" +
+	"\x10RetiredKeysEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x120\n" +
+	"\x05value\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x05value:\x028\x01\"\xfc\x01\n" +
 	"\x1dCreateScopedSigningKeyRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
@@ -893,7 +1122,22 @@ const file_nis_v1_scoped_key_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\v2\x18.nis.v1.ScopedSigningKeyR\x03key\"/\n" +
 	"\x1dDeleteScopedSigningKeyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\" \n" +
-	"\x1eDeleteScopedSigningKeyResponse2\xe6\x05\n" +
+	"\x1eDeleteScopedSigningKeyResponse\"h\n" +
+	"\x1dRotateScopedSigningKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x120\n" +
+	"\x14grace_period_seconds\x18\x02 \x01(\x03R\x12gracePeriodSeconds\"\x82\x01\n" +
+	"\x1eRotateScopedSigningKeyProgress\x12%\n" +
+	"\x0ereissued_users\x18\x01 \x01(\x05R\rreissuedUsers\x12#\n" +
+	"\rrevoked_users\x18\x02 \x01(\x05R\frevokedUsers\x12\x14\n" +
+	"\x05users\x18\x03 \x01(\x05R\x05users\"\xf0\x02\n" +
+	"\x1eRotateScopedSigningKeyResponse\x12*\n" +
+	"\x03key\x18\x01 \x01(\v2\x18.nis.v1.ScopedSigningKeyR\x03key\x12,\n" +
+	"\x12retired_public_key\x18\x02 \x01(\tR\x10retiredPublicKey\x127\n" +
+	"\tretire_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bretireAt\x12%\n" +
+	"\x0ereissued_users\x18\x04 \x01(\x05R\rreissuedUsers\x12+\n" +
+	"\x06pushed\x18\x05 \x03(\v2\x13.nis.v1.AccountPushR\x06pushed\x12#\n" +
+	"\rrevoked_users\x18\x06 \x01(\x05R\frevokedUsers\x12B\n" +
+	"\bprogress\x18\a \x01(\v2&.nis.v1.RotateScopedSigningKeyProgressR\bprogress2\xd1\x06\n" +
 	"\x17ScopedSigningKeyService\x12g\n" +
 	"\x16CreateScopedSigningKey\x12%.nis.v1.CreateScopedSigningKeyRequest\x1a&.nis.v1.CreateScopedSigningKeyResponse\x12^\n" +
 	"\x13GetScopedSigningKey\x12\".nis.v1.GetScopedSigningKeyRequest\x1a#.nis.v1.GetScopedSigningKeyResponse\x12p\n" +
@@ -901,7 +1145,8 @@ const file_nis_v1_scoped_key_proto_rawDesc = "" +
 	"\x15ListScopedSigningKeys\x12$.nis.v1.ListScopedSigningKeysRequest\x1a%.nis.v1.ListScopedSigningKeysResponse\x12g\n" +
 	"\x16UpdateScopedSigningKey\x12%.nis.v1.UpdateScopedSigningKeyRequest\x1a&.nis.v1.UpdateScopedSigningKeyResponse\x12X\n" +
 	"\x11UpdatePermissions\x12 .nis.v1.UpdatePermissionsRequest\x1a!.nis.v1.UpdatePermissionsResponse\x12g\n" +
-	"\x16DeleteScopedSigningKey\x12%.nis.v1.DeleteScopedSigningKeyRequest\x1a&.nis.v1.DeleteScopedSigningKeyResponseB\x85\x01\n" +
+	"\x16DeleteScopedSigningKey\x12%.nis.v1.DeleteScopedSigningKeyRequest\x1a&.nis.v1.DeleteScopedSigningKeyResponse\x12i\n" +
+	"\x16RotateScopedSigningKey\x12%.nis.v1.RotateScopedSigningKeyRequest\x1a&.nis.v1.RotateScopedSigningKeyResponse0\x01B\x85\x01\n" +
 	"\n" +
 	"com.nis.v1B\x0eScopedKeyProtoP\x01Z.github.com/thomas-maurice/nis/gen/nis/v1;nisv1\xa2\x02\x03NXX\xaa\x02\x06Nis.V1\xca\x02\x06Nis\\V1\xe2\x02\x12Nis\\V1\\GPBMetadata\xea\x02\aNis::V1b\x06proto3"
 
@@ -917,7 +1162,7 @@ func file_nis_v1_scoped_key_proto_rawDescGZIP() []byte {
 	return file_nis_v1_scoped_key_proto_rawDescData
 }
 
-var file_nis_v1_scoped_key_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_nis_v1_scoped_key_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_nis_v1_scoped_key_proto_goTypes = []any{
 	(*ScopedSigningKey)(nil),                  // 0: nis.v1.ScopedSigningKey
 	(*CreateScopedSigningKeyRequest)(nil),     // 1: nis.v1.CreateScopedSigningKeyRequest
@@ -934,46 +1179,59 @@ var file_nis_v1_scoped_key_proto_goTypes = []any{
 	(*UpdatePermissionsResponse)(nil),         // 12: nis.v1.UpdatePermissionsResponse
 	(*DeleteScopedSigningKeyRequest)(nil),     // 13: nis.v1.DeleteScopedSigningKeyRequest
 	(*DeleteScopedSigningKeyResponse)(nil),    // 14: nis.v1.DeleteScopedSigningKeyResponse
-	(*UserPermissions)(nil),                   // 15: nis.v1.UserPermissions
-	(*ResponsePermission)(nil),                // 16: nis.v1.ResponsePermission
-	(*timestamppb.Timestamp)(nil),             // 17: google.protobuf.Timestamp
-	(*ListOptions)(nil),                       // 18: nis.v1.ListOptions
+	(*RotateScopedSigningKeyRequest)(nil),     // 15: nis.v1.RotateScopedSigningKeyRequest
+	(*RotateScopedSigningKeyProgress)(nil),    // 16: nis.v1.RotateScopedSigningKeyProgress
+	(*RotateScopedSigningKeyResponse)(nil),    // 17: nis.v1.RotateScopedSigningKeyResponse
+	nil,                                       // 18: nis.v1.ScopedSigningKey.RetiredKeysEntry
+	(*UserPermissions)(nil),                   // 19: nis.v1.UserPermissions
+	(*ResponsePermission)(nil),                // 20: nis.v1.ResponsePermission
+	(*timestamppb.Timestamp)(nil),             // 21: google.protobuf.Timestamp
+	(*ListOptions)(nil),                       // 22: nis.v1.ListOptions
+	(*AccountPush)(nil),                       // 23: nis.v1.AccountPush
 }
 var file_nis_v1_scoped_key_proto_depIdxs = []int32{
-	15, // 0: nis.v1.ScopedSigningKey.permissions:type_name -> nis.v1.UserPermissions
-	16, // 1: nis.v1.ScopedSigningKey.response_permission:type_name -> nis.v1.ResponsePermission
-	17, // 2: nis.v1.ScopedSigningKey.created_at:type_name -> google.protobuf.Timestamp
-	17, // 3: nis.v1.ScopedSigningKey.updated_at:type_name -> google.protobuf.Timestamp
-	15, // 4: nis.v1.CreateScopedSigningKeyRequest.permissions:type_name -> nis.v1.UserPermissions
-	16, // 5: nis.v1.CreateScopedSigningKeyRequest.response_permission:type_name -> nis.v1.ResponsePermission
-	0,  // 6: nis.v1.CreateScopedSigningKeyResponse.key:type_name -> nis.v1.ScopedSigningKey
-	0,  // 7: nis.v1.GetScopedSigningKeyResponse.key:type_name -> nis.v1.ScopedSigningKey
-	0,  // 8: nis.v1.GetScopedSigningKeyByNameResponse.key:type_name -> nis.v1.ScopedSigningKey
-	18, // 9: nis.v1.ListScopedSigningKeysRequest.options:type_name -> nis.v1.ListOptions
-	0,  // 10: nis.v1.ListScopedSigningKeysResponse.keys:type_name -> nis.v1.ScopedSigningKey
-	0,  // 11: nis.v1.UpdateScopedSigningKeyResponse.key:type_name -> nis.v1.ScopedSigningKey
-	15, // 12: nis.v1.UpdatePermissionsRequest.permissions:type_name -> nis.v1.UserPermissions
-	16, // 13: nis.v1.UpdatePermissionsRequest.response_permission:type_name -> nis.v1.ResponsePermission
-	0,  // 14: nis.v1.UpdatePermissionsResponse.key:type_name -> nis.v1.ScopedSigningKey
-	1,  // 15: nis.v1.ScopedSigningKeyService.CreateScopedSigningKey:input_type -> nis.v1.CreateScopedSigningKeyRequest
-	3,  // 16: nis.v1.ScopedSigningKeyService.GetScopedSigningKey:input_type -> nis.v1.GetScopedSigningKeyRequest
-	5,  // 17: nis.v1.ScopedSigningKeyService.GetScopedSigningKeyByName:input_type -> nis.v1.GetScopedSigningKeyByNameRequest
-	7,  // 18: nis.v1.ScopedSigningKeyService.ListScopedSigningKeys:input_type -> nis.v1.ListScopedSigningKeysRequest
-	9,  // 19: nis.v1.ScopedSigningKeyService.UpdateScopedSigningKey:input_type -> nis.v1.UpdateScopedSigningKeyRequest
-	11, // 20: nis.v1.ScopedSigningKeyService.UpdatePermissions:input_type -> nis.v1.UpdatePermissionsRequest
-	13, // 21: nis.v1.ScopedSigningKeyService.DeleteScopedSigningKey:input_type -> nis.v1.DeleteScopedSigningKeyRequest
-	2,  // 22: nis.v1.ScopedSigningKeyService.CreateScopedSigningKey:output_type -> nis.v1.CreateScopedSigningKeyResponse
-	4,  // 23: nis.v1.ScopedSigningKeyService.GetScopedSigningKey:output_type -> nis.v1.GetScopedSigningKeyResponse
-	6,  // 24: nis.v1.ScopedSigningKeyService.GetScopedSigningKeyByName:output_type -> nis.v1.GetScopedSigningKeyByNameResponse
-	8,  // 25: nis.v1.ScopedSigningKeyService.ListScopedSigningKeys:output_type -> nis.v1.ListScopedSigningKeysResponse
-	10, // 26: nis.v1.ScopedSigningKeyService.UpdateScopedSigningKey:output_type -> nis.v1.UpdateScopedSigningKeyResponse
-	12, // 27: nis.v1.ScopedSigningKeyService.UpdatePermissions:output_type -> nis.v1.UpdatePermissionsResponse
-	14, // 28: nis.v1.ScopedSigningKeyService.DeleteScopedSigningKey:output_type -> nis.v1.DeleteScopedSigningKeyResponse
-	22, // [22:29] is the sub-list for method output_type
-	15, // [15:22] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	19, // 0: nis.v1.ScopedSigningKey.permissions:type_name -> nis.v1.UserPermissions
+	20, // 1: nis.v1.ScopedSigningKey.response_permission:type_name -> nis.v1.ResponsePermission
+	21, // 2: nis.v1.ScopedSigningKey.created_at:type_name -> google.protobuf.Timestamp
+	21, // 3: nis.v1.ScopedSigningKey.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 4: nis.v1.ScopedSigningKey.retired_keys:type_name -> nis.v1.ScopedSigningKey.RetiredKeysEntry
+	19, // 5: nis.v1.CreateScopedSigningKeyRequest.permissions:type_name -> nis.v1.UserPermissions
+	20, // 6: nis.v1.CreateScopedSigningKeyRequest.response_permission:type_name -> nis.v1.ResponsePermission
+	0,  // 7: nis.v1.CreateScopedSigningKeyResponse.key:type_name -> nis.v1.ScopedSigningKey
+	0,  // 8: nis.v1.GetScopedSigningKeyResponse.key:type_name -> nis.v1.ScopedSigningKey
+	0,  // 9: nis.v1.GetScopedSigningKeyByNameResponse.key:type_name -> nis.v1.ScopedSigningKey
+	22, // 10: nis.v1.ListScopedSigningKeysRequest.options:type_name -> nis.v1.ListOptions
+	0,  // 11: nis.v1.ListScopedSigningKeysResponse.keys:type_name -> nis.v1.ScopedSigningKey
+	0,  // 12: nis.v1.UpdateScopedSigningKeyResponse.key:type_name -> nis.v1.ScopedSigningKey
+	19, // 13: nis.v1.UpdatePermissionsRequest.permissions:type_name -> nis.v1.UserPermissions
+	20, // 14: nis.v1.UpdatePermissionsRequest.response_permission:type_name -> nis.v1.ResponsePermission
+	0,  // 15: nis.v1.UpdatePermissionsResponse.key:type_name -> nis.v1.ScopedSigningKey
+	0,  // 16: nis.v1.RotateScopedSigningKeyResponse.key:type_name -> nis.v1.ScopedSigningKey
+	21, // 17: nis.v1.RotateScopedSigningKeyResponse.retire_at:type_name -> google.protobuf.Timestamp
+	23, // 18: nis.v1.RotateScopedSigningKeyResponse.pushed:type_name -> nis.v1.AccountPush
+	16, // 19: nis.v1.RotateScopedSigningKeyResponse.progress:type_name -> nis.v1.RotateScopedSigningKeyProgress
+	21, // 20: nis.v1.ScopedSigningKey.RetiredKeysEntry.value:type_name -> google.protobuf.Timestamp
+	1,  // 21: nis.v1.ScopedSigningKeyService.CreateScopedSigningKey:input_type -> nis.v1.CreateScopedSigningKeyRequest
+	3,  // 22: nis.v1.ScopedSigningKeyService.GetScopedSigningKey:input_type -> nis.v1.GetScopedSigningKeyRequest
+	5,  // 23: nis.v1.ScopedSigningKeyService.GetScopedSigningKeyByName:input_type -> nis.v1.GetScopedSigningKeyByNameRequest
+	7,  // 24: nis.v1.ScopedSigningKeyService.ListScopedSigningKeys:input_type -> nis.v1.ListScopedSigningKeysRequest
+	9,  // 25: nis.v1.ScopedSigningKeyService.UpdateScopedSigningKey:input_type -> nis.v1.UpdateScopedSigningKeyRequest
+	11, // 26: nis.v1.ScopedSigningKeyService.UpdatePermissions:input_type -> nis.v1.UpdatePermissionsRequest
+	13, // 27: nis.v1.ScopedSigningKeyService.DeleteScopedSigningKey:input_type -> nis.v1.DeleteScopedSigningKeyRequest
+	15, // 28: nis.v1.ScopedSigningKeyService.RotateScopedSigningKey:input_type -> nis.v1.RotateScopedSigningKeyRequest
+	2,  // 29: nis.v1.ScopedSigningKeyService.CreateScopedSigningKey:output_type -> nis.v1.CreateScopedSigningKeyResponse
+	4,  // 30: nis.v1.ScopedSigningKeyService.GetScopedSigningKey:output_type -> nis.v1.GetScopedSigningKeyResponse
+	6,  // 31: nis.v1.ScopedSigningKeyService.GetScopedSigningKeyByName:output_type -> nis.v1.GetScopedSigningKeyByNameResponse
+	8,  // 32: nis.v1.ScopedSigningKeyService.ListScopedSigningKeys:output_type -> nis.v1.ListScopedSigningKeysResponse
+	10, // 33: nis.v1.ScopedSigningKeyService.UpdateScopedSigningKey:output_type -> nis.v1.UpdateScopedSigningKeyResponse
+	12, // 34: nis.v1.ScopedSigningKeyService.UpdatePermissions:output_type -> nis.v1.UpdatePermissionsResponse
+	14, // 35: nis.v1.ScopedSigningKeyService.DeleteScopedSigningKey:output_type -> nis.v1.DeleteScopedSigningKeyResponse
+	17, // 36: nis.v1.ScopedSigningKeyService.RotateScopedSigningKey:output_type -> nis.v1.RotateScopedSigningKeyResponse
+	29, // [29:37] is the sub-list for method output_type
+	21, // [21:29] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
 }
 
 func init() { file_nis_v1_scoped_key_proto_init() }
@@ -989,7 +1247,7 @@ func file_nis_v1_scoped_key_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nis_v1_scoped_key_proto_rawDesc), len(file_nis_v1_scoped_key_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -34,8 +34,13 @@ type User struct {
 	ScopedSigningKeyId string                 `protobuf:"bytes,7,opt,name=scoped_signing_key_id,json=scopedSigningKeyId,proto3" json:"scoped_signing_key_id,omitempty"`
 	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Client activity recorded from the clusters' connect and disconnect events
+	LastConnectedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_connected_at,json=lastConnectedAt,proto3" json:"last_connected_at,omitempty"`
+	LastSeenAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	LastRemoteIp    string                 `protobuf:"bytes,12,opt,name=last_remote_ip,json=lastRemoteIp,proto3" json:"last_remote_ip,omitempty"`
+	ConnectionCount int64                  `protobuf:"varint,13,opt,name=connection_count,json=connectionCount,proto3" json:"connection_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -131,6 +136,34 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetLastConnectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastConnectedAt
+	}
+	return nil
+}
+
+func (x *User) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *User) GetLastRemoteIp() string {
+	if x != nil {
+		return x.LastRemoteIp
+	}
+	return ""
+}
+
+func (x *User) GetConnectionCount() int64 {
+	if x != nil {
+		return x.ConnectionCount
+	}
+	return 0
+}
+
 // CreateUserRequest is the request to create a new user
 type CreateUserRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
@@ -435,9 +468,11 @@ func (x *GetUserByNameResponse) GetUser() *User {
 
 // ListUsersRequest is the request to list users
 type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Options       *ListOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	AccountId string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Options   *ListOptions           `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	// When positive, only list the users not seen on any cluster for that many days
+	StaleDays     int32 `protobuf:"varint,3,opt,name=stale_days,json=staleDays,proto3" json:"stale_days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -486,6 +521,13 @@ func (x *ListUsersRequest) GetOptions() *ListOptions {
 	return nil
 }
 
+func (x *ListUsersRequest) GetStaleDays() int32 {
+	if x != nil {
+		return x.StaleDays
+	}
+	return 0
+}
+
 // ListUsersResponse is the response from listing users
 type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -809,64 +851,1073 @@ func (x *GetUserCredentialsResponse) GetCredentials() string {
 	return ""
 }
 
-var File_nis_v1_user_proto protoreflect.FileDescriptor
+// UserKey is a key a user held before a credential rotation
+type UserKey struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PublicKey string                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RetiredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=retired_at,json=retiredAt,proto3" json:"retired_at,omitempty"`
+	// End of the grace period, when the key is revoked
+	RevokeAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=revoke_at,json=revokeAt,proto3" json:"revoke_at,omitempty"`
+	// Set once the account JWT revokes the key
+	RevokedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_nis_v1_user_proto_rawDesc = "" +
-	"\n" +
-	"\x11nis/v1/user.proto\x12\x06nis.v1\x1a\x13nis/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc5\x02\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
-	"\n" +
-	"public_key\x18\x05 \x01(\tR\tpublicKey\x12\x10\n" +
-	"\x03jwt\x18\x06 \x01(\tR\x03jwt\x121\n" +
-	"\x15scoped_signing_key_id\x18\a \x01(\tR\x12scopedSigningKeyId\x129\n" +
-	"\n" +
-	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9b\x01\n" +
-	"\x11CreateUserRequest\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x121\n" +
-	"\x15scoped_signing_key_id\x18\x04 \x01(\tR\x12scopedSigningKeyId\"6\n" +
-	"\x12CreateUserResponse\x12 \n" +
-	"\x04user\x18\x01 \x01(\v2\f.nis.v1.UserR\x04user\" \n" +
-	"\x0eGetUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
-	"\x0fGetUserResponse\x12 \n" +
-	"\x04user\x18\x01 \x01(\v2\f.nis.v1.UserR\x04user\"I\n" +
-	"\x14GetUserByNameRequest\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"9\n" +
-	"\x15GetUserByNameResponse\x12 \n" +
-	"\x04user\x18\x01 \x01(\v2\f.nis.v1.UserR\x04user\"`\n" +
-	"\x10ListUsersRequest\x12\x1d\n" +
-	"\n" +
-	"account_id\x18\x01 \x01(\tR\taccountId\x12-\n" +
-	"\aoptions\x18\x02 \x01(\v2\x13.nis.v1.ListOptionsR\aoptions\"7\n" +
-	"\x11ListUsersResponse\x12\"\n" +
-	"\x05users\x18\x01 \x03(\v2\f.nis.v1.UserR\x05users\"|\n" +
-	"\x11UpdateUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
-	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
-	"\x05_nameB\x0e\n" +
-	"\f_description\"6\n" +
-	"\x12UpdateUserResponse\x12 \n" +
-	"\x04user\x18\x01 \x01(\v2\f.nis.v1.UserR\x04user\"#\n" +
-	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
-	"\x12DeleteUserResponse\"+\n" +
-	"\x19GetUserCredentialsRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
-	"\x1aGetUserCredentialsResponse\x12 \n" +
-	"\vcredentials\x18\x01 \x01(\tR\vcredentials2\x85\x04\n" +
+func (x *UserKey) Reset() {
+	*x = UserKey{}
+	mi := &file_nis_v1_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserKey) ProtoMessage() {}
+
+func (x *UserKey) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserKey.ProtoReflect.Descriptor instead.
+func (*UserKey) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *UserKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserKey) GetRetiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetiredAt
+	}
+	return nil
+}
+
+func (x *UserKey) GetRevokeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokeAt
+	}
+	return nil
+}
+
+func (x *UserKey) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+// RotateUserCredentialsRequest is the request to rotate the credentials of a user
+type RotateUserCredentialsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// How long the retired key keeps working, 0 revokes it right away
+	GracePeriodSeconds int64 `protobuf:"varint,2,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateUserCredentialsRequest) Reset() {
+	*x = RotateUserCredentialsRequest{}
+	mi := &file_nis_v1_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateUserCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateUserCredentialsRequest) ProtoMessage() {}
+
+func (x *RotateUserCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateUserCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*RotateUserCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RotateUserCredentialsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RotateUserCredentialsRequest) GetGracePeriodSeconds() int64 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+// RotateUserCredentialsResponse is the response from rotating the credentials of a user
+type RotateUserCredentialsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	User       *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	RetiredKey *UserKey               `protobuf:"bytes,2,opt,name=retired_key,json=retiredKey,proto3" json:"retired_key,omitempty"`
+	// Clusters the account JWT revoking the retired key was pushed to, without grace period
+	Pushed        []*AccountPush `protobuf:"bytes,3,rep,name=pushed,proto3" json:"pushed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateUserCredentialsResponse) Reset() {
+	*x = RotateUserCredentialsResponse{}
+	mi := &file_nis_v1_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateUserCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateUserCredentialsResponse) ProtoMessage() {}
+
+func (x *RotateUserCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateUserCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*RotateUserCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RotateUserCredentialsResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RotateUserCredentialsResponse) GetRetiredKey() *UserKey {
+	if x != nil {
+		return x.RetiredKey
+	}
+	return nil
+}
+
+func (x *RotateUserCredentialsResponse) GetPushed() []*AccountPush {
+	if x != nil {
+		return x.Pushed
+	}
+	return nil
+}
+
+// ListUserKeysRequest is the request to list the keys a user held before its rotations
+type ListUserKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserKeysRequest) Reset() {
+	*x = ListUserKeysRequest{}
+	mi := &file_nis_v1_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserKeysRequest) ProtoMessage() {}
+
+func (x *ListUserKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListUserKeysRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListUserKeysRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// ListUserKeysResponse is the response from listing the keys of a user, the latest first
+type ListUserKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*UserKey             `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserKeysResponse) Reset() {
+	*x = ListUserKeysResponse{}
+	mi := &file_nis_v1_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserKeysResponse) ProtoMessage() {}
+
+func (x *ListUserKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListUserKeysResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListUserKeysResponse) GetKeys() []*UserKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// RotationPolicy rotates the credentials of the users of an operator, an account or
+// a scoped signing key on a schedule
+type RotationPolicy struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OperatorId string                 `protobuf:"bytes,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Set for account and scoped signing key policies
+	AccountId string `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// Set for scoped signing key policies
+	ScopedSigningKeyId string `protobuf:"bytes,4,opt,name=scoped_signing_key_id,json=scopedSigningKeyId,proto3" json:"scoped_signing_key_id,omitempty"`
+	// operator, account or scoped_signing_key
+	Scope           string `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	IntervalSeconds int64  `protobuf:"varint,6,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// How long the retired keys keep working
+	GracePeriodSeconds int64                  `protobuf:"varint,7,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+	CreatedBy          string                 `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotationPolicy) Reset() {
+	*x = RotationPolicy{}
+	mi := &file_nis_v1_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotationPolicy) ProtoMessage() {}
+
+func (x *RotationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotationPolicy.ProtoReflect.Descriptor instead.
+func (*RotationPolicy) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RotationPolicy) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RotationPolicy) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *RotationPolicy) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *RotationPolicy) GetScopedSigningKeyId() string {
+	if x != nil {
+		return x.ScopedSigningKeyId
+	}
+	return ""
+}
+
+func (x *RotationPolicy) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *RotationPolicy) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *RotationPolicy) GetGracePeriodSeconds() int64 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+func (x *RotationPolicy) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *RotationPolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RotationPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CreateRotationPolicyRequest is the request to schedule credential rotations. Set
+// scoped_signing_key_id for the users signed by a scoped key, account_id for the
+// users of an account, or neither for every user of the operator.
+type CreateRotationPolicyRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId         string                 `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	AccountId          string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ScopedSigningKeyId string                 `protobuf:"bytes,3,opt,name=scoped_signing_key_id,json=scopedSigningKeyId,proto3" json:"scoped_signing_key_id,omitempty"`
+	IntervalSeconds    int64                  `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	GracePeriodSeconds int64                  `protobuf:"varint,5,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateRotationPolicyRequest) Reset() {
+	*x = CreateRotationPolicyRequest{}
+	mi := &file_nis_v1_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRotationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRotationPolicyRequest) ProtoMessage() {}
+
+func (x *CreateRotationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRotationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*CreateRotationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateRotationPolicyRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *CreateRotationPolicyRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreateRotationPolicyRequest) GetScopedSigningKeyId() string {
+	if x != nil {
+		return x.ScopedSigningKeyId
+	}
+	return ""
+}
+
+func (x *CreateRotationPolicyRequest) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *CreateRotationPolicyRequest) GetGracePeriodSeconds() int64 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+// CreateRotationPolicyResponse is the response from creating a rotation policy
+type CreateRotationPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *RotationPolicy        `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRotationPolicyResponse) Reset() {
+	*x = CreateRotationPolicyResponse{}
+	mi := &file_nis_v1_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRotationPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRotationPolicyResponse) ProtoMessage() {}
+
+func (x *CreateRotationPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRotationPolicyResponse.ProtoReflect.Descriptor instead.
+func (*CreateRotationPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CreateRotationPolicyResponse) GetPolicy() *RotationPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// ListRotationPoliciesRequest is the request to list rotation policies
+type ListRotationPoliciesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restricts the list to an operator when set
+	OperatorId    string `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRotationPoliciesRequest) Reset() {
+	*x = ListRotationPoliciesRequest{}
+	mi := &file_nis_v1_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRotationPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRotationPoliciesRequest) ProtoMessage() {}
+
+func (x *ListRotationPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRotationPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListRotationPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListRotationPoliciesRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+// ListRotationPoliciesResponse is the response from listing rotation policies
+type ListRotationPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*RotationPolicy      `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRotationPoliciesResponse) Reset() {
+	*x = ListRotationPoliciesResponse{}
+	mi := &file_nis_v1_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRotationPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRotationPoliciesResponse) ProtoMessage() {}
+
+func (x *ListRotationPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRotationPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListRotationPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListRotationPoliciesResponse) GetPolicies() []*RotationPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+// DeleteRotationPolicyRequest is the request to delete a rotation policy
+type DeleteRotationPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRotationPolicyRequest) Reset() {
+	*x = DeleteRotationPolicyRequest{}
+	mi := &file_nis_v1_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRotationPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRotationPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteRotationPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRotationPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRotationPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteRotationPolicyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteRotationPolicyResponse is the response from deleting a rotation policy
+type DeleteRotationPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRotationPolicyResponse) Reset() {
+	*x = DeleteRotationPolicyResponse{}
+	mi := &file_nis_v1_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRotationPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRotationPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteRotationPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRotationPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRotationPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{26}
+}
+
+// PlannedRotation is an upcoming scheduled rotation of a user's credentials
+type PlannedRotation struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName     string                 `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	AccountId    string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountName  string                 `protobuf:"bytes,4,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	OperatorId   string                 `protobuf:"bytes,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	OperatorName string                 `protobuf:"bytes,6,opt,name=operator_name,json=operatorName,proto3" json:"operator_name,omitempty"`
+	// Most specific policy covering the user
+	PolicyId string `protobuf:"bytes,7,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Scope    string `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope,omitempty"`
+	// When the user's current key was issued
+	LastRotatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_rotated_at,json=lastRotatedAt,proto3" json:"last_rotated_at,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedRotation) Reset() {
+	*x = PlannedRotation{}
+	mi := &file_nis_v1_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedRotation) ProtoMessage() {}
+
+func (x *PlannedRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedRotation.ProtoReflect.Descriptor instead.
+func (*PlannedRotation) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *PlannedRotation) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PlannedRotation) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *PlannedRotation) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PlannedRotation) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+func (x *PlannedRotation) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *PlannedRotation) GetOperatorName() string {
+	if x != nil {
+		return x.OperatorName
+	}
+	return ""
+}
+
+func (x *PlannedRotation) GetPolicyId() string {
+	if x != nil {
+		return x.PolicyId
+	}
+	return ""
+}
+
+func (x *PlannedRotation) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *PlannedRotation) GetLastRotatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRotatedAt
+	}
+	return nil
+}
+
+func (x *PlannedRotation) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+// PlanRotationsRequest is the request to list the upcoming scheduled rotations
+type PlanRotationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restricts the plan to an operator when set
+	OperatorId string `protobuf:"bytes,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	// Lists the rotations due within this duration, 0 lists them all
+	WithinSeconds int64 `protobuf:"varint,2,opt,name=within_seconds,json=withinSeconds,proto3" json:"within_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanRotationsRequest) Reset() {
+	*x = PlanRotationsRequest{}
+	mi := &file_nis_v1_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanRotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanRotationsRequest) ProtoMessage() {}
+
+func (x *PlanRotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanRotationsRequest.ProtoReflect.Descriptor instead.
+func (*PlanRotationsRequest) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PlanRotationsRequest) GetOperatorId() string {
+	if x != nil {
+		return x.OperatorId
+	}
+	return ""
+}
+
+func (x *PlanRotationsRequest) GetWithinSeconds() int64 {
+	if x != nil {
+		return x.WithinSeconds
+	}
+	return 0
+}
+
+// PlanRotationsResponse is the response from planning rotations, the earliest first.
+// Overdue rotations are included.
+type PlanRotationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rotations     []*PlannedRotation     `protobuf:"bytes,1,rep,name=rotations,proto3" json:"rotations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanRotationsResponse) Reset() {
+	*x = PlanRotationsResponse{}
+	mi := &file_nis_v1_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanRotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanRotationsResponse) ProtoMessage() {}
+
+func (x *PlanRotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nis_v1_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanRotationsResponse.ProtoReflect.Descriptor instead.
+func (*PlanRotationsResponse) Descriptor() ([]byte, []int) {
+	return file_nis_v1_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PlanRotationsResponse) GetRotations() []*PlannedRotation {
+	if x != nil {
+		return x.Rotations
+	}
+	return nil
+}
+
+var File_nis_v1_user_proto protoreflect.FileDescriptor
+
+const file_nis_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x11nis/v1/user.proto\x12\x06nis.v1\x1a\x13nis/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9c\x04\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x05 \x01(\tR\tpublicKey\x12\x10\n" +
+	"\x03jwt\x18\x06 \x01(\tR\x03jwt\x121\n" +
+	"\x15scoped_signing_key_id\x18\a \x01(\tR\x12scopedSigningKeyId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12F\n" +
+	"\x11last_connected_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x0flastConnectedAt\x12<\n" +
+	"\flast_seen_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x12$\n" +
+	"\x0elast_remote_ip\x18\f \x01(\tR\flastRemoteIp\x12)\n" +
+	"\x10connection_count\x18\r \x01(\x03R\x0fconnectionCount\"\x9b\x01\n" +
+	"\x11CreateUserRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x121\n" +
+	"\x15scoped_signing_key_id\x18\x04 \x01(\tR\x12scopedSigningKeyId\"6\n" +
+	"\x12CreateUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.nis.v1.UserR\x04user\" \n" +
+	"\x0eGetUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
+	"\x0fGetUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.nis.v1.UserR\x04user\"I\n" +
+	"\x14GetUserByNameRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"9\n" +
+	"\x15GetUserByNameResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.nis.v1.UserR\x04user\"\x7f\n" +
+	"\x10ListUsersRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\tR\taccountId\x12-\n" +
+	"\aoptions\x18\x02 \x01(\v2\x13.nis.v1.ListOptionsR\aoptions\x12\x1d\n" +
+	"\n" +
+	"stale_days\x18\x03 \x01(\x05R\tstaleDays\"7\n" +
+	"\x11ListUsersResponse\x12\"\n" +
+	"\x05users\x18\x01 \x03(\v2\f.nis.v1.UserR\x05users\"|\n" +
+	"\x11UpdateUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01B\a\n" +
+	"\x05_nameB\x0e\n" +
+	"\f_description\"6\n" +
+	"\x12UpdateUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.nis.v1.UserR\x04user\"#\n" +
+	"\x11DeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteUserResponse\"+\n" +
+	"\x19GetUserCredentialsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\">\n" +
+	"\x1aGetUserCredentialsResponse\x12 \n" +
+	"\vcredentials\x18\x01 \x01(\tR\vcredentials\"\x92\x02\n" +
+	"\aUserKey\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\tR\tpublicKey\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"retired_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tretiredAt\x127\n" +
+	"\trevoke_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\brevokeAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\"i\n" +
+	"\x1cRotateUserCredentialsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x120\n" +
+	"\x14grace_period_seconds\x18\x02 \x01(\x03R\x12gracePeriodSeconds\"\xa0\x01\n" +
+	"\x1dRotateUserCredentialsResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.nis.v1.UserR\x04user\x120\n" +
+	"\vretired_key\x18\x02 \x01(\v2\x0f.nis.v1.UserKeyR\n" +
+	"retiredKey\x12+\n" +
+	"\x06pushed\x18\x03 \x03(\v2\x13.nis.v1.AccountPushR\x06pushed\".\n" +
+	"\x13ListUserKeysRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\";\n" +
+	"\x14ListUserKeysResponse\x12#\n" +
+	"\x04keys\x18\x01 \x03(\v2\x0f.nis.v1.UserKeyR\x04keys\"\x9b\x03\n" +
+	"\x0eRotationPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\tR\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x121\n" +
+	"\x15scoped_signing_key_id\x18\x04 \x01(\tR\x12scopedSigningKeyId\x12\x14\n" +
+	"\x05scope\x18\x05 \x01(\tR\x05scope\x12)\n" +
+	"\x10interval_seconds\x18\x06 \x01(\x03R\x0fintervalSeconds\x120\n" +
+	"\x14grace_period_seconds\x18\a \x01(\x03R\x12gracePeriodSeconds\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xed\x01\n" +
+	"\x1bCreateRotationPolicyRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\tR\taccountId\x121\n" +
+	"\x15scoped_signing_key_id\x18\x03 \x01(\tR\x12scopedSigningKeyId\x12)\n" +
+	"\x10interval_seconds\x18\x04 \x01(\x03R\x0fintervalSeconds\x120\n" +
+	"\x14grace_period_seconds\x18\x05 \x01(\x03R\x12gracePeriodSeconds\"N\n" +
+	"\x1cCreateRotationPolicyResponse\x12.\n" +
+	"\x06policy\x18\x01 \x01(\v2\x16.nis.v1.RotationPolicyR\x06policy\">\n" +
+	"\x1bListRotationPoliciesRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\"R\n" +
+	"\x1cListRotationPoliciesResponse\x122\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x16.nis.v1.RotationPolicyR\bpolicies\"-\n" +
+	"\x1bDeleteRotationPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1e\n" +
+	"\x1cDeleteRotationPolicyResponse\"\xf9\x02\n" +
+	"\x0fPlannedRotation\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tuser_name\x18\x02 \x01(\tR\buserName\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\tR\taccountId\x12!\n" +
+	"\faccount_name\x18\x04 \x01(\tR\vaccountName\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\tR\n" +
+	"operatorId\x12#\n" +
+	"\roperator_name\x18\x06 \x01(\tR\foperatorName\x12\x1b\n" +
+	"\tpolicy_id\x18\a \x01(\tR\bpolicyId\x12\x14\n" +
+	"\x05scope\x18\b \x01(\tR\x05scope\x12B\n" +
+	"\x0flast_rotated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\rlastRotatedAt\x121\n" +
+	"\x06due_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\"^\n" +
+	"\x14PlanRotationsRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\tR\n" +
+	"operatorId\x12%\n" +
+	"\x0ewithin_seconds\x18\x02 \x01(\x03R\rwithinSeconds\"N\n" +
+	"\x15PlanRotationsResponse\x125\n" +
+	"\trotations\x18\x01 \x03(\v2\x17.nis.v1.PlannedRotationR\trotations2\xad\b\n" +
 	"\vUserService\x12C\n" +
 	"\n" +
 	"CreateUser\x12\x19.nis.v1.CreateUserRequest\x1a\x1a.nis.v1.CreateUserResponse\x12:\n" +
@@ -877,7 +1928,13 @@ const file_nis_v1_user_proto_rawDesc = "" +
 	"UpdateUser\x12\x19.nis.v1.UpdateUserRequest\x1a\x1a.nis.v1.UpdateUserResponse\x12C\n" +
 	"\n" +
 	"DeleteUser\x12\x19.nis.v1.DeleteUserRequest\x1a\x1a.nis.v1.DeleteUserResponse\x12[\n" +
-	"\x12GetUserCredentials\x12!.nis.v1.GetUserCredentialsRequest\x1a\".nis.v1.GetUserCredentialsResponseB\x80\x01\n" +
+	"\x12GetUserCredentials\x12!.nis.v1.GetUserCredentialsRequest\x1a\".nis.v1.GetUserCredentialsResponse\x12d\n" +
+	"\x15RotateUserCredentials\x12$.nis.v1.RotateUserCredentialsRequest\x1a%.nis.v1.RotateUserCredentialsResponse\x12I\n" +
+	"\fListUserKeys\x12\x1b.nis.v1.ListUserKeysRequest\x1a\x1c.nis.v1.ListUserKeysResponse\x12a\n" +
+	"\x14CreateRotationPolicy\x12#.nis.v1.CreateRotationPolicyRequest\x1a$.nis.v1.CreateRotationPolicyResponse\x12a\n" +
+	"\x14ListRotationPolicies\x12#.nis.v1.ListRotationPoliciesRequest\x1a$.nis.v1.ListRotationPoliciesResponse\x12a\n" +
+	"\x14DeleteRotationPolicy\x12#.nis.v1.DeleteRotationPolicyRequest\x1a$.nis.v1.DeleteRotationPolicyResponse\x12L\n" +
+	"\rPlanRotations\x12\x1c.nis.v1.PlanRotationsRequest\x1a\x1d.nis.v1.PlanRotationsResponseB\x80\x01\n" +
 	"\n" +
 	"com.nis.v1B\tUserProtoP\x01Z.github.com/thomas-maurice/nis/gen/nis/v1;nisv1\xa2\x02\x03NXX\xaa\x02\x06Nis.V1\xca\x02\x06Nis\\V1\xe2\x02\x12Nis\\V1\\GPBMetadata\xea\x02\aNis::V1b\x06proto3"
 
@@ -893,54 +1950,99 @@ func file_nis_v1_user_proto_rawDescGZIP() []byte {
 	return file_nis_v1_user_proto_rawDescData
 }
 
-var file_nis_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_nis_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_nis_v1_user_proto_goTypes = []any{
-	(*User)(nil),                       // 0: nis.v1.User
-	(*CreateUserRequest)(nil),          // 1: nis.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),         // 2: nis.v1.CreateUserResponse
-	(*GetUserRequest)(nil),             // 3: nis.v1.GetUserRequest
-	(*GetUserResponse)(nil),            // 4: nis.v1.GetUserResponse
-	(*GetUserByNameRequest)(nil),       // 5: nis.v1.GetUserByNameRequest
-	(*GetUserByNameResponse)(nil),      // 6: nis.v1.GetUserByNameResponse
-	(*ListUsersRequest)(nil),           // 7: nis.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),          // 8: nis.v1.ListUsersResponse
-	(*UpdateUserRequest)(nil),          // 9: nis.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),         // 10: nis.v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),          // 11: nis.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),         // 12: nis.v1.DeleteUserResponse
-	(*GetUserCredentialsRequest)(nil),  // 13: nis.v1.GetUserCredentialsRequest
-	(*GetUserCredentialsResponse)(nil), // 14: nis.v1.GetUserCredentialsResponse
-	(*timestamppb.Timestamp)(nil),      // 15: google.protobuf.Timestamp
-	(*ListOptions)(nil),                // 16: nis.v1.ListOptions
+	(*User)(nil),                          // 0: nis.v1.User
+	(*CreateUserRequest)(nil),             // 1: nis.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),            // 2: nis.v1.CreateUserResponse
+	(*GetUserRequest)(nil),                // 3: nis.v1.GetUserRequest
+	(*GetUserResponse)(nil),               // 4: nis.v1.GetUserResponse
+	(*GetUserByNameRequest)(nil),          // 5: nis.v1.GetUserByNameRequest
+	(*GetUserByNameResponse)(nil),         // 6: nis.v1.GetUserByNameResponse
+	(*ListUsersRequest)(nil),              // 7: nis.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),             // 8: nis.v1.ListUsersResponse
+	(*UpdateUserRequest)(nil),             // 9: nis.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),            // 10: nis.v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),             // 11: nis.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),            // 12: nis.v1.DeleteUserResponse
+	(*GetUserCredentialsRequest)(nil),     // 13: nis.v1.GetUserCredentialsRequest
+	(*GetUserCredentialsResponse)(nil),    // 14: nis.v1.GetUserCredentialsResponse
+	(*UserKey)(nil),                       // 15: nis.v1.UserKey
+	(*RotateUserCredentialsRequest)(nil),  // 16: nis.v1.RotateUserCredentialsRequest
+	(*RotateUserCredentialsResponse)(nil), // 17: nis.v1.RotateUserCredentialsResponse
+	(*ListUserKeysRequest)(nil),           // 18: nis.v1.ListUserKeysRequest
+	(*ListUserKeysResponse)(nil),          // 19: nis.v1.ListUserKeysResponse
+	(*RotationPolicy)(nil),                // 20: nis.v1.RotationPolicy
+	(*CreateRotationPolicyRequest)(nil),   // 21: nis.v1.CreateRotationPolicyRequest
+	(*CreateRotationPolicyResponse)(nil),  // 22: nis.v1.CreateRotationPolicyResponse
+	(*ListRotationPoliciesRequest)(nil),   // 23: nis.v1.ListRotationPoliciesRequest
+	(*ListRotationPoliciesResponse)(nil),  // 24: nis.v1.ListRotationPoliciesResponse
+	(*DeleteRotationPolicyRequest)(nil),   // 25: nis.v1.DeleteRotationPolicyRequest
+	(*DeleteRotationPolicyResponse)(nil),  // 26: nis.v1.DeleteRotationPolicyResponse
+	(*PlannedRotation)(nil),               // 27: nis.v1.PlannedRotation
+	(*PlanRotationsRequest)(nil),          // 28: nis.v1.PlanRotationsRequest
+	(*PlanRotationsResponse)(nil),         // 29: nis.v1.PlanRotationsResponse
+	(*timestamppb.Timestamp)(nil),         // 30: google.protobuf.Timestamp
+	(*ListOptions)(nil),                   // 31: nis.v1.ListOptions
+	(*AccountPush)(nil),                   // 32: nis.v1.AccountPush
 }
 var file_nis_v1_user_proto_depIdxs = []int32{
-	15, // 0: nis.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	15, // 1: nis.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 2: nis.v1.CreateUserResponse.user:type_name -> nis.v1.User
-	0,  // 3: nis.v1.GetUserResponse.user:type_name -> nis.v1.User
-	0,  // 4: nis.v1.GetUserByNameResponse.user:type_name -> nis.v1.User
-	16, // 5: nis.v1.ListUsersRequest.options:type_name -> nis.v1.ListOptions
-	0,  // 6: nis.v1.ListUsersResponse.users:type_name -> nis.v1.User
-	0,  // 7: nis.v1.UpdateUserResponse.user:type_name -> nis.v1.User
-	1,  // 8: nis.v1.UserService.CreateUser:input_type -> nis.v1.CreateUserRequest
-	3,  // 9: nis.v1.UserService.GetUser:input_type -> nis.v1.GetUserRequest
-	5,  // 10: nis.v1.UserService.GetUserByName:input_type -> nis.v1.GetUserByNameRequest
-	7,  // 11: nis.v1.UserService.ListUsers:input_type -> nis.v1.ListUsersRequest
-	9,  // 12: nis.v1.UserService.UpdateUser:input_type -> nis.v1.UpdateUserRequest
-	11, // 13: nis.v1.UserService.DeleteUser:input_type -> nis.v1.DeleteUserRequest
-	13, // 14: nis.v1.UserService.GetUserCredentials:input_type -> nis.v1.GetUserCredentialsRequest
-	2,  // 15: nis.v1.UserService.CreateUser:output_type -> nis.v1.CreateUserResponse
-	4,  // 16: nis.v1.UserService.GetUser:output_type -> nis.v1.GetUserResponse
-	6,  // 17: nis.v1.UserService.GetUserByName:output_type -> nis.v1.GetUserByNameResponse
-	8,  // 18: nis.v1.UserService.ListUsers:output_type -> nis.v1.ListUsersResponse
-	10, // 19: nis.v1.UserService.UpdateUser:output_type -> nis.v1.UpdateUserResponse
-	12, // 20: nis.v1.UserService.DeleteUser:output_type -> nis.v1.DeleteUserResponse
-	14, // 21: nis.v1.UserService.GetUserCredentials:output_type -> nis.v1.GetUserCredentialsResponse
-	15, // [15:22] is the sub-list for method output_type
-	8,  // [8:15] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	30, // 0: nis.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	30, // 1: nis.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	30, // 2: nis.v1.User.last_connected_at:type_name -> google.protobuf.Timestamp
+	30, // 3: nis.v1.User.last_seen_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: nis.v1.CreateUserResponse.user:type_name -> nis.v1.User
+	0,  // 5: nis.v1.GetUserResponse.user:type_name -> nis.v1.User
+	0,  // 6: nis.v1.GetUserByNameResponse.user:type_name -> nis.v1.User
+	31, // 7: nis.v1.ListUsersRequest.options:type_name -> nis.v1.ListOptions
+	0,  // 8: nis.v1.ListUsersResponse.users:type_name -> nis.v1.User
+	0,  // 9: nis.v1.UpdateUserResponse.user:type_name -> nis.v1.User
+	30, // 10: nis.v1.UserKey.created_at:type_name -> google.protobuf.Timestamp
+	30, // 11: nis.v1.UserKey.retired_at:type_name -> google.protobuf.Timestamp
+	30, // 12: nis.v1.UserKey.revoke_at:type_name -> google.protobuf.Timestamp
+	30, // 13: nis.v1.UserKey.revoked_at:type_name -> google.protobuf.Timestamp
+	0,  // 14: nis.v1.RotateUserCredentialsResponse.user:type_name -> nis.v1.User
+	15, // 15: nis.v1.RotateUserCredentialsResponse.retired_key:type_name -> nis.v1.UserKey
+	32, // 16: nis.v1.RotateUserCredentialsResponse.pushed:type_name -> nis.v1.AccountPush
+	15, // 17: nis.v1.ListUserKeysResponse.keys:type_name -> nis.v1.UserKey
+	30, // 18: nis.v1.RotationPolicy.created_at:type_name -> google.protobuf.Timestamp
+	30, // 19: nis.v1.RotationPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	20, // 20: nis.v1.CreateRotationPolicyResponse.policy:type_name -> nis.v1.RotationPolicy
+	20, // 21: nis.v1.ListRotationPoliciesResponse.policies:type_name -> nis.v1.RotationPolicy
+	30, // 22: nis.v1.PlannedRotation.last_rotated_at:type_name -> google.protobuf.Timestamp
+	30, // 23: nis.v1.PlannedRotation.due_at:type_name -> google.protobuf.Timestamp
+	27, // 24: nis.v1.PlanRotationsResponse.rotations:type_name -> nis.v1.PlannedRotation
+	1,  // 25: nis.v1.UserService.CreateUser:input_type -> nis.v1.CreateUserRequest
+	3,  // 26: nis.v1.UserService.GetUser:input_type -> nis.v1.GetUserRequest
+	5,  // 27: nis.v1.UserService.GetUserByName:input_type -> nis.v1.GetUserByNameRequest
+	7,  // 28: nis.v1.UserService.ListUsers:input_type -> nis.v1.ListUsersRequest
+	9,  // 29: nis.v1.UserService.UpdateUser:input_type -> nis.v1.UpdateUserRequest
+	11, // 30: nis.v1.UserService.DeleteUser:input_type -> nis.v1.DeleteUserRequest
+	13, // 31: nis.v1.UserService.GetUserCredentials:input_type -> nis.v1.GetUserCredentialsRequest
+	16, // 32: nis.v1.UserService.RotateUserCredentials:input_type -> nis.v1.RotateUserCredentialsRequest
+	18, // 33: nis.v1.UserService.ListUserKeys:input_type -> nis.v1.ListUserKeysRequest
+	21, // 34: nis.v1.UserService.CreateRotationPolicy:input_type -> nis.v1.CreateRotationPolicyRequest
+	23, // 35: nis.v1.UserService.ListRotationPolicies:input_type -> nis.v1.ListRotationPoliciesRequest
+	25, // 36: nis.v1.UserService.DeleteRotationPolicy:input_type -> nis.v1.DeleteRotationPolicyRequest
+	28, // 37: nis.v1.UserService.PlanRotations:input_type -> nis.v1.PlanRotationsRequest
+	2,  // 38: nis.v1.UserService.CreateUser:output_type -> nis.v1.CreateUserResponse
+	4,  // 39: nis.v1.UserService.GetUser:output_type -> nis.v1.GetUserResponse
+	6,  // 40: nis.v1.UserService.GetUserByName:output_type -> nis.v1.GetUserByNameResponse
+	8,  // 41: nis.v1.UserService.ListUsers:output_type -> nis.v1.ListUsersResponse
+	10, // 42: nis.v1.UserService.UpdateUser:output_type -> nis.v1.UpdateUserResponse
+	12, // 43: nis.v1.UserService.DeleteUser:output_type -> nis.v1.DeleteUserResponse
+	14, // 44: nis.v1.UserService.GetUserCredentials:output_type -> nis.v1.GetUserCredentialsResponse
+	17, // 45: nis.v1.UserService.RotateUserCredentials:output_type -> nis.v1.RotateUserCredentialsResponse
+	19, // 46: nis.v1.UserService.ListUserKeys:output_type -> nis.v1.ListUserKeysResponse
+	22, // 47: nis.v1.UserService.CreateRotationPolicy:output_type -> nis.v1.CreateRotationPolicyResponse
+	24, // 48: nis.v1.UserService.ListRotationPolicies:output_type -> nis.v1.ListRotationPoliciesResponse
+	26, // 49: nis.v1.UserService.DeleteRotationPolicy:output_type -> nis.v1.DeleteRotationPolicyResponse
+	29, // 50: nis.v1.UserService.PlanRotations:output_type -> nis.v1.PlanRotationsResponse
+	38, // [38:51] is the sub-list for method output_type
+	25, // [25:38] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_nis_v1_user_proto_init() }
@@ -956,7 +2058,7 @@ func file_nis_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nis_v1_user_proto_rawDesc), len(file_nis_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+)
+
+// ErrInvalidMove is returned when an account cannot be moved to the requested operator
+var ErrInvalidMove = errors.New("invalid account move")
+
+// AccountMove reports the move of an account to another operator
+type AccountMove struct {
+	Account        *entities.Account
+	FromOperatorID uuid.UUID
+	// Removed lists the clusters of the old operator the account was deleted from
+	Removed []PlacementRemoval
+	// Pushed lists the clusters of the new operator the account was pushed to
+	Pushed []AccountPush
+	// Warnings lists the clusters of the new operator whose JetStream capacity the
+	// account's limits over-commit
+	Warnings []string
+}
+
+// AccountPush reports the push of an account JWT to a cluster
+type AccountPush struct {
+	ClusterID   uuid.UUID
+	ClusterName string
+	Error       string // Set when the cluster could not be reached, its next sync retries
+}
+
+// MoveAccount moves an account to another operator. The account JWT is re-signed by
+// the new operator, keeping the account's identity key, so its users and scoped keys
+// stay valid. The account is deleted from the old operator's clusters and pushed to
+// the new operator's clusters. Its placement names clusters of the old operator and
+// is cleared, placing it on every cluster of the new one. The rotation policies of the
+// account and of its scoped keys follow it to the new operator. Accounts of a locked down
+// operator cannot be moved, as the new operator would lift the lockdown's revocation.
+func (s *AccountService) MoveAccount(ctx context.Context, accountID, targetOperatorID uuid.UUID) (*AccountMove, error) {
+	account, err := s.repo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.OperatorID == targetOperatorID {
+		return nil, fmt.Errorf("%w: account %s already belongs to the target operator", ErrInvalidMove, account.Name)
+	}
+	sourceOperator, err := s.operatorRepo.GetByID(ctx, account.OperatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator: %w", err)
+	}
+	if account.PublicKey == sourceOperator.SystemAccountPubKey {
+		return nil, fmt.Errorf("%w: system accounts cannot be moved", ErrInvalidMove)
+	}
+	if sourceOperator.LockedDown() {
+		return nil, fmt.Errorf("%w: operator %s was locked down by %s: %s",
+			ErrOperatorLockedDown, sourceOperator.Name, sourceOperator.LockedDownBy, sourceOperator.LockdownReason)
+	}
+	targetOperator, err := s.operatorRepo.GetByID(ctx, targetOperatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target operator: %w", err)
+	}
+
+	existing, err := s.repo.GetByName(ctx, targetOperatorID, account.Name)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("failed to check existing account: %w", err)
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("%w: operator %s already has an account named %s",
+			repositories.ErrAlreadyExists, targetOperator.Name, account.Name)
+	}
+
+	oldClusters, err := s.clusters.repo.ListByOperator(ctx, account.OperatorID, repositories.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	if err := s.clusters.checkLeafnodeBindings(ctx, account, oldClusters); err != nil {
+		return nil, err
+	}
+	placements, err := s.clusters.placementRepo.ListByAccount(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account placements: %w", err)
+	}
+	placedOn := buildAccountPlacement(account, oldClusters, newPlacementIndex(placements)).Clusters
+
+	scopedKeys, err := s.scopedKeyRepo.ListByAccount(ctx, account.ID, repositories.ListOptions{Limit: 1000})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scoped signing keys: %w", err)
+	}
+
+	moved := *account
+	moved.OperatorID = targetOperatorID
+	moved.JWT, err = s.jwtService.GenerateAccountJWT(ctx, &moved, targetOperator, scopedKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to regenerate account JWT: %w", err)
+	}
+	moved.UpdatedAt = time.Now()
+
+	if err := s.repo.Update(ctx, &moved); err != nil {
+		return nil, fmt.Errorf("failed to update account: %w", err)
+	}
+	// Cleared once the account moved, so a failed move keeps its placement
+	if err := s.clusters.placementRepo.Replace(ctx, account.ID, nil); err != nil {
+		return nil, fmt.Errorf("account moved but failed to clear its placement: %w", err)
+	}
+	if err := s.users.moveAccountPolicies(ctx, account.ID, targetOperatorID); err != nil {
+		return nil, fmt.Errorf("account moved but failed to move its rotation policies: %w", err)
+	}
+
+	result := &AccountMove{Account: &moved, FromOperatorID: account.OperatorID}
+	if len(placedOn) > 0 {
+		result.Removed = s.clusters.removeAccountFromClusters(ctx, account.OperatorID, account, placedOn)
+	}
+
+	result.Pushed = s.clusters.pushAccountToClusters(ctx, &moved)
+
+	result.Warnings = s.JetStreamCapacityWarnings(ctx, &moved)
+
+	logging.LogFromContext(ctx).Info("moved account",
+		"account", moved.Name, "from", sourceOperator.Name, "to", targetOperator.Name)
+	return result, nil
+}
+
+// checkLeafnodeBindings rejects moving an account whose users are bound to a hub
+// cluster of its operator by a leafnode profile, as the hub would lose the account
+func (s *ClusterService) checkLeafnodeBindings(ctx context.Context, account *entities.Account, clusters []*entities.Cluster) error {
+	for _, cluster := range clusters {
+		profiles, err := s.leafnodeRepo.ListByCluster(ctx, cluster.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list leafnode profiles: %w", err)
+		}
+		for _, profile := range profiles {
+			user, err := s.userRepo.GetByID(ctx, profile.UserID)
+			if err != nil {
+				return fmt.Errorf("failed to get leafnode profile user: %w", err)
+			}
+			if user.AccountID == account.ID {
+				return fmt.Errorf("%w: user %s is bound to hub cluster %s by leafnode profile %s, delete the profile first",
+					ErrInvalidMove, user.Name, cluster.Name, profile.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+)
+
+type AccountMoveTestSuite struct {
+	serviceSuite
+}
+
+func (s *AccountMoveTestSuite) TearDownTest() {
+	s.emptyTables("users", "scoped_signing_keys", "accounts", "operators")
+}
+
+func TestAccountMoveSuite(t *testing.T) {
+	suite.Run(t, new(AccountMoveTestSuite))
+}
+
+// TestMoveAccount tests that moving an account re-signs it under the new operator and keeps its keys and users
+func (s *AccountMoveTestSuite) TestMoveAccount() {
+	teamA := s.createOperator("team-a")
+	teamB := s.createOperator("team-b")
+
+	account := s.createAccount(teamA.ID, "orders")
+	scopedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, account.ID, "default")
+	require.NoError(s.T(), err)
+	user, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+
+	move, err := s.accountService.MoveAccount(s.ctx, account.ID, teamB.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), teamA.ID, move.FromOperatorID)
+
+	moved, err := s.accountRepo.GetByID(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), teamB.ID, moved.OperatorID)
+	assert.Equal(s.T(), account.PublicKey, moved.PublicKey)
+	assert.Equal(s.T(), account.EncryptedSeed, moved.EncryptedSeed)
+
+	claims, err := jwt.DecodeAccountClaims(moved.JWT)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), teamB.PublicKey, claims.Issuer)
+	assert.Equal(s.T(), account.PublicKey, claims.Subject)
+	assert.True(s.T(), claims.SigningKeys.Contains(scopedKey.PublicKey))
+
+	// Users and scoped keys are untouched
+	keptUser, err := s.userRepo.GetByID(s.ctx, user.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), user.JWT, keptUser.JWT)
+	_, err = s.scopedSigningKeyRepo.GetByID(s.ctx, scopedKey.ID)
+	assert.NoError(s.T(), err)
+}
+
+// TestMoveAccount_Invalid tests that moves to the same operator, of system accounts or onto a taken name are rejected
+func (s *AccountMoveTestSuite) TestMoveAccount_Invalid() {
+	teamA := s.createOperator("team-a")
+	teamB := s.createOperator("team-b")
+
+	account := s.createAccount(teamA.ID, "orders")
+	_, err := s.accountService.MoveAccount(s.ctx, account.ID, teamA.ID)
+	assert.ErrorIs(s.T(), err, ErrInvalidMove)
+
+	sysAccount, err := s.accountService.GetAccountByName(s.ctx, teamA.ID, "$SYS")
+	require.NoError(s.T(), err)
+	_, err = s.accountService.MoveAccount(s.ctx, sysAccount.ID, teamB.ID)
+	assert.ErrorIs(s.T(), err, ErrInvalidMove)
+
+	_, err = s.accountService.CreateAccount(s.ctx, CreateAccountRequest{OperatorID: teamB.ID, Name: "orders"})
+	require.NoError(s.T(), err)
+	_, err = s.accountService.MoveAccount(s.ctx, account.ID, teamB.ID)
+	assert.ErrorIs(s.T(), err, repositories.ErrAlreadyExists)
+}
+
+// TestMoveAccount_LockedDown tests that accounts of a locked down operator cannot be moved
+func (s *AccountMoveTestSuite) TestMoveAccount_LockedDown() {
+	teamA := s.createOperator("team-a")
+	teamB := s.createOperator("team-b")
+
+	account := s.createAccount(teamA.ID, "orders")
+	_, err := s.operatorService.LockdownOperator(s.ctx, teamA.ID, "admin", "leaked credentials")
+	require.NoError(s.T(), err)
+
+	_, err = s.accountService.MoveAccount(s.ctx, account.ID, teamB.ID)
+	assert.ErrorIs(s.T(), err, ErrOperatorLockedDown)
+
+	kept, err := s.accountRepo.GetByID(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), teamA.ID, kept.OperatorID)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,6 +22,11 @@ type AccountService struct {
 	scopedKeyRepo repositories.ScopedSigningKeyRepository
 	jwtService    *JWTService
 	encryptor     encryption.Encryptor
+
+	capacity       JetStreamCapacityChecker
+	denyOvercommit bool
+	clusters       *ClusterService
+	users          *UserService
 }
 
 // NewAccountService creates a new account service
@@ -40,6 +46,55 @@ func NewAccountService(
 	}
 }
 
+// SetJetStreamCapacityChecker makes CreateAccount and UpdateJetStreamLimits check the
+// account's JetStream limits against cluster capacity. With deny, limits that would
+// over-commit a cluster are rejected with ErrJetStreamOvercommit instead of only
+// being reported by JetStreamCapacityWarnings.
+func (s *AccountService) SetJetStreamCapacityChecker(checker JetStreamCapacityChecker, deny bool) {
+	s.capacity = checker
+	s.denyOvercommit = deny
+}
+
+// SetClusterService gives the account operations reaching the NATS servers, such as
+// GetAccountUsage, access to the clusters of the account's operator
+func (s *AccountService) SetClusterService(clusters *ClusterService) {
+	s.clusters = clusters
+}
+
+// SetUserService gives the account operations touching users and their rotation
+// policies, such as MoveAccount, access to the user service
+func (s *AccountService) SetUserService(users *UserService) {
+	s.users = users
+}
+
+// JetStreamCapacityWarnings returns the clusters whose JetStream capacity the account's
+// limits over-commit. Capacity check failures are logged, not returned.
+func (s *AccountService) JetStreamCapacityWarnings(ctx context.Context, account *entities.Account) []string {
+	if s.capacity == nil || !account.JetStreamEnabled {
+		return nil
+	}
+
+	warnings, err := s.capacity.CheckJetStreamCapacity(ctx, account)
+	if err != nil {
+		logging.LogFromContext(ctx).Warn("failed to check JetStream capacity",
+			"account", account.Name, "error", err)
+		return nil
+	}
+	return warnings
+}
+
+// enforceJetStreamCapacity rejects limits that over-commit a cluster when over-commit
+// is denied
+func (s *AccountService) enforceJetStreamCapacity(ctx context.Context, account *entities.Account) error {
+	if !s.denyOvercommit {
+		return nil
+	}
+	if warnings := s.JetStreamCapacityWarnings(ctx, account); len(warnings) > 0 {
+		return fmt.Errorf("%w: %s", ErrJetStreamOvercommit, strings.Join(warnings, "; "))
+	}
+	return nil
+}
+
 // CreateAccountRequest contains the data needed to create an account
 type CreateAccountRequest struct {
 	OperatorID            uuid.UUID
@@ -114,6 +169,10 @@ func (s *AccountService) CreateAccount(ctx context.Context, req CreateAccountReq
 		UpdatedAt:             time.Now(),
 	}
 
+	if err := s.enforceJetStreamCapacity(ctx, account); err != nil {
+		return nil, err
+	}
+
 	// Generate JWT signed by operator, declaring the default scoped key as a signer.
 	jwt, err := s.jwtService.GenerateAccountJWT(ctx, account, operator, []*entities.ScopedSigningKey{defaultKey})
 	if err != nil {
@@ -294,6 +353,10 @@ func (s *AccountService) UpdateJetStreamLimits(ctx context.Context, id uuid.UUID
 	account.JetStreamMaxConsumers = req.MaxConsumers
 	account.UpdatedAt = time.Now()
 
+	if err := s.enforceJetStreamCapacity(ctx, account); err != nil {
+		return nil, err
+	}
+
 	// Get operator to sign the updated JWT
 	operator, err := s.operatorRepo.GetByID(ctx, account.OperatorID)
 	if err != nil {
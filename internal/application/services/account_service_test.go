@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/thomas-maurice/nis/internal/config"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
 	"github.com/thomas-maurice/nis/internal/domain/repositories"
 	"github.com/thomas-maurice/nis/internal/infrastructure/encryption"
 	"github.com/thomas-maurice/nis/internal/infrastructure/persistence/sql"
@@ -70,8 +71,8 @@ func (s *AccountServiceTestSuite) SetupSuite() {
 	s.scopedSigningKeyRepo = sql.NewScopedSigningKeyRepo(s.db)
 
 	s.accountService = NewAccountService(s.accountRepo, s.operatorRepo, s.scopedSigningKeyRepo, s.jwtService, s.encryptor)
-	s.operatorService = NewOperatorService(s.operatorRepo, s.accountRepo, s.userRepo, s.accountService, s.jwtService, s.encryptor)
-	s.userService = NewUserService(s.userRepo, s.accountRepo, s.scopedSigningKeyRepo, s.jwtService, s.encryptor)
+	s.userService = NewUserService(s.userRepo, s.accountRepo, s.operatorRepo, s.scopedSigningKeyRepo, sql.NewUserKeyRepo(s.db), sql.NewRotationPolicyRepo(s.db), s.jwtService, s.encryptor)
+	s.operatorService = NewOperatorService(s.operatorRepo, s.accountRepo, s.userRepo, s.accountService, s.userService, s.jwtService, s.encryptor)
 }
 
 func (s *AccountServiceTestSuite) TearDownSuite() {
@@ -384,6 +385,60 @@ func (s *AccountServiceTestSuite) TestUpdateJetStreamLimits() {
 	assert.NotEqual(s.T(), created.JWT, updated.JWT) // JWT should be regenerated
 }
 
+// fakeCapacityChecker reports a fixed over-commit for accounts above a storage limit
+type fakeCapacityChecker struct {
+	maxStorage int64
+}
+
+func (f *fakeCapacityChecker) CheckJetStreamCapacity(_ context.Context, account *entities.Account) ([]string, error) {
+	if account.JetStreamMaxStorage > f.maxStorage {
+		return []string{fmt.Sprintf("cluster test: %d bytes assigned", account.JetStreamMaxStorage)}, nil
+	}
+	return nil, nil
+}
+
+// TestJetStreamCapacity tests the over-commit warnings and the deny policy
+func (s *AccountServiceTestSuite) TestJetStreamCapacity() {
+	defer s.accountService.SetJetStreamCapacityChecker(nil, false)
+
+	operator, err := s.operatorService.CreateOperator(s.ctx, *s.createTestOperator("Capacity Operator"))
+	require.NoError(s.T(), err)
+
+	// Warn policy: the account is created and the over-commit is reported
+	s.accountService.SetJetStreamCapacityChecker(&fakeCapacityChecker{maxStorage: 1 << 30}, false)
+	account, err := s.accountService.CreateAccount(s.ctx, CreateAccountRequest{
+		OperatorID:          operator.ID,
+		Name:                "Big Account",
+		JetStreamEnabled:    true,
+		JetStreamMaxMemory:  -1,
+		JetStreamMaxStorage: 2 << 30,
+	})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), s.accountService.JetStreamCapacityWarnings(s.ctx, account), 1)
+
+	// Deny policy: raising the limits is rejected and nothing is saved
+	s.accountService.SetJetStreamCapacityChecker(&fakeCapacityChecker{maxStorage: 1 << 30}, true)
+	_, err = s.accountService.UpdateJetStreamLimits(s.ctx, account.ID, UpdateJetStreamLimitsRequest{
+		Enabled:    true,
+		MaxMemory:  -1,
+		MaxStorage: 4 << 30,
+	})
+	require.ErrorIs(s.T(), err, ErrJetStreamOvercommit)
+
+	stored, err := s.accountService.GetAccount(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2<<30), stored.JetStreamMaxStorage)
+
+	// Limits within capacity pass
+	updated, err := s.accountService.UpdateJetStreamLimits(s.ctx, account.ID, UpdateJetStreamLimitsRequest{
+		Enabled:    true,
+		MaxMemory:  -1,
+		MaxStorage: 1 << 30,
+	})
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), s.accountService.JetStreamCapacityWarnings(s.ctx, updated))
+}
+
 // TestDeleteAccount tests account deletion
 func (s *AccountServiceTestSuite) TestDeleteAccount() {
 	operator, err := s.operatorService.CreateOperator(s.ctx, *s.createTestOperator("Test Operator"))
@@ -0,0 +1,305 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
+)
+
+const (
+	// DefaultAccountStatsInterval is how often the account statistics are collected
+	DefaultAccountStatsInterval = 60 * time.Second
+	// AccountStatsRawResolution is the bucket length of the detailed account statistics
+	AccountStatsRawResolution = time.Minute
+	// AccountStatsRawRetention is how long the detailed account statistics are kept
+	AccountStatsRawRetention = 48 * time.Hour
+	// AccountStatsHourlyResolution is the bucket length the statistics are downsampled to
+	AccountStatsHourlyResolution = time.Hour
+	// DefaultAccountStatsRetention is how long the hourly account statistics are kept
+	DefaultAccountStatsRetention = 90 * 24 * time.Hour
+
+	// statzAccountBatch is the number of accounts asked for in a single STATZ request
+	statzAccountBatch = 256
+)
+
+// SetAccountStatsRetention overrides how long the hourly account statistics are kept.
+// Non-positive values keep the current setting.
+func (s *ClusterService) SetAccountStatsRetention(retention time.Duration) {
+	if retention > 0 {
+		s.accountStatsRetention = retention
+	}
+}
+
+// AccountStatsSeries is the result of GetAccountStats
+type AccountStatsSeries struct {
+	Resolution time.Duration
+	// Points holds one bucket per cluster and time, oldest first. Buckets without any
+	// connection or traffic are absent.
+	Points []*entities.AccountStats
+}
+
+// GetAccountStats returns the traffic of an account between from and to, on the given
+// cluster or on every cluster when clusterID is nil. A zero resolution picks the
+// detailed buckets when they still cover from, and the hourly ones otherwise.
+func (s *AccountService) GetAccountStats(ctx context.Context, accountID uuid.UUID, clusterID *uuid.UUID, from, to time.Time, resolution time.Duration) (*AccountStatsSeries, error) {
+	if _, err := s.repo.GetByID(ctx, accountID); err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+	if clusterID != nil {
+		if _, err := s.clusters.repo.GetByID(ctx, *clusterID); err != nil {
+			return nil, fmt.Errorf("failed to get cluster: %w", err)
+		}
+	}
+
+	switch resolution {
+	case 0:
+		resolution = AccountStatsHourlyResolution
+		if from.After(time.Now().Add(-AccountStatsRawRetention)) {
+			resolution = AccountStatsRawResolution
+		}
+	case AccountStatsRawResolution, AccountStatsHourlyResolution:
+	default:
+		return nil, fmt.Errorf("unsupported resolution %s (expected %s or %s)",
+			resolution, AccountStatsRawResolution, AccountStatsHourlyResolution)
+	}
+
+	points, err := s.clusters.accountStats.List(ctx, repositories.AccountStatsFilter{
+		AccountID:  accountID,
+		ClusterID:  clusterID,
+		Resolution: resolution,
+		From:       from.Truncate(resolution),
+		To:         to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountStatsSeries{Resolution: resolution, Points: points}, nil
+}
+
+// AccountStatsCollector periodically asks every managed cluster for the STATZ of its
+// operator's accounts and records their traffic. The servers report counters since
+// they started, so the collector keeps the previous sample of every server and
+// account to record the difference. Only the leader replica collects.
+type AccountStatsCollector struct {
+	clusters *ClusterService
+
+	mu       sync.Mutex
+	previous map[uuid.UUID]map[statzKey]nats.AccountStatz
+}
+
+// statzKey identifies the counters of an account on one server
+type statzKey struct {
+	server  string
+	account string
+}
+
+// NewAccountStatsCollector creates a collector recording into the cluster service's
+// account statistics
+func NewAccountStatsCollector(clusters *ClusterService) *AccountStatsCollector {
+	return &AccountStatsCollector{
+		clusters: clusters,
+		previous: make(map[uuid.UUID]map[statzKey]nats.AccountStatz),
+	}
+}
+
+// Run collects every interval until ctx is done. The previous samples are dropped
+// whenever isLeader reports false, so a replica taking over does not record the
+// traffic of the period it did not observe.
+func (c *AccountStatsCollector) Run(ctx context.Context, interval time.Duration, isLeader func() bool) {
+	if interval <= 0 {
+		interval = DefaultAccountStatsInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !isLeader() {
+				c.Reset()
+				continue
+			}
+			if err := c.Collect(ctx); err != nil {
+				logging.LogFromContext(ctx).Error("account statistics collection error", "error", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Reset forgets the previous samples
+func (c *AccountStatsCollector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.previous = make(map[uuid.UUID]map[statzKey]nats.AccountStatz)
+}
+
+// Collect records one sample of every managed cluster and prunes the statistics past
+// their retention. Clusters that cannot be reached are logged and skipped.
+func (c *AccountStatsCollector) Collect(ctx context.Context) error {
+	log := logging.LogFromContext(ctx)
+	now := time.Now().UTC()
+
+	seen := make(map[uuid.UUID]bool)
+	for offset := 0; ; offset += syncPageSize {
+		clusters, err := c.clusters.repo.List(ctx, repositories.ListOptions{
+			Limit:  syncPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list clusters: %w", err)
+		}
+
+		for _, cluster := range clusters {
+			if cluster.EncryptedCreds == "" {
+				continue
+			}
+			seen[cluster.ID] = true
+			if err := c.collectCluster(ctx, cluster, now); err != nil {
+				log.Warn("failed to collect account statistics", "cluster", cluster.Name, "error", err)
+			}
+		}
+
+		if len(clusters) < syncPageSize {
+			break
+		}
+	}
+
+	c.mu.Lock()
+	for id := range c.previous {
+		if !seen[id] {
+			delete(c.previous, id)
+		}
+	}
+	c.mu.Unlock()
+
+	if _, err := c.clusters.accountStats.DeleteBefore(ctx, AccountStatsRawResolution, now.Add(-AccountStatsRawRetention)); err != nil {
+		log.Warn("failed to prune account statistics", "error", err)
+	}
+	if _, err := c.clusters.accountStats.DeleteBefore(ctx, AccountStatsHourlyResolution, now.Add(-c.clusters.accountStatsRetention)); err != nil {
+		log.Warn("failed to prune account statistics", "error", err)
+	}
+
+	return nil
+}
+
+// collectCluster samples the accounts of a cluster's operator and records their traffic
+// since the previous sample
+func (c *AccountStatsCollector) collectCluster(ctx context.Context, cluster *entities.Cluster, now time.Time) error {
+	accounts, err := c.clusters.listOperatorAccounts(ctx, cluster.OperatorID)
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 {
+		return nil
+	}
+
+	connectCtx, cancel := context.WithTimeout(ctx, defaultConnectTimeout)
+	defer cancel()
+	natsClient, _, err := c.clusters.clusterClient(connectCtx, cluster.ID)
+	if err != nil {
+		return err
+	}
+
+	servers, err := natsClient.PingServers(ctx)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[statzKey]nats.AccountStatz)
+	for start := 0; start < len(accounts); start += statzAccountBatch {
+		batch := accounts[start:min(start+statzAccountBatch, len(accounts))]
+		keys := make([]string, len(batch))
+		for i, account := range batch {
+			keys[i] = account.PublicKey
+		}
+
+		replies, err := natsClient.AccountStatz(ctx, keys, len(servers))
+		if err != nil {
+			return err
+		}
+		for _, reply := range replies {
+			if reply.Error != "" {
+				logging.LogFromContext(ctx).Warn("server failed to report account statistics",
+					"cluster", cluster.Name, "server", reply.Server.Label(), "error", reply.Error)
+				continue
+			}
+			for _, stats := range reply.Accounts {
+				current[statzKey{server: reply.Server.ID, account: stats.Account}] = stats
+			}
+		}
+	}
+
+	c.mu.Lock()
+	previous := c.previous[cluster.ID]
+	c.previous[cluster.ID] = current
+	c.mu.Unlock()
+
+	samples := accountStatsDeltas(previous, current)
+	for _, account := range accounts {
+		sample, ok := samples[account.PublicKey]
+		if !ok || idleAccountStats(sample) {
+			continue
+		}
+		for _, resolution := range []time.Duration{AccountStatsRawResolution, AccountStatsHourlyResolution} {
+			stats := sample
+			stats.ID = uuid.New()
+			stats.ClusterID = cluster.ID
+			stats.AccountID = account.ID
+			stats.Resolution = resolution
+			stats.BucketStart = now.Truncate(resolution)
+			if err := c.clusters.accountStats.Record(ctx, &stats); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// idleAccountStats tells whether a sample saw neither connections nor traffic
+func idleAccountStats(s entities.AccountStats) bool {
+	return s.ConnectionsSum == 0 && s.MsgsIn == 0 && s.MsgsOut == 0 &&
+		s.BytesIn == 0 && s.BytesOut == 0 && s.SlowConsumers == 0
+}
+
+// accountStatsDeltas sums the per-server STATZ of every account into a single sample.
+// Connections are the current counts, while traffic is the difference with the
+// previous sample of the same server: servers without one contribute no traffic, and
+// counters that went down, because the server restarted, count from zero.
+func accountStatsDeltas(previous, current map[statzKey]nats.AccountStatz) map[string]entities.AccountStats {
+	delta := func(cur, prev int64) int64 {
+		if cur < prev {
+			return cur
+		}
+		return cur - prev
+	}
+
+	samples := make(map[string]entities.AccountStats)
+	for key, cur := range current {
+		sample := samples[key.account]
+		sample.Samples = 1
+		sample.ConnectionsSum += int64(cur.Conns)
+		sample.MaxConnections = sample.ConnectionsSum
+
+		if prev, ok := previous[key]; ok {
+			sample.MsgsIn += delta(cur.Received.Msgs, prev.Received.Msgs)
+			sample.MsgsOut += delta(cur.Sent.Msgs, prev.Sent.Msgs)
+			sample.BytesIn += delta(cur.Received.Bytes, prev.Received.Bytes)
+			sample.BytesOut += delta(cur.Sent.Bytes, prev.Sent.Bytes)
+			sample.SlowConsumers += delta(cur.SlowConsumers, prev.SlowConsumers)
+		}
+		samples[key.account] = sample
+	}
+
+	return samples
+}
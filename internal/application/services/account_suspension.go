@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+)
+
+// ErrInvalidSuspension is returned when an account cannot be suspended or resumed
+var ErrInvalidSuspension = errors.New("invalid account suspension")
+
+// AccountSuspension reports the suspension or resumption of an account
+type AccountSuspension struct {
+	Account *entities.Account
+	// Pushed lists the clusters the re-signed account JWT was pushed to
+	Pushed []AccountPush
+}
+
+// SuspendAccount suspends an account: its JWT is re-signed allowing no connection and
+// pushed to the clusters it is placed on, whose servers disconnect its clients. With
+// revokeUsers, every user JWT issued so far is revoked as well, for good: the users
+// need new credentials once the account is resumed. Suspending a suspended account
+// updates the reason and can add the revocation.
+func (s *AccountService) SuspendAccount(ctx context.Context, accountID uuid.UUID, suspendedBy, reason string, revokeUsers bool) (*AccountSuspension, error) {
+	account, err := s.repo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	operator, err := s.operatorRepo.GetByID(ctx, account.OperatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator: %w", err)
+	}
+	if account.PublicKey == operator.SystemAccountPubKey {
+		return nil, fmt.Errorf("%w: the system account cannot be suspended", ErrInvalidSuspension)
+	}
+
+	now := time.Now()
+	if account.SuspendedAt == nil {
+		account.SuspendedAt = &now
+	}
+	account.SuspendedBy = suspendedBy
+	account.SuspendReason = reason
+	if revokeUsers {
+		account.UsersRevokedAt = &now
+	}
+	if err := s.clusters.resignAccount(ctx, account, operator); err != nil {
+		return nil, err
+	}
+
+	logging.LogFromContext(ctx).Info("suspended account",
+		"account", account.Name, "by", suspendedBy, "reason", reason, "revoke_users", revokeUsers)
+	return &AccountSuspension{Account: account, Pushed: s.clusters.pushAccountToClusters(ctx, account)}, nil
+}
+
+// ResumeAccount resumes a suspended account, restoring its connection limits. A user
+// revocation made by SuspendAccount is kept.
+func (s *AccountService) ResumeAccount(ctx context.Context, accountID uuid.UUID) (*AccountSuspension, error) {
+	account, err := s.repo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if !account.Suspended() {
+		return nil, fmt.Errorf("%w: account %s is not suspended", ErrInvalidSuspension, account.Name)
+	}
+	operator, err := s.operatorRepo.GetByID(ctx, account.OperatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator: %w", err)
+	}
+
+	account.SuspendedAt = nil
+	account.SuspendedBy = ""
+	account.SuspendReason = ""
+	if err := s.clusters.resignAccount(ctx, account, operator); err != nil {
+		return nil, err
+	}
+
+	logging.LogFromContext(ctx).Info("resumed account", "account", account.Name)
+	return &AccountSuspension{Account: account, Pushed: s.clusters.pushAccountToClusters(ctx, account)}, nil
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type AccountSuspensionTestSuite struct {
+	serviceSuite
+}
+
+func (s *AccountSuspensionTestSuite) TearDownTest() {
+	s.emptyTables("users", "scoped_signing_keys", "accounts", "operators")
+}
+
+func TestAccountSuspensionSuite(t *testing.T) {
+	suite.Run(t, new(AccountSuspensionTestSuite))
+}
+
+// TestSuspendAccount tests that suspending an account disables its connections until it is resumed
+func (s *AccountSuspensionTestSuite) TestSuspendAccount() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+
+	suspension, err := s.accountService.SuspendAccount(s.ctx, account.ID, "admin", "unpaid invoice", true)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), suspension.Account.Suspended())
+	assert.Equal(s.T(), "admin", suspension.Account.SuspendedBy)
+	assert.Equal(s.T(), "unpaid invoice", suspension.Account.SuspendReason)
+	require.NotNil(s.T(), suspension.Account.UsersRevokedAt)
+
+	claims, err := jwt.DecodeAccountClaims(suspension.Account.JWT)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(0), claims.Limits.Conn)
+	assert.Equal(s.T(), int64(0), claims.Limits.LeafNodeConn)
+	assert.Contains(s.T(), claims.Revocations, jwt.All)
+
+	// Other updates re-sign the account and keep it suspended
+	newDesc := "suspended"
+	_, err = s.accountService.UpdateAccount(s.ctx, account.ID, UpdateAccountRequest{Description: &newDesc})
+	require.NoError(s.T(), err)
+	updated, err := s.accountRepo.GetByID(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	claims, err = jwt.DecodeAccountClaims(updated.JWT)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(0), claims.Limits.Conn)
+
+	resumed, err := s.accountService.ResumeAccount(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), resumed.Account.Suspended())
+	assert.Empty(s.T(), resumed.Account.SuspendedBy)
+
+	claims, err = jwt.DecodeAccountClaims(resumed.Account.JWT)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(jwt.NoLimit), claims.Limits.Conn)
+	assert.Equal(s.T(), int64(jwt.NoLimit), claims.Limits.LeafNodeConn)
+	// The user revocation outlives the suspension
+	assert.Contains(s.T(), claims.Revocations, jwt.All)
+}
+
+// TestSuspendAccount_Invalid tests that the system account cannot be suspended and active accounts cannot be resumed
+func (s *AccountSuspensionTestSuite) TestSuspendAccount_Invalid() {
+	operator := s.createOperator("test-operator")
+
+	sysAccount, err := s.accountService.GetAccountByName(s.ctx, operator.ID, "$SYS")
+	require.NoError(s.T(), err)
+	_, err = s.accountService.SuspendAccount(s.ctx, sysAccount.ID, "admin", "test", false)
+	assert.ErrorIs(s.T(), err, ErrInvalidSuspension)
+
+	account := s.createAccount(operator.ID, "orders")
+	_, err = s.accountService.ResumeAccount(s.ctx, account.ID)
+	assert.ErrorIs(s.T(), err, ErrInvalidSuspension)
+}
+
+// TestUpdateUser_Revoked tests that updating a user revoked by a suspension keeps its revoked JWT
+func (s *AccountSuspensionTestSuite) TestUpdateUser_Revoked() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+	user, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app"})
+	require.NoError(s.T(), err)
+
+	_, err = s.accountService.SuspendAccount(s.ctx, account.ID, "admin", "incident", true)
+	require.NoError(s.T(), err)
+	_, err = s.accountService.ResumeAccount(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+
+	description := "revoked"
+	updated, err := s.userService.UpdateUser(s.ctx, user.ID, UpdateUserRequest{Description: &description})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "revoked", updated.Description)
+	assert.Equal(s.T(), user.JWT, updated.JWT)
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/metrics"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
+)
+
+const (
+	// AuthFailureWindow is the period NATS authentication failures are aggregated over
+	AuthFailureWindow = time.Minute
+	// DefaultAuthFailureRetention is how long the authentication failure log is kept
+	DefaultAuthFailureRetention = 30 * 24 * time.Hour
+
+	// unknownAccountLabel is the metric label of failures that match no NIS account
+	unknownAccountLabel = "unknown"
+)
+
+// SetAuthFailureRetention overrides how long the NATS authentication failure log is
+// kept. Non-positive values keep the current setting.
+func (s *ClusterService) SetAuthFailureRetention(retention time.Duration) {
+	if retention > 0 {
+		s.authFailureRetention = retention
+	}
+}
+
+// RecordAuthFailure adds a failed client authentication reported by a cluster to the
+// auth failure log. The presented keys are correlated with the users and accounts of
+// the cluster's operator; keys matching nothing are recorded as they are.
+func (s *ClusterService) RecordAuthFailure(ctx context.Context, cluster *entities.Cluster, event *nats.ClientEvent) error {
+	at := event.Timestamp
+	if at.IsZero() {
+		at = time.Now()
+	}
+	at = at.UTC()
+
+	failure := &entities.AuthFailure{
+		ID:               uuid.New(),
+		ClusterID:        cluster.ID,
+		WindowStart:      at.Truncate(AuthFailureWindow),
+		UserPublicKey:    event.Client.User,
+		AccountPublicKey: event.Client.Account,
+		RemoteIP:         event.Client.Host,
+		Reason:           event.Reason,
+		ServerName:       event.Server.Label(),
+		ClientName:       event.Client.Name,
+		Count:            1,
+		FirstSeen:        at,
+		LastSeen:         at,
+	}
+
+	account, err := s.correlateAuthFailure(ctx, cluster, failure)
+	if err != nil {
+		return err
+	}
+
+	if err := s.authFailures.Record(ctx, failure); err != nil {
+		return err
+	}
+
+	accountLabel := unknownAccountLabel
+	if account != nil {
+		accountLabel = account.Name
+	}
+	metrics.Default().RecordNATSAuthFailure(ctx, cluster.Name, accountLabel)
+
+	return nil
+}
+
+// correlateAuthFailure fills in the user and account of a failure from its presented
+// keys and returns the account, nil when the keys belong to no entity of the cluster's
+// operator
+func (s *ClusterService) correlateAuthFailure(ctx context.Context, cluster *entities.Cluster, failure *entities.AuthFailure) (*entities.Account, error) {
+	var account *entities.Account
+
+	if failure.UserPublicKey != "" {
+		user, err := s.userRepo.GetByPublicKey(ctx, failure.UserPublicKey)
+		switch {
+		case err == nil:
+			account, err = s.accountRepo.GetByID(ctx, user.AccountID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get account: %w", err)
+			}
+			if account.OperatorID != cluster.OperatorID {
+				return nil, nil
+			}
+			failure.UserID = &user.ID
+		case !errors.Is(err, repositories.ErrNotFound):
+			return nil, fmt.Errorf("failed to get user: %w", err)
+		}
+	}
+
+	if account == nil && failure.AccountPublicKey != "" {
+		acc, err := s.accountRepo.GetByPublicKey(ctx, failure.AccountPublicKey)
+		switch {
+		case err == nil:
+			account = acc
+		case !errors.Is(err, repositories.ErrNotFound):
+			return nil, fmt.Errorf("failed to get account: %w", err)
+		}
+	}
+
+	if account == nil || account.OperatorID != cluster.OperatorID {
+		return nil, nil
+	}
+
+	failure.AccountID = &account.ID
+	failure.AccountPublicKey = account.PublicKey
+	return account, nil
+}
+
+// ListAuthFailures lists the aggregated NATS authentication failures matching the
+// filter, most recently seen first
+func (s *ClusterService) ListAuthFailures(ctx context.Context, filter repositories.AuthFailureFilter, opts repositories.ListOptions) ([]*entities.AuthFailure, error) {
+	return s.authFailures.List(ctx, filter, opts)
+}
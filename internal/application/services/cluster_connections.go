@@ -0,0 +1,452 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
+)
+
+// DefaultConnectionLimit caps the connections returned per server by ListConnections
+const DefaultConnectionLimit = 1024
+
+// ClientConnection is a live client connection mapped back to the NIS user it
+// authenticated as
+type ClientConnection struct {
+	nats.Connection
+	ClusterID   uuid.UUID
+	ClusterName string
+	ServerID    string
+	ServerName  string
+	// User is nil when the connection's public key is not a user of the account in NIS
+	User *entities.User
+	// ScopedSigningKey is set when the user is signed by a scoped signing key
+	ScopedSigningKey *entities.ScopedSigningKey
+}
+
+// ConnectionListing is the result of ListConnections
+type ConnectionListing struct {
+	Connections []ClientConnection
+	// Truncated is true when a server had more matching connections than the limit
+	Truncated bool
+	// Warnings lists the clusters and servers that could not be queried
+	Warnings []string
+}
+
+// ConnectionQuery selects the connections returned by ListConnections
+type ConnectionQuery struct {
+	AccountID uuid.UUID  // Optional when UserID is set
+	UserID    *uuid.UUID // Optional - only this user's connections
+	ClusterID *uuid.UUID // Optional - every cluster of the account's operator if nil
+	Limit     int        // Optional - per server, 0 uses DefaultConnectionLimit
+}
+
+// ListConnections queries CONNZ on every server of the selected clusters and maps each
+// connection's user public key back to the NIS user and scoped signing key. Keys a
+// rotation retired still connect until they are revoked, so they are matched as well.
+// When no cluster is given, clusters that cannot be reached are reported as warnings,
+// and a supercluster is queried through one of its clusters since CONNZ crosses the
+// gateways.
+func (s *ClusterService) ListConnections(ctx context.Context, query ConnectionQuery) (*ConnectionListing, error) {
+	account, user, err := s.resolveConnectionOwner(ctx, query.AccountID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = DefaultConnectionLimit
+	}
+	userKeys := []string{""}
+	if user != nil {
+		if userKeys, err = s.connectionKeys(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	clusters, err := s.connectionClusters(ctx, account, query.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	listing := &ConnectionListing{}
+	resolver, err := newConnectionResolver(ctx, s, account)
+	if err != nil {
+		return nil, err
+	}
+	reached := make(map[string]bool)
+	for _, cluster := range clusters {
+		if cluster.Supercluster != "" && reached[cluster.Supercluster] {
+			continue
+		}
+		servers, err := s.clusterConnections(ctx, cluster.ID, account, userKeys, limit)
+		if err != nil {
+			if query.ClusterID != nil {
+				return nil, err
+			}
+			listing.Warnings = append(listing.Warnings, fmt.Sprintf("cluster %s: %v", cluster.Name, err))
+			continue
+		}
+		if cluster.Supercluster != "" {
+			reached[cluster.Supercluster] = true
+		}
+
+		for _, srv := range servers {
+			if srv.Error != "" {
+				listing.Warnings = append(listing.Warnings, fmt.Sprintf("cluster %s, server %s: %s", cluster.Name, srv.Server.Label(), srv.Error))
+				continue
+			}
+			if srv.Total > len(srv.Connections) {
+				listing.Truncated = true
+			}
+			for _, conn := range srv.Connections {
+				mapped := ClientConnection{
+					Connection:  conn,
+					ClusterID:   cluster.ID,
+					ClusterName: cluster.Name,
+					ServerID:    srv.Server.ID,
+					ServerName:  srv.Server.Name,
+				}
+				if mapped.User, mapped.ScopedSigningKey, err = resolver.resolve(ctx, conn.AuthorizedUser); err != nil {
+					return nil, err
+				}
+				listing.Connections = append(listing.Connections, mapped)
+			}
+		}
+	}
+
+	sort.SliceStable(listing.Connections, func(i, j int) bool {
+		a, b := listing.Connections[i], listing.Connections[j]
+		if a.ClusterName != b.ClusterName {
+			return a.ClusterName < b.ClusterName
+		}
+		if a.ServerName != b.ServerName {
+			return a.ServerName < b.ServerName
+		}
+		return a.CID < b.CID
+	})
+
+	return listing, nil
+}
+
+// resolveConnectionOwner loads the account and, if given, the user whose connections are
+// queried. The account is taken from the user when no account ID is given.
+func (s *ClusterService) resolveConnectionOwner(ctx context.Context, accountID uuid.UUID, userID *uuid.UUID) (*entities.Account, *entities.User, error) {
+	var user *entities.User
+	if userID != nil {
+		var err error
+		user, err = s.userRepo.GetByID(ctx, *userID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get user: %w", err)
+		}
+		if accountID == uuid.Nil {
+			accountID = user.AccountID
+		} else if accountID != user.AccountID {
+			return nil, nil, fmt.Errorf("user %s does not belong to the account", user.Name)
+		}
+	}
+	if accountID == uuid.Nil {
+		return nil, nil, fmt.Errorf("an account or a user is required")
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	return account, user, nil
+}
+
+// connectionKeys returns the keys a user can connect with: its current key and the
+// keys a rotation retired that are not revoked yet
+func (s *ClusterService) connectionKeys(ctx context.Context, user *entities.User) ([]string, error) {
+	retired, err := s.userKeyRepo.ListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user keys: %w", err)
+	}
+	keys := []string{user.PublicKey}
+	for _, key := range retired {
+		if !key.Revoked() {
+			keys = append(keys, key.PublicKey)
+		}
+	}
+	return keys, nil
+}
+
+// clusterConnections queries CONNZ for an account on every server of a cluster, once
+// per user key. An empty key lists the connections of every user.
+func (s *ClusterService) clusterConnections(ctx context.Context, clusterID uuid.UUID, account *entities.Account, userKeys []string, limit int) ([]nats.ServerConnections, error) {
+	natsClient, _, err := s.clusterClient(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := natsClient.PingServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var conns []nats.ServerConnections
+	for _, key := range userKeys {
+		keyConns, err := natsClient.ListAccountConnections(ctx, account.PublicKey, key, limit, len(servers))
+		if err != nil {
+			return nil, err
+		}
+		conns = append(conns, keyConns...)
+	}
+	return conns, nil
+}
+
+// connectionResolver maps user public keys to NIS users, caching lookups across servers
+type connectionResolver struct {
+	service    *ClusterService
+	account    *entities.Account
+	users      map[string]*entities.User
+	retired    map[string]uuid.UUID // Retired keys not revoked yet, to their user
+	scopedKeys map[uuid.UUID]*entities.ScopedSigningKey
+}
+
+func newConnectionResolver(ctx context.Context, service *ClusterService, account *entities.Account) (*connectionResolver, error) {
+	keys, err := service.userKeyRepo.ListByAccount(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user keys: %w", err)
+	}
+	retired := make(map[string]uuid.UUID, len(keys))
+	for _, key := range keys {
+		if !key.Revoked() {
+			retired[key.PublicKey] = key.UserID
+		}
+	}
+
+	return &connectionResolver{
+		service:    service,
+		account:    account,
+		users:      make(map[string]*entities.User),
+		retired:    retired,
+		scopedKeys: make(map[uuid.UUID]*entities.ScopedSigningKey),
+	}, nil
+}
+
+// resolve returns the user of the account holding, or having held during a rotation's
+// grace period, the given public key and its scoped signing key, or nil for keys NIS
+// does not know
+func (r *connectionResolver) resolve(ctx context.Context, publicKey string) (*entities.User, *entities.ScopedSigningKey, error) {
+	if publicKey == "" {
+		return nil, nil, nil
+	}
+
+	user, ok := r.users[publicKey]
+	if !ok {
+		var err error
+		if userID, ok := r.retired[publicKey]; ok {
+			user, err = r.service.userRepo.GetByID(ctx, userID)
+		} else {
+			user, err = r.service.userRepo.GetByPublicKey(ctx, publicKey)
+		}
+		if errors.Is(err, repositories.ErrNotFound) {
+			user = nil
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("failed to look up user %s: %w", publicKey, err)
+		}
+		if user != nil && user.AccountID != r.account.ID {
+			user = nil
+		}
+		r.users[publicKey] = user
+	}
+	if user == nil || user.ScopedSigningKeyID == nil {
+		return user, nil, nil
+	}
+
+	key, ok := r.scopedKeys[*user.ScopedSigningKeyID]
+	if !ok {
+		var err error
+		key, err = r.service.scopedKeyRepo.GetByID(ctx, *user.ScopedSigningKeyID)
+		if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+			return nil, nil, fmt.Errorf("failed to get scoped signing key: %w", err)
+		}
+		r.scopedKeys[*user.ScopedSigningKeyID] = key
+	}
+
+	return user, key, nil
+}
+
+// ServerDisconnects reports the connections of a user terminated on one server
+type ServerDisconnects struct {
+	ClusterID    uuid.UUID
+	ClusterName  string
+	Server       nats.ServerInfo
+	Found        int      // Connections of the user found on the server
+	Disconnected int      // Connections successfully kicked
+	Errors       []string // One entry per connection that could not be kicked
+}
+
+// DisconnectResult is the result of DisconnectUser
+type DisconnectResult struct {
+	Servers []ServerDisconnects
+	// Warnings lists the clusters and servers that could not be queried
+	Warnings []string
+}
+
+// Disconnected returns the number of connections terminated on all servers
+func (r *DisconnectResult) Disconnected() int {
+	total := 0
+	for _, srv := range r.Servers {
+		total += srv.Disconnected
+	}
+	return total
+}
+
+// DisconnectUser kicks every live connection of a user. The connections are found via
+// CONNZ on each server of the given cluster, or of every cluster of the user's operator
+// when clusterID is nil, and each one is terminated with $SYS.REQ.SERVER.<id>.KICK. A
+// supercluster is reached through one of its clusters, so each connection is kicked
+// once. Connections using a key retired by a rotation and not revoked yet are kicked as
+// well. Clients reconnect unless their credentials were revoked beforehand.
+func (s *ClusterService) DisconnectUser(ctx context.Context, userID uuid.UUID, clusterID *uuid.UUID) (*DisconnectResult, error) {
+	account, user, err := s.resolveConnectionOwner(ctx, uuid.Nil, &userID)
+	if err != nil {
+		return nil, err
+	}
+
+	userKeys, err := s.connectionKeys(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	clusters, err := s.connectionClusters(ctx, account, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DisconnectResult{}
+	reached := make(map[string]bool)
+	for _, cluster := range clusters {
+		if cluster.Supercluster != "" && reached[cluster.Supercluster] {
+			continue
+		}
+		servers, warnings, err := s.disconnectOnCluster(ctx, cluster, account, userKeys)
+		if err != nil {
+			if clusterID != nil {
+				return nil, err
+			}
+			result.Warnings = append(result.Warnings, fmt.Sprintf("cluster %s: %v", cluster.Name, err))
+			continue
+		}
+		if cluster.Supercluster != "" {
+			reached[cluster.Supercluster] = true
+		}
+		result.Servers = append(result.Servers, servers...)
+		result.Warnings = append(result.Warnings, warnings...)
+	}
+
+	sort.SliceStable(result.Servers, func(i, j int) bool {
+		a, b := result.Servers[i], result.Servers[j]
+		if a.ClusterName != b.ClusterName {
+			return a.ClusterName < b.ClusterName
+		}
+		return a.Server.Label() < b.Server.Label()
+	})
+
+	return result, nil
+}
+
+// disconnectOnCluster kicks the connections using one of the user's keys on every
+// server of a cluster over a single system account connection. Only servers where the
+// user was connected are reported.
+func (s *ClusterService) disconnectOnCluster(ctx context.Context, cluster *entities.Cluster, account *entities.Account, userKeys []string) ([]ServerDisconnects, []string, error) {
+	natsClient, _, err := s.clusterClient(ctx, cluster.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	servers, err := natsClient.PingServers(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		result   []ServerDisconnects
+		warnings []string
+	)
+	failed := make(map[string]bool)
+	reports := make(map[string]int) // Server ID to its index in result
+	for _, key := range userKeys {
+		conns, err := natsClient.ListAccountConnections(ctx, account.PublicKey, key, DefaultConnectionLimit, len(servers))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, srv := range conns {
+			if srv.Error != "" {
+				if !failed[srv.Server.ID] {
+					failed[srv.Server.ID] = true
+					warnings = append(warnings, fmt.Sprintf("cluster %s, server %s: %s", cluster.Name, srv.Server.Label(), srv.Error))
+				}
+				continue
+			}
+
+			report := ServerDisconnects{
+				ClusterID:   cluster.ID,
+				ClusterName: cluster.Name,
+				Server:      srv.Server,
+			}
+			if i, ok := reports[srv.Server.ID]; ok {
+				report = result[i]
+			}
+
+			for _, conn := range srv.Connections {
+				// CONNZ filters on the user already, this guards against servers ignoring it
+				if conn.AuthorizedUser != key {
+					continue
+				}
+				report.Found++
+				if err := natsClient.KickClient(ctx, srv.Server.ID, conn.CID); err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("cid %d: %v", conn.CID, err))
+					continue
+				}
+				report.Disconnected++
+			}
+			if srv.Total > len(srv.Connections) {
+				warnings = append(warnings, fmt.Sprintf("cluster %s, server %s: %d more connections were not listed, run the disconnect again",
+					cluster.Name, srv.Server.Label(), srv.Total-len(srv.Connections)))
+			}
+			if report.Found == 0 {
+				continue
+			}
+			if i, ok := reports[srv.Server.ID]; ok {
+				result[i] = report
+			} else {
+				reports[srv.Server.ID] = len(result)
+				result = append(result, report)
+			}
+		}
+	}
+
+	return result, warnings, nil
+}
+
+// connectionClusters returns the given cluster, checked against the account's
+// operator, or every cluster of the operator when clusterID is nil
+func (s *ClusterService) connectionClusters(ctx context.Context, account *entities.Account, clusterID *uuid.UUID) ([]*entities.Cluster, error) {
+	if clusterID == nil {
+		clusters, err := s.repo.ListByOperator(ctx, account.OperatorID, repositories.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list clusters: %w", err)
+		}
+		return clusters, nil
+	}
+
+	cluster, err := s.repo.GetByID(ctx, *clusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+	if cluster.OperatorID != account.OperatorID {
+		return nil, fmt.Errorf("account %s does not belong to the operator of cluster %s", account.Name, cluster.Name)
+	}
+	return []*entities.Cluster{cluster}, nil
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
+)
+
+const (
+	// DefaultEventReconcileInterval is how often the event monitor picks up added,
+	// changed and deleted clusters
+	DefaultEventReconcileInterval = 30 * time.Second
+	// eventWriteTimeout bounds the database write of a single event
+	eventWriteTimeout = 5 * time.Second
+)
+
+// EventSubscription is a system event subject the monitor subscribes to on every
+// managed cluster, with the handler of its messages
+type EventSubscription struct {
+	Subject string
+	Handle  func(ctx context.Context, cluster *entities.Cluster, data []byte)
+}
+
+// ClusterEventMonitor keeps a connection to every managed cluster and subscribes to
+// its system events. Only the leader replica subscribes, and a supercluster is watched
+// through one of its clusters since system events cross the gateways, so each event is
+// recorded once. The subscriptions live and die with their connection, so the monitor opens
+// its own connections rather than sharing the pooled ones.
+type ClusterEventMonitor struct {
+	clusters      *ClusterService
+	subscriptions []EventSubscription
+
+	mu    sync.Mutex
+	conns map[uuid.UUID]*monitoredCluster
+}
+
+// monitoredCluster is an open event connection, its key tells when the cluster's URLs
+// or credentials changed and the connection must be reopened
+type monitoredCluster struct {
+	client *nats.Client
+	key    string
+}
+
+// NewClusterEventMonitor creates a monitor recording the client connect and disconnect
+// events into the users' activity, and the authentication failures into the auth
+// failure log
+func NewClusterEventMonitor(clusters *ClusterService) *ClusterEventMonitor {
+	m := &ClusterEventMonitor{
+		clusters: clusters,
+		conns:    make(map[uuid.UUID]*monitoredCluster),
+	}
+	m.AddSubscription(EventSubscription{Subject: nats.ConnectEventSubject, Handle: m.recordClientEvent})
+	m.AddSubscription(EventSubscription{Subject: nats.DisconnectEventSubject, Handle: m.recordClientEvent})
+	m.AddSubscription(EventSubscription{Subject: nats.AuthErrorEventSubject, Handle: m.recordAuthFailure})
+	return m
+}
+
+// AddSubscription subscribes the monitor to another subject. It must be called before
+// Run.
+func (m *ClusterEventMonitor) AddSubscription(sub EventSubscription) {
+	m.subscriptions = append(m.subscriptions, sub)
+}
+
+// Run reconciles the monitored clusters every interval until ctx is done. The
+// connections are closed whenever isLeader reports false.
+func (m *ClusterEventMonitor) Run(ctx context.Context, interval time.Duration, isLeader func() bool) {
+	if interval <= 0 {
+		interval = DefaultEventReconcileInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	defer m.closeAll()
+
+	for {
+		if isLeader() {
+			m.reconcile(ctx)
+		} else {
+			m.closeAll()
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reconcile opens the connections of new or changed clusters and closes those of
+// deleted ones. A supercluster keeps the cluster it is watched through while that one
+// can be reached, and moves to another of its clusters otherwise.
+func (m *ClusterEventMonitor) reconcile(ctx context.Context) {
+	log := logging.LogFromContext(ctx)
+
+	var managed []*entities.Cluster
+	for offset := 0; ; offset += syncPageSize {
+		clusters, err := m.clusters.repo.List(ctx, repositories.ListOptions{
+			Limit:  syncPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			log.Warn("failed to list clusters for event monitoring", "error", err)
+			return
+		}
+
+		for _, cluster := range clusters {
+			if cluster.EncryptedCreds != "" {
+				managed = append(managed, cluster)
+			}
+		}
+
+		if len(clusters) < syncPageSize {
+			break
+		}
+	}
+
+	current := make(map[uuid.UUID]bool)
+	reached := make(map[string]bool)
+	for _, cluster := range m.watchOrder(managed) {
+		supercluster := superclusterKey(cluster)
+		if supercluster != "" && reached[supercluster] {
+			continue
+		}
+		if err := m.watch(ctx, cluster); err != nil {
+			log.Warn("failed to subscribe to cluster events", "cluster", cluster.Name, "error", err)
+			continue
+		}
+		current[cluster.ID] = true
+		if supercluster != "" {
+			reached[supercluster] = true
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, conn := range m.conns {
+		if !current[id] {
+			_ = conn.client.Close()
+			delete(m.conns, id)
+		}
+	}
+}
+
+// watchOrder puts the clusters already watched first, so a supercluster stays watched
+// through the same cluster
+func (m *ClusterEventMonitor) watchOrder(clusters []*entities.Cluster) []*entities.Cluster {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sort.SliceStable(clusters, func(i, j int) bool {
+		_, watchedI := m.conns[clusters[i].ID]
+		_, watchedJ := m.conns[clusters[j].ID]
+		return watchedI && !watchedJ
+	})
+	return clusters
+}
+
+// superclusterKey identifies the supercluster of a cluster across operators, empty
+// when the cluster is not part of one
+func superclusterKey(cluster *entities.Cluster) string {
+	if cluster.Supercluster == "" {
+		return ""
+	}
+	return cluster.OperatorID.String() + "/" + cluster.Supercluster
+}
+
+// watch subscribes to the events of a cluster unless an up to date connection exists
+func (m *ClusterEventMonitor) watch(ctx context.Context, cluster *entities.Cluster) error {
+	key := connectionKey(cluster)
+	m.mu.Lock()
+	existing, ok := m.conns[cluster.ID]
+	m.mu.Unlock()
+	if ok && existing.key == key && !existing.client.IsClosed() {
+		return nil
+	}
+
+	connectCtx, cancel := context.WithTimeout(ctx, defaultConnectTimeout)
+	defer cancel()
+	client, _, err := m.clusters.openManagedCluster(connectCtx, cluster.ID)
+	if err != nil {
+		return err
+	}
+
+	// The handlers outlive the reconcile call, they only inherit the logger
+	handlerCtx := logging.WithLogger(context.Background(), logging.LogFromContext(ctx))
+	for _, sub := range m.subscriptions {
+		handle := sub.Handle
+		err := client.Subscribe(sub.Subject, func(_ string, data []byte) {
+			handle(handlerCtx, cluster, data)
+		})
+		if err != nil {
+			_ = client.Close()
+			return err
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if existing, ok := m.conns[cluster.ID]; ok {
+		_ = existing.client.Close()
+	}
+	m.conns[cluster.ID] = &monitoredCluster{client: client, key: key}
+	return nil
+}
+
+// closeAll closes every event connection
+func (m *ClusterEventMonitor) closeAll() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, conn := range m.conns {
+		_ = conn.client.Close()
+		delete(m.conns, id)
+	}
+}
+
+// recordClientEvent records a connect or disconnect event into the activity of the
+// user it is about. Events of clients that are not NIS users are ignored.
+func (m *ClusterEventMonitor) recordClientEvent(ctx context.Context, cluster *entities.Cluster, data []byte) {
+	log := logging.LogFromContext(ctx)
+
+	event, err := nats.ParseClientEvent(data)
+	if err != nil {
+		log.Debug("ignoring client event", "cluster", cluster.Name, "error", err)
+		return
+	}
+	if event.Client.User == "" {
+		return
+	}
+
+	at := event.Timestamp
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, eventWriteTimeout)
+	defer cancel()
+
+	if event.IsConnect() {
+		_, err = m.clusters.userRepo.RecordConnect(ctx, event.Client.User, at, event.Client.Host)
+	} else {
+		_, err = m.clusters.userRepo.RecordDisconnect(ctx, event.Client.User, at)
+	}
+	if err != nil {
+		log.Warn("failed to record user activity", "cluster", cluster.Name,
+			"user_public_key", event.Client.User, "error", err)
+	}
+}
+
+// recordAuthFailure records an authentication failure event into the auth failure log
+func (m *ClusterEventMonitor) recordAuthFailure(ctx context.Context, cluster *entities.Cluster, data []byte) {
+	log := logging.LogFromContext(ctx)
+
+	event, err := nats.ParseClientEvent(data)
+	if err != nil {
+		log.Debug("ignoring auth error event", "cluster", cluster.Name, "error", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, eventWriteTimeout)
+	defer cancel()
+
+	if err := m.clusters.RecordAuthFailure(ctx, cluster, event); err != nil {
+		log.Warn("failed to record auth failure", "cluster", cluster.Name,
+			"user_public_key", event.Client.User, "error", err)
+	}
+}
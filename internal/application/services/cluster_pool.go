@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/infrastructure/metrics"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
+)
+
+// clusterPool keeps one long-lived, reconnecting connection per managed cluster, so
+// resolver operations and lookups do not pay for decrypting the credentials and a NATS
+// handshake every time
+type clusterPool struct {
+	mu    sync.Mutex
+	conns map[uuid.UUID]*pooledCluster
+}
+
+// pooledCluster is an open connection with the key of the settings it was opened with
+type pooledCluster struct {
+	client *nats.Client
+	name   string
+	key    string
+}
+
+func newClusterPool() *clusterPool {
+	return &clusterPool{conns: make(map[uuid.UUID]*pooledCluster)}
+}
+
+// connectionKey identifies the settings a cluster connection is opened with. The
+// connection must be reopened when it changes, but not when the cluster is renamed or
+// its health status is updated.
+func connectionKey(cluster *entities.Cluster) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\n%t\n%s",
+		strings.Join(cluster.ServerURLs, ","), cluster.SkipVerifyTLS, cluster.EncryptedCreds)))
+	return hex.EncodeToString(sum[:])
+}
+
+// get returns the pooled connection of a cluster if it was opened with the same key and
+// was not closed, nil otherwise
+func (p *clusterPool) get(cluster *entities.Cluster, key string) *nats.Client {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	conn, ok := p.conns[cluster.ID]
+	if !ok || conn.key != key || conn.client.IsClosed() {
+		return nil
+	}
+	conn.name = cluster.Name
+	return conn.client
+}
+
+// put stores a new connection and closes the one it replaces. When another caller
+// pooled a connection with the same key in the meantime, client is closed and that
+// connection is returned instead.
+func (p *clusterPool) put(cluster *entities.Cluster, key string, client *nats.Client) *nats.Client {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if conn, ok := p.conns[cluster.ID]; ok {
+		if conn.key == key && !conn.client.IsClosed() {
+			_ = client.Close()
+			return conn.client
+		}
+		_ = conn.client.Close()
+	}
+	p.conns[cluster.ID] = &pooledCluster{client: client, name: cluster.Name, key: key}
+	return client
+}
+
+// invalidate closes the pooled connection of a cluster, if any
+func (p *clusterPool) invalidate(id uuid.UUID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if conn, ok := p.conns[id]; ok {
+		_ = conn.client.Close()
+		delete(p.conns, id)
+	}
+}
+
+// retain closes the connections of the clusters not in ids, which were deleted by
+// another replica
+func (p *clusterPool) retain(ids map[uuid.UUID]bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for id, conn := range p.conns {
+		if !ids[id] {
+			_ = conn.client.Close()
+			delete(p.conns, id)
+		}
+	}
+}
+
+// closeAll closes every pooled connection
+func (p *clusterPool) closeAll() {
+	p.retain(nil)
+}
+
+// states reports the state of every pooled connection, ordered by cluster name
+func (p *clusterPool) states() []metrics.NATSConnectionState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	states := make([]metrics.NATSConnectionState, 0, len(p.conns))
+	for _, conn := range p.conns {
+		states = append(states, metrics.NATSConnectionState{
+			Cluster:   conn.name,
+			Status:    conn.client.Status(),
+			Connected: conn.client.IsConnected(),
+		})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Cluster < states[j].Cluster })
+	return states
+}
+
+// clusterClient returns the pooled connection of a cluster, opening it on first use and
+// whenever the cluster's URLs, credentials or TLS setting changed. The connection is
+// shared: callers MUST NOT close it.
+func (s *ClusterService) clusterClient(ctx context.Context, id uuid.UUID) (*nats.Client, *entities.Cluster, error) {
+	cluster, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+	if cluster.EncryptedCreds == "" {
+		return nil, nil, fmt.Errorf("cluster has no system account credentials configured")
+	}
+
+	key := connectionKey(cluster)
+	if client := s.pool.get(cluster, key); client != nil {
+		return client, cluster, nil
+	}
+
+	name := cluster.Name
+	recordEvent := func(event string) {
+		metrics.Default().RecordNATSConnectionEvent(context.Background(), name, event)
+	}
+	// The connection outlives the request that opens it, so its handlers log with the
+	// service logger rather than the request one
+	log := s.log
+	client, err := s.dialCluster(ctx, cluster, nats.ConnectionHandlers{
+		Disconnected: func(err error) {
+			// Closing the connection reports a disconnect without an error
+			if err == nil {
+				return
+			}
+			log.Warn("cluster connection lost", "cluster", name, "error", err)
+			recordEvent("disconnected")
+		},
+		Reconnected: func() {
+			log.Info("cluster connection restored", "cluster", name)
+			recordEvent("reconnected")
+		},
+		Closed: func() { recordEvent("closed") },
+	})
+	if err != nil {
+		recordEvent("connect_failed")
+		return nil, nil, err
+	}
+	recordEvent("connected")
+
+	return s.pool.put(cluster, key, client), cluster, nil
+}
+
+// ConnectionStates reports the state of the pooled cluster connections
+func (s *ClusterService) ConnectionStates() []metrics.NATSConnectionState {
+	return s.pool.states()
+}
+
+// Close closes the pooled cluster connections
+func (s *ClusterService) Close() {
+	s.pool.closeAll()
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,38 +18,153 @@ import (
 	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
 )
 
+const (
+	// DefaultSyncConcurrency is the number of account JWTs SyncCluster pushes in parallel
+	DefaultSyncConcurrency = 16
+	// DefaultSyncTimeout bounds a whole SyncCluster run, listing and pruning included
+	DefaultSyncTimeout = 5 * time.Minute
+
+	// syncPageSize is the number of accounts loaded per repository page during sync
+	syncPageSize = 500
+	// syncPushTimeout bounds a single $SYS.REQ.CLAIMS.UPDATE round-trip
+	syncPushTimeout = 5 * time.Second
+
+	// DefaultHealthCheckInterval is the time between two health checks of a healthy cluster
+	DefaultHealthCheckInterval = 60 * time.Second
+	// DefaultHealthCheckTimeout bounds a single health check
+	DefaultHealthCheckTimeout = 10 * time.Second
+	// DefaultHealthCheckRetention is how long the health check history is kept
+	DefaultHealthCheckRetention = 7 * 24 * time.Hour
+	// UptimeWindow is the period covered by the uptime percentage of a cluster
+	UptimeWindow = 24 * time.Hour
+
+	// minHealthCheckInterval is the shortest per-cluster interval accepted
+	minHealthCheckInterval = 10 * time.Second
+	// maxHealthCheckBackoff caps how far the checks of an unhealthy cluster are spaced out
+	maxHealthCheckBackoff = 15 * time.Minute
+	// defaultConnectTimeout bounds connecting to a cluster when the caller has no deadline
+	defaultConnectTimeout = 10 * time.Second
+
+	// serverRecordRetention is how long a server that stopped answering health check
+	// pings stays in the cluster topology before its record is dropped
+	serverRecordRetention = 7 * 24 * time.Hour
+)
+
 // ClusterService provides business logic for cluster management
 type ClusterService struct {
 	repo          repositories.ClusterRepository
+	serverRepo    repositories.ClusterServerRepository
+	healthRepo    repositories.ClusterHealthCheckRepository
+	authFailures  repositories.AuthFailureRepository
+	accountStats  repositories.AccountStatsRepository
+	leafnodeRepo  repositories.LeafnodeProfileRepository
+	placementRepo repositories.AccountPlacementRepository
 	operatorRepo  repositories.OperatorRepository
 	accountRepo   repositories.AccountRepository
 	userRepo      repositories.UserRepository
+	userKeyRepo   repositories.UserKeyRepository
 	scopedKeyRepo repositories.ScopedSigningKeyRepository
 	encryptor     encryption.Encryptor
 	jwtService    *JWTService
+	pool          *clusterPool
+	log           *slog.Logger
+
+	syncConcurrency int
+	syncTimeout     time.Duration
+	healthRetention time.Duration
+
+	authFailureRetention  time.Duration
+	accountStatsRetention time.Duration
+}
+
+// ClusterRepositories holds the repositories of the clusters and of what the cluster
+// service records about them
+type ClusterRepositories struct {
+	Clusters     repositories.ClusterRepository
+	Servers      repositories.ClusterServerRepository
+	HealthChecks repositories.ClusterHealthCheckRepository
+	AuthFailures repositories.AuthFailureRepository
+	AccountStats repositories.AccountStatsRepository
+	Leafnodes    repositories.LeafnodeProfileRepository
+	Placements   repositories.AccountPlacementRepository
 }
 
 // NewClusterService creates a new cluster service
 func NewClusterService(
-	repo repositories.ClusterRepository,
+	repos ClusterRepositories,
 	operatorRepo repositories.OperatorRepository,
 	accountRepo repositories.AccountRepository,
 	userRepo repositories.UserRepository,
+	userKeyRepo repositories.UserKeyRepository,
 	scopedKeyRepo repositories.ScopedSigningKeyRepository,
 	encryptor encryption.Encryptor,
 	jwtService *JWTService,
 ) *ClusterService {
 	return &ClusterService{
-		repo:          repo,
+		repo:          repos.Clusters,
+		serverRepo:    repos.Servers,
+		healthRepo:    repos.HealthChecks,
+		authFailures:  repos.AuthFailures,
+		accountStats:  repos.AccountStats,
+		leafnodeRepo:  repos.Leafnodes,
+		placementRepo: repos.Placements,
 		operatorRepo:  operatorRepo,
 		accountRepo:   accountRepo,
 		userRepo:      userRepo,
+		userKeyRepo:   userKeyRepo,
 		scopedKeyRepo: scopedKeyRepo,
 		encryptor:     encryptor,
 		jwtService:    jwtService,
+		pool:          newClusterPool(),
+		log:           logging.GetLogger(),
+
+		syncConcurrency: DefaultSyncConcurrency,
+		syncTimeout:     DefaultSyncTimeout,
+		healthRetention: DefaultHealthCheckRetention,
+
+		authFailureRetention:  DefaultAuthFailureRetention,
+		accountStatsRetention: DefaultAccountStatsRetention,
+	}
+}
+
+// SetLogger sets the logger of what outlives a request, like the events of the pooled
+// cluster connections
+func (s *ClusterService) SetLogger(logger *slog.Logger) {
+	if logger != nil {
+		s.log = logger
+	}
+}
+
+// SetSyncLimits overrides how many account JWTs SyncCluster pushes in parallel and
+// the deadline for a whole sync run. Non-positive values keep the current setting.
+func (s *ClusterService) SetSyncLimits(concurrency int, timeout time.Duration) {
+	if concurrency > 0 {
+		s.syncConcurrency = concurrency
+	}
+	if timeout > 0 {
+		s.syncTimeout = timeout
+	}
+}
+
+// SetHealthCheckRetention overrides how long the health check history is kept.
+// Non-positive values keep the current setting.
+func (s *ClusterService) SetHealthCheckRetention(retention time.Duration) {
+	if retention > 0 {
+		s.healthRetention = retention
 	}
 }
 
+// validateHealthCheckSchedule checks per-cluster health check settings, zero meaning default
+func validateHealthCheckSchedule(interval, timeout time.Duration) error {
+	if interval < 0 || (interval > 0 && interval < minHealthCheckInterval) {
+		return fmt.Errorf("health check interval must be at least %s", minHealthCheckInterval)
+	}
+	if timeout < 0 {
+		return fmt.Errorf("health check timeout must not be negative")
+	}
+	return nil
+}
+
 // CreateClusterRequest contains the data needed to create a cluster
 type CreateClusterRequest struct {
 	Name                string
@@ -56,6 +174,8 @@ type CreateClusterRequest struct {
 	SystemAccountPubKey string     // Optional
 	SystemAccountUserID *uuid.UUID // Optional - if provided, generates encrypted creds
 	SkipVerifyTLS       bool
+	HealthCheckInterval time.Duration // Optional - 0 uses DefaultHealthCheckInterval
+	HealthCheckTimeout  time.Duration // Optional - 0 uses DefaultHealthCheckTimeout
 }
 
 // CreateCluster creates a new cluster configuration and automatically creates a SYS user for management
@@ -67,6 +187,9 @@ func (s *ClusterService) CreateCluster(ctx context.Context, req CreateClusterReq
 	if len(req.ServerURLs) == 0 {
 		return nil, fmt.Errorf("at least one server URL is required")
 	}
+	if err := validateHealthCheckSchedule(req.HealthCheckInterval, req.HealthCheckTimeout); err != nil {
+		return nil, err
+	}
 
 	// Get operator to verify it exists and has system account
 	operator, err := s.operatorRepo.GetByID(ctx, req.OperatorID)
@@ -104,6 +227,8 @@ func (s *ClusterService) CreateCluster(ctx context.Context, req CreateClusterReq
 		SystemAccountPubKey: sysAccount.PublicKey,
 		EncryptedCreds:      "", // Will be set below if system user exists
 		SkipVerifyTLS:       req.SkipVerifyTLS,
+		HealthCheckInterval: req.HealthCheckInterval,
+		HealthCheckTimeout:  req.HealthCheckTimeout,
 		CreatedAt:           time.Now(),
 		UpdatedAt:           time.Now(),
 	}
@@ -167,6 +292,8 @@ type UpdateClusterRequest struct {
 	ServerURLs          []string
 	SystemAccountPubKey *string
 	SkipVerifyTLS       *bool
+	HealthCheckInterval *time.Duration
+	HealthCheckTimeout  *time.Duration
 }
 
 // UpdateCluster updates a cluster's configuration
@@ -177,6 +304,8 @@ func (s *ClusterService) UpdateCluster(ctx context.Context, id uuid.UUID, req Up
 		return nil, err
 	}
 
+	previousKey := connectionKey(cluster)
+
 	// Update fields if provided
 	updated := false
 	if req.Name != nil && *req.Name != cluster.Name {
@@ -212,6 +341,26 @@ func (s *ClusterService) UpdateCluster(ctx context.Context, id uuid.UUID, req Up
 		updated = true
 	}
 
+	if req.HealthCheckInterval != nil || req.HealthCheckTimeout != nil {
+		interval, timeout := cluster.HealthCheckInterval, cluster.HealthCheckTimeout
+		if req.HealthCheckInterval != nil {
+			interval = *req.HealthCheckInterval
+		}
+		if req.HealthCheckTimeout != nil {
+			timeout = *req.HealthCheckTimeout
+		}
+		if err := validateHealthCheckSchedule(interval, timeout); err != nil {
+			return nil, err
+		}
+		if interval != cluster.HealthCheckInterval || timeout != cluster.HealthCheckTimeout {
+			cluster.HealthCheckInterval = interval
+			cluster.HealthCheckTimeout = timeout
+			// Apply the new schedule from the next tick of the health loop
+			cluster.NextHealthCheck = nil
+			updated = true
+		}
+	}
+
 	if !updated {
 		return cluster, nil
 	}
@@ -222,6 +371,9 @@ func (s *ClusterService) UpdateCluster(ctx context.Context, id uuid.UUID, req Up
 	if err := s.repo.Update(ctx, cluster); err != nil {
 		return nil, fmt.Errorf("failed to update cluster: %w", err)
 	}
+	if connectionKey(cluster) != previousKey {
+		s.pool.invalidate(id)
+	}
 
 	return cluster, nil
 }
@@ -259,6 +411,7 @@ func (s *ClusterService) UpdateClusterCredentials(ctx context.Context, id uuid.U
 	if err := s.repo.Update(ctx, cluster); err != nil {
 		return nil, fmt.Errorf("failed to update cluster: %w", err)
 	}
+	s.pool.invalidate(id)
 
 	return cluster, nil
 }
@@ -293,7 +446,12 @@ func (s *ClusterService) DeleteCluster(ctx context.Context, id uuid.UUID) error
 	}
 
 	// Delete cluster
-	return s.repo.Delete(ctx, id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.pool.invalidate(id)
+
+	return nil
 }
 
 // SyncResult contains the result of a sync operation
@@ -304,6 +462,22 @@ type SyncResult struct {
 	AccountsUpdated int
 	RemovedAccounts []string
 	Errors          []SyncError
+	// Servers tallies, per NATS server, how the account JWT pushes were answered
+	Servers []ServerSyncStatus
+	// Peers reports, for the other clusters of the supercluster, whether the pushed
+	// accounts reached them through the gateways
+	Peers []PeerSyncStatus
+}
+
+// ServerSyncStatus counts how one NATS server answered the JWT pushes of a sync.
+// Missing counts pushes the server never acknowledged within the reply window.
+type ServerSyncStatus struct {
+	ServerID     string
+	ServerName   string
+	Cluster      string // Cluster of the server, another member's in a supercluster
+	Acknowledged int
+	Rejected     int
+	Missing      int
 }
 
 // SyncError represents an error encountered during sync
@@ -313,8 +487,9 @@ type SyncError struct {
 	Error            string
 }
 
-// openManagedCluster fetches a cluster, decrypts its system credentials, and opens a NATS
-// connection. Returns the live client and the cluster entity. Caller MUST close the client.
+// openManagedCluster fetches a cluster, decrypts its system credentials, and opens a
+// dedicated NATS connection. Returns the live client and the cluster entity. Caller MUST
+// close the client. Prefer clusterClient, which shares a pooled connection.
 func (s *ClusterService) openManagedCluster(ctx context.Context, id uuid.UUID) (*nats.Client, *entities.Cluster, error) {
 	cluster, err := s.repo.GetByID(ctx, id)
 	if err != nil {
@@ -323,19 +498,205 @@ func (s *ClusterService) openManagedCluster(ctx context.Context, id uuid.UUID) (
 	if cluster.EncryptedCreds == "" {
 		return nil, nil, fmt.Errorf("cluster has no system account credentials configured")
 	}
+	client, err := s.dialCluster(ctx, cluster, nats.ConnectionHandlers{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return client, cluster, nil
+}
+
+// dialCluster decrypts the system credentials of a cluster and connects to it
+func (s *ClusterService) dialCluster(ctx context.Context, cluster *entities.Cluster, handlers nats.ConnectionHandlers) (*nats.Client, error) {
 	credsBytes, err := s.encryptor.Decrypt(ctx, cluster.EncryptedCreds)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to decrypt credentials: %w", err)
+		return nil, fmt.Errorf("failed to decrypt credentials: %w", err)
+	}
+	// Honour the caller's deadline for the connection itself, not only for the requests
+	timeout := defaultConnectTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining <= 0 {
+			return nil, context.DeadlineExceeded
+		} else if remaining < timeout {
+			timeout = remaining
+		}
 	}
-	client, err := nats.NewClientFromCreds(cluster.ServerURLs, string(credsBytes), cluster.SkipVerifyTLS)
+	client, err := nats.NewClientFromCredsWithHandlers(cluster.ServerURLs, string(credsBytes), cluster.SkipVerifyTLS, timeout, handlers)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to connect to NATS cluster: %w", err)
+		return nil, fmt.Errorf("failed to connect to NATS cluster: %w", err)
 	}
-	return client, cluster, nil
+	return client, nil
 }
 
-// SyncCluster pushes all account JWTs for the operator to the NATS cluster resolver
+// listOperatorAccounts pages through every account of an operator
+func (s *ClusterService) listOperatorAccounts(ctx context.Context, operatorID uuid.UUID) ([]*entities.Account, error) {
+	var accounts []*entities.Account
+	for offset := 0; ; offset += syncPageSize {
+		page, err := s.accountRepo.ListByOperator(ctx, operatorID, repositories.ListOptions{
+			Limit:  syncPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, page...)
+		if len(page) < syncPageSize {
+			return accounts, nil
+		}
+	}
+}
+
+// pushAccounts calls push for every account with at most concurrency calls in flight.
+// Each call gets its own syncPushTimeout derived from ctx, so the caller's deadline
+// still bounds the whole batch. The returned errors are in the same order as accounts;
+// accounts that could not be started before ctx expired carry ctx's error.
+func pushAccounts(ctx context.Context, accounts []*entities.Account, concurrency int, push func(context.Context, *entities.Account) error) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	errs := make([]error, len(accounts))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, account := range accounts {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			for j := i; j < len(accounts); j++ {
+				errs[j] = ctx.Err()
+			}
+			wg.Wait()
+			return errs
+		}
+
+		wg.Add(1)
+		go func(i int, account *entities.Account) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			pushCtx, cancel := context.WithTimeout(ctx, syncPushTimeout)
+			defer cancel()
+			errs[i] = push(pushCtx, account)
+		}(i, account)
+	}
+
+	wg.Wait()
+	return errs
+}
+
+// propagationTracker maps claim update replies to the servers discovered with
+// $SYS.REQ.SERVER.PING and tallies them per server across a sync run
+type propagationTracker struct {
+	mu    sync.Mutex
+	known []nats.ServerInfo
+	byID  map[string]*ServerSyncStatus
+	order []string
+}
+
+func newPropagationTracker(servers []nats.ServerInfo) *propagationTracker {
+	t := &propagationTracker{
+		known: servers,
+		byID:  make(map[string]*ServerSyncStatus, len(servers)),
+	}
+	for _, srv := range servers {
+		t.status(srv)
+	}
+	return t
+}
+
+// status returns the tally for a server, creating it on first sight. Caller holds mu
+// or is the constructor.
+func (t *propagationTracker) status(srv nats.ServerInfo) *ServerSyncStatus {
+	st, ok := t.byID[srv.ID]
+	if !ok {
+		st = &ServerSyncStatus{ServerID: srv.ID, ServerName: srv.Name, Cluster: srv.Cluster}
+		t.byID[srv.ID] = st
+		t.order = append(t.order, srv.ID)
+	}
+	return st
+}
+
+// record tallies the replies to one push and returns an error unless every known
+// server acknowledged it. Legacy replies carry no server identity, so they can only
+// be compared by count.
+func (t *propagationTracker) record(acks []nats.ServerAck) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	answered := make(map[string]bool, len(acks))
+	anonymous := 0
+	var rejected []string
+	for _, ack := range acks {
+		if ack.Server.ID == "" {
+			anonymous++
+			if !ack.OK {
+				rejected = append(rejected, ack.Error)
+			}
+			continue
+		}
+
+		answered[ack.Server.ID] = true
+		st := t.status(ack.Server)
+		if ack.OK {
+			st.Acknowledged++
+		} else {
+			st.Rejected++
+			rejected = append(rejected, fmt.Sprintf("%s: %s", ack.Server.Label(), ack.Error))
+		}
+	}
+
+	if len(rejected) > 0 {
+		return fmt.Errorf("rejected by %s", strings.Join(rejected, "; "))
+	}
+
+	if anonymous > 0 {
+		if len(acks) < len(t.known) {
+			return fmt.Errorf("acknowledged by %d of %d servers", len(acks), len(t.known))
+		}
+		return nil
+	}
+
+	var missing []string
+	for _, srv := range t.known {
+		if !answered[srv.ID] {
+			t.byID[srv.ID].Missing++
+			missing = append(missing, srv.Label())
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("not acknowledged by %d of %d servers: %s", len(missing), len(t.known), strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// servers returns the per-server tallies in discovery order
+func (t *propagationTracker) servers() []ServerSyncStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	out := make([]ServerSyncStatus, 0, len(t.order))
+	for _, id := range t.order {
+		out = append(out, *t.byID[id])
+	}
+	return out
+}
+
+// SyncCluster pushes the account JWTs of the operator placed on the cluster to its resolver
 // If prune is true, it also removes accounts from the resolver that are not in the database
+// or not placed on the cluster
+//
+// Accounts are loaded page by page and pushed with bounded concurrency. The whole run is
+// bounded by the sync timeout (see SetSyncLimits); accounts that could not be pushed before
+// the deadline are reported in SyncResult.Errors like any other failed push.
+//
+// Every server's reply to a push is collected and matched against the servers that
+// answered $SYS.REQ.SERVER.PING, so an account that only reached part of the cluster
+// is reported as an error and counted in SyncResult.Servers.
+//
+// In a supercluster the pushes reach the other clusters through the gateways. Each
+// other member is then checked through its own connection and reported in
+// SyncResult.Peers; accounts missing on a member are reported as errors.
 func (s *ClusterService) SyncCluster(ctx context.Context, id uuid.UUID, prune bool) (result *SyncResult, retErr error) {
 	syncStart := time.Now()
 	defer func() {
@@ -346,22 +707,26 @@ func (s *ClusterService) SyncCluster(ctx context.Context, id uuid.UUID, prune bo
 		metrics.Default().RecordClusterSyncDuration(ctx, time.Since(syncStart).Seconds(), outcome)
 	}()
 
-	natsClient, cluster, err := s.openManagedCluster(ctx, id)
+	ctx, cancel := context.WithTimeout(ctx, s.syncTimeout)
+	defer cancel()
+
+	natsClient, cluster, err := s.clusterClient(ctx, id)
 	if err != nil {
 		metrics.Default().RecordClusterSyncError(ctx, "open_cluster")
 		return nil, err
 	}
-	defer func() { _ = natsClient.Close() }()
 
-	// Get all accounts for this operator
-	accounts, err := s.accountRepo.ListByOperator(ctx, cluster.OperatorID, repositories.ListOptions{
-		Limit:  1000, // TODO: Handle pagination if needed
-		Offset: 0,
-	})
+	// Get the accounts of this operator placed on the cluster
+	accounts, err := s.listOperatorAccounts(ctx, cluster.OperatorID)
 	if err != nil {
 		metrics.Default().RecordClusterSyncError(ctx, "list_accounts")
 		return nil, fmt.Errorf("failed to list accounts: %w", err)
 	}
+	accounts, err = s.placedAccounts(ctx, cluster, accounts)
+	if err != nil {
+		metrics.Default().RecordClusterSyncError(ctx, "list_accounts")
+		return nil, err
+	}
 
 	result = &SyncResult{
 		Accounts:        make([]string, 0),
@@ -369,6 +734,22 @@ func (s *ClusterService) SyncCluster(ctx context.Context, id uuid.UUID, prune bo
 		Errors:          make([]SyncError, 0),
 	}
 
+	// Discover the servers of the cluster so every push can be checked against all of them
+	servers, err := natsClient.PingServers(ctx)
+	if err != nil {
+		result.Errors = append(result.Errors, SyncError{
+			Error: fmt.Sprintf("failed to discover cluster servers: %v", err),
+		})
+	}
+	tracker := newPropagationTracker(servers)
+	push := func(ctx context.Context, account *entities.Account) error {
+		acks, err := natsClient.PushAccountJWTToServers(ctx, account, len(servers))
+		if err != nil {
+			return err
+		}
+		return tracker.record(acks)
+	}
+
 	// Build a map of database accounts by public key
 	dbAccountsByPubKey := make(map[string]*entities.Account)
 	for _, account := range accounts {
@@ -387,14 +768,25 @@ func (s *ClusterService) SyncCluster(ctx context.Context, id uuid.UUID, prune bo
 		}
 	}
 
-	// Push each account JWT to the resolver
+	// Skip accounts without JWTs (shouldn't happen, but be defensive)
+	toPush := make([]*entities.Account, 0, len(accounts))
 	for _, account := range accounts {
-		if account.JWT == "" {
-			// Skip accounts without JWTs (shouldn't happen, but be defensive)
-			continue
+		if account.JWT != "" {
+			toPush = append(toPush, account)
 		}
+	}
 
-		if err := natsClient.PushAccountJWT(ctx, account); err != nil {
+	// Push account JWTs to the resolver
+	pushStart := time.Now()
+	pushErrs := pushAccounts(ctx, toPush, s.syncConcurrency, push)
+	pushDuration := time.Since(pushStart)
+
+	failed := 0
+	pushed := make([]*entities.Account, 0, len(toPush))
+	for i, account := range toPush {
+		if err := pushErrs[i]; err != nil {
+			failed++
+			metrics.Default().RecordClusterSyncError(ctx, "push_account")
 			result.Errors = append(result.Errors, SyncError{
 				AccountPublicKey: account.PublicKey,
 				AccountName:      account.Name,
@@ -403,12 +795,41 @@ func (s *ClusterService) SyncCluster(ctx context.Context, id uuid.UUID, prune bo
 			continue
 		}
 
+		pushed = append(pushed, account)
 		result.Accounts = append(result.Accounts, account.Name)
 		result.AccountsUpdated++
 	}
+	result.Servers = tracker.servers()
+
+	metrics.Default().RecordClusterSyncAccounts(ctx, result.AccountsUpdated, failed)
+	rate := 0.0
+	if pushDuration > 0 {
+		rate = float64(result.AccountsUpdated) / pushDuration.Seconds()
+		metrics.Default().RecordClusterSyncThroughput(ctx, rate)
+	}
+	logging.LogFromContext(ctx).Info("pushed account JWTs to cluster",
+		"cluster", cluster.Name,
+		"accounts", len(toPush),
+		"pushed", result.AccountsUpdated,
+		"failed", failed,
+		"servers", len(servers),
+		"duration", pushDuration,
+		"accounts_per_second", rate)
+
+	if cluster.Supercluster != "" {
+		s.verifySupercluster(ctx, cluster, pushed, result)
+	}
 
 	// Prune stale accounts from resolver if requested
 	if prune && len(resolverAccounts) > 0 {
+		if ctx.Err() != nil {
+			metrics.Default().RecordClusterSyncError(ctx, "deadline")
+			result.Errors = append(result.Errors, SyncError{
+				Error: fmt.Sprintf("skipped pruning: %v", ctx.Err()),
+			})
+			return result, nil
+		}
+
 		// Collect stale accounts to delete
 		var staleAccounts []string
 		for _, resolverPubKey := range resolverAccounts {
@@ -461,24 +882,57 @@ func (s *ClusterService) SyncCluster(ctx context.Context, id uuid.UUID, prune bo
 	return result, nil
 }
 
+// verifySupercluster checks the pushed accounts on the other clusters of the
+// supercluster and reports the accounts they miss as sync errors
+func (s *ClusterService) verifySupercluster(ctx context.Context, cluster *entities.Cluster, pushed []*entities.Account, result *SyncResult) {
+	peers, err := s.verifySuperclusterPeers(ctx, cluster, pushed)
+	if err != nil {
+		metrics.Default().RecordClusterSyncError(ctx, "supercluster")
+		result.Errors = append(result.Errors, SyncError{
+			Error: fmt.Sprintf("failed to list supercluster clusters: %v", err),
+		})
+		return
+	}
+	result.Peers = peers
+
+	pubKeys := make(map[string]string, len(pushed))
+	for _, account := range pushed {
+		pubKeys[account.Name] = account.PublicKey
+	}
+	for _, peer := range peers {
+		if peer.Error != "" {
+			metrics.Default().RecordClusterSyncError(ctx, "supercluster")
+			result.Errors = append(result.Errors, SyncError{
+				Error: fmt.Sprintf("failed to verify cluster %s: %s", peer.ClusterName, peer.Error),
+			})
+			continue
+		}
+		for _, name := range peer.Missing {
+			result.Errors = append(result.Errors, SyncError{
+				AccountPublicKey: pubKeys[name],
+				AccountName:      name,
+				Error:            fmt.Sprintf("not propagated to cluster %s", peer.ClusterName),
+			})
+		}
+	}
+}
+
 // ListResolverAccounts lists all account public keys currently on the NATS resolver
 func (s *ClusterService) ListResolverAccounts(ctx context.Context, clusterID uuid.UUID) ([]string, error) {
-	natsClient, _, err := s.openManagedCluster(ctx, clusterID)
+	natsClient, _, err := s.clusterClient(ctx, clusterID)
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = natsClient.Close() }()
 
 	return natsClient.ListAccountsFromResolver(ctx)
 }
 
 // DeleteResolverAccount removes an account from the NATS resolver
 func (s *ClusterService) DeleteResolverAccount(ctx context.Context, clusterID uuid.UUID, publicKey string) error {
-	natsClient, cluster, err := s.openManagedCluster(ctx, clusterID)
+	natsClient, cluster, err := s.clusterClient(ctx, clusterID)
 	if err != nil {
 		return err
 	}
-	defer func() { _ = natsClient.Close() }()
 
 	// Safety check: don't allow deleting the system account
 	if publicKey == cluster.SystemAccountPubKey {
@@ -501,36 +955,187 @@ func (s *ClusterService) DeleteResolverAccount(ctx context.Context, clusterID uu
 	return natsClient.DeleteAccountJWT(ctx, deleteClaimJWT)
 }
 
-// CheckClusterHealth checks if a cluster is reachable and updates its health status
+// AccountVerification reports which servers of a cluster hold an account's current JWT
+type AccountVerification struct {
+	AccountPublicKey string
+	AccountName      string
+	// Servers lists every server that answered the ping or the resolver listing
+	Servers []ServerVerification
+	// CurrentCopies, MismatchedCopies and MissingCopies tally the answers to the JWT
+	// lookup: the JWT stored in the database, a different JWT, or no JWT at all
+	CurrentCopies    int
+	MismatchedCopies int
+	MissingCopies    int
+	// Propagated is true when every server holds the current JWT
+	Propagated bool
+}
+
+// ServerVerification is the state of an account on a single NATS server
+type ServerVerification struct {
+	ServerID   string
+	ServerName string
+	// Responded is false when the server answered the ping but not the resolver listing
+	Responded  bool
+	HasAccount bool
+}
+
+// VerifyAccount checks that an account's JWT reached every server of a cluster. The
+// servers are discovered with $SYS.REQ.SERVER.PING, presence per server comes from
+// $SYS.REQ.CLAIMS.LIST, and the stored copies are compared through the claims lookup.
+func (s *ClusterService) VerifyAccount(ctx context.Context, clusterID, accountID uuid.UUID) (*AccountVerification, error) {
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	natsClient, cluster, err := s.clusterClient(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if account.OperatorID != cluster.OperatorID {
+		return nil, fmt.Errorf("account %s does not belong to the operator of cluster %s", account.Name, cluster.Name)
+	}
+
+	servers, err := natsClient.PingServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Servers that stay silent are reported as not responding rather than failing the check
+	lists, err := natsClient.ListAccountsByServer(ctx, len(servers))
+	if err != nil {
+		logging.LogFromContext(ctx).Warn("no resolver answered the account listing",
+			"cluster", cluster.Name, "error", err)
+	}
+	copies, err := natsClient.LookupAccountJWTs(ctx, account.PublicKey, len(servers))
+	if err != nil {
+		logging.LogFromContext(ctx).Warn("no resolver answered the account lookup",
+			"cluster", cluster.Name, "account", account.Name, "error", err)
+	}
+
+	return buildAccountVerification(account, servers, lists, copies), nil
+}
+
+// buildAccountVerification merges the ping, listing and lookup replies into a verification
+func buildAccountVerification(account *entities.Account, servers []nats.ServerInfo, lists []nats.ServerAccounts, copies []string) *AccountVerification {
+	v := &AccountVerification{
+		AccountPublicKey: account.PublicKey,
+		AccountName:      account.Name,
+		Servers:          make([]ServerVerification, 0, len(servers)),
+	}
+
+	index := make(map[string]int, len(servers))
+	for _, srv := range servers {
+		index[srv.ID] = len(v.Servers)
+		v.Servers = append(v.Servers, ServerVerification{ServerID: srv.ID, ServerName: srv.Name})
+	}
+
+	for _, list := range lists {
+		if list.Server.ID == "" {
+			continue
+		}
+		i, ok := index[list.Server.ID]
+		if !ok {
+			i = len(v.Servers)
+			index[list.Server.ID] = i
+			v.Servers = append(v.Servers, ServerVerification{ServerID: list.Server.ID, ServerName: list.Server.Name})
+		}
+		v.Servers[i].Responded = true
+		for _, pubKey := range list.Accounts {
+			if pubKey == account.PublicKey {
+				v.Servers[i].HasAccount = true
+				break
+			}
+		}
+	}
+
+	for _, c := range copies {
+		switch c {
+		case "":
+			v.MissingCopies++
+		case account.JWT:
+			v.CurrentCopies++
+		default:
+			v.MismatchedCopies++
+		}
+	}
+
+	v.Propagated = len(v.Servers) > 0 &&
+		v.CurrentCopies >= len(v.Servers) &&
+		v.MismatchedCopies == 0 &&
+		v.MissingCopies == 0
+	for _, srv := range v.Servers {
+		if !srv.HasAccount {
+			v.Propagated = false
+		}
+	}
+
+	return v
+}
+
+// CheckClusterHealth checks if a cluster is reachable and updates its health status.
+// Every check is recorded in the health check history with its latency, and the
+// next check is scheduled from the cluster's interval, backing off while unhealthy.
 func (s *ClusterService) CheckClusterHealth(ctx context.Context, id uuid.UUID) error {
 	cluster, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return fmt.Errorf("failed to get cluster: %w", err)
 	}
 
+	interval, timeout := healthCheckSchedule(cluster)
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	healthy := false
 	var healthErr string
+	var latency time.Duration
 	now := time.Now()
 
 	// Try to connect to the cluster
 	if cluster.EncryptedCreds != "" {
-		natsClient, _, connErr := s.openManagedCluster(ctx, id)
+		natsClient, _, connErr := s.clusterClient(checkCtx, id)
+		if connErr == nil {
+			// Latency covers one round trip to the server, plus connecting when the
+			// pooled connection had to be opened
+			connErr = natsClient.Flush(checkCtx)
+			latency = time.Since(now)
+		}
 		if connErr != nil {
 			healthErr = connErr.Error()
 			metrics.Default().RecordClusterHealthCheckFailure(ctx)
 		} else {
 			healthy = true
-			_ = natsClient.Close()
+			s.recordClusterServers(checkCtx, natsClient, cluster, now)
 		}
 	} else {
 		healthErr = "no credentials configured"
 		metrics.Default().RecordClusterHealthCheckFailure(ctx)
 	}
 
+	if err := s.healthRepo.Create(ctx, &entities.ClusterHealthCheck{
+		ID:        uuid.New(),
+		ClusterID: cluster.ID,
+		CheckedAt: now,
+		Healthy:   healthy,
+		Latency:   latency,
+		Error:     healthErr,
+	}); err != nil {
+		logging.LogFromContext(ctx).Warn("failed to record cluster health check",
+			"cluster", cluster.Name, "error", err)
+	}
+
 	// Update health status
+	if healthy {
+		cluster.HealthCheckFailures = 0
+	} else {
+		cluster.HealthCheckFailures++
+	}
+	next := now.Add(healthCheckBackoff(interval, cluster.HealthCheckFailures))
 	cluster.Healthy = healthy
 	cluster.LastHealthCheck = &now
 	cluster.HealthCheckError = healthErr
+	cluster.NextHealthCheck = &next
 	cluster.UpdatedAt = time.Now()
 
 	if err := s.repo.Update(ctx, cluster); err != nil {
@@ -540,20 +1145,252 @@ func (s *ClusterService) CheckClusterHealth(ctx context.Context, id uuid.UUID) e
 	return nil
 }
 
-// CheckAllClustersHealth checks health for all clusters
+// healthCheckSchedule returns the effective health check interval and timeout of a cluster
+func healthCheckSchedule(cluster *entities.Cluster) (time.Duration, time.Duration) {
+	interval := cluster.HealthCheckInterval
+	if interval <= 0 {
+		interval = DefaultHealthCheckInterval
+	}
+	timeout := cluster.HealthCheckTimeout
+	if timeout <= 0 {
+		timeout = DefaultHealthCheckTimeout
+	}
+	return interval, timeout
+}
+
+// healthCheckBackoff returns the delay before the next check: the interval while the
+// cluster is healthy, doubling with every consecutive failure up to maxHealthCheckBackoff
+// (or the interval itself if that is longer)
+func healthCheckBackoff(interval time.Duration, failures int) time.Duration {
+	limit := maxHealthCheckBackoff
+	if interval > limit {
+		limit = interval
+	}
+
+	delay := interval
+	for i := 0; i < failures && delay < limit; i++ {
+		delay *= 2
+	}
+	if delay > limit {
+		delay = limit
+	}
+	return delay
+}
+
+// CheckAllClustersHealth checks the clusters whose next health check is due, then prunes
+// the health check history past the retention period. Meant to be called on a short tick.
 func (s *ClusterService) CheckAllClustersHealth(ctx context.Context) error {
-	clusters, err := s.repo.List(ctx, repositories.ListOptions{
-		Limit:  1000, // TODO: Handle pagination
-		Offset: 0,
-	})
-	if err != nil {
-		return fmt.Errorf("failed to list clusters: %w", err)
+	now := time.Now()
+	existing := make(map[uuid.UUID]bool)
+	for offset := 0; ; offset += syncPageSize {
+		clusters, err := s.repo.List(ctx, repositories.ListOptions{
+			Limit:  syncPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list clusters: %w", err)
+		}
+
+		for _, cluster := range clusters {
+			existing[cluster.ID] = true
+			if cluster.NextHealthCheck != nil && cluster.NextHealthCheck.After(now) {
+				continue
+			}
+			// Check each cluster's health (ignore errors for individual clusters)
+			_ = s.CheckClusterHealth(ctx, cluster.ID)
+		}
+
+		if len(clusters) < syncPageSize {
+			break
+		}
 	}
 
-	for _, cluster := range clusters {
-		// Check each cluster's health (ignore errors for individual clusters)
-		_ = s.CheckClusterHealth(ctx, cluster.ID)
+	// Clusters deleted through another replica leave their connection behind
+	s.pool.retain(existing)
+
+	if _, err := s.healthRepo.DeleteBefore(ctx, now.Add(-s.healthRetention)); err != nil {
+		return fmt.Errorf("failed to prune cluster health checks: %w", err)
+	}
+	if _, err := s.authFailures.DeleteBefore(ctx, now.Add(-s.authFailureRetention)); err != nil {
+		return fmt.Errorf("failed to prune auth failures: %w", err)
 	}
 
 	return nil
 }
+
+// ListHealthChecks returns the health check history of a cluster, newest first
+func (s *ClusterService) ListHealthChecks(ctx context.Context, id uuid.UUID, opts repositories.ListOptions) ([]*entities.ClusterHealthCheck, error) {
+	return s.healthRepo.ListByCluster(ctx, id, opts)
+}
+
+// ClusterUptime returns the share of healthy checks of a cluster over UptimeWindow, as a
+// percentage, and the number of checks it is based on. With no checks it returns 0, 0.
+func (s *ClusterService) ClusterUptime(ctx context.Context, id uuid.UUID) (float64, int64, error) {
+	total, healthy, err := s.healthRepo.CountSince(ctx, id, time.Now().Add(-UptimeWindow))
+	if err != nil {
+		return 0, 0, err
+	}
+	if total == 0 {
+		return 0, 0, nil
+	}
+	return float64(healthy) * 100 / float64(total), total, nil
+}
+
+// recordClusterServers pings every server of a connected cluster and stores what they
+// report, stamped with the health check time. Failures only cost the topology refresh,
+// they never mark the cluster unhealthy.
+func (s *ClusterService) recordClusterServers(ctx context.Context, natsClient *nats.Client, cluster *entities.Cluster, now time.Time) {
+	stats, err := natsClient.PingServerStats(ctx)
+	if err != nil {
+		logging.LogFromContext(ctx).Warn("failed to ping cluster servers",
+			"cluster", cluster.Name, "error", err)
+		return
+	}
+
+	capacity := s.serverJetStreamCapacity(ctx, natsClient, cluster, stats)
+
+	for _, st := range stats {
+		server := &entities.ClusterServer{
+			ID:          uuid.New(),
+			ClusterID:   cluster.ID,
+			ServerID:    st.Server.ID,
+			Name:        st.Server.Name,
+			Host:        st.Server.Host,
+			Version:     st.Server.Version,
+			JetStream:   st.Server.JetStream,
+			ClusterName: st.Server.Cluster,
+			Gateways:    st.Gateways,
+			Connections: st.Connections,
+			LastSeen:    now,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		}
+		if !st.Start.IsZero() {
+			started := st.Start
+			server.StartedAt = &started
+		}
+		if config, ok := capacity[st.Server.ID]; ok {
+			server.JetStreamMaxMemory = config.MaxMemory
+			server.JetStreamMaxStorage = config.MaxStorage
+		}
+		if err := s.serverRepo.Upsert(ctx, server); err != nil {
+			logging.LogFromContext(ctx).Warn("failed to record cluster server",
+				"cluster", cluster.Name, "server", st.Server.Label(), "error", err)
+		}
+	}
+
+	if _, err := s.serverRepo.DeleteStale(ctx, cluster.ID, now.Add(-serverRecordRetention)); err != nil {
+		logging.LogFromContext(ctx).Warn("failed to prune cluster servers",
+			"cluster", cluster.Name, "error", err)
+	}
+}
+
+// serverJetStreamCapacity asks the JetStream servers of a cluster for their configured
+// capacity via JSZ. Servers that did not answer are missing from the result.
+func (s *ClusterService) serverJetStreamCapacity(ctx context.Context, natsClient *nats.Client, cluster *entities.Cluster, stats []nats.ServerStats) map[string]nats.JetStreamConfig {
+	jetStream := false
+	for _, st := range stats {
+		jetStream = jetStream || st.Server.JetStream
+	}
+	if !jetStream {
+		return nil
+	}
+
+	// Servers without JetStream answer JSZ too, flagged as disabled
+	infos, err := natsClient.JetStreamInfo(ctx, false, 0, len(stats))
+	if err != nil {
+		logging.LogFromContext(ctx).Warn("failed to get cluster JetStream capacity",
+			"cluster", cluster.Name, "error", err)
+		return nil
+	}
+
+	capacity := make(map[string]nats.JetStreamConfig, len(infos))
+	for _, info := range infos {
+		if info.Disabled || info.Error != "" {
+			continue
+		}
+		capacity[info.Server.ID] = info.Config
+	}
+	return capacity
+}
+
+// ClusterTopology is the per-server view of a cluster recorded by the last health checks
+type ClusterTopology struct {
+	Cluster *entities.Cluster
+	Servers []TopologyServer
+	// Warnings flags version skew and servers missing from the last health check
+	Warnings []string
+}
+
+// TopologyServer is a recorded server and whether it answered the last health check
+type TopologyServer struct {
+	*entities.ClusterServer
+	Missing bool
+}
+
+// GetClusterTopology returns the servers recorded for a cluster, flagging the ones that
+// did not answer the last health check and any version skew between the others
+func (s *ClusterService) GetClusterTopology(ctx context.Context, id uuid.UUID) (*ClusterTopology, error) {
+	cluster, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := s.serverRepo.ListByCluster(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster servers: %w", err)
+	}
+
+	return buildClusterTopology(cluster, servers), nil
+}
+
+// buildClusterTopology flags missing servers and computes the topology warnings
+func buildClusterTopology(cluster *entities.Cluster, servers []*entities.ClusterServer) *ClusterTopology {
+	topology := &ClusterTopology{
+		Cluster:  cluster,
+		Servers:  make([]TopologyServer, 0, len(servers)),
+		Warnings: make([]string, 0),
+	}
+
+	// Servers are stamped with the health check time, so anything older missed the last one
+	versions := make(map[string][]string)
+	var versionOrder []string
+	present := 0
+	for _, srv := range servers {
+		missing := cluster.LastHealthCheck != nil && srv.LastSeen.Before(*cluster.LastHealthCheck)
+		topology.Servers = append(topology.Servers, TopologyServer{ClusterServer: srv, Missing: missing})
+
+		label := srv.Name
+		if label == "" {
+			label = srv.ServerID
+		}
+		if missing {
+			topology.Warnings = append(topology.Warnings, fmt.Sprintf(
+				"server %s did not answer the last health check (last seen %s)",
+				label, srv.LastSeen.UTC().Format(time.RFC3339)))
+			continue
+		}
+
+		present++
+		if _, ok := versions[srv.Version]; !ok {
+			versionOrder = append(versionOrder, srv.Version)
+		}
+		versions[srv.Version] = append(versions[srv.Version], label)
+	}
+
+	if len(versionOrder) > 1 {
+		parts := make([]string, 0, len(versionOrder))
+		for _, version := range versionOrder {
+			parts = append(parts, fmt.Sprintf("%s on %s", version, strings.Join(versions[version], ", ")))
+		}
+		topology.Warnings = append(topology.Warnings, "version skew: "+strings.Join(parts, "; "))
+	}
+
+	if cluster.LastHealthCheck != nil && cluster.Healthy && present < len(cluster.ServerURLs) {
+		topology.Warnings = append(topology.Warnings, fmt.Sprintf(
+			"%d server URLs configured but only %d servers answered the last health check",
+			len(cluster.ServerURLs), present))
+	}
+
+	return topology
+}
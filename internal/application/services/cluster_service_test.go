@@ -1,9 +1,21 @@
 package services
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
 )
 
 func TestSyncResult_Empty(t *testing.T) {
@@ -124,3 +136,664 @@ func TestSyncError_AccountIdentification(t *testing.T) {
 		})
 	}
 }
+
+func testSyncAccounts(n int) []*entities.Account {
+	accounts := make([]*entities.Account, n)
+	for i := range accounts {
+		accounts[i] = &entities.Account{Name: fmt.Sprintf("account-%d", i), JWT: "jwt"}
+	}
+	return accounts
+}
+
+func TestPushAccounts_PreservesOrder(t *testing.T) {
+	accounts := testSyncAccounts(50)
+	errFailed := errors.New("push failed")
+
+	errs := pushAccounts(context.Background(), accounts, 8, func(_ context.Context, a *entities.Account) error {
+		if a.Name == "account-7" || a.Name == "account-42" {
+			return errFailed
+		}
+		return nil
+	})
+
+	require.Len(t, errs, len(accounts))
+	for i, err := range errs {
+		if i == 7 || i == 42 {
+			assert.ErrorIs(t, err, errFailed)
+		} else {
+			assert.NoError(t, err, "account %d", i)
+		}
+	}
+}
+
+func TestPushAccounts_BoundedConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+
+	errs := pushAccounts(context.Background(), testSyncAccounts(40), 4, func(_ context.Context, _ *entities.Account) error {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		inFlight.Add(-1)
+		return nil
+	})
+
+	assert.Len(t, errs, 40)
+	assert.LessOrEqual(t, peak.Load(), int32(4))
+	assert.Greater(t, peak.Load(), int32(1))
+}
+
+func TestPushAccounts_HonoursDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	var pushed atomic.Int32
+	errs := pushAccounts(ctx, testSyncAccounts(100), 2, func(ctx context.Context, _ *entities.Account) error {
+		select {
+		case <-time.After(10 * time.Millisecond):
+			pushed.Add(1)
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+
+	require.Len(t, errs, 100)
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+			failed++
+		}
+	}
+	assert.Equal(t, 100, failed+int(pushed.Load()))
+	assert.Greater(t, failed, 50)
+}
+
+func testServers() []nats.ServerInfo {
+	return []nats.ServerInfo{
+		{ID: "NSRV1", Name: "nats-1"},
+		{ID: "NSRV2", Name: "nats-2"},
+		{ID: "NSRV3", Name: "nats-3"},
+	}
+}
+
+func TestPropagationTracker_AllServersAcknowledge(t *testing.T) {
+	servers := testServers()
+	tracker := newPropagationTracker(servers)
+
+	acks := make([]nats.ServerAck, 0, len(servers))
+	for _, srv := range servers {
+		acks = append(acks, nats.ServerAck{Server: srv, OK: true})
+	}
+
+	require.NoError(t, tracker.record(acks))
+	require.NoError(t, tracker.record(acks))
+
+	statuses := tracker.servers()
+	require.Len(t, statuses, 3)
+	for i, st := range statuses {
+		assert.Equal(t, servers[i].ID, st.ServerID)
+		assert.Equal(t, 2, st.Acknowledged)
+		assert.Zero(t, st.Rejected)
+		assert.Zero(t, st.Missing)
+	}
+}
+
+func TestPropagationTracker_PartialPropagation(t *testing.T) {
+	servers := testServers()
+	tracker := newPropagationTracker(servers)
+
+	err := tracker.record([]nats.ServerAck{
+		{Server: servers[0], OK: true},
+		{Server: servers[1], OK: true},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not acknowledged by 1 of 3 servers: nats-3")
+
+	statuses := tracker.servers()
+	assert.Equal(t, 1, statuses[0].Acknowledged)
+	assert.Equal(t, 1, statuses[1].Acknowledged)
+	assert.Equal(t, 0, statuses[2].Acknowledged)
+	assert.Equal(t, 1, statuses[2].Missing)
+}
+
+func TestPropagationTracker_Rejection(t *testing.T) {
+	servers := testServers()
+	tracker := newPropagationTracker(servers)
+
+	err := tracker.record([]nats.ServerAck{
+		{Server: servers[0], OK: true},
+		{Server: servers[1], Error: "resolver error 500: jwt validation failed"},
+		{Server: servers[2], OK: true},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "nats-2: resolver error 500")
+	assert.Equal(t, 1, tracker.servers()[1].Rejected)
+}
+
+func TestPropagationTracker_UnknownServerIsAdded(t *testing.T) {
+	tracker := newPropagationTracker(nil)
+
+	require.NoError(t, tracker.record([]nats.ServerAck{
+		{Server: nats.ServerInfo{ID: "NLATE", Name: "late"}, OK: true},
+	}))
+
+	statuses := tracker.servers()
+	require.Len(t, statuses, 1)
+	assert.Equal(t, "NLATE", statuses[0].ServerID)
+	assert.Equal(t, 1, statuses[0].Acknowledged)
+}
+
+func TestPropagationTracker_LegacyRepliesComparedByCount(t *testing.T) {
+	tracker := newPropagationTracker(testServers())
+
+	err := tracker.record([]nats.ServerAck{{OK: true}, {OK: true}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "acknowledged by 2 of 3 servers")
+
+	assert.NoError(t, tracker.record([]nats.ServerAck{{OK: true}, {OK: true}, {OK: true}}))
+}
+
+func TestBuildAccountVerification(t *testing.T) {
+	account := &entities.Account{Name: "app", PublicKey: "ACCOUNT", JWT: "current.jwt"}
+	servers := testServers()
+
+	t.Run("propagated", func(t *testing.T) {
+		lists := []nats.ServerAccounts{
+			{Server: servers[0], Accounts: []string{"OTHER", "ACCOUNT"}},
+			{Server: servers[1], Accounts: []string{"ACCOUNT"}},
+			{Server: servers[2], Accounts: []string{"ACCOUNT"}},
+		}
+		copies := []string{"current.jwt", "current.jwt", "current.jwt"}
+
+		v := buildAccountVerification(account, servers, lists, copies)
+		assert.True(t, v.Propagated)
+		assert.Equal(t, 3, v.CurrentCopies)
+		for _, srv := range v.Servers {
+			assert.True(t, srv.Responded)
+			assert.True(t, srv.HasAccount)
+		}
+	})
+
+	t.Run("stale and missing copies", func(t *testing.T) {
+		lists := []nats.ServerAccounts{
+			{Server: servers[0], Accounts: []string{"ACCOUNT"}},
+			{Server: servers[1], Accounts: []string{"ACCOUNT"}},
+			{Server: servers[2], Accounts: []string{"OTHER"}},
+		}
+		copies := []string{"current.jwt", "old.jwt", ""}
+
+		v := buildAccountVerification(account, servers, lists, copies)
+		assert.False(t, v.Propagated)
+		assert.Equal(t, 1, v.CurrentCopies)
+		assert.Equal(t, 1, v.MismatchedCopies)
+		assert.Equal(t, 1, v.MissingCopies)
+		assert.False(t, v.Servers[2].HasAccount)
+	})
+
+	t.Run("silent server", func(t *testing.T) {
+		lists := []nats.ServerAccounts{
+			{Server: servers[0], Accounts: []string{"ACCOUNT"}},
+			{Server: servers[1], Accounts: []string{"ACCOUNT"}},
+		}
+		copies := []string{"current.jwt", "current.jwt"}
+
+		v := buildAccountVerification(account, servers, lists, copies)
+		assert.False(t, v.Propagated)
+		assert.False(t, v.Servers[2].Responded)
+	})
+
+	t.Run("no servers", func(t *testing.T) {
+		v := buildAccountVerification(account, nil, nil, nil)
+		assert.False(t, v.Propagated)
+		assert.Empty(t, v.Servers)
+	})
+}
+
+func TestBuildClusterTopology(t *testing.T) {
+	checkedAt := time.Now()
+	cluster := &entities.Cluster{
+		Name:            "prod",
+		ServerURLs:      []string{"nats://a:4222", "nats://b:4222", "nats://c:4222"},
+		Healthy:         true,
+		LastHealthCheck: &checkedAt,
+	}
+	server := func(id, name, version string, lastSeen time.Time) *entities.ClusterServer {
+		return &entities.ClusterServer{ServerID: id, Name: name, Version: version, LastSeen: lastSeen}
+	}
+
+	t.Run("healthy topology", func(t *testing.T) {
+		topology := buildClusterTopology(cluster, []*entities.ClusterServer{
+			server("NA", "nats-a", "2.10.0", checkedAt),
+			server("NB", "nats-b", "2.10.0", checkedAt),
+			server("NC", "nats-c", "2.10.0", checkedAt),
+		})
+		assert.Len(t, topology.Servers, 3)
+		assert.Empty(t, topology.Warnings)
+		for _, srv := range topology.Servers {
+			assert.False(t, srv.Missing)
+		}
+	})
+
+	t.Run("version skew", func(t *testing.T) {
+		topology := buildClusterTopology(cluster, []*entities.ClusterServer{
+			server("NA", "nats-a", "2.10.0", checkedAt),
+			server("NB", "nats-b", "2.11.0", checkedAt),
+			server("NC", "nats-c", "2.10.0", checkedAt),
+		})
+		require.Len(t, topology.Warnings, 1)
+		assert.Equal(t, "version skew: 2.10.0 on nats-a, nats-c; 2.11.0 on nats-b", topology.Warnings[0])
+	})
+
+	t.Run("missing server", func(t *testing.T) {
+		topology := buildClusterTopology(cluster, []*entities.ClusterServer{
+			server("NA", "nats-a", "2.10.0", checkedAt),
+			server("NB", "nats-b", "2.10.0", checkedAt),
+			server("NC", "nats-c", "2.9.0", checkedAt.Add(-time.Hour)),
+		})
+		assert.True(t, topology.Servers[2].Missing)
+		require.Len(t, topology.Warnings, 2)
+		assert.Contains(t, topology.Warnings[0], "server nats-c did not answer the last health check")
+		assert.Equal(t, "3 server URLs configured but only 2 servers answered the last health check", topology.Warnings[1])
+	})
+
+	t.Run("never checked", func(t *testing.T) {
+		topology := buildClusterTopology(&entities.Cluster{ServerURLs: cluster.ServerURLs}, nil)
+		assert.Empty(t, topology.Servers)
+		assert.Empty(t, topology.Warnings)
+	})
+}
+
+func TestHealthCheckBackoff(t *testing.T) {
+	interval := time.Minute
+
+	assert.Equal(t, interval, healthCheckBackoff(interval, 0))
+	assert.Equal(t, 2*time.Minute, healthCheckBackoff(interval, 1))
+	assert.Equal(t, 8*time.Minute, healthCheckBackoff(interval, 3))
+	// Capped while the cluster stays down
+	assert.Equal(t, maxHealthCheckBackoff, healthCheckBackoff(interval, 10))
+	assert.Equal(t, maxHealthCheckBackoff, healthCheckBackoff(interval, 1000))
+	// An interval above the cap is never shortened
+	assert.Equal(t, time.Hour, healthCheckBackoff(time.Hour, 5))
+}
+
+func TestHealthCheckSchedule(t *testing.T) {
+	interval, timeout := healthCheckSchedule(&entities.Cluster{})
+	assert.Equal(t, DefaultHealthCheckInterval, interval)
+	assert.Equal(t, DefaultHealthCheckTimeout, timeout)
+
+	interval, timeout = healthCheckSchedule(&entities.Cluster{
+		HealthCheckInterval: 5 * time.Minute,
+		HealthCheckTimeout:  3 * time.Second,
+	})
+	assert.Equal(t, 5*time.Minute, interval)
+	assert.Equal(t, 3*time.Second, timeout)
+
+	assert.NoError(t, validateHealthCheckSchedule(0, 0))
+	assert.NoError(t, validateHealthCheckSchedule(30*time.Second, time.Second))
+	assert.Error(t, validateHealthCheckSchedule(time.Second, 0))
+	assert.Error(t, validateHealthCheckSchedule(time.Minute, -time.Second))
+}
+
+func TestAggregateJetStreamUsage(t *testing.T) {
+	stream := func(name string, consumers int) nats.JetStreamStream {
+		var s nats.JetStreamStream
+		s.Name = name
+		s.State.Consumers = consumers
+		return s
+	}
+
+	// ORDERS is an R3 stream, EVENTS only lives on the first server
+	details := []nats.JetStreamAccount{
+		{Name: "AXYZ", Memory: 10, Storage: 100, Streams: []nats.JetStreamStream{stream("ORDERS", 2), stream("EVENTS", 1)}},
+		{Name: "AXYZ", Memory: 10, Storage: 100, Streams: []nats.JetStreamStream{stream("ORDERS", 2)}},
+		{Name: "AXYZ", Memory: 10, Storage: 90, Streams: []nats.JetStreamStream{stream("ORDERS", 1)}},
+	}
+
+	usage := aggregateJetStreamUsage(details)
+	assert.Equal(t, int64(30), usage.Memory)
+	assert.Equal(t, int64(290), usage.Storage)
+	assert.Equal(t, int64(2), usage.Streams)
+	assert.Equal(t, int64(3), usage.Consumers)
+
+	assert.Equal(t, JetStreamUsage{}, aggregateJetStreamUsage(nil))
+}
+
+func TestRecordedClusterCapacity(t *testing.T) {
+	cluster := &entities.Cluster{Name: "east"}
+	now := time.Now()
+	servers := []*entities.ClusterServer{
+		{Name: "nats-1", JetStream: true, JetStreamMaxMemory: 1 << 30, JetStreamMaxStorage: 10 << 30, LastSeen: now},
+		{Name: "nats-2", JetStream: true, JetStreamMaxMemory: 1 << 30, JetStreamMaxStorage: 10 << 30, LastSeen: now},
+		// No JetStream, unknown capacity and a server that left the cluster are ignored
+		{Name: "nats-3", LastSeen: now},
+		{Name: "nats-4", JetStream: true, LastSeen: now},
+		{Name: "nats-old", JetStream: true, JetStreamMaxMemory: 1 << 30, JetStreamMaxStorage: 10 << 30, LastSeen: now.Add(-time.Hour)},
+	}
+
+	capacity := recordedClusterCapacity(cluster, servers)
+	assert.Equal(t, 2, capacity.JetStreamServers)
+	assert.Equal(t, int64(2<<30), capacity.MemoryCapacity)
+	assert.Equal(t, int64(20<<30), capacity.StorageCapacity)
+
+	capacity.assign([]*entities.Account{
+		{Name: "orders", JetStreamEnabled: true, JetStreamMaxMemory: 1 << 30, JetStreamMaxStorage: 15 << 30},
+		{Name: "events", JetStreamEnabled: true, JetStreamMaxMemory: -1, JetStreamMaxStorage: 10 << 30},
+		{Name: "disabled", JetStreamMaxMemory: 100 << 30, JetStreamMaxStorage: 100 << 30},
+	})
+	assert.Equal(t, 2, capacity.Accounts)
+	assert.Equal(t, int64(1<<30), capacity.MemoryAssigned)
+	assert.Equal(t, int64(25<<30), capacity.StorageAssigned)
+	assert.Equal(t, []string{"events"}, capacity.UnlimitedMemory)
+	assert.Empty(t, capacity.UnlimitedStorage)
+
+	warnings := capacity.Overcommitted()
+	require.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "storage")
+
+	// Unknown capacity never warns
+	unknown := recordedClusterCapacity(cluster, nil)
+	unknown.assign([]*entities.Account{{Name: "orders", JetStreamEnabled: true, JetStreamMaxStorage: 1}})
+	assert.Empty(t, unknown.Overcommitted())
+}
+
+func TestLatestServerCount(t *testing.T) {
+	now := time.Now()
+	servers := []*entities.ClusterServer{
+		{Name: "nats-1", LastSeen: now},
+		{Name: "nats-2", LastSeen: now},
+		{Name: "nats-old", LastSeen: now.Add(-time.Hour)},
+	}
+	assert.Equal(t, 2, latestServerCount(servers))
+	assert.Equal(t, 0, latestServerCount(nil))
+}
+
+func TestAccountStatsDeltas(t *testing.T) {
+	statz := func(conns int, msgsIn, bytesOut, slow int64) nats.AccountStatz {
+		return nats.AccountStatz{
+			Conns:         conns,
+			Received:      nats.DataStats{Msgs: msgsIn, Bytes: msgsIn * 10},
+			Sent:          nats.DataStats{Msgs: bytesOut / 10, Bytes: bytesOut},
+			SlowConsumers: slow,
+		}
+	}
+
+	previous := map[statzKey]nats.AccountStatz{
+		{server: "S1", account: "AONE"}: statz(2, 100, 1000, 1),
+		{server: "S2", account: "AONE"}: statz(1, 500, 0, 0),
+	}
+	current := map[statzKey]nats.AccountStatz{
+		{server: "S1", account: "AONE"}: statz(3, 150, 1500, 2),
+		// S2 restarted, its counters start over
+		{server: "S2", account: "AONE"}: statz(1, 20, 0, 0),
+		// S3 is new, it only contributes connections
+		{server: "S3", account: "AONE"}: statz(4, 9000, 9000, 5),
+		{server: "S1", account: "AIDLE"}: statz(0, 0, 0, 0),
+	}
+
+	samples := accountStatsDeltas(previous, current)
+	require.Len(t, samples, 2)
+
+	one := samples["AONE"]
+	assert.Equal(t, int64(1), one.Samples)
+	assert.Equal(t, int64(8), one.ConnectionsSum)
+	assert.Equal(t, int64(8), one.MaxConnections)
+	assert.Equal(t, int64(70), one.MsgsIn)
+	assert.Equal(t, int64(700), one.BytesIn)
+	assert.Equal(t, int64(50), one.MsgsOut)
+	assert.Equal(t, int64(500), one.BytesOut)
+	assert.Equal(t, int64(1), one.SlowConsumers)
+	assert.False(t, idleAccountStats(one))
+
+	assert.True(t, idleAccountStats(samples["AIDLE"]))
+}
+
+func TestConnectionKey(t *testing.T) {
+	cluster := &entities.Cluster{
+		Name:           "east",
+		ServerURLs:     []string{"nats://a:4222", "nats://b:4222"},
+		EncryptedCreds: "encrypted:key-1:abc",
+	}
+	key := connectionKey(cluster)
+
+	// Renames and health updates keep the connection
+	renamed := *cluster
+	renamed.Name = "west"
+	renamed.Healthy = true
+	renamed.UpdatedAt = time.Now()
+	assert.Equal(t, key, connectionKey(&renamed))
+
+	// Anything the connection is opened with requires a new one
+	for name, change := range map[string]func(*entities.Cluster){
+		"urls":  func(c *entities.Cluster) { c.ServerURLs = []string{"nats://a:4222"} },
+		"creds": func(c *entities.Cluster) { c.EncryptedCreds = "encrypted:key-1:def" },
+		"tls":   func(c *entities.Cluster) { c.SkipVerifyTLS = true },
+	} {
+		changed := *cluster
+		change(&changed)
+		assert.NotEqual(t, key, connectionKey(&changed), name)
+	}
+}
+
+func TestClusterPool_ClosedConnectionsAreNotReused(t *testing.T) {
+	pool := newClusterPool()
+	cluster := &entities.Cluster{ID: uuid.New(), Name: "east"}
+	key := connectionKey(cluster)
+
+	// A client without a live connection counts as closed
+	pool.put(cluster, key, &nats.Client{})
+	assert.Nil(t, pool.get(cluster, key))
+
+	states := pool.states()
+	require.Len(t, states, 1)
+	assert.Equal(t, "east", states[0].Cluster)
+	assert.False(t, states[0].Connected)
+
+	pool.retain(map[uuid.UUID]bool{uuid.New(): true})
+	assert.Empty(t, pool.states())
+}
+
+func TestValidateServerProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(p *entities.ServerProfile)
+		wantErr bool
+	}{
+		{name: "defaults", modify: func(p *entities.ServerProfile) {}},
+		{name: "no client port", modify: func(p *entities.ServerProfile) { p.Port = 0 }, wantErr: true},
+		{name: "port out of range", modify: func(p *entities.ServerProfile) { p.Gateway.Port = 70000 }, wantErr: true},
+		{name: "routes without cluster port", modify: func(p *entities.ServerProfile) {
+			p.Routes = []string{"nats-route://b:6222"}
+		}, wantErr: true},
+		{name: "certificate without key", modify: func(p *entities.ServerProfile) {
+			p.TLS.CertFile = "/tls/cert.pem"
+		}, wantErr: true},
+		{name: "negative JetStream limit", modify: func(p *entities.ServerProfile) {
+			p.JetStream.MaxMemory = -1
+		}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := entities.DefaultServerProfile()
+			tt.modify(profile)
+			err := validateServerProfile(profile)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidServerConfig)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLeafnodeRemote(t *testing.T) {
+	cluster := &entities.Cluster{
+		Name:       "hub",
+		ServerURLs: []string{"nats://hub-1:4222", "nats://hub-2:4222"},
+	}
+	profile := &entities.LeafnodeProfile{
+		Name:            "edge",
+		CredentialsPath: "/etc/nats/edge.creds",
+		LocalAccount:    "LOCAL",
+	}
+
+	// The default server profile has no leafnode listener
+	_, err := leafnodeRemote(cluster, profile)
+	assert.ErrorIs(t, err, ErrInvalidLeafnodeProfile)
+
+	hub := entities.DefaultServerProfile()
+	hub.Leafnode.Port = 7422
+	cluster.ServerProfile = hub
+	remote, err := leafnodeRemote(cluster, profile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nats-leaf://hub-1:7422", "nats-leaf://hub-2:7422"}, remote.URLs)
+	assert.Equal(t, "/etc/nats/edge.creds", remote.Credentials)
+	assert.Equal(t, "LOCAL", remote.LocalAccount)
+
+	hub.TLS = entities.ServerTLS{CertFile: "/tls/cert.pem", KeyFile: "/tls/key.pem"}
+	remote, err = leafnodeRemote(cluster, profile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tls://hub-1:7422", "tls://hub-2:7422"}, remote.URLs)
+
+	// Explicit URLs win over the derived ones
+	profile.URLs = []string{"nats-leaf://lb.example.com:7422"}
+	remote, err = leafnodeRemote(cluster, profile)
+	require.NoError(t, err)
+	assert.Equal(t, profile.URLs, remote.URLs)
+}
+
+func TestValidateLeafnodeURL(t *testing.T) {
+	for _, u := range []string{"nats-leaf://hub:7422", "tls://hub:7422", "wss://hub:443/leafnode"} {
+		assert.NoError(t, validateLeafnodeURL(u), u)
+	}
+	for _, u := range []string{"hub:7422", "http://hub:7422", "nats-leaf://"} {
+		assert.ErrorIs(t, validateLeafnodeURL(u), ErrInvalidLeafnodeProfile, u)
+	}
+}
+
+func TestBuildPeerSyncStatus(t *testing.T) {
+	peer := &entities.Cluster{ID: uuid.New(), Name: "east"}
+	alpha := &entities.Account{Name: "alpha", PublicKey: "AALPHA"}
+	beta := &entities.Account{Name: "beta", PublicKey: "ABETA"}
+
+	lists := []nats.ServerAccounts{
+		{Server: nats.ServerInfo{ID: "e1", Cluster: "east"}, Accounts: []string{"AALPHA", "ABETA"}},
+		{Server: nats.ServerInfo{ID: "e2", Cluster: "east"}, Accounts: []string{"AALPHA"}},
+		// Servers of the other members answer through the gateways and are ignored
+		{Server: nats.ServerInfo{ID: "w1", Cluster: "west"}, Accounts: nil},
+		// Legacy replies cannot be attributed to a cluster
+		{Accounts: nil},
+	}
+
+	st := buildPeerSyncStatus(peer, []*entities.Account{alpha, beta}, lists)
+	assert.Equal(t, peer.ID, st.ClusterID)
+	assert.Equal(t, 2, st.Servers)
+	assert.Equal(t, 1, st.Verified)
+	assert.Equal(t, []string{"beta"}, st.Missing)
+	assert.Empty(t, st.Error)
+
+	st = buildPeerSyncStatus(peer, []*entities.Account{alpha}, lists[2:])
+	assert.Zero(t, st.Servers)
+	assert.NotEmpty(t, st.Error)
+}
+
+func TestClusterGatewayURLs(t *testing.T) {
+	cluster := &entities.Cluster{Name: "east", ServerURLs: []string{"nats://east-1:4222", "nats://east-2:4222"}}
+
+	// Neither explicit URLs nor a gateway port to derive them from
+	_, err := clusterGatewayURLs(cluster)
+	assert.ErrorIs(t, err, ErrInvalidGateway)
+
+	cluster.ServerProfile = entities.DefaultServerProfile()
+	cluster.ServerProfile.Gateway.Port = 7222
+	urls, err := clusterGatewayURLs(cluster)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nats://east-1:7222", "nats://east-2:7222"}, urls)
+
+	cluster.GatewayURLs = []string{"nats://gw.east:7222"}
+	urls, err = clusterGatewayURLs(cluster)
+	require.NoError(t, err)
+	assert.Equal(t, cluster.GatewayURLs, urls)
+}
+
+func TestValidateGatewayURL(t *testing.T) {
+	for _, u := range []string{"nats://east:7222", "tls://10.0.0.1:7222"} {
+		assert.NoError(t, validateGatewayURL(u), u)
+	}
+	for _, u := range []string{"east:7222", "nats://east", "nats-leaf://east:7222"} {
+		assert.ErrorIs(t, validateGatewayURL(u), ErrInvalidGateway, u)
+	}
+}
+
+func TestBuildAccountPlacement(t *testing.T) {
+	euWest := &entities.Cluster{ID: uuid.New(), Name: "eu-west", Supercluster: "eu"}
+	euCentral := &entities.Cluster{ID: uuid.New(), Name: "eu-central", Supercluster: "eu"}
+	usEast := &entities.Cluster{ID: uuid.New(), Name: "us-east"}
+	clusters := []*entities.Cluster{euWest, euCentral, usEast}
+
+	tenant := &entities.Account{ID: uuid.New(), Name: "tenant"}
+	other := &entities.Account{ID: uuid.New(), Name: "other"}
+	index := newPlacementIndex([]*entities.AccountPlacement{
+		{AccountID: tenant.ID, ClusterID: euWest.ID},
+	})
+
+	// Accounts without placement are on every cluster
+	status := buildAccountPlacement(other, clusters, index)
+	assert.Empty(t, status.Assigned)
+	assert.Equal(t, clusters, status.Clusters)
+
+	// A supercluster member brings the other members along, they share the resolver updates
+	status = buildAccountPlacement(tenant, clusters, index)
+	assert.Equal(t, []*entities.Cluster{euWest}, status.Assigned)
+	assert.Equal(t, []*entities.Cluster{euWest, euCentral}, status.Clusters)
+	assert.True(t, index.placedOn(tenant.ID, placementScope(euCentral, clusters)))
+	assert.False(t, index.placedOn(tenant.ID, placementScope(usEast, clusters)))
+
+	everywhere := buildAccountPlacement(tenant, clusters, newPlacementIndex(nil))
+	assert.Equal(t, []*entities.Cluster{usEast}, leftClusters(everywhere.Clusters, status.Clusters))
+	assert.Empty(t, leftClusters(status.Clusters, everywhere.Clusters))
+}
+
+func TestRotationPolicyFor(t *testing.T) {
+	account := &entities.Account{ID: uuid.New()}
+	otherAccount := uuid.New()
+	scopedKey, otherKey := uuid.New(), uuid.New()
+	operatorPolicy := &entities.RotationPolicy{ID: uuid.New()}
+	accountPolicy := &entities.RotationPolicy{ID: uuid.New(), AccountID: &account.ID}
+	keyPolicy := &entities.RotationPolicy{ID: uuid.New(), AccountID: &account.ID, ScopedSigningKeyID: &scopedKey}
+	strangerPolicy := &entities.RotationPolicy{ID: uuid.New(), AccountID: &otherAccount, ScopedSigningKeyID: &otherKey}
+	policies := []*entities.RotationPolicy{operatorPolicy, keyPolicy, accountPolicy, strangerPolicy}
+
+	signed := &entities.User{ScopedSigningKeyID: &scopedKey}
+	plain := &entities.User{}
+	assert.Equal(t, keyPolicy, rotationPolicyFor(policies, account, signed))
+	assert.Equal(t, accountPolicy, rotationPolicyFor(policies, account, plain))
+	assert.Equal(t, operatorPolicy, rotationPolicyFor(policies, &entities.Account{ID: otherAccount}, plain))
+	assert.Nil(t, rotationPolicyFor([]*entities.RotationPolicy{strangerPolicy}, account, signed))
+}
+
+func TestWebhookRotationNotifier(t *testing.T) {
+	var received RotationNotification
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	notifier := NewWebhookRotationNotifier(server.URL, 0)
+	notification := RotationNotification{Operator: "prod", Account: "orders", User: "app", PublicKey: "UNEW", RetiredKey: "UOLD"}
+	require.NoError(t, notifier.NotifyRotation(context.Background(), notification))
+	assert.Equal(t, notification, received)
+
+	status = http.StatusInternalServerError
+	assert.Error(t, notifier.NotifyRotation(context.Background(), notification))
+}
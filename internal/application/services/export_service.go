@@ -26,6 +26,7 @@ type ExportService struct {
 	userRepo         repositories.UserRepository
 	scopedKeyRepo    repositories.ScopedSigningKeyRepository
 	clusterRepo      repositories.ClusterRepository
+	userKeyRepo      repositories.UserKeyRepository
 	operatorService  *OperatorService
 	accountService   *AccountService
 	userService      *UserService
@@ -41,6 +42,7 @@ func NewExportService(
 	userRepo repositories.UserRepository,
 	scopedKeyRepo repositories.ScopedSigningKeyRepository,
 	clusterRepo repositories.ClusterRepository,
+	userKeyRepo repositories.UserKeyRepository,
 	operatorService *OperatorService,
 	accountService *AccountService,
 	userService *UserService,
@@ -54,6 +56,7 @@ func NewExportService(
 		userRepo:         userRepo,
 		scopedKeyRepo:    scopedKeyRepo,
 		clusterRepo:      clusterRepo,
+		userKeyRepo:      userKeyRepo,
 		operatorService:  operatorService,
 		accountService:   accountService,
 		userService:      userService,
@@ -85,6 +88,12 @@ type ExportedOperatorData struct {
 	JWT                 string    `json:"jwt"`
 	CreatedAt           time.Time `json:"created_at"`
 	UpdatedAt           time.Time `json:"updated_at"`
+	// Lockdown record, kept because the account JWTs revoke the users from its time
+	LockedDownAt     *time.Time `json:"locked_down_at,omitempty"`
+	LockedDownBy     string     `json:"locked_down_by,omitempty"`
+	LockdownReason   string     `json:"lockdown_reason,omitempty"`
+	LockdownLiftedAt *time.Time `json:"lockdown_lifted_at,omitempty"`
+	LockdownLiftedBy string     `json:"lockdown_lifted_by,omitempty"`
 }
 
 // ExportedAccountData contains account data
@@ -103,6 +112,13 @@ type ExportedAccountData struct {
 	JWT                   string    `json:"jwt"`
 	CreatedAt             time.Time `json:"created_at"`
 	UpdatedAt             time.Time `json:"updated_at"`
+	// Suspension state, kept so an imported suspended account stays suspended
+	SuspendedAt    *time.Time `json:"suspended_at,omitempty"`
+	SuspendedBy    string     `json:"suspended_by,omitempty"`
+	SuspendReason  string     `json:"suspend_reason,omitempty"`
+	UsersRevokedAt *time.Time `json:"users_revoked_at,omitempty"`
+	// Retired user keys revoked by the account JWT, with their revocation time
+	RevokedUserKeys map[string]time.Time `json:"revoked_user_keys,omitempty"`
 }
 
 // ExportedScopedKeyData contains scoped signing key data
@@ -135,6 +151,18 @@ type ExportedUserData struct {
 	ScopedSigningKeyID *uuid.UUID `json:"scoped_signing_key_id,omitempty"`
 	CreatedAt          time.Time  `json:"created_at"`
 	UpdatedAt          time.Time  `json:"updated_at"`
+	// Keys the user held before its credential rotations, the latest first
+	Keys []*ExportedUserKeyData `json:"keys,omitempty"`
+}
+
+// ExportedUserKeyData contains a key a user held before a credential rotation
+type ExportedUserKeyData struct {
+	ID        uuid.UUID  `json:"id"`
+	PublicKey string     `json:"public_key"`
+	CreatedAt time.Time  `json:"created_at"`
+	RetiredAt time.Time  `json:"retired_at"`
+	RevokeAt  time.Time  `json:"revoke_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
 }
 
 // ExportedClusterData contains cluster data
@@ -157,6 +185,11 @@ func (s *ExportService) ExportOperator(ctx context.Context, operatorID uuid.UUID
 	if err != nil {
 		return nil, fmt.Errorf("failed to get operator: %w", err)
 	}
+	// The seeds of a locked down operator stay in nis until the incident is over
+	if includeSecrets && operator.LockedDown() {
+		return nil, fmt.Errorf("%w: operator %s cannot be exported with its secrets",
+			ErrOperatorLockedDown, operator.Name)
+	}
 
 	exported := &ExportedOperator{
 		Version:    "1.0",
@@ -170,6 +203,11 @@ func (s *ExportService) ExportOperator(ctx context.Context, operatorID uuid.UUID
 			JWT:                 operator.JWT,
 			CreatedAt:           operator.CreatedAt,
 			UpdatedAt:           operator.UpdatedAt,
+			LockedDownAt:        operator.LockedDownAt,
+			LockedDownBy:        operator.LockedDownBy,
+			LockdownReason:      operator.LockdownReason,
+			LockdownLiftedAt:    operator.LockdownLiftedAt,
+			LockdownLiftedBy:    operator.LockdownLiftedBy,
 		},
 		Accounts:   make([]*ExportedAccountData, 0),
 		ScopedKeys: make([]*ExportedScopedKeyData, 0),
@@ -203,6 +241,11 @@ func (s *ExportService) ExportOperator(ctx context.Context, operatorID uuid.UUID
 			JWT:                   account.JWT,
 			CreatedAt:             account.CreatedAt,
 			UpdatedAt:             account.UpdatedAt,
+			SuspendedAt:           account.SuspendedAt,
+			SuspendedBy:           account.SuspendedBy,
+			SuspendReason:         account.SuspendReason,
+			UsersRevokedAt:        account.UsersRevokedAt,
+			RevokedUserKeys:       account.RevokedUserKeys,
 		}
 
 		if includeSecrets {
@@ -264,6 +307,21 @@ func (s *ExportService) ExportOperator(ctx context.Context, operatorID uuid.UUID
 				exportedUser.EncryptedSeed = user.EncryptedSeed
 			}
 
+			keys, err := s.userKeyRepo.ListByUser(ctx, user.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to list keys of user %s: %w", user.ID, err)
+			}
+			for _, key := range keys {
+				exportedUser.Keys = append(exportedUser.Keys, &ExportedUserKeyData{
+					ID:        key.ID,
+					PublicKey: key.PublicKey,
+					CreatedAt: key.CreatedAt,
+					RetiredAt: key.RetiredAt,
+					RevokeAt:  key.RevokeAt,
+					RevokedAt: key.RevokedAt,
+				})
+			}
+
 			exported.Users = append(exported.Users, exportedUser)
 		}
 	}
@@ -344,6 +402,11 @@ func (s *ExportService) ImportOperator(ctx context.Context, exported *ExportedOp
 		EncryptedSeed:       exported.Operator.EncryptedSeed,
 		SystemAccountPubKey: exported.Operator.SystemAccountPubKey,
 		JWT:                 exported.Operator.JWT,
+		LockedDownAt:        exported.Operator.LockedDownAt,
+		LockedDownBy:        exported.Operator.LockedDownBy,
+		LockdownReason:      exported.Operator.LockdownReason,
+		LockdownLiftedAt:    exported.Operator.LockdownLiftedAt,
+		LockdownLiftedBy:    exported.Operator.LockdownLiftedBy,
 		CreatedAt:           exported.Operator.CreatedAt,
 		UpdatedAt:           time.Now(),
 	}
@@ -373,6 +436,11 @@ func (s *ExportService) ImportOperator(ctx context.Context, exported *ExportedOp
 			JetStreamMaxStreams:   exportedAccount.JetStreamMaxStreams,
 			JetStreamMaxConsumers: exportedAccount.JetStreamMaxConsumers,
 			JWT:                   exportedAccount.JWT,
+			SuspendedAt:           exportedAccount.SuspendedAt,
+			SuspendedBy:           exportedAccount.SuspendedBy,
+			SuspendReason:         exportedAccount.SuspendReason,
+			UsersRevokedAt:        exportedAccount.UsersRevokedAt,
+			RevokedUserKeys:       exportedAccount.RevokedUserKeys,
 			CreatedAt:             exportedAccount.CreatedAt,
 			UpdatedAt:             time.Now(),
 		}
@@ -454,6 +522,27 @@ func (s *ExportService) ImportOperator(ctx context.Context, exported *ExportedOp
 		if err := s.userRepo.Create(ctx, user); err != nil {
 			return fmt.Errorf("failed to create user %s: %w", exportedUser.Name, err)
 		}
+
+		// The retired keys keep their grace period, the pending ones are revoked on time
+		for _, exportedKey := range exportedUser.Keys {
+			keyID := exportedKey.ID
+			if regenerateIDs {
+				keyID = uuid.New()
+			}
+			key := &entities.UserKey{
+				ID:        keyID,
+				UserID:    userID,
+				AccountID: accountID,
+				PublicKey: exportedKey.PublicKey,
+				CreatedAt: exportedKey.CreatedAt,
+				RetiredAt: exportedKey.RetiredAt,
+				RevokeAt:  exportedKey.RevokeAt,
+				RevokedAt: exportedKey.RevokedAt,
+			}
+			if err := s.userKeyRepo.Create(ctx, key); err != nil {
+				return fmt.Errorf("failed to record retired key of user %s: %w", exportedUser.Name, err)
+			}
+		}
 	}
 
 	// Import clusters
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
+	"github.com/nats-io/jwt/v2"
 	"github.com/pressly/goose/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -28,6 +30,14 @@ type ExportServiceTestSuite struct {
 	userRepo             repositories.UserRepository
 	scopedSigningKeyRepo repositories.ScopedSigningKeyRepository
 	clusterRepo          repositories.ClusterRepository
+	clusterServerRepo    repositories.ClusterServerRepository
+	clusterHealthRepo    repositories.ClusterHealthCheckRepository
+	authFailureRepo      repositories.AuthFailureRepository
+	accountStatsRepo     repositories.AccountStatsRepository
+	leafnodeRepo         repositories.LeafnodeProfileRepository
+	placementRepo        repositories.AccountPlacementRepository
+	userKeyRepo          repositories.UserKeyRepository
+	rotationRepo         repositories.RotationPolicyRepository
 	accountService       *AccountService
 	operatorService      *OperatorService
 	userService          *UserService
@@ -72,19 +82,41 @@ func (s *ExportServiceTestSuite) SetupSuite() {
 	s.userRepo = sql.NewUserRepo(s.db)
 	s.scopedSigningKeyRepo = sql.NewScopedSigningKeyRepo(s.db)
 	s.clusterRepo = sql.NewClusterRepo(s.db)
+	s.clusterServerRepo = sql.NewClusterServerRepo(s.db)
+	s.clusterHealthRepo = sql.NewClusterHealthCheckRepo(s.db)
+	s.authFailureRepo = sql.NewAuthFailureRepo(s.db)
+	s.accountStatsRepo = sql.NewAccountStatsRepo(s.db)
+	s.leafnodeRepo = sql.NewLeafnodeProfileRepo(s.db)
+	s.placementRepo = sql.NewAccountPlacementRepo(s.db)
+	s.userKeyRepo = sql.NewUserKeyRepo(s.db)
+	s.rotationRepo = sql.NewRotationPolicyRepo(s.db)
 
 	// Create services
 	s.accountService = NewAccountService(s.accountRepo, s.operatorRepo, s.scopedSigningKeyRepo, s.jwtService, s.encryptor)
-	s.operatorService = NewOperatorService(s.operatorRepo, s.accountRepo, s.userRepo, s.accountService, s.jwtService, s.encryptor)
-	s.userService = NewUserService(s.userRepo, s.accountRepo, s.scopedSigningKeyRepo, s.jwtService, s.encryptor)
-	s.scopedKeyService = NewScopedSigningKeyService(s.scopedSigningKeyRepo, s.accountRepo, s.operatorRepo, s.jwtService, s.encryptor)
-	s.clusterService = NewClusterService(s.clusterRepo, s.operatorRepo, s.accountRepo, s.userRepo, s.scopedSigningKeyRepo, s.encryptor, s.jwtService)
+	s.userService = NewUserService(s.userRepo, s.accountRepo, s.operatorRepo, s.scopedSigningKeyRepo, s.userKeyRepo, s.rotationRepo, s.jwtService, s.encryptor)
+	s.operatorService = NewOperatorService(s.operatorRepo, s.accountRepo, s.userRepo, s.accountService, s.userService, s.jwtService, s.encryptor)
+	s.scopedKeyService = NewScopedSigningKeyService(s.scopedSigningKeyRepo, s.accountRepo, s.operatorRepo, s.userService, s.jwtService, s.encryptor)
+	s.clusterService = NewClusterService(ClusterRepositories{
+		Clusters:     s.clusterRepo,
+		Servers:      s.clusterServerRepo,
+		HealthChecks: s.clusterHealthRepo,
+		AuthFailures: s.authFailureRepo,
+		AccountStats: s.accountStatsRepo,
+		Leafnodes:    s.leafnodeRepo,
+		Placements:   s.placementRepo,
+	}, s.operatorRepo, s.accountRepo, s.userRepo, s.userKeyRepo, s.scopedSigningKeyRepo, s.encryptor, s.jwtService)
+	s.accountService.SetClusterService(s.clusterService)
+	s.userService.SetClusterService(s.clusterService)
+	s.accountService.SetUserService(s.userService)
+	s.operatorService.SetClusterService(s.clusterService)
+	s.scopedKeyService.SetClusterService(s.clusterService)
 	s.exportService = NewExportService(
 		s.operatorRepo,
 		s.accountRepo,
 		s.userRepo,
 		s.scopedSigningKeyRepo,
 		s.clusterRepo,
+		s.userKeyRepo,
 		s.operatorService,
 		s.accountService,
 		s.userService,
@@ -100,6 +132,7 @@ func (s *ExportServiceTestSuite) TearDownSuite() {
 
 func (s *ExportServiceTestSuite) TearDownTest() {
 	// Clean up database after each test
+	s.db.Exec("DELETE FROM user_keys")
 	s.db.Exec("DELETE FROM users")
 	s.db.Exec("DELETE FROM scoped_signing_keys")
 	s.db.Exec("DELETE FROM accounts")
@@ -307,6 +340,126 @@ func (s *ExportServiceTestSuite) TestExportAndImport() {
 	assert.Equal(s.T(), int64(50), importedAccount.JetStreamMaxStreams)
 }
 
+// TestExportAndImport_SuspendedAccount tests that an imported suspended account stays suspended
+func (s *ExportServiceTestSuite) TestExportAndImport_SuspendedAccount() {
+	operator, err := s.operatorService.CreateOperator(s.ctx, CreateOperatorRequest{Name: "Suspended Operator"})
+	require.NoError(s.T(), err)
+	account, err := s.accountService.CreateAccount(s.ctx, CreateAccountRequest{OperatorID: operator.ID, Name: "orders"})
+	require.NoError(s.T(), err)
+	_, err = s.accountService.SuspendAccount(s.ctx, account.ID, "admin", "unpaid invoice", true)
+	require.NoError(s.T(), err)
+
+	data, err := s.exportService.ExportOperatorJSON(s.ctx, operator.ID, true)
+	require.NoError(s.T(), err)
+
+	s.db.Exec("DELETE FROM users")
+	s.db.Exec("DELETE FROM scoped_signing_keys")
+	s.db.Exec("DELETE FROM accounts")
+	s.db.Exec("DELETE FROM operators")
+
+	err = s.exportService.ImportOperatorJSON(s.ctx, data, true)
+	require.NoError(s.T(), err)
+
+	importedOperator, err := s.operatorService.GetOperatorByName(s.ctx, "Suspended Operator")
+	require.NoError(s.T(), err)
+	imported, err := s.accountService.GetAccountByName(s.ctx, importedOperator.ID, "orders")
+	require.NoError(s.T(), err)
+	assert.True(s.T(), imported.Suspended())
+	assert.Equal(s.T(), "admin", imported.SuspendedBy)
+	assert.Equal(s.T(), "unpaid invoice", imported.SuspendReason)
+	require.NotNil(s.T(), imported.UsersRevokedAt)
+
+	// Re-signing the imported account keeps it suspended and its users revoked
+	newDesc := "imported"
+	updated, err := s.accountService.UpdateAccount(s.ctx, imported.ID, UpdateAccountRequest{Description: &newDesc})
+	require.NoError(s.T(), err)
+	claims, err := jwt.DecodeAccountClaims(updated.JWT)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(0), claims.Limits.Conn)
+	assert.Contains(s.T(), claims.Revocations, jwt.All)
+}
+
+// TestExportAndImport_RotatedUser tests that the revoked and pending keys of a rotated user survive an export, an import and a re-sign
+func (s *ExportServiceTestSuite) TestExportAndImport_RotatedUser() {
+	operator, err := s.operatorService.CreateOperator(s.ctx, CreateOperatorRequest{Name: "Rotated Operator"})
+	require.NoError(s.T(), err)
+	account, err := s.accountService.CreateAccount(s.ctx, CreateAccountRequest{OperatorID: operator.ID, Name: "orders"})
+	require.NoError(s.T(), err)
+	user, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app"})
+	require.NoError(s.T(), err)
+
+	// The first key is revoked right away, the second one after its grace period
+	revoked, err := s.userService.RotateUserCredentials(s.ctx, user.ID, 0)
+	require.NoError(s.T(), err)
+	pending, err := s.userService.RotateUserCredentials(s.ctx, user.ID, 50*time.Millisecond)
+	require.NoError(s.T(), err)
+
+	data, err := s.exportService.ExportOperatorJSON(s.ctx, operator.ID, true)
+	require.NoError(s.T(), err)
+
+	s.db.Exec("DELETE FROM user_keys")
+	s.db.Exec("DELETE FROM users")
+	s.db.Exec("DELETE FROM scoped_signing_keys")
+	s.db.Exec("DELETE FROM accounts")
+	s.db.Exec("DELETE FROM operators")
+
+	err = s.exportService.ImportOperatorJSON(s.ctx, data, true)
+	require.NoError(s.T(), err)
+
+	importedOperator, err := s.operatorService.GetOperatorByName(s.ctx, "Rotated Operator")
+	require.NoError(s.T(), err)
+	imported, err := s.accountService.GetAccountByName(s.ctx, importedOperator.ID, "orders")
+	require.NoError(s.T(), err)
+	importedUser, err := s.userService.GetUserByName(s.ctx, imported.ID, "app")
+	require.NoError(s.T(), err)
+	keys, err := s.userService.ListUserKeys(s.ctx, importedUser.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), keys, 2)
+	assert.Equal(s.T(), pending.RetiredKey.PublicKey, keys[0].PublicKey)
+	assert.False(s.T(), keys[0].Revoked())
+	assert.Equal(s.T(), revoked.RetiredKey.PublicKey, keys[1].PublicKey)
+	assert.True(s.T(), keys[1].Revoked())
+
+	// Re-signing the imported account keeps the revocation
+	newDesc := "imported"
+	updated, err := s.accountService.UpdateAccount(s.ctx, imported.ID, UpdateAccountRequest{Description: &newDesc})
+	require.NoError(s.T(), err)
+	claims, err := jwt.DecodeAccountClaims(updated.JWT)
+	require.NoError(s.T(), err)
+	assert.Contains(s.T(), claims.Revocations, revoked.RetiredKey.PublicKey)
+	assert.NotContains(s.T(), claims.Revocations, pending.RetiredKey.PublicKey)
+
+	// The pending key is revoked once its grace period ends
+	time.Sleep(60 * time.Millisecond)
+	count, err := s.userService.RevokeExpiredUserKeys(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, count)
+	current, err := s.accountRepo.GetByID(s.ctx, imported.ID)
+	require.NoError(s.T(), err)
+	claims, err = jwt.DecodeAccountClaims(current.JWT)
+	require.NoError(s.T(), err)
+	assert.Contains(s.T(), claims.Revocations, revoked.RetiredKey.PublicKey)
+	assert.Contains(s.T(), claims.Revocations, pending.RetiredKey.PublicKey)
+}
+
+// TestExportOperator_LockedDown tests that a locked down operator cannot be exported with its secrets
+func (s *ExportServiceTestSuite) TestExportOperator_LockedDown() {
+	operator, err := s.operatorService.CreateOperator(s.ctx, CreateOperatorRequest{Name: "Locked Operator"})
+	require.NoError(s.T(), err)
+	_, err = s.operatorService.LockdownOperator(s.ctx, operator.ID, "admin", "leaked credentials")
+	require.NoError(s.T(), err)
+
+	_, err = s.exportService.ExportOperator(s.ctx, operator.ID, true)
+	assert.ErrorIs(s.T(), err, ErrOperatorLockedDown)
+
+	exported, err := s.exportService.ExportOperator(s.ctx, operator.ID, false)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), exported.Operator.EncryptedSeed)
+	require.NotNil(s.T(), exported.Operator.LockedDownAt)
+	assert.Equal(s.T(), "admin", exported.Operator.LockedDownBy)
+	assert.Equal(s.T(), "leaked credentials", exported.Operator.LockdownReason)
+}
+
 // TestImportOperator_DuplicateName tests that importing an operator with an existing name fails
 func (s *ExportServiceTestSuite) TestImportOperator_DuplicateName() {
 	// Create operator
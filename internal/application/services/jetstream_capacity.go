@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+)
+
+// ErrJetStreamOvercommit is returned when account JetStream limits would reserve more
+// memory or storage than a cluster has and over-commit is denied
+var ErrJetStreamOvercommit = errors.New("JetStream limits exceed cluster capacity")
+
+// JetStreamCapacityChecker reports the clusters whose JetStream capacity an account's
+// limits would over-commit. ClusterService implements it.
+type JetStreamCapacityChecker interface {
+	CheckJetStreamCapacity(ctx context.Context, account *entities.Account) ([]string, error)
+}
+
+// ClusterCapacity compares the JetStream capacity of a cluster with the limits assigned
+// to the JetStream enabled accounts of its operator placed on it
+type ClusterCapacity struct {
+	Cluster *entities.Cluster
+	// JetStreamServers is the number of servers the capacity is summed over
+	JetStreamServers int
+	MemoryCapacity   int64 // Sum of the servers' max_memory
+	StorageCapacity  int64 // Sum of the servers' max_storage
+	// MemoryAssigned and StorageAssigned sum the account limits, unlimited excluded
+	MemoryAssigned  int64
+	StorageAssigned int64
+	// Accounts is the number of JetStream enabled accounts of the operator placed on the cluster
+	Accounts int
+	// UnlimitedMemory and UnlimitedStorage name the accounts without a limit, which
+	// may use up to the whole capacity
+	UnlimitedMemory  []string
+	UnlimitedStorage []string
+	// MemoryReserved, StorageReserved, MemoryUsed and StorageUsed are reported live by
+	// the servers, they are only set by GetClusterCapacity
+	MemoryReserved  int64
+	StorageReserved int64
+	MemoryUsed      int64
+	StorageUsed     int64
+}
+
+// Overcommitted describes the resources whose assigned limits exceed the capacity
+func (c *ClusterCapacity) Overcommitted() []string {
+	if c.JetStreamServers == 0 {
+		return nil
+	}
+
+	var result []string
+	if c.MemoryAssigned > c.MemoryCapacity {
+		result = append(result, fmt.Sprintf("cluster %s: %d bytes of JetStream memory assigned to accounts but only %d available",
+			c.Cluster.Name, c.MemoryAssigned, c.MemoryCapacity))
+	}
+	if c.StorageAssigned > c.StorageCapacity {
+		result = append(result, fmt.Sprintf("cluster %s: %d bytes of JetStream storage assigned to accounts but only %d available",
+			c.Cluster.Name, c.StorageAssigned, c.StorageCapacity))
+	}
+	return result
+}
+
+// GetClusterCapacity reports the JetStream capacity of a cluster, queried live from
+// every server via JSZ, against the limits of the operator's accounts placed on it
+func (s *ClusterService) GetClusterCapacity(ctx context.Context, id uuid.UUID) (*ClusterCapacity, error) {
+	natsClient, cluster, err := s.clusterClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := natsClient.PingServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := natsClient.JetStreamInfo(ctx, false, 0, len(servers))
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.listOperatorAccounts(ctx, cluster.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+	accounts, err = s.placedAccounts(ctx, cluster, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := &ClusterCapacity{Cluster: cluster}
+	for _, info := range infos {
+		if info.Disabled || info.Error != "" {
+			continue
+		}
+		capacity.JetStreamServers++
+		capacity.MemoryCapacity += info.Config.MaxMemory
+		capacity.StorageCapacity += info.Config.MaxStorage
+		capacity.MemoryReserved += int64(info.ReservedMemory)
+		capacity.StorageReserved += int64(info.ReservedStorage)
+		capacity.MemoryUsed += int64(info.Memory)
+		capacity.StorageUsed += int64(info.Storage)
+	}
+	capacity.assign(accounts)
+
+	return capacity, nil
+}
+
+// CheckJetStreamCapacity checks an account's JetStream limits against the capacity of
+// every cluster of its operator it is placed on, as recorded by the last health checks. The account
+// replaces its stored version in the totals, so it works before and after saving it.
+// Clusters whose capacity is unknown are skipped.
+func (s *ClusterService) CheckJetStreamCapacity(ctx context.Context, account *entities.Account) ([]string, error) {
+	clusters, err := s.repo.ListByOperator(ctx, account.OperatorID, repositories.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	if len(clusters) == 0 {
+		return nil, nil
+	}
+
+	stored, err := s.listOperatorAccounts(ctx, account.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]*entities.Account, 0, len(stored)+1)
+	for _, acc := range stored {
+		if acc.ID != account.ID {
+			accounts = append(accounts, acc)
+		}
+	}
+	accounts = append(accounts, account)
+
+	var warnings []string
+	for _, cluster := range clusters {
+		placed, err := s.placedAccounts(ctx, cluster, accounts)
+		if err != nil {
+			return nil, err
+		}
+		if !containsAccount(placed, account.ID) {
+			continue
+		}
+
+		servers, err := s.serverRepo.ListByCluster(ctx, cluster.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list cluster servers: %w", err)
+		}
+
+		capacity := recordedClusterCapacity(cluster, servers)
+		capacity.assign(placed)
+		warnings = append(warnings, capacity.Overcommitted()...)
+	}
+
+	return warnings, nil
+}
+
+// recordedClusterCapacity sums the JetStream capacity of the servers recorded by the
+// latest health check that reached the cluster. Older records belong to servers that
+// have since left the cluster.
+func recordedClusterCapacity(cluster *entities.Cluster, servers []*entities.ClusterServer) *ClusterCapacity {
+	var latest time.Time
+	for _, srv := range servers {
+		if srv.LastSeen.After(latest) {
+			latest = srv.LastSeen
+		}
+	}
+
+	capacity := &ClusterCapacity{Cluster: cluster}
+	for _, srv := range servers {
+		if !srv.JetStream || srv.LastSeen.Before(latest) {
+			continue
+		}
+		if srv.JetStreamMaxMemory == 0 && srv.JetStreamMaxStorage == 0 {
+			continue
+		}
+		capacity.JetStreamServers++
+		capacity.MemoryCapacity += srv.JetStreamMaxMemory
+		capacity.StorageCapacity += srv.JetStreamMaxStorage
+	}
+	return capacity
+}
+
+// assign sums the JetStream limits of the enabled accounts into the capacity
+func (c *ClusterCapacity) assign(accounts []*entities.Account) {
+	for _, account := range accounts {
+		if !account.JetStreamEnabled {
+			continue
+		}
+		c.Accounts++
+		if account.JetStreamMaxMemory < 0 {
+			c.UnlimitedMemory = append(c.UnlimitedMemory, account.Name)
+		} else {
+			c.MemoryAssigned += account.JetStreamMaxMemory
+		}
+		if account.JetStreamMaxStorage < 0 {
+			c.UnlimitedStorage = append(c.UnlimitedStorage, account.Name)
+		} else {
+			c.StorageAssigned += account.JetStreamMaxStorage
+		}
+	}
+	sort.Strings(c.UnlimitedMemory)
+	sort.Strings(c.UnlimitedStorage)
+}
+
+// containsAccount reports whether an account is in the list
+func containsAccount(accounts []*entities.Account, id uuid.UUID) bool {
+	for _, account := range accounts {
+		if account.ID == id {
+			return true
+		}
+	}
+	return false
+}
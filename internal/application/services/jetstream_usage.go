@@ -0,0 +1,210 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
+)
+
+// jszAccountLimit is the minimum number of accounts requested per server from JSZ
+const jszAccountLimit = 1024
+
+// JetStreamUsage is the JetStream resources an account uses on a cluster
+type JetStreamUsage struct {
+	Memory    int64 // Bytes, summed over the servers so replicas count in full
+	Storage   int64 // Bytes, summed over the servers so replicas count in full
+	Streams   int64
+	Consumers int64
+}
+
+// AccountUsage is the JetStream usage of an account on one cluster, to be compared
+// with the account's JetStreamMax* limits
+type AccountUsage struct {
+	ClusterID   uuid.UUID
+	ClusterName string
+	Account     *entities.Account
+	JetStreamUsage
+	Servers int // Servers that hold JetStream state for the account
+}
+
+// AccountUsageReport is the result of GetAccountUsage
+type AccountUsageReport struct {
+	Usage []AccountUsage
+	// Warnings lists the clusters that could not be queried
+	Warnings []string
+}
+
+// GetAccountUsage reports the JetStream usage of an account on the given cluster, or
+// on every cluster of its operator when clusterID is nil
+func (s *AccountService) GetAccountUsage(ctx context.Context, accountID uuid.UUID, clusterID *uuid.UUID) (*AccountUsageReport, error) {
+	account, err := s.repo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+
+	clusters, err := s.clusters.connectionClusters(ctx, account, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AccountUsageReport{}
+	for _, cluster := range clusters {
+		usage, err := s.clusters.accountUsageOnCluster(ctx, cluster, account)
+		if err != nil {
+			if clusterID != nil {
+				return nil, err
+			}
+			report.Warnings = append(report.Warnings, fmt.Sprintf("cluster %s: %v", cluster.Name, err))
+			continue
+		}
+		report.Usage = append(report.Usage, *usage)
+	}
+
+	sort.SliceStable(report.Usage, func(i, j int) bool {
+		return report.Usage[i].ClusterName < report.Usage[j].ClusterName
+	})
+
+	return report, nil
+}
+
+// accountUsageOnCluster queries the account's JSZ on every server of a cluster
+func (s *ClusterService) accountUsageOnCluster(ctx context.Context, cluster *entities.Cluster, account *entities.Account) (*AccountUsage, error) {
+	natsClient, _, err := s.clusterClient(ctx, cluster.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := natsClient.PingServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := natsClient.AccountJetStreamInfo(ctx, account.PublicKey, len(servers))
+	if err != nil {
+		return nil, err
+	}
+
+	// Servers where the account never used JetStream answer with an error, which
+	// only means there is nothing to count there
+	var details []nats.JetStreamAccount
+	for _, info := range infos {
+		details = append(details, info.Accounts...)
+	}
+
+	return &AccountUsage{
+		ClusterID:      cluster.ID,
+		ClusterName:    cluster.Name,
+		Account:        account,
+		JetStreamUsage: aggregateJetStreamUsage(details),
+		Servers:        len(details),
+	}, nil
+}
+
+// CollectJetStreamUsage reports the JetStream usage of every JetStream enabled account
+// on every cluster, with a single JSZ request per cluster. Clusters that cannot be
+// reached are logged and skipped.
+func (s *ClusterService) CollectJetStreamUsage(ctx context.Context) ([]AccountUsage, error) {
+	var result []AccountUsage
+	for offset := 0; ; offset += syncPageSize {
+		clusters, err := s.repo.List(ctx, repositories.ListOptions{
+			Limit:  syncPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list clusters: %w", err)
+		}
+
+		for _, cluster := range clusters {
+			usage, err := s.clusterJetStreamUsage(ctx, cluster)
+			if err != nil {
+				logging.LogFromContext(ctx).Warn("failed to collect JetStream usage",
+					"cluster", cluster.Name, "error", err)
+				continue
+			}
+			result = append(result, usage...)
+		}
+
+		if len(clusters) < syncPageSize {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// clusterJetStreamUsage reports the usage of the JetStream enabled accounts of the
+// cluster's operator
+func (s *ClusterService) clusterJetStreamUsage(ctx context.Context, cluster *entities.Cluster) ([]AccountUsage, error) {
+	accounts, err := s.listOperatorAccounts(ctx, cluster.OperatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	natsClient, _, err := s.clusterClient(ctx, cluster.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := natsClient.PingServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := natsClient.JetStreamInfo(ctx, true, max(jszAccountLimit, len(accounts)), len(servers))
+	if err != nil {
+		return nil, err
+	}
+
+	details := make(map[string][]nats.JetStreamAccount)
+	for _, info := range infos {
+		for _, acc := range info.Accounts {
+			details[acc.Name] = append(details[acc.Name], acc)
+		}
+	}
+
+	result := make([]AccountUsage, 0, len(accounts))
+	for _, account := range accounts {
+		if !account.JetStreamEnabled {
+			continue
+		}
+		result = append(result, AccountUsage{
+			ClusterID:      cluster.ID,
+			ClusterName:    cluster.Name,
+			Account:        account,
+			JetStreamUsage: aggregateJetStreamUsage(details[account.PublicKey]),
+			Servers:        len(details[account.PublicKey]),
+		})
+	}
+
+	return result, nil
+}
+
+// aggregateJetStreamUsage sums an account's per-server JSZ details into its cluster-wide
+// usage. Memory and storage are summed like the server accounts them against the
+// limits, while replicated streams and their consumers are only counted once.
+func aggregateJetStreamUsage(details []nats.JetStreamAccount) JetStreamUsage {
+	var usage JetStreamUsage
+	consumers := make(map[string]int)
+	for _, detail := range details {
+		usage.Memory += int64(detail.Memory)
+		usage.Storage += int64(detail.Storage)
+		for _, stream := range detail.Streams {
+			if n, ok := consumers[stream.Name]; !ok || stream.State.Consumers > n {
+				consumers[stream.Name] = stream.State.Consumers
+			}
+		}
+	}
+
+	usage.Streams = int64(len(consumers))
+	for _, n := range consumers {
+		usage.Consumers += int64(n)
+	}
+
+	return usage
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/nats-io/jwt/v2"
 	"github.com/nats-io/nkeys"
@@ -90,28 +91,43 @@ func (s *JWTService) GenerateAccountJWT(ctx context.Context, account *entities.A
 		}
 	}
 
+	// A suspended account allows no client or leafnode connection: the servers
+	// disconnect its clients when they receive the JWT
+	if account.Suspended() {
+		claims.Limits.Conn = 0
+		claims.Limits.LeafNodeConn = 0
+	}
+	// The users are revoked by a suspension of their account and by a lockdown of their
+	// operator, whose revocation outlives the lockdown. The system account is never
+	// locked down: nis reaches the clusters through it.
+	usersRevokedAt := account.UsersRevokedAt
+	if operator.LockedDownAt != nil && account.PublicKey != operator.SystemAccountPubKey &&
+		(usersRevokedAt == nil || operator.LockedDownAt.After(*usersRevokedAt)) {
+		usersRevokedAt = operator.LockedDownAt
+	}
+	if usersRevokedAt != nil {
+		claims.RevokeAt(jwt.All, *usersRevokedAt)
+	}
+	for publicKey, revokedAt := range account.RevokedUserKeys {
+		claims.RevokeAt(publicKey, revokedAt)
+	}
+
 	// Register each scoped signing key as a NATS scoped signer. `AddScopedSigner`
 	// embeds the template (pub/sub permissions + response limits) into the account
 	// JWT so NATS can apply them to any user JWT signed by that key.
+	// A key replaced by a rotation stays a scoped signer with the same template until
+	// the end of its grace period, so the users it signed keep connecting meanwhile.
+	now := time.Now()
 	for _, sk := range scopedKeys {
 		if sk == nil {
 			continue
 		}
-		scope := jwt.NewUserScope()
-		scope.Key = sk.PublicKey
-		scope.Role = sk.Name
-		scope.Description = sk.Description
-		scope.Template.Pub.Allow = sk.PubAllow
-		scope.Template.Pub.Deny = sk.PubDeny
-		scope.Template.Sub.Allow = sk.SubAllow
-		scope.Template.Sub.Deny = sk.SubDeny
-		if sk.ResponseMaxMsgs > 0 || sk.ResponseTTL > 0 {
-			scope.Template.Resp = &jwt.ResponsePermission{
-				MaxMsgs: sk.ResponseMaxMsgs,
-				Expires: sk.ResponseTTL,
+		claims.SigningKeys.AddScopedSigner(scopedSigner(sk, sk.PublicKey))
+		for publicKey, retireAt := range sk.RetiredKeys {
+			if retireAt.After(now) {
+				claims.SigningKeys.AddScopedSigner(scopedSigner(sk, publicKey))
 			}
 		}
-		claims.SigningKeys.AddScopedSigner(scope)
 	}
 
 	// Encode and sign the JWT with operator key
@@ -123,6 +139,25 @@ func (s *JWTService) GenerateAccountJWT(ctx context.Context, account *entities.A
 	return token, nil
 }
 
+// scopedSigner builds the NATS scope of a scoped signing key for one of its public keys
+func scopedSigner(sk *entities.ScopedSigningKey, publicKey string) *jwt.UserScope {
+	scope := jwt.NewUserScope()
+	scope.Key = publicKey
+	scope.Role = sk.Name
+	scope.Description = sk.Description
+	scope.Template.Pub.Allow = sk.PubAllow
+	scope.Template.Pub.Deny = sk.PubDeny
+	scope.Template.Sub.Allow = sk.SubAllow
+	scope.Template.Sub.Deny = sk.SubDeny
+	if sk.ResponseMaxMsgs > 0 || sk.ResponseTTL > 0 {
+		scope.Template.Resp = &jwt.ResponsePermission{
+			MaxMsgs: sk.ResponseMaxMsgs,
+			Expires: sk.ResponseTTL,
+		}
+	}
+	return scope
+}
+
 // GenerateUserJWT generates a user JWT signed by the account or scoped signing key
 func (s *JWTService) GenerateUserJWT(ctx context.Context, user *entities.User, account *entities.Account, scopedKey *entities.ScopedSigningKey) (string, error) {
 	// Create user claims
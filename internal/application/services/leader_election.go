@@ -0,0 +1,181 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+)
+
+const (
+	// DefaultLeaderLeaseTTL is how long a leader lease stays valid without renewal. A
+	// crashed leader is replaced after at most this long.
+	DefaultLeaderLeaseTTL = 15 * time.Second
+	// LeaderLeaseName is the lease electing the replica that runs the periodic tasks
+	LeaderLeaseName = "nis-serve"
+
+	// LeaderModeLease elects a leader through a lease row in the database
+	LeaderModeLease = "lease"
+	// LeaderModeSingle makes this replica the leader unconditionally
+	LeaderModeSingle = "single"
+)
+
+// LeaderElector decides which nis serve replica runs the periodic tasks (health checks,
+// metrics refresh). In lease mode replicas compete for a lease row that the leader renews
+// every third of its TTL; in single mode, meant for SQLite, this replica is always leader.
+type LeaderElector struct {
+	repo repositories.LeaderLeaseRepository
+	name string
+	id   string
+	ttl  time.Duration
+
+	mu          sync.RWMutex
+	leader      bool
+	holder      string
+	lastRenewed time.Time
+}
+
+// NewLeaderElector creates an elector competing for the LeaderLeaseName lease
+func NewLeaderElector(repo repositories.LeaderLeaseRepository, instanceID string, ttl time.Duration) *LeaderElector {
+	if ttl <= 0 {
+		ttl = DefaultLeaderLeaseTTL
+	}
+	return &LeaderElector{
+		repo: repo,
+		name: LeaderLeaseName,
+		id:   instanceID,
+		ttl:  ttl,
+	}
+}
+
+// NewSingleLeaderElector creates an elector for deployments with a single replica
+func NewSingleLeaderElector(instanceID string) *LeaderElector {
+	return &LeaderElector{
+		id:     instanceID,
+		leader: true,
+		holder: instanceID,
+	}
+}
+
+// NewInstanceID returns an identifier for this replica: the hostname and a random suffix,
+// so a restarted pod does not inherit the lease of its previous incarnation
+func NewInstanceID() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "nis"
+	}
+	return fmt.Sprintf("%s-%s", host, uuid.New().String()[:8])
+}
+
+// ID returns the instance ID of this replica
+func (e *LeaderElector) ID() string {
+	return e.id
+}
+
+// Mode returns LeaderModeLease or LeaderModeSingle
+func (e *LeaderElector) Mode() string {
+	if e.repo == nil {
+		return LeaderModeSingle
+	}
+	return LeaderModeLease
+}
+
+// IsLeader reports whether this replica should run the periodic tasks
+func (e *LeaderElector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader
+}
+
+// Leader returns the instance ID of the current leader as last seen, empty if unknown
+func (e *LeaderElector) Leader() string {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.holder
+}
+
+// Run competes for the lease until ctx is done, then releases it if held. In single
+// mode it returns immediately.
+func (e *LeaderElector) Run(ctx context.Context) {
+	if e.repo == nil {
+		return
+	}
+
+	e.step(ctx, time.Now())
+	ticker := time.NewTicker(e.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.step(ctx, time.Now())
+		case <-ctx.Done():
+			e.release()
+			return
+		}
+	}
+}
+
+// step makes one attempt to acquire or renew the lease
+func (e *LeaderElector) step(ctx context.Context, now time.Time) {
+	logger := logging.LogFromContext(ctx)
+
+	acquired, err := e.repo.TryAcquire(ctx, e.name, e.id, e.ttl, now)
+	if err != nil {
+		// Keep leading until our last renewal runs out: nobody else can take over an
+		// unexpired lease, so a database blip does not need to stop the periodic tasks
+		logger.Warn("failed to renew leader lease", "lease", e.name, "error", err)
+		e.mu.Lock()
+		if e.leader && !now.Before(e.lastRenewed.Add(e.ttl)) {
+			e.leader = false
+			logger.Warn("lost leadership", "lease", e.name, "instance", e.id)
+		}
+		e.mu.Unlock()
+		return
+	}
+
+	holder := e.id
+	if !acquired {
+		holder = ""
+		if lease, err := e.repo.Get(ctx, e.name); err == nil {
+			holder = lease.HolderID
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	switch {
+	case acquired && !e.leader:
+		logger.Info("acquired leadership", "lease", e.name, "instance", e.id)
+	case !acquired && e.leader:
+		logger.Warn("lost leadership", "lease", e.name, "instance", e.id, "leader", holder)
+	}
+	e.leader = acquired
+	e.holder = holder
+	if acquired {
+		e.lastRenewed = now
+	}
+}
+
+// release hands the lease over on shutdown so another replica takes over immediately
+func (e *LeaderElector) release() {
+	e.mu.Lock()
+	wasLeader := e.leader
+	e.leader = false
+	e.mu.Unlock()
+
+	if !wasLeader {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := e.repo.Release(ctx, e.name, e.id); err != nil {
+		logging.LogFromContext(ctx).Warn("failed to release leader lease", "lease", e.name, "error", err)
+	}
+}
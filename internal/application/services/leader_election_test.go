@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+)
+
+// fakeLeaseRepo is an in-memory lease store shared by several electors
+type fakeLeaseRepo struct {
+	lease *entities.LeaderLease
+	err   error
+}
+
+func (r *fakeLeaseRepo) TryAcquire(_ context.Context, name, holderID string, ttl time.Duration, now time.Time) (bool, error) {
+	if r.err != nil {
+		return false, r.err
+	}
+	if r.lease != nil && r.lease.HolderID != holderID && !r.lease.Expired(now) {
+		return false, nil
+	}
+	r.lease = &entities.LeaderLease{Name: name, HolderID: holderID, RenewedAt: now, ExpiresAt: now.Add(ttl)}
+	return true, nil
+}
+
+func (r *fakeLeaseRepo) Release(_ context.Context, _, holderID string) error {
+	if r.lease != nil && r.lease.HolderID == holderID {
+		r.lease = nil
+	}
+	return nil
+}
+
+func (r *fakeLeaseRepo) Get(_ context.Context, _ string) (*entities.LeaderLease, error) {
+	if r.lease == nil {
+		return nil, repositories.ErrNotFound
+	}
+	return r.lease, nil
+}
+
+func TestLeaderElector_SingleLeader(t *testing.T) {
+	e := NewSingleLeaderElector("replica-a")
+	assert.True(t, e.IsLeader())
+	assert.Equal(t, "replica-a", e.Leader())
+	assert.Equal(t, LeaderModeSingle, e.Mode())
+
+	// Run returns immediately without a lease store
+	e.Run(context.Background())
+	assert.True(t, e.IsLeader())
+}
+
+func TestLeaderElector_OneLeaderAtATime(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeLeaseRepo{}
+	ttl := 15 * time.Second
+	now := time.Now()
+
+	a := NewLeaderElector(repo, "replica-a", ttl)
+	b := NewLeaderElector(repo, "replica-b", ttl)
+	assert.Equal(t, LeaderModeLease, a.Mode())
+	assert.False(t, a.IsLeader(), "not leader before the first election round")
+
+	a.step(ctx, now)
+	b.step(ctx, now)
+	assert.True(t, a.IsLeader())
+	assert.False(t, b.IsLeader())
+	assert.Equal(t, "replica-a", b.Leader())
+
+	// a stops renewing: b takes over once the lease expires
+	b.step(ctx, now.Add(ttl/2))
+	assert.False(t, b.IsLeader())
+	b.step(ctx, now.Add(ttl))
+	assert.True(t, b.IsLeader())
+
+	// a notices on its next round
+	a.step(ctx, now.Add(ttl+time.Second))
+	assert.False(t, a.IsLeader())
+	assert.Equal(t, "replica-b", a.Leader())
+
+	// Releasing hands over immediately
+	b.release()
+	assert.False(t, b.IsLeader())
+	a.step(ctx, now.Add(ttl+2*time.Second))
+	assert.True(t, a.IsLeader())
+}
+
+func TestLeaderElector_StoreErrorKeepsLeadershipUntilExpiry(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeLeaseRepo{}
+	ttl := 15 * time.Second
+	now := time.Now()
+
+	e := NewLeaderElector(repo, "replica-a", ttl)
+	e.step(ctx, now)
+	require.True(t, e.IsLeader())
+
+	repo.err = errors.New("connection reset")
+	e.step(ctx, now.Add(ttl/3))
+	assert.True(t, e.IsLeader(), "a blip shorter than the TTL keeps the leader")
+
+	e.step(ctx, now.Add(ttl))
+	assert.False(t, e.IsLeader(), "the lease may have been taken over after the TTL")
+}
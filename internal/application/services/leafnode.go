@@ -0,0 +1,221 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
+)
+
+// ErrInvalidLeafnodeProfile is returned when a leafnode profile cannot produce a
+// working leafnode remote
+var ErrInvalidLeafnodeProfile = errors.New("invalid leafnode profile")
+
+// leafnodeURLSchemes are the URL schemes nats-server accepts for leafnode remotes
+var leafnodeURLSchemes = map[string]bool{
+	"nats-leaf": true,
+	"nats":      true,
+	"tls":       true,
+	"ws":        true,
+	"wss":       true,
+}
+
+// CreateLeafnodeProfileRequest contains the data for binding a user to a hub cluster
+type CreateLeafnodeProfileRequest struct {
+	ClusterID       uuid.UUID
+	Name            string
+	UserID          uuid.UUID
+	LocalAccount    string
+	URLs            []string // Derived from the hub's server URLs and leafnode port when empty
+	CredentialsPath string   // Defaults to <DefaultLeafnodeCredentialsDir>/<name>.creds
+	CAFile          string
+}
+
+// LeafnodeConfig is the configuration an edge server needs to join a hub cluster
+type LeafnodeConfig struct {
+	Profile     *entities.LeafnodeProfile
+	Config      string // leafnodes block of the leaf server configuration
+	Credentials string // .creds file to install at Profile.CredentialsPath
+}
+
+// CreateLeafnodeProfile binds a user of the hub cluster's operator to the hub. The
+// profile is checked by rendering its leafnode remote before it is stored.
+func (s *ClusterService) CreateLeafnodeProfile(ctx context.Context, req CreateLeafnodeProfileRequest) (*entities.LeafnodeProfile, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidLeafnodeProfile)
+	}
+	if strings.ContainsAny(req.Name, "/\\ \t\n") {
+		return nil, fmt.Errorf("%w: name must not contain slashes or spaces", ErrInvalidLeafnodeProfile)
+	}
+	for _, u := range req.URLs {
+		if err := validateLeafnodeURL(u); err != nil {
+			return nil, err
+		}
+	}
+
+	cluster, err := s.repo.GetByID(ctx, req.ClusterID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userRepo.GetByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	account, err := s.accountRepo.GetByID(ctx, user.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+	if account.OperatorID != cluster.OperatorID {
+		return nil, fmt.Errorf("%w: user %s does not belong to the operator of cluster %s",
+			ErrInvalidLeafnodeProfile, user.Name, cluster.Name)
+	}
+
+	existing, err := s.leafnodeRepo.ListByCluster(ctx, cluster.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range existing {
+		if p.Name == req.Name {
+			return nil, repositories.ErrAlreadyExists
+		}
+	}
+
+	now := time.Now()
+	profile := &entities.LeafnodeProfile{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            req.Name,
+		UserID:          user.ID,
+		LocalAccount:    req.LocalAccount,
+		URLs:            req.URLs,
+		CredentialsPath: req.CredentialsPath,
+		CAFile:          req.CAFile,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if profile.CredentialsPath == "" {
+		profile.CredentialsPath = path.Join(entities.DefaultLeafnodeCredentialsDir, req.Name+".creds")
+	}
+
+	remote, err := leafnodeRemote(cluster, profile)
+	if err != nil {
+		return nil, err
+	}
+	if err := nats.ValidateLeafnodeConfig(remote); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLeafnodeProfile, err)
+	}
+
+	if err := s.leafnodeRepo.Create(ctx, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// GetLeafnodeProfile retrieves a leafnode profile by ID
+func (s *ClusterService) GetLeafnodeProfile(ctx context.Context, id uuid.UUID) (*entities.LeafnodeProfile, error) {
+	return s.leafnodeRepo.GetByID(ctx, id)
+}
+
+// ListLeafnodeProfiles retrieves the leafnode profiles of a hub cluster
+func (s *ClusterService) ListLeafnodeProfiles(ctx context.Context, clusterID uuid.UUID) ([]*entities.LeafnodeProfile, error) {
+	return s.leafnodeRepo.ListByCluster(ctx, clusterID)
+}
+
+// DeleteLeafnodeProfile deletes a leafnode profile. The user and its credentials
+// are left alone, edge servers using them keep connecting until the user goes.
+func (s *ClusterService) DeleteLeafnodeProfile(ctx context.Context, id uuid.UUID) error {
+	return s.leafnodeRepo.Delete(ctx, id)
+}
+
+// GenerateLeafnodeConfig generates the leafnodes block of an edge server and the
+// credentials of the profile's user. URLs left to the hub are derived from its
+// current server URLs and server profile.
+func (s *ClusterService) GenerateLeafnodeConfig(ctx context.Context, id uuid.UUID) (*LeafnodeConfig, error) {
+	profile, err := s.leafnodeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	cluster, err := s.repo.GetByID(ctx, profile.ClusterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hub cluster: %w", err)
+	}
+	user, err := s.userRepo.GetByID(ctx, profile.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	remote, err := leafnodeRemote(cluster, profile)
+	if err != nil {
+		return nil, err
+	}
+	if err := nats.ValidateLeafnodeConfig(remote); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLeafnodeProfile, err)
+	}
+	config, err := nats.GenerateLeafnodeConfig(remote)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidLeafnodeProfile, err)
+	}
+
+	if err := checkOperatorLockdown(ctx, s.accountRepo, s.operatorRepo, user.AccountID); err != nil {
+		return nil, err
+	}
+	creds, err := s.jwtService.GetUserCredentials(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeafnodeConfig{
+		Profile:     profile,
+		Config:      config,
+		Credentials: creds,
+	}, nil
+}
+
+// leafnodeRemote builds the leafnode remote of a profile, deriving the hub URLs
+// from the cluster when the profile has none
+func leafnodeRemote(cluster *entities.Cluster, profile *entities.LeafnodeProfile) (nats.LeafnodeRemote, error) {
+	remote := nats.LeafnodeRemote{
+		URLs:         profile.URLs,
+		Credentials:  profile.CredentialsPath,
+		LocalAccount: profile.LocalAccount,
+		CAFile:       profile.CAFile,
+	}
+	if len(remote.URLs) > 0 {
+		return remote, nil
+	}
+
+	hub := cluster.ServerProfile
+	if hub == nil {
+		hub = entities.DefaultServerProfile()
+	}
+	if hub.Leafnode.Port == 0 {
+		return remote, fmt.Errorf("%w: cluster %s has no leafnode port in its server profile, set one or give the URLs",
+			ErrInvalidLeafnodeProfile, cluster.Name)
+	}
+	urls, err := nats.LeafnodeURLs(cluster.ServerURLs, hub.Leafnode.Port, hub.TLS.Enabled())
+	if err != nil {
+		return remote, fmt.Errorf("%w: %v", ErrInvalidLeafnodeProfile, err)
+	}
+	remote.URLs = urls
+	return remote, nil
+}
+
+// validateLeafnodeURL checks that a URL can be used for a leafnode remote
+func validateLeafnodeURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return fmt.Errorf("%w: invalid URL %q", ErrInvalidLeafnodeProfile, raw)
+	}
+	if !leafnodeURLSchemes[u.Scheme] {
+		return fmt.Errorf("%w: unsupported URL scheme %q in %q", ErrInvalidLeafnodeProfile, u.Scheme, raw)
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/jwt/v2"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+)
+
+var (
+	// ErrInvalidLockdown is returned when an operator cannot be locked down or its lockdown lifted
+	ErrInvalidLockdown = errors.New("invalid operator lockdown")
+	// ErrOperatorLockedDown is returned when downloading the credentials of a user
+	// whose operator is locked down
+	ErrOperatorLockedDown = errors.New("operator is locked down")
+)
+
+// OperatorLockdown reports the lockdown of an operator or the lifting of it
+type OperatorLockdown struct {
+	Operator *entities.Operator
+	// Accounts lists the accounts whose users were revoked, or given a new JWT
+	Accounts []string
+	// Skipped lists the suspended accounts whose users were left revoked
+	Skipped []string
+	// Pushed lists the clusters of the operator synced with the re-signed accounts
+	Pushed []AccountPush
+	// ReissuedUsers counts the users given a new JWT when the lockdown was lifted
+	ReissuedUsers int
+	// RevokedUsers counts the users left revoked by a revocation of their account
+	RevokedUsers int
+}
+
+// LockdownOperator freezes an operator during an incident. Every account but the
+// system account is re-signed revoking all the user JWTs issued so far, then the
+// operator is saved locked down and its clusters are synced, disconnecting the users.
+// Until the lockdown is lifted, the credentials of the operator's users cannot be
+// downloaded.
+func (s *OperatorService) LockdownOperator(ctx context.Context, operatorID uuid.UUID, lockedBy, reason string) (*OperatorLockdown, error) {
+	operator, err := s.repo.GetByID(ctx, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	if operator.LockedDown() {
+		return nil, fmt.Errorf("%w: operator %s is already locked down", ErrInvalidLockdown, operator.Name)
+	}
+
+	accounts, err := s.clusters.listOperatorAccounts(ctx, operator.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	// The account JWTs revoke the users from the lockdown time of their operator
+	now := time.Now()
+	operator.LockedDownAt = &now
+	operator.LockedDownBy = lockedBy
+	operator.LockdownReason = reason
+	operator.LockdownLiftedAt = nil
+	operator.LockdownLiftedBy = ""
+	operator.UpdatedAt = now
+
+	// The operator is saved last, so a failure leaves it as it was: the accounts
+	// re-signed so far get their previous JWT back
+	result := &OperatorLockdown{Operator: operator}
+	var resigned []*entities.Account
+	var previous []string
+	for _, account := range accounts {
+		if account.PublicKey == operator.SystemAccountPubKey {
+			continue
+		}
+		previousJWT := account.JWT
+		if err := s.clusters.resignAccount(ctx, account, operator); err != nil {
+			s.restoreAccountJWTs(ctx, resigned, previous)
+			return nil, err
+		}
+		resigned = append(resigned, account)
+		previous = append(previous, previousJWT)
+		result.Accounts = append(result.Accounts, account.Name)
+	}
+	if err := s.repo.Update(ctx, operator); err != nil {
+		s.restoreAccountJWTs(ctx, resigned, previous)
+		return nil, fmt.Errorf("failed to update operator: %w", err)
+	}
+
+	result.Pushed = s.clusters.syncOperatorClusters(ctx, operator.ID)
+
+	logging.LogFromContext(ctx).Warn("locked down operator",
+		"operator", operator.Name, "by", lockedBy, "reason", reason, "accounts", len(result.Accounts))
+	return result, nil
+}
+
+// restoreAccountJWTs puts back the JWTs of the accounts re-signed by a lockdown that
+// failed. The clusters were not synced yet, so they never saw the new ones.
+func (s *OperatorService) restoreAccountJWTs(ctx context.Context, accounts []*entities.Account, jwts []string) {
+	for i, account := range accounts {
+		account.JWT = jwts[i]
+		if err := s.accountRepo.Update(ctx, account); err != nil {
+			logging.LogFromContext(ctx).Error("failed to restore account JWT after a failed lockdown",
+				"account", account.Name, "error", err)
+		}
+	}
+}
+
+// LiftOperatorLockdown lifts the lockdown of an operator. The users revoked by the
+// lockdown get a new JWT, signed by the same keys, so their credentials can be
+// downloaded and used again. The revocations stay in the account JWTs. The users of
+// suspended accounts, and those revoked by their account before the lockdown, are
+// left revoked.
+func (s *OperatorService) LiftOperatorLockdown(ctx context.Context, operatorID uuid.UUID, liftedBy string) (*OperatorLockdown, error) {
+	operator, err := s.repo.GetByID(ctx, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	if !operator.LockedDown() {
+		return nil, fmt.Errorf("%w: operator %s is not locked down", ErrInvalidLockdown, operator.Name)
+	}
+
+	accounts, err := s.clusters.listOperatorAccounts(ctx, operator.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	// A revocation covers the JWTs issued up to its second included, so the new
+	// JWTs must be issued after the lockdown
+	if wait := time.Until(operator.LockedDownAt.Truncate(time.Second).Add(time.Second)); wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	result := &OperatorLockdown{Operator: operator}
+	for _, account := range accounts {
+		if account.PublicKey == operator.SystemAccountPubKey {
+			continue
+		}
+		if account.Suspended() {
+			result.Skipped = append(result.Skipped, account.Name)
+			continue
+		}
+		reissued, revoked, err := s.userService.reissueUserJWTs(ctx, account)
+		if err != nil {
+			return nil, err
+		}
+		if reissued > 0 {
+			result.Accounts = append(result.Accounts, account.Name)
+		}
+		result.ReissuedUsers += reissued
+		result.RevokedUsers += revoked
+	}
+
+	now := time.Now()
+	operator.LockdownLiftedAt = &now
+	operator.LockdownLiftedBy = liftedBy
+	operator.UpdatedAt = now
+	if err := s.repo.Update(ctx, operator); err != nil {
+		return nil, fmt.Errorf("failed to update operator: %w", err)
+	}
+
+	logging.LogFromContext(ctx).Warn("lifted operator lockdown",
+		"operator", operator.Name, "by", liftedBy, "reissued_users", result.ReissuedUsers,
+		"revoked_users", result.RevokedUsers, "skipped_accounts", len(result.Skipped))
+	return result, nil
+}
+
+// reissueUserJWTs signs a new JWT, with the same keys, for every user of an account
+// revoked by a lockdown of its operator. The users whose JWT predates the account's
+// own revocation stay revoked. It returns how many users were reissued and how many
+// were left revoked.
+func (s *UserService) reissueUserJWTs(ctx context.Context, account *entities.Account) (int, int, error) {
+	users, err := s.repo.ListByAccount(ctx, account.ID, repositories.ListOptions{})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list users: %w", err)
+	}
+	scopedKeys, err := s.scopedKeyRepo.ListByAccount(ctx, account.ID, repositories.ListOptions{Limit: 1000})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to list scoped signing keys: %w", err)
+	}
+	keysByID := make(map[uuid.UUID]*entities.ScopedSigningKey, len(scopedKeys))
+	for _, key := range scopedKeys {
+		keysByID[key.ID] = key
+	}
+
+	reissued, revoked := 0, 0
+	for _, user := range users {
+		revokedUser, err := revokedByAccount(user, account)
+		if err != nil {
+			return 0, 0, err
+		}
+		if revokedUser {
+			revoked++
+			continue
+		}
+
+		var scopedKey *entities.ScopedSigningKey
+		if user.ScopedSigningKeyID != nil {
+			scopedKey = keysByID[*user.ScopedSigningKeyID]
+			if scopedKey == nil {
+				return 0, 0, fmt.Errorf("scoped signing key of user %s not found", user.Name)
+			}
+		}
+		user.JWT, err = s.jwtService.GenerateUserJWT(ctx, user, account, scopedKey)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to regenerate JWT of user %s: %w", user.Name, err)
+		}
+		user.UpdatedAt = time.Now()
+		if err := s.repo.Update(ctx, user); err != nil {
+			return 0, 0, fmt.Errorf("failed to update user %s: %w", user.Name, err)
+		}
+		reissued++
+	}
+	return reissued, revoked, nil
+}
+
+// revokedByAccount reports whether the JWT of a user was issued before its account
+// revoked its users
+func revokedByAccount(user *entities.User, account *entities.Account) (bool, error) {
+	if account.UsersRevokedAt == nil {
+		return false, nil
+	}
+	claims, err := jwt.DecodeUserClaims(user.JWT)
+	if err != nil {
+		return false, fmt.Errorf("failed to decode JWT of user %s: %w", user.Name, err)
+	}
+	return claims.IssuedAt <= account.UsersRevokedAt.Unix(), nil
+}
+
+// syncOperatorClusters syncs every cluster of an operator, reporting a cluster whose
+// sync failed or could not push all the accounts
+func (s *ClusterService) syncOperatorClusters(ctx context.Context, operatorID uuid.UUID) []AccountPush {
+	clusters, err := s.repo.ListByOperator(ctx, operatorID, repositories.ListOptions{})
+	if err != nil {
+		logging.LogFromContext(ctx).Warn("failed to list clusters to sync",
+			"operator_id", operatorID, "error", err)
+		return nil
+	}
+
+	pushes := make([]AccountPush, 0, len(clusters))
+	for _, cluster := range clusters {
+		push := AccountPush{ClusterID: cluster.ID, ClusterName: cluster.Name}
+		result, err := s.SyncCluster(ctx, cluster.ID, false)
+		switch {
+		case err != nil:
+			push.Error = err.Error()
+		case len(result.Errors) > 0:
+			push.Error = fmt.Sprintf("%d sync errors, first: %s", len(result.Errors), result.Errors[0].Error)
+		}
+		pushes = append(pushes, push)
+	}
+	return pushes
+}
+
+// checkOperatorLockdown refuses to hand out the credentials of a user of an account
+// whose operator is locked down
+func checkOperatorLockdown(ctx context.Context, accountRepo repositories.AccountRepository, operatorRepo repositories.OperatorRepository, accountID uuid.UUID) error {
+	account, err := accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+	operator, err := operatorRepo.GetByID(ctx, account.OperatorID)
+	if err != nil {
+		return fmt.Errorf("failed to get operator: %w", err)
+	}
+	if operator.LockedDown() {
+		return fmt.Errorf("%w: operator %s was locked down by %s: %s",
+			ErrOperatorLockedDown, operator.Name, operator.LockedDownBy, operator.LockdownReason)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+type OperatorLockdownTestSuite struct {
+	serviceSuite
+}
+
+func (s *OperatorLockdownTestSuite) TearDownTest() {
+	s.emptyTables("users", "scoped_signing_keys", "accounts", "operators")
+}
+
+func TestOperatorLockdownSuite(t *testing.T) {
+	suite.Run(t, new(OperatorLockdownTestSuite))
+}
+
+// TestLockdownOperator tests that a lockdown revokes every user and blocks credential downloads until lifted
+func (s *OperatorLockdownTestSuite) TestLockdownOperator() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+	scopedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, account.ID, "default")
+	require.NoError(s.T(), err)
+	user, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+	_, err = s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "worker"})
+	require.NoError(s.T(), err)
+
+	lockdown, err := s.operatorService.LockdownOperator(s.ctx, operator.ID, "admin", "leaked credentials")
+	require.NoError(s.T(), err)
+	assert.True(s.T(), lockdown.Operator.LockedDown())
+	assert.Equal(s.T(), "admin", lockdown.Operator.LockedDownBy)
+	assert.Equal(s.T(), []string{"orders"}, lockdown.Accounts)
+
+	locked, err := s.accountRepo.GetByID(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	accountClaims, err := jwt.DecodeAccountClaims(locked.JWT)
+	require.NoError(s.T(), err)
+	userClaims, err := jwt.DecodeUserClaims(user.JWT)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), accountClaims.IsClaimRevoked(userClaims))
+
+	// The system account keeps its users
+	sysAccount, err := s.accountService.GetAccountByName(s.ctx, operator.ID, "$SYS")
+	require.NoError(s.T(), err)
+	sysClaims, err := jwt.DecodeAccountClaims(sysAccount.JWT)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), sysClaims.Revocations)
+
+	_, err = s.userService.GetUserCredentials(s.ctx, user.ID)
+	assert.ErrorIs(s.T(), err, ErrOperatorLockedDown)
+	_, err = s.operatorService.LockdownOperator(s.ctx, operator.ID, "admin", "again")
+	assert.ErrorIs(s.T(), err, ErrInvalidLockdown)
+
+	lifted, err := s.operatorService.LiftOperatorLockdown(s.ctx, operator.ID, "oncall")
+	require.NoError(s.T(), err)
+	assert.False(s.T(), lifted.Operator.LockedDown())
+	assert.Equal(s.T(), "oncall", lifted.Operator.LockdownLiftedBy)
+	assert.Equal(s.T(), "leaked credentials", lifted.Operator.LockdownReason)
+	assert.Equal(s.T(), 2, lifted.ReissuedUsers)
+
+	reissued, err := s.userRepo.GetByID(s.ctx, user.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), user.PublicKey, reissued.PublicKey)
+	userClaims, err = jwt.DecodeUserClaims(reissued.JWT)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), accountClaims.IsClaimRevoked(userClaims))
+	assert.Equal(s.T(), scopedKey.PublicKey, userClaims.Issuer)
+
+	_, err = s.userService.GetUserCredentials(s.ctx, user.ID)
+	assert.NoError(s.T(), err)
+	_, err = s.operatorService.LiftOperatorLockdown(s.ctx, operator.ID, "oncall")
+	assert.ErrorIs(s.T(), err, ErrInvalidLockdown)
+}
+
+// TestLiftOperatorLockdown_KeepsRevokedUsers tests that lifting a lockdown leaves revoked the users of suspended accounts and the users revoked by their account before the lockdown
+func (s *OperatorLockdownTestSuite) TestLiftOperatorLockdown_KeepsRevokedUsers() {
+	operator := s.createOperator("test-operator")
+	orders := s.createAccount(operator.ID, "orders")
+	billing := s.createAccount(operator.ID, "billing")
+	legacy := s.createAccount(operator.ID, "legacy")
+	for _, account := range []*entities.Account{orders, billing, legacy} {
+		_, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app"})
+		require.NoError(s.T(), err)
+	}
+
+	// legacy had its users revoked by a suspension, then resumed
+	_, err := s.accountService.SuspendAccount(s.ctx, legacy.ID, "admin", "compromised", true)
+	require.NoError(s.T(), err)
+	_, err = s.accountService.ResumeAccount(s.ctx, legacy.ID)
+	require.NoError(s.T(), err)
+	_, err = s.accountService.SuspendAccount(s.ctx, billing.ID, "admin", "unpaid invoice", false)
+	require.NoError(s.T(), err)
+
+	lockdown, err := s.operatorService.LockdownOperator(s.ctx, operator.ID, "admin", "leaked credentials")
+	require.NoError(s.T(), err)
+	assert.ElementsMatch(s.T(), []string{"orders", "billing", "legacy"}, lockdown.Accounts)
+
+	// The lockdown leaves the account's own revocation alone
+	locked, err := s.accountRepo.GetByID(s.ctx, orders.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), locked.UsersRevokedAt)
+
+	lifted, err := s.operatorService.LiftOperatorLockdown(s.ctx, operator.ID, "oncall")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []string{"orders"}, lifted.Accounts)
+	assert.Equal(s.T(), []string{"billing"}, lifted.Skipped)
+	assert.Equal(s.T(), 1, lifted.ReissuedUsers)
+	assert.Equal(s.T(), 1, lifted.RevokedUsers)
+
+	for _, account := range []*entities.Account{orders, billing, legacy} {
+		stored, err := s.accountRepo.GetByID(s.ctx, account.ID)
+		require.NoError(s.T(), err)
+		accountClaims, err := jwt.DecodeAccountClaims(stored.JWT)
+		require.NoError(s.T(), err)
+		user, err := s.userService.GetUserByName(s.ctx, account.ID, "app")
+		require.NoError(s.T(), err)
+		userClaims, err := jwt.DecodeUserClaims(user.JWT)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), account.ID != orders.ID, accountClaims.IsClaimRevoked(userClaims), account.Name)
+	}
+}
+
+// TestUpdateUser_LockedDown tests that users are not updated while their operator is locked down
+func (s *OperatorLockdownTestSuite) TestUpdateUser_LockedDown() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+	user, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app"})
+	require.NoError(s.T(), err)
+
+	_, err = s.operatorService.LockdownOperator(s.ctx, operator.ID, "admin", "leaked credentials")
+	require.NoError(s.T(), err)
+
+	description := "renamed during the incident"
+	_, err = s.userService.UpdateUser(s.ctx, user.ID, UpdateUserRequest{Description: &description})
+	assert.ErrorIs(s.T(), err, ErrOperatorLockedDown)
+
+	kept, err := s.userRepo.GetByID(s.ctx, user.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), user.JWT, kept.JWT)
+	assert.Empty(s.T(), kept.Description)
+}
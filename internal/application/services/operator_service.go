@@ -20,8 +20,10 @@ type OperatorService struct {
 	accountRepo    repositories.AccountRepository
 	userRepo       repositories.UserRepository
 	accountService *AccountService
+	userService    *UserService
 	jwtService     *JWTService
 	encryptor      encryption.Encryptor
+	clusters       *ClusterService
 }
 
 // NewOperatorService creates a new operator service
@@ -30,6 +32,7 @@ func NewOperatorService(
 	accountRepo repositories.AccountRepository,
 	userRepo repositories.UserRepository,
 	accountService *AccountService,
+	userService *UserService,
 	jwtService *JWTService,
 	encryptor encryption.Encryptor,
 ) *OperatorService {
@@ -38,11 +41,17 @@ func NewOperatorService(
 		accountRepo:    accountRepo,
 		userRepo:       userRepo,
 		accountService: accountService,
+		userService:    userService,
 		jwtService:     jwtService,
 		encryptor:      encryptor,
 	}
 }
 
+// SetClusterService gives the operator lockdown access to the clusters it syncs
+func (s *OperatorService) SetClusterService(clusters *ClusterService) {
+	s.clusters = clusters
+}
+
 // CreateOperatorRequest contains the data needed to create an operator
 type CreateOperatorRequest struct {
 	Name                string
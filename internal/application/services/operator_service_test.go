@@ -70,7 +70,8 @@ func (s *OperatorServiceTestSuite) SetupSuite() {
 
 	// Create accountService first (required by operatorService)
 	s.accountService = NewAccountService(s.accountRepo, s.operatorRepo, s.scopedSigningKeyRepo, s.jwtService, s.encryptor)
-	s.operatorService = NewOperatorService(s.operatorRepo, s.accountRepo, s.userRepo, s.accountService, s.jwtService, s.encryptor)
+	s.operatorService = NewOperatorService(s.operatorRepo, s.accountRepo, s.userRepo, s.accountService,
+		NewUserService(s.userRepo, s.accountRepo, s.operatorRepo, s.scopedSigningKeyRepo, sql.NewUserKeyRepo(s.db), sql.NewRotationPolicyRepo(s.db), s.jwtService, s.encryptor), s.jwtService, s.encryptor)
 }
 
 func (s *OperatorServiceTestSuite) TearDownSuite() {
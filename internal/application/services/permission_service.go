@@ -374,6 +374,26 @@ func (s *PermissionService) CanReadCluster(ctx context.Context, apiUser *entitie
 	return nil
 }
 
+// CanReadClusterEvents: admin or operator-admin scoped to the cluster's operator.
+// Cluster-wide events such as auth failures span accounts, so account-admins only
+// get them filtered by their account.
+func (s *PermissionService) CanReadClusterEvents(ctx context.Context, apiUser *entities.APIUser, cluster *entities.Cluster) error {
+	if apiUser == nil || cluster == nil {
+		return ErrPermissionDenied
+	}
+	if apiUser.Role == entities.RoleAccountAdmin {
+		return denyf("account admins cannot read cluster-wide events")
+	}
+	ok, err := s.ownsOperator(ctx, apiUser, cluster.OperatorID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return denyf("cannot read events of cluster %s", cluster.ID)
+	}
+	return nil
+}
+
 // CanSyncCluster: admin or operator-admin scoped to the cluster's operator.
 // Account-admins can't trigger cluster-wide JWT pushes.
 func (s *PermissionService) CanSyncCluster(ctx context.Context, apiUser *entities.APIUser, cluster *entities.Cluster) error {
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -200,6 +201,24 @@ func (m *mockUserRepo) ListByScopedSigningKey(ctx context.Context, scopedKeyID u
 	return result, nil
 }
 
+func (m *mockUserRepo) ListNotSeenSince(ctx context.Context, accountID *uuid.UUID, cutoff time.Time, opts repositories.ListOptions) ([]*entities.User, error) {
+	result := make([]*entities.User, 0)
+	for _, user := range m.users {
+		if (accountID == nil || user.AccountID == *accountID) && user.StaleSince(cutoff) {
+			result = append(result, user)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockUserRepo) RecordConnect(ctx context.Context, publicKey string, at time.Time, remoteIP string) (bool, error) {
+	return false, nil
+}
+
+func (m *mockUserRepo) RecordDisconnect(ctx context.Context, publicKey string, at time.Time) (bool, error) {
+	return false, nil
+}
+
 // Test fixtures
 func setupPermissionTest() (*PermissionService, *mockOperatorRepo, *mockAccountRepo, *mockUserRepo, uuid.UUID, uuid.UUID, uuid.UUID, uuid.UUID) {
 	operatorRepo := &mockOperatorRepo{operators: make(map[uuid.UUID]*entities.Operator)}
@@ -647,6 +666,52 @@ func TestCanDeleteAccount(t *testing.T) {
 	}
 }
 
+// Test CanReadClusterEvents
+func TestCanReadClusterEvents(t *testing.T) {
+	permService, _, _, _, operator1ID, operator2ID, account1ID, _ := setupPermissionTest()
+	ctx := context.Background()
+	cluster := &entities.Cluster{ID: uuid.New(), Name: "cluster1", OperatorID: operator1ID}
+
+	tests := []struct {
+		name        string
+		apiUser     *entities.APIUser
+		expectError bool
+	}{
+		{
+			name:        "Admin can read cluster events",
+			apiUser:     &entities.APIUser{Role: entities.RoleAdmin},
+			expectError: false,
+		},
+		{
+			name:        "Operator admin can read events of own cluster",
+			apiUser:     &entities.APIUser{Role: entities.RoleOperatorAdmin, OperatorID: &operator1ID},
+			expectError: false,
+		},
+		{
+			name:        "Operator admin cannot read events of other operator's cluster",
+			apiUser:     &entities.APIUser{Role: entities.RoleOperatorAdmin, OperatorID: &operator2ID},
+			expectError: true,
+		},
+		{
+			name:        "Account admin cannot read cluster-wide events",
+			apiUser:     &entities.APIUser{Role: entities.RoleAccountAdmin, AccountID: &account1ID},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := permService.CanReadClusterEvents(ctx, tt.apiUser, cluster)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.ErrorIs(t, err, ErrPermissionDenied)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 // Test CanManageAPIUsers
 func TestCanManageAPIUsers(t *testing.T) {
 	permService, _, _, _, operator1ID, _, account1ID, _ := setupPermissionTest()
@@ -0,0 +1,344 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
+)
+
+// ErrInvalidPlacement is returned when an account cannot be placed on the requested clusters
+var ErrInvalidPlacement = errors.New("invalid account placement")
+
+// AccountPlacementStatus lists the clusters an account is pushed to
+type AccountPlacementStatus struct {
+	AccountID  uuid.UUID
+	OperatorID uuid.UUID
+	// Assigned lists the clusters the account is placed on, none for every cluster
+	Assigned []*entities.Cluster
+	// Clusters lists the clusters the account is pushed to: the assigned ones and the
+	// other members of their superclusters, which receive it over the gateways
+	Clusters []*entities.Cluster
+	// Removed lists the clusters SetAccountPlacement deleted the account from
+	Removed []PlacementRemoval
+}
+
+// PlacementRemoval reports the delete claim sent to a cluster the account left
+type PlacementRemoval struct {
+	ClusterID   uuid.UUID
+	ClusterName string
+	Error       string // Set when the cluster could not be reached, the next pruning sync retries
+}
+
+// placementIndex maps account IDs to the clusters they are assigned to. Accounts
+// without an entry are placed on every cluster.
+type placementIndex map[uuid.UUID]map[uuid.UUID]bool
+
+func newPlacementIndex(placements []*entities.AccountPlacement) placementIndex {
+	index := make(placementIndex)
+	for _, p := range placements {
+		if index[p.AccountID] == nil {
+			index[p.AccountID] = make(map[uuid.UUID]bool)
+		}
+		index[p.AccountID][p.ClusterID] = true
+	}
+	return index
+}
+
+// placedOn reports whether an account reaches a cluster, given the cluster and the
+// other members of its supercluster
+func (p placementIndex) placedOn(accountID uuid.UUID, scope []uuid.UUID) bool {
+	assigned, ok := p[accountID]
+	if !ok {
+		return true
+	}
+	for _, clusterID := range scope {
+		if assigned[clusterID] {
+			return true
+		}
+	}
+	return false
+}
+
+// placementScope returns the clusters whose placements reach a cluster: the cluster
+// itself and the other members of its supercluster
+func placementScope(cluster *entities.Cluster, clusters []*entities.Cluster) []uuid.UUID {
+	scope := []uuid.UUID{cluster.ID}
+	if cluster.Supercluster == "" {
+		return scope
+	}
+	for _, other := range clusters {
+		if other.ID != cluster.ID && other.Supercluster == cluster.Supercluster {
+			scope = append(scope, other.ID)
+		}
+	}
+	return scope
+}
+
+// placedAccounts keeps the accounts of the cluster's operator that are placed on it.
+// The system account is on every cluster.
+func (s *ClusterService) placedAccounts(ctx context.Context, cluster *entities.Cluster, accounts []*entities.Account) ([]*entities.Account, error) {
+	placements, err := s.placementRepo.ListByOperator(ctx, cluster.OperatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account placements: %w", err)
+	}
+	if len(placements) == 0 {
+		return accounts, nil
+	}
+	clusters, err := s.repo.ListByOperator(ctx, cluster.OperatorID, repositories.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	index := newPlacementIndex(placements)
+	scope := placementScope(cluster, clusters)
+	placed := make([]*entities.Account, 0, len(accounts))
+	for _, account := range accounts {
+		if account.PublicKey == cluster.SystemAccountPubKey || index.placedOn(account.ID, scope) {
+			placed = append(placed, account)
+		}
+	}
+	return placed, nil
+}
+
+// GetAccountPlacement lists the clusters an account is assigned and pushed to
+func (s *AccountService) GetAccountPlacement(ctx context.Context, accountID uuid.UUID) (*AccountPlacementStatus, error) {
+	account, err := s.repo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := s.clusters.repo.ListByOperator(ctx, account.OperatorID, repositories.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	placements, err := s.clusters.placementRepo.ListByAccount(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account placements: %w", err)
+	}
+
+	return buildAccountPlacement(account, clusters, newPlacementIndex(placements)), nil
+}
+
+// SetAccountPlacement places an account on the given clusters of its operator, none
+// placing it on every cluster. The account is deleted right away from the clusters it
+// leaves with an operator-signed delete claim; the clusters it joins receive it on
+// their next sync.
+func (s *AccountService) SetAccountPlacement(ctx context.Context, accountID uuid.UUID, clusterIDs []uuid.UUID) (*AccountPlacementStatus, error) {
+	account, err := s.repo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := s.clusters.repo.ListByOperator(ctx, account.OperatorID, repositories.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	byID := make(map[uuid.UUID]*entities.Cluster, len(clusters))
+	for _, cluster := range clusters {
+		if cluster.SystemAccountPubKey == account.PublicKey {
+			return nil, fmt.Errorf("%w: the system account is on every cluster", ErrInvalidPlacement)
+		}
+		byID[cluster.ID] = cluster
+	}
+	assigned := make([]uuid.UUID, 0, len(clusterIDs))
+	seen := make(map[uuid.UUID]bool, len(clusterIDs))
+	for _, id := range clusterIDs {
+		if byID[id] == nil {
+			return nil, fmt.Errorf("%w: cluster %s does not belong to the operator of account %s",
+				ErrInvalidPlacement, id, account.Name)
+		}
+		if !seen[id] {
+			seen[id] = true
+			assigned = append(assigned, id)
+		}
+	}
+
+	current, err := s.clusters.placementRepo.ListByAccount(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account placements: %w", err)
+	}
+	before := buildAccountPlacement(account, clusters, newPlacementIndex(current))
+
+	if err := s.clusters.placementRepo.Replace(ctx, account.ID, assigned); err != nil {
+		return nil, err
+	}
+	placements := make([]*entities.AccountPlacement, 0, len(assigned))
+	for _, id := range assigned {
+		placements = append(placements, &entities.AccountPlacement{AccountID: account.ID, ClusterID: id})
+	}
+	status := buildAccountPlacement(account, clusters, newPlacementIndex(placements))
+
+	left := leftClusters(before.Clusters, status.Clusters)
+	if len(left) > 0 {
+		status.Removed = s.clusters.removeAccountFromClusters(ctx, account.OperatorID, account, left)
+	}
+	return status, nil
+}
+
+// removeAccountFromClusters deletes an account from the resolvers of clusters with a
+// delete claim signed by operatorID, the operator the clusters trust
+func (s *ClusterService) removeAccountFromClusters(ctx context.Context, operatorID uuid.UUID, account *entities.Account, clusters []*entities.Cluster) []PlacementRemoval {
+	operator, err := s.operatorRepo.GetByID(ctx, operatorID)
+	var claim string
+	if err == nil {
+		claim, err = s.jwtService.GenerateDeleteClaimJWT(ctx, operator, []string{account.PublicKey})
+	}
+
+	var errs []string
+	if err != nil {
+		errs = make([]string, len(clusters))
+		for i := range errs {
+			errs[i] = fmt.Sprintf("failed to generate delete claim: %v", err)
+		}
+	} else {
+		errs = s.updateClusters(ctx, clusters, func(natsClient *nats.Client, _ *entities.Cluster) error {
+			return natsClient.DeleteAccountJWT(ctx, claim)
+		})
+	}
+
+	removals := make([]PlacementRemoval, 0, len(clusters))
+	for i, cluster := range clusters {
+		removals = append(removals, PlacementRemoval{ClusterID: cluster.ID, ClusterName: cluster.Name, Error: errs[i]})
+	}
+	return removals
+}
+
+// resignAccount regenerates the JWT of an account signed by operator and saves the account
+func (s *ClusterService) resignAccount(ctx context.Context, account *entities.Account, operator *entities.Operator) error {
+	scopedKeys, err := s.scopedKeyRepo.ListByAccount(ctx, account.ID, repositories.ListOptions{Limit: 1000})
+	if err != nil {
+		return fmt.Errorf("failed to list scoped signing keys: %w", err)
+	}
+	account.JWT, err = s.jwtService.GenerateAccountJWT(ctx, account, operator, scopedKeys)
+	if err != nil {
+		return fmt.Errorf("failed to regenerate account JWT: %w", err)
+	}
+	account.UpdatedAt = time.Now()
+	if err := s.accountRepo.Update(ctx, account); err != nil {
+		return fmt.Errorf("failed to update account: %w", err)
+	}
+	return nil
+}
+
+// pushAccountToClusters pushes the JWT of an account to the clusters of its operator
+// it is placed on
+func (s *ClusterService) pushAccountToClusters(ctx context.Context, account *entities.Account) []AccountPush {
+	clusters, err := s.repo.ListByOperator(ctx, account.OperatorID, repositories.ListOptions{})
+	if err != nil {
+		logging.LogFromContext(ctx).Warn("failed to list clusters to push the account to",
+			"account", account.Name, "error", err)
+		return nil
+	}
+	placements, err := s.placementRepo.ListByAccount(ctx, account.ID)
+	if err != nil {
+		logging.LogFromContext(ctx).Warn("failed to list account placements",
+			"account", account.Name, "error", err)
+		return nil
+	}
+	all := clusters
+	clusters = buildAccountPlacement(account, clusters, newPlacementIndex(placements)).Clusters
+
+	errs := s.updateClusters(ctx, clusters, func(natsClient *nats.Client, cluster *entities.Cluster) error {
+		return natsClient.PushAccountJWT(ctx, account, s.knownServers(ctx, cluster, all))
+	})
+	pushes := make([]AccountPush, 0, len(clusters))
+	for i, cluster := range clusters {
+		pushes = append(pushes, AccountPush{ClusterID: cluster.ID, ClusterName: cluster.Name, Error: errs[i]})
+	}
+	return pushes
+}
+
+// updateClusters runs update through the connection of each cluster. The members of a
+// supercluster share claim updates over the gateways, so only one of them is updated
+// unless it cannot be reached. It returns the error of each cluster, empty on success.
+func (s *ClusterService) updateClusters(ctx context.Context, clusters []*entities.Cluster, update func(*nats.Client, *entities.Cluster) error) []string {
+	errs := make([]string, len(clusters))
+	reached := make(map[string]bool)
+	for i, cluster := range clusters {
+		if cluster.Supercluster != "" && reached[cluster.Supercluster] {
+			continue
+		}
+		natsClient, _, err := s.clusterClient(ctx, cluster.ID)
+		if err == nil {
+			err = update(natsClient, cluster)
+		}
+		if err != nil {
+			errs[i] = err.Error()
+		} else if cluster.Supercluster != "" {
+			reached[cluster.Supercluster] = true
+		}
+	}
+	return errs
+}
+
+// knownServers returns how many servers a claim update sent to cluster reaches, as
+// recorded by the latest health checks: the servers of the cluster, or of its whole
+// supercluster among clusters. It returns 0 when no server was recorded, so the
+// request waits for the replies to stop instead.
+func (s *ClusterService) knownServers(ctx context.Context, cluster *entities.Cluster, clusters []*entities.Cluster) int {
+	known := 0
+	for _, member := range clusters {
+		if member.ID != cluster.ID && (cluster.Supercluster == "" || member.Supercluster != cluster.Supercluster) {
+			continue
+		}
+		servers, err := s.serverRepo.ListByCluster(ctx, member.ID)
+		if err != nil {
+			return 0
+		}
+		known += latestServerCount(servers)
+	}
+	return known
+}
+
+// latestServerCount counts the servers recorded by the latest health check that
+// reached the cluster
+func latestServerCount(servers []*entities.ClusterServer) int {
+	var latest time.Time
+	for _, srv := range servers {
+		if srv.LastSeen.After(latest) {
+			latest = srv.LastSeen
+		}
+	}
+	count := 0
+	for _, srv := range servers {
+		if !srv.LastSeen.Before(latest) {
+			count++
+		}
+	}
+	return count
+}
+
+// buildAccountPlacement resolves the clusters an account is assigned and pushed to
+func buildAccountPlacement(account *entities.Account, clusters []*entities.Cluster, index placementIndex) *AccountPlacementStatus {
+	status := &AccountPlacementStatus{AccountID: account.ID, OperatorID: account.OperatorID}
+	for _, cluster := range clusters {
+		if index[account.ID][cluster.ID] {
+			status.Assigned = append(status.Assigned, cluster)
+		}
+		if index.placedOn(account.ID, placementScope(cluster, clusters)) {
+			status.Clusters = append(status.Clusters, cluster)
+		}
+	}
+	return status
+}
+
+// leftClusters returns the clusters of before that are not in after
+func leftClusters(before, after []*entities.Cluster) []*entities.Cluster {
+	kept := make(map[uuid.UUID]bool, len(after))
+	for _, cluster := range after {
+		kept[cluster.ID] = true
+	}
+	var left []*entities.Cluster
+	for _, cluster := range before {
+		if !kept[cluster.ID] {
+			left = append(left, cluster)
+		}
+	}
+	return left
+}
@@ -0,0 +1,318 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+)
+
+// ErrInvalidPromotion is returned when an account cannot be promoted to the requested operator
+var ErrInvalidPromotion = errors.New("invalid account promotion")
+
+// AccountPromotion reports the promotion of an account's configuration to another operator
+type AccountPromotion struct {
+	Source *entities.Account
+	// Target is the account of the target operator, nil on a dry run creating it
+	Target  *entities.Account
+	Created bool // The target did not exist (and was created unless on a dry run)
+	Changes []PromotionChange
+	// Warnings lists the clusters of the target operator whose JetStream capacity the
+	// promoted limits over-commit, and the subject mappings that are not promoted
+	Warnings []string
+}
+
+// PromotionChange is one configuration value promotion changes on the target
+type PromotionChange struct {
+	Field string // e.g. "jetstream.max_memory" or "scoped_keys.app.pub_allow"
+	From  string // Empty when the target does not have it yet
+	To    string
+}
+
+// PromoteAccount copies an account's configuration onto the account of the same name
+// of another operator, creating it if needed: description, JetStream limits and
+// scoped signing key templates. Seeds are never copied: the target keeps its own
+// keys, and the scoped keys it lacks are created with new seeds. Scoped keys only
+// the target has are kept, as deleting them would delete their users. With dryRun,
+// only the changes are computed.
+//
+// NIS does not manage subject mappings, so they are not promoted. Only an account
+// imported from NSC can carry some in its JWT, and the promotion warns about them.
+func (s *AccountService) PromoteAccount(ctx context.Context, accountID, targetOperatorID uuid.UUID, dryRun bool) (*AccountPromotion, error) {
+	source, err := s.repo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if source.OperatorID == targetOperatorID {
+		return nil, fmt.Errorf("%w: account %s already belongs to the target operator", ErrInvalidPromotion, source.Name)
+	}
+	sourceOperator, err := s.operatorRepo.GetByID(ctx, source.OperatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator: %w", err)
+	}
+	if source.PublicKey == sourceOperator.SystemAccountPubKey {
+		return nil, fmt.Errorf("%w: system accounts cannot be promoted", ErrInvalidPromotion)
+	}
+	targetOperator, err := s.operatorRepo.GetByID(ctx, targetOperatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target operator: %w", err)
+	}
+
+	sourceKeys, err := s.scopedKeyRepo.ListByAccount(ctx, source.ID, repositories.ListOptions{Limit: 1000})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scoped signing keys: %w", err)
+	}
+
+	target, err := s.repo.GetByName(ctx, targetOperatorID, source.Name)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return nil, fmt.Errorf("failed to check existing account: %w", err)
+	}
+	var targetKeys []*entities.ScopedSigningKey
+	if target != nil {
+		if target.PublicKey == targetOperator.SystemAccountPubKey {
+			return nil, fmt.Errorf("%w: account %s is the system account of operator %s",
+				ErrInvalidPromotion, target.Name, targetOperator.Name)
+		}
+		targetKeys, err = s.scopedKeyRepo.ListByAccount(ctx, target.ID, repositories.ListOptions{Limit: 1000})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list scoped signing keys: %w", err)
+		}
+	}
+
+	promotion := &AccountPromotion{
+		Source:  source,
+		Target:  target,
+		Created: target == nil,
+		Changes: diffAccountConfig(source, sourceKeys, target, targetKeys),
+	}
+	if dryRun || (target != nil && len(promotion.Changes) == 0) {
+		promoted := &entities.Account{OperatorID: targetOperatorID, Name: source.Name}
+		if target != nil {
+			copied := *target
+			promoted = &copied
+		}
+		copyJetStreamLimits(promoted, source)
+		promotion.Warnings = append(s.JetStreamCapacityWarnings(ctx, promoted), unpromotedMappings(source)...)
+		return promotion, nil
+	}
+
+	if target == nil {
+		target, err = s.CreateAccount(ctx, CreateAccountRequest{
+			OperatorID:            targetOperatorID,
+			Name:                  source.Name,
+			Description:           source.Description,
+			JetStreamEnabled:      source.JetStreamEnabled,
+			JetStreamMaxMemory:    source.JetStreamMaxMemory,
+			JetStreamMaxStorage:   source.JetStreamMaxStorage,
+			JetStreamMaxStreams:   source.JetStreamMaxStreams,
+			JetStreamMaxConsumers: source.JetStreamMaxConsumers,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// CreateAccount adds the default scoped signing key
+		targetKeys, err = s.scopedKeyRepo.ListByAccount(ctx, target.ID, repositories.ListOptions{Limit: 1000})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list scoped signing keys: %w", err)
+		}
+	} else {
+		target.Description = source.Description
+		copyJetStreamLimits(target, source)
+		if err := s.enforceJetStreamCapacity(ctx, target); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.promoteScopedKeys(ctx, target.ID, sourceKeys, targetKeys); err != nil {
+		return nil, err
+	}
+
+	// Re-sign the target JWT once, declaring the promoted scoped keys
+	scopedKeys, err := s.scopedKeyRepo.ListByAccount(ctx, target.ID, repositories.ListOptions{Limit: 1000})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scoped signing keys: %w", err)
+	}
+	jwt, err := s.jwtService.GenerateAccountJWT(ctx, target, targetOperator, scopedKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to regenerate account JWT: %w", err)
+	}
+	target.JWT = jwt
+	target.UpdatedAt = time.Now()
+	if err := s.repo.Update(ctx, target); err != nil {
+		return nil, fmt.Errorf("failed to update account: %w", err)
+	}
+
+	promotion.Target = target
+	promotion.Warnings = append(s.JetStreamCapacityWarnings(ctx, target), unpromotedMappings(source)...)
+	return promotion, nil
+}
+
+// unpromotedMappings warns about the subject mappings the source account JWT declares,
+// which promotion does not copy
+func unpromotedMappings(source *entities.Account) []string {
+	claims, err := jwt.DecodeAccountClaims(source.JWT)
+	if err != nil || len(claims.Mappings) == 0 {
+		return nil
+	}
+	subjects := make([]string, 0, len(claims.Mappings))
+	for subject := range claims.Mappings {
+		subjects = append(subjects, string(subject))
+	}
+	sort.Strings(subjects)
+	return []string{fmt.Sprintf(
+		"account %s has subject mappings NIS does not manage, they are not promoted: %s",
+		source.Name, strings.Join(subjects, ", "))}
+}
+
+// copyJetStreamLimits copies the JetStream limits of src onto dst
+func copyJetStreamLimits(dst, src *entities.Account) {
+	dst.JetStreamEnabled = src.JetStreamEnabled
+	dst.JetStreamMaxMemory = src.JetStreamMaxMemory
+	dst.JetStreamMaxStorage = src.JetStreamMaxStorage
+	dst.JetStreamMaxStreams = src.JetStreamMaxStreams
+	dst.JetStreamMaxConsumers = src.JetStreamMaxConsumers
+}
+
+// promoteScopedKeys copies the source scoped key templates onto the target account,
+// creating the keys it lacks with new seeds
+func (s *AccountService) promoteScopedKeys(ctx context.Context, targetID uuid.UUID, sourceKeys, targetKeys []*entities.ScopedSigningKey) error {
+	byName := make(map[string]*entities.ScopedSigningKey, len(targetKeys))
+	for _, key := range targetKeys {
+		byName[key.Name] = key
+	}
+
+	for _, src := range sourceKeys {
+		existing := byName[src.Name]
+		if existing != nil {
+			if len(diffScopedKey(src, existing)) == 0 {
+				continue
+			}
+			copyScopedKeyTemplate(existing, src)
+			existing.UpdatedAt = time.Now()
+			if err := s.scopedKeyRepo.Update(ctx, existing); err != nil {
+				return fmt.Errorf("failed to update scoped signing key %s: %w", src.Name, err)
+			}
+			continue
+		}
+
+		seed, pubKey, err := GenerateNKey(nkeys.PrefixByteAccount)
+		if err != nil {
+			return fmt.Errorf("failed to generate scoped signing key: %w", err)
+		}
+		encryptedSeed, err := s.encryptor.Encrypt(ctx, seed)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt scoped signing key seed: %w", err)
+		}
+		key := &entities.ScopedSigningKey{
+			ID:            uuid.New(),
+			AccountID:     targetID,
+			EncryptedSeed: encryptedSeed,
+			PublicKey:     pubKey,
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+		copyScopedKeyTemplate(key, src)
+		if err := s.scopedKeyRepo.Create(ctx, key); err != nil {
+			return fmt.Errorf("failed to create scoped signing key %s: %w", src.Name, err)
+		}
+	}
+	return nil
+}
+
+// copyScopedKeyTemplate copies the name and permission template of a scoped key, not its keys
+func copyScopedKeyTemplate(dst, src *entities.ScopedSigningKey) {
+	dst.Name = src.Name
+	dst.Description = src.Description
+	dst.PubAllow = src.PubAllow
+	dst.PubDeny = src.PubDeny
+	dst.SubAllow = src.SubAllow
+	dst.SubDeny = src.SubDeny
+	dst.ResponseMaxMsgs = src.ResponseMaxMsgs
+	dst.ResponseTTL = src.ResponseTTL
+}
+
+// diffAccountConfig lists the changes promoting source onto target makes. A nil
+// target is an account to create, whose values have no From.
+func diffAccountConfig(source *entities.Account, sourceKeys []*entities.ScopedSigningKey, target *entities.Account, targetKeys []*entities.ScopedSigningKey) []PromotionChange {
+	created := target == nil
+	if created {
+		target = &entities.Account{}
+	}
+
+	var changes []PromotionChange
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, PromotionChange{Field: field, From: from, To: to})
+		}
+	}
+	add("description", target.Description, source.Description)
+	add("jetstream.enabled", strconv.FormatBool(target.JetStreamEnabled), strconv.FormatBool(source.JetStreamEnabled))
+	add("jetstream.max_memory", formatLimit(target.JetStreamMaxMemory), formatLimit(source.JetStreamMaxMemory))
+	add("jetstream.max_storage", formatLimit(target.JetStreamMaxStorage), formatLimit(source.JetStreamMaxStorage))
+	add("jetstream.max_streams", formatLimit(target.JetStreamMaxStreams), formatLimit(source.JetStreamMaxStreams))
+	add("jetstream.max_consumers", formatLimit(target.JetStreamMaxConsumers), formatLimit(source.JetStreamMaxConsumers))
+
+	byName := make(map[string]*entities.ScopedSigningKey, len(targetKeys))
+	for _, key := range targetKeys {
+		byName[key.Name] = key
+	}
+	for _, src := range sourceKeys {
+		existing := byName[src.Name]
+		if existing != nil {
+			changes = append(changes, diffScopedKey(src, existing)...)
+			continue
+		}
+		changes = append(changes, PromotionChange{Field: "scoped_keys." + src.Name, To: "created"})
+		for _, change := range diffScopedKey(src, &entities.ScopedSigningKey{}) {
+			change.From = ""
+			changes = append(changes, change)
+		}
+	}
+	if created {
+		for i := range changes {
+			changes[i].From = ""
+		}
+	}
+	return changes
+}
+
+// diffScopedKey lists the template values of target that differ from source
+func diffScopedKey(source, target *entities.ScopedSigningKey) []PromotionChange {
+	prefix := "scoped_keys." + source.Name + "."
+	var changes []PromotionChange
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, PromotionChange{Field: prefix + field, From: from, To: to})
+		}
+	}
+	add("description", target.Description, source.Description)
+	add("pub_allow", formatSubjects(target.PubAllow), formatSubjects(source.PubAllow))
+	add("pub_deny", formatSubjects(target.PubDeny), formatSubjects(source.PubDeny))
+	add("sub_allow", formatSubjects(target.SubAllow), formatSubjects(source.SubAllow))
+	add("sub_deny", formatSubjects(target.SubDeny), formatSubjects(source.SubDeny))
+	add("response_max_msgs", strconv.Itoa(target.ResponseMaxMsgs), strconv.Itoa(source.ResponseMaxMsgs))
+	add("response_ttl", target.ResponseTTL.String(), source.ResponseTTL.String())
+	return changes
+}
+
+// formatLimit formats a JetStream limit, -1 meaning unlimited
+func formatLimit(limit int64) string {
+	if limit < 0 {
+		return "unlimited"
+	}
+	return strconv.FormatInt(limit, 10)
+}
+
+// formatSubjects formats a subject list, empty lists and nil alike
+func formatSubjects(subjects []string) string {
+	return "[" + strings.Join(subjects, ", ") + "]"
+}
@@ -0,0 +1,144 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+)
+
+type PromotionTestSuite struct {
+	serviceSuite
+}
+
+func (s *PromotionTestSuite) TearDownTest() {
+	s.emptyTables("users", "scoped_signing_keys", "accounts", "operators")
+}
+
+func TestPromotionSuite(t *testing.T) {
+	suite.Run(t, new(PromotionTestSuite))
+}
+
+// TestPromoteAccount tests copying an account's configuration to another operator
+func (s *PromotionTestSuite) TestPromoteAccount() {
+	staging := s.createOperator("staging")
+	prod := s.createOperator("prod")
+
+	source, err := s.accountService.CreateAccount(s.ctx, CreateAccountRequest{
+		OperatorID:          staging.ID,
+		Name:                "orders",
+		Description:         "Order processing",
+		JetStreamEnabled:    true,
+		JetStreamMaxMemory:  1024,
+		JetStreamMaxStorage: -1,
+	})
+	require.NoError(s.T(), err)
+	sourceKey, err := s.scopedKeyService.CreateScopedSigningKey(s.ctx, CreateScopedSigningKeyRequest{
+		AccountID: source.ID,
+		Name:      "app",
+		PubAllow:  []string{"orders.>"},
+	})
+	require.NoError(s.T(), err)
+
+	// A dry run only reports the changes
+	preview, err := s.accountService.PromoteAccount(s.ctx, source.ID, prod.ID, true)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), preview.Created)
+	assert.Nil(s.T(), preview.Target)
+	assert.Contains(s.T(), preview.Changes, PromotionChange{Field: "jetstream.max_memory", To: "1024"})
+	assert.Contains(s.T(), preview.Changes, PromotionChange{Field: "scoped_keys.app.pub_allow", To: "[orders.>]"})
+	_, err = s.accountService.GetAccountByName(s.ctx, prod.ID, "orders")
+	assert.ErrorIs(s.T(), err, repositories.ErrNotFound)
+
+	promotion, err := s.accountService.PromoteAccount(s.ctx, source.ID, prod.ID, false)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), promotion.Target)
+	target := promotion.Target
+	assert.Equal(s.T(), prod.ID, target.OperatorID)
+	assert.Equal(s.T(), "Order processing", target.Description)
+	assert.Equal(s.T(), int64(1024), target.JetStreamMaxMemory)
+	assert.Equal(s.T(), int64(-1), target.JetStreamMaxStorage)
+	assert.NotEqual(s.T(), source.PublicKey, target.PublicKey)
+
+	// Templates are copied, seeds are not
+	targetKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, target.ID, "app")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []string{"orders.>"}, targetKey.PubAllow)
+	assert.NotEqual(s.T(), sourceKey.PublicKey, targetKey.PublicKey)
+	assert.NotEqual(s.T(), sourceKey.EncryptedSeed, targetKey.EncryptedSeed)
+
+	// The target JWT is signed by the target operator and declares the promoted key
+	claims, err := jwt.DecodeAccountClaims(target.JWT)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), prod.PublicKey, claims.Issuer)
+	assert.True(s.T(), claims.SigningKeys.Contains(targetKey.PublicKey))
+
+	// Promoting again changes nothing
+	again, err := s.accountService.PromoteAccount(s.ctx, source.ID, prod.ID, false)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), again.Created)
+	assert.Empty(s.T(), again.Changes)
+
+	// Updates reuse the target's keys
+	_, err = s.scopedKeyService.UpdateScopedSigningKey(s.ctx, sourceKey.ID, UpdateScopedSigningKeyRequest{
+		PubAllow: []string{"orders.>", "billing.>"},
+	})
+	require.NoError(s.T(), err)
+	updated, err := s.accountService.PromoteAccount(s.ctx, source.ID, prod.ID, false)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []PromotionChange{{
+		Field: "scoped_keys.app.pub_allow", From: "[orders.>]", To: "[orders.>, billing.>]",
+	}}, updated.Changes)
+	updatedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, target.ID, "app")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), targetKey.PublicKey, updatedKey.PublicKey)
+	assert.Equal(s.T(), []string{"orders.>", "billing.>"}, updatedKey.PubAllow)
+}
+
+// TestPromoteAccount_Invalid tests that promotions to the same operator or of system accounts are rejected
+func (s *PromotionTestSuite) TestPromoteAccount_Invalid() {
+	staging := s.createOperator("staging")
+	prod := s.createOperator("prod")
+
+	account := s.createAccount(staging.ID, "orders")
+	_, err := s.accountService.PromoteAccount(s.ctx, account.ID, staging.ID, true)
+	assert.ErrorIs(s.T(), err, ErrInvalidPromotion)
+
+	sysAccount, err := s.accountService.GetAccountByName(s.ctx, staging.ID, "$SYS")
+	require.NoError(s.T(), err)
+	_, err = s.accountService.PromoteAccount(s.ctx, sysAccount.ID, prod.ID, true)
+	assert.ErrorIs(s.T(), err, ErrInvalidPromotion)
+}
+
+// TestPromoteAccount_Mappings tests that the subject mappings of an imported account JWT are reported as not promoted
+func (s *PromotionTestSuite) TestPromoteAccount_Mappings() {
+	staging := s.createOperator("staging")
+	prod := s.createOperator("prod")
+
+	// An account imported from NSC keeps its JWT, mappings included
+	account := s.createAccount(staging.ID, "orders")
+	claims, err := jwt.DecodeAccountClaims(account.JWT)
+	require.NoError(s.T(), err)
+	claims.Mappings = jwt.Mapping{"orders.v1.>": {{Subject: "orders.>"}}}
+	operatorKey, err := nkeys.CreateOperator()
+	require.NoError(s.T(), err)
+	account.JWT, err = claims.Encode(operatorKey)
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), s.accountRepo.Update(s.ctx, account))
+
+	preview, err := s.accountService.PromoteAccount(s.ctx, account.ID, prod.ID, true)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), preview.Warnings, 1)
+	assert.Contains(s.T(), preview.Warnings[0], "orders.v1.>")
+
+	promotion, err := s.accountService.PromoteAccount(s.ctx, account.ID, prod.ID, false)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), promotion.Warnings, 1)
+	targetClaims, err := jwt.DecodeAccountClaims(promotion.Target.JWT)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), targetClaims.Mappings)
+}
@@ -0,0 +1,110 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+)
+
+// DefaultRotationWebhookTimeout bounds a rotation webhook call
+const DefaultRotationWebhookTimeout = 10 * time.Second
+
+// RotationNotification tells that a scheduled rotation made new user credentials
+// available. The credentials themselves are never sent: they are downloaded from nis.
+type RotationNotification struct {
+	Operator   string    `json:"operator"`
+	Account    string    `json:"account"`
+	AccountID  string    `json:"account_id"`
+	User       string    `json:"user"`
+	UserID     string    `json:"user_id"`
+	PublicKey  string    `json:"public_key"`
+	RetiredKey string    `json:"retired_key"`
+	RotatedAt  time.Time `json:"rotated_at"`
+	RevokeAt   time.Time `json:"revoke_at"`
+	PolicyID   string    `json:"policy_id"`
+}
+
+// RotationNotifier is told about the credentials made available by scheduled rotations
+type RotationNotifier interface {
+	NotifyRotation(ctx context.Context, notification RotationNotification) error
+}
+
+// WebhookRotationNotifier posts the rotation notifications as JSON to a URL
+type WebhookRotationNotifier struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookRotationNotifier creates a notifier posting to url. A non-positive
+// timeout uses DefaultRotationWebhookTimeout.
+func NewWebhookRotationNotifier(url string, timeout time.Duration) *WebhookRotationNotifier {
+	if timeout <= 0 {
+		timeout = DefaultRotationWebhookTimeout
+	}
+	return &WebhookRotationNotifier{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// NotifyRotation posts a notification, failing unless the webhook answers with a 2xx status
+func (n *WebhookRotationNotifier) NotifyRotation(ctx context.Context, notification RotationNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to encode rotation notification: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build rotation webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("rotation webhook failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("rotation webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// SetRotationNotifier sets who is told about the credentials made available by
+// scheduled rotations. Without a notifier, they are only logged.
+func (s *UserService) SetRotationNotifier(notifier RotationNotifier) {
+	s.notifier = notifier
+}
+
+// notifyRotation logs a scheduled rotation and passes it to the notifier. A failed
+// notification is logged, the rotation stands.
+func (s *UserService) notifyRotation(ctx context.Context, planned PlannedRotation, rotation *UserRotation) {
+	notification := RotationNotification{
+		Operator:   planned.Operator.Name,
+		Account:    planned.Account.Name,
+		AccountID:  planned.Account.ID.String(),
+		User:       rotation.User.Name,
+		UserID:     rotation.User.ID.String(),
+		PublicKey:  rotation.User.PublicKey,
+		RetiredKey: rotation.RetiredKey.PublicKey,
+		RotatedAt:  rotation.RetiredKey.RetiredAt,
+		RevokeAt:   rotation.RetiredKey.RevokeAt,
+		PolicyID:   planned.Policy.ID.String(),
+	}
+	logging.LogFromContext(ctx).Info("new user credentials available",
+		"operator", notification.Operator, "account", notification.Account, "user", notification.User,
+		"revoke_at", notification.RevokeAt)
+
+	if s.notifier == nil {
+		return
+	}
+	if err := s.notifier.NotifyRotation(ctx, notification); err != nil {
+		logging.LogFromContext(ctx).Warn("failed to notify credential rotation",
+			"user", notification.User, "account", notification.Account, "error", err)
+	}
+}
@@ -0,0 +1,351 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+)
+
+const (
+	// DefaultRotationScheduleInterval is how often the scheduler rotates the users whose
+	// rotation is due
+	DefaultRotationScheduleInterval = 5 * time.Minute
+	// MinRotationInterval is the shortest interval a rotation policy accepts
+	MinRotationInterval = time.Hour
+)
+
+// ErrInvalidRotationPolicy is returned when a rotation policy is malformed
+var ErrInvalidRotationPolicy = errors.New("invalid rotation policy")
+
+// CreateRotationPolicyRequest contains the data for scheduling credential rotations.
+// Set ScopedSigningKeyID for the users signed by a scoped key, AccountID for the users
+// of an account, or neither for every user of the operator.
+type CreateRotationPolicyRequest struct {
+	OperatorID         uuid.UUID // Derived from the account or scoped key when they are set
+	AccountID          *uuid.UUID
+	ScopedSigningKeyID *uuid.UUID
+	Interval           time.Duration
+	GracePeriod        time.Duration // Must be shorter than Interval
+	CreatedBy          string
+}
+
+// PlannedRotation is an upcoming scheduled rotation of a user's credentials
+type PlannedRotation struct {
+	User     *entities.User
+	Account  *entities.Account
+	Operator *entities.Operator
+	// Policy is the most specific policy covering the user
+	Policy *entities.RotationPolicy
+	// LastRotatedAt is when the user's current key was issued
+	LastRotatedAt time.Time
+	DueAt         time.Time
+}
+
+// CreateRotationPolicy schedules the rotation of the credentials of the users of an
+// operator, an account or a scoped signing key. There is at most one policy per target.
+func (s *UserService) CreateRotationPolicy(ctx context.Context, req CreateRotationPolicyRequest) (*entities.RotationPolicy, error) {
+	if req.Interval < MinRotationInterval {
+		return nil, fmt.Errorf("%w: the interval must be at least %s", ErrInvalidRotationPolicy, MinRotationInterval)
+	}
+	if req.GracePeriod < 0 || req.GracePeriod >= req.Interval {
+		return nil, fmt.Errorf("%w: the grace period cannot be negative and must be shorter than the interval", ErrInvalidRotationPolicy)
+	}
+
+	accountID := req.AccountID
+	if req.ScopedSigningKeyID != nil {
+		key, err := s.scopedKeyRepo.GetByID(ctx, *req.ScopedSigningKeyID)
+		if err != nil {
+			return nil, err
+		}
+		if accountID != nil && *accountID != key.AccountID {
+			return nil, fmt.Errorf("%w: scoped signing key %s does not belong to the account", ErrInvalidRotationPolicy, key.Name)
+		}
+		accountID = &key.AccountID
+	}
+
+	operatorID := req.OperatorID
+	if accountID != nil {
+		account, err := s.accountRepo.GetByID(ctx, *accountID)
+		if err != nil {
+			return nil, err
+		}
+		if operatorID != uuid.Nil && operatorID != account.OperatorID {
+			return nil, fmt.Errorf("%w: account %s does not belong to the operator", ErrInvalidRotationPolicy, account.Name)
+		}
+		operatorID = account.OperatorID
+
+		operator, err := s.operatorRepo.GetByID(ctx, operatorID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get operator: %w", err)
+		}
+		if account.PublicKey == operator.SystemAccountPubKey {
+			return nil, fmt.Errorf("%w: system account users connect nis to the clusters and cannot be rotated", ErrInvalidRotationPolicy)
+		}
+	} else if _, err := s.operatorRepo.GetByID(ctx, operatorID); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.rotationRepo.ListByOperator(ctx, operatorID)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range existing {
+		if sameUUID(p.AccountID, accountID) && sameUUID(p.ScopedSigningKeyID, req.ScopedSigningKeyID) {
+			return nil, repositories.ErrAlreadyExists
+		}
+	}
+
+	now := time.Now()
+	policy := &entities.RotationPolicy{
+		ID:                 uuid.New(),
+		OperatorID:         operatorID,
+		AccountID:          accountID,
+		ScopedSigningKeyID: req.ScopedSigningKeyID,
+		Interval:           req.Interval,
+		GracePeriod:        req.GracePeriod,
+		CreatedBy:          req.CreatedBy,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	if err := s.rotationRepo.Create(ctx, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// GetRotationPolicy retrieves a rotation policy by ID
+func (s *UserService) GetRotationPolicy(ctx context.Context, id uuid.UUID) (*entities.RotationPolicy, error) {
+	return s.rotationRepo.GetByID(ctx, id)
+}
+
+// ListRotationPolicies retrieves the rotation policies of an operator, or of every
+// operator when operatorID is uuid.Nil
+func (s *UserService) ListRotationPolicies(ctx context.Context, operatorID uuid.UUID) ([]*entities.RotationPolicy, error) {
+	if operatorID == uuid.Nil {
+		return s.rotationRepo.List(ctx)
+	}
+	return s.rotationRepo.ListByOperator(ctx, operatorID)
+}
+
+// DeleteRotationPolicy deletes a rotation policy. Keys already retired by it are
+// still revoked at the end of their grace period.
+func (s *UserService) DeleteRotationPolicy(ctx context.Context, id uuid.UUID) error {
+	return s.rotationRepo.Delete(ctx, id)
+}
+
+// PlanRotations lists the scheduled rotations due within the given duration, the
+// earliest first. Overdue rotations are included, within 0 lists every user covered
+// by a policy. Users of locked down operators are left out: their rotations wait
+// until the lockdown is lifted. operatorID restricts the plan to an operator unless
+// it is uuid.Nil.
+func (s *UserService) PlanRotations(ctx context.Context, operatorID uuid.UUID, within time.Duration) ([]PlannedRotation, error) {
+	policies, err := s.ListRotationPolicies(ctx, operatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rotation policies: %w", err)
+	}
+
+	var operatorIDs []uuid.UUID
+	byOperator := make(map[uuid.UUID][]*entities.RotationPolicy)
+	for _, p := range policies {
+		if _, ok := byOperator[p.OperatorID]; !ok {
+			operatorIDs = append(operatorIDs, p.OperatorID)
+		}
+		byOperator[p.OperatorID] = append(byOperator[p.OperatorID], p)
+	}
+
+	var plan []PlannedRotation
+	for _, id := range operatorIDs {
+		operator, err := s.operatorRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get operator: %w", err)
+		}
+		if operator.LockedDown() {
+			continue
+		}
+		planned, err := s.planOperatorRotations(ctx, operator, byOperator[id])
+		if err != nil {
+			return nil, err
+		}
+		plan = append(plan, planned...)
+	}
+
+	if within > 0 {
+		horizon := time.Now().Add(within)
+		kept := plan[:0]
+		for _, p := range plan {
+			if !p.DueAt.After(horizon) {
+				kept = append(kept, p)
+			}
+		}
+		plan = kept
+	}
+	sort.SliceStable(plan, func(i, j int) bool { return plan[i].DueAt.Before(plan[j].DueAt) })
+	return plan, nil
+}
+
+// planOperatorRotations plans the rotations of the users of an operator covered by
+// one of its policies. Suspended accounts and the users their account revoked are left
+// out, as they cannot be rotated.
+func (s *UserService) planOperatorRotations(ctx context.Context, operator *entities.Operator, policies []*entities.RotationPolicy) ([]PlannedRotation, error) {
+	accounts, err := s.clusters.listOperatorAccounts(ctx, operator.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts: %w", err)
+	}
+
+	var plan []PlannedRotation
+	for _, account := range accounts {
+		if account.PublicKey == operator.SystemAccountPubKey || account.Suspended() {
+			continue
+		}
+		users, err := s.repo.ListByAccount(ctx, account.ID, repositories.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+		lastRotations, err := s.lastRotations(ctx, account.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			policy := rotationPolicyFor(policies, account, user)
+			if policy == nil {
+				continue
+			}
+			revoked, err := revokedByAccount(user, account)
+			if err != nil {
+				return nil, err
+			}
+			if revoked {
+				continue
+			}
+			lastRotatedAt, ok := lastRotations[user.ID]
+			if !ok {
+				lastRotatedAt = user.CreatedAt
+			}
+			plan = append(plan, PlannedRotation{
+				User:          user,
+				Account:       account,
+				Operator:      operator,
+				Policy:        policy,
+				LastRotatedAt: lastRotatedAt,
+				DueAt:         lastRotatedAt.Add(policy.Interval),
+			})
+		}
+	}
+	return plan, nil
+}
+
+// moveAccountPolicies moves the rotation policies of an account and of its scoped
+// signing keys to the operator the account moved to
+func (s *UserService) moveAccountPolicies(ctx context.Context, accountID, operatorID uuid.UUID) error {
+	return s.rotationRepo.MoveAccount(ctx, accountID, operatorID)
+}
+
+// lastRotations returns when the users of an account last rotated their credentials,
+// from the retired keys of the account. Users never rotated are left out.
+func (s *UserService) lastRotations(ctx context.Context, accountID uuid.UUID) (map[uuid.UUID]time.Time, error) {
+	history, err := s.userKeyRepo.ListByAccount(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user keys: %w", err)
+	}
+	lastRotations := make(map[uuid.UUID]time.Time)
+	for _, key := range history {
+		if _, ok := lastRotations[key.UserID]; !ok {
+			lastRotations[key.UserID] = key.RetiredAt
+		}
+	}
+	return lastRotations, nil
+}
+
+// RotateDueUsers rotates the credentials of the users whose scheduled rotation is
+// due, then notifies that their new credentials are available. A failed rotation is
+// logged and retried on the next run. It returns how many users were rotated.
+func (s *UserService) RotateDueUsers(ctx context.Context) (int, error) {
+	plan, err := s.PlanRotations(ctx, uuid.Nil, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	rotated := 0
+	for _, p := range plan {
+		if p.DueAt.After(now) {
+			break
+		}
+		rotation, err := s.RotateUserCredentials(ctx, p.User.ID, p.Policy.GracePeriod)
+		if err != nil {
+			logging.LogFromContext(ctx).Error("scheduled credential rotation failed",
+				"user", p.User.Name, "account", p.Account.Name, "policy", p.Policy.ID, "error", err)
+			continue
+		}
+		rotated++
+		s.notifyRotation(ctx, p, rotation)
+	}
+	return rotated, nil
+}
+
+// RunScheduledRotations rotates the users whose scheduled rotation is due every
+// interval until ctx is done, while isLeader reports this instance leads
+func (s *UserService) RunScheduledRotations(ctx context.Context, interval time.Duration, isLeader func() bool) {
+	if interval <= 0 {
+		interval = DefaultRotationScheduleInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !isLeader() {
+				continue
+			}
+			rotated, err := s.RotateDueUsers(ctx)
+			if err != nil {
+				logging.LogFromContext(ctx).Error("scheduled credential rotation error", "error", err)
+			}
+			if rotated > 0 {
+				logging.LogFromContext(ctx).Info("rotated scheduled user credentials", "users", rotated)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// rotationPolicyFor returns the most specific policy covering a user: its scoped
+// signing key's, then its account's, then its operator's
+func rotationPolicyFor(policies []*entities.RotationPolicy, account *entities.Account, user *entities.User) *entities.RotationPolicy {
+	var accountPolicy, operatorPolicy *entities.RotationPolicy
+	for _, p := range policies {
+		switch p.Scope() {
+		case entities.RotationPolicyScopeScopedSigningKey:
+			if user.ScopedSigningKeyID != nil && *p.ScopedSigningKeyID == *user.ScopedSigningKeyID {
+				return p
+			}
+		case entities.RotationPolicyScopeAccount:
+			if *p.AccountID == account.ID {
+				accountPolicy = p
+			}
+		default:
+			operatorPolicy = p
+		}
+	}
+	if accountPolicy != nil {
+		return accountPolicy
+	}
+	return operatorPolicy
+}
+
+// sameUUID reports whether two optional IDs are equal
+func sameUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
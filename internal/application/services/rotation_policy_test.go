@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/persistence/sql"
+)
+
+type RotationPolicyTestSuite struct {
+	serviceSuite
+}
+
+func (s *RotationPolicyTestSuite) TearDownTest() {
+	s.emptyTables("rotation_policies", "user_keys", "users", "scoped_signing_keys", "accounts", "operators")
+}
+
+func TestRotationPolicySuite(t *testing.T) {
+	suite.Run(t, new(RotationPolicyTestSuite))
+}
+
+// recordingNotifier keeps the rotation notifications it is given
+type recordingNotifier struct {
+	notifications []RotationNotification
+}
+
+func (n *recordingNotifier) NotifyRotation(_ context.Context, notification RotationNotification) error {
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func (s *RotationPolicyTestSuite) TestRotationPolicies() {
+	operator := s.createOperator("test-operator")
+	orders := s.createAccount(operator.ID, "orders")
+	billing := s.createAccount(operator.ID, "billing")
+	scopedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, orders.ID, "default")
+	require.NoError(s.T(), err)
+	app, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: orders.ID, Name: "app", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+	worker, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: orders.ID, Name: "worker"})
+	require.NoError(s.T(), err)
+	invoicer, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: billing.ID, Name: "invoicer"})
+	require.NoError(s.T(), err)
+	notifier := &recordingNotifier{}
+	s.userService.SetRotationNotifier(notifier)
+
+	_, err = s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{
+		OperatorID: operator.ID, Interval: 30 * 24 * time.Hour, GracePeriod: time.Hour, CreatedBy: "admin",
+	})
+	require.NoError(s.T(), err)
+	accountPolicy, err := s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{
+		AccountID: &orders.ID, Interval: 7 * 24 * time.Hour,
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), operator.ID, accountPolicy.OperatorID)
+	keyPolicy, err := s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{
+		ScopedSigningKeyID: &scopedKey.ID, Interval: 24 * time.Hour, GracePeriod: time.Hour,
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), entities.RotationPolicyScopeScopedSigningKey, keyPolicy.Scope())
+	assert.Equal(s.T(), orders.ID, *keyPolicy.AccountID)
+
+	// The most specific policy applies, the earliest rotation first
+	plan, err := s.userService.PlanRotations(s.ctx, uuid.Nil, 0)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), plan, 3)
+	assert.Equal(s.T(), app.ID, plan[0].User.ID)
+	assert.Equal(s.T(), keyPolicy.ID, plan[0].Policy.ID)
+	assert.Equal(s.T(), app.CreatedAt.Add(24*time.Hour).Unix(), plan[0].DueAt.Unix())
+	assert.Equal(s.T(), worker.ID, plan[1].User.ID)
+	assert.Equal(s.T(), accountPolicy.ID, plan[1].Policy.ID)
+	assert.Equal(s.T(), invoicer.ID, plan[2].User.ID)
+	assert.Equal(s.T(), entities.RotationPolicyScopeOperator, plan[2].Policy.Scope())
+
+	plan, err = s.userService.PlanRotations(s.ctx, operator.ID, 48*time.Hour)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), plan, 1)
+	assert.Equal(s.T(), app.ID, plan[0].User.ID)
+
+	rotated, err := s.userService.RotateDueUsers(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 0, rotated)
+
+	// Make the current key of app two days old
+	require.NoError(s.T(), sql.NewUserKeyRepo(s.db).Create(s.ctx, &entities.UserKey{
+		ID:        uuid.New(),
+		UserID:    app.ID,
+		AccountID: orders.ID,
+		PublicKey: "UOLDERKEY",
+		CreatedAt: time.Now().Add(-72 * time.Hour),
+		RetiredAt: time.Now().Add(-48 * time.Hour),
+		RevokeAt:  time.Now().Add(-47 * time.Hour),
+	}))
+	plan, err = s.userService.PlanRotations(s.ctx, operator.ID, time.Nanosecond)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), plan, 1)
+
+	rotated, err = s.userService.RotateDueUsers(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, rotated)
+	require.Len(s.T(), notifier.notifications, 1)
+	notification := notifier.notifications[0]
+	assert.Equal(s.T(), "orders", notification.Account)
+	assert.Equal(s.T(), "app", notification.User)
+	assert.Equal(s.T(), app.PublicKey, notification.RetiredKey)
+	assert.Equal(s.T(), keyPolicy.ID.String(), notification.PolicyID)
+
+	rotatedApp, err := s.userRepo.GetByID(s.ctx, app.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), notification.PublicKey, rotatedApp.PublicKey)
+	keys, err := s.userService.ListUserKeys(s.ctx, app.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), keys, 2)
+	assert.Equal(s.T(), app.PublicKey, keys[0].PublicKey)
+	assert.Equal(s.T(), notification.RevokeAt.Unix(), keys[0].RetiredAt.Add(time.Hour).Unix())
+
+	// The next rotation is a full interval away
+	plan, err = s.userService.PlanRotations(s.ctx, operator.ID, 0)
+	require.NoError(s.T(), err)
+	for _, p := range plan {
+		assert.True(s.T(), p.DueAt.After(time.Now().Add(23*time.Hour)), p.User.Name)
+	}
+
+	// A locked down operator is not rotated
+	_, err = s.operatorService.LockdownOperator(s.ctx, operator.ID, "admin", "incident")
+	require.NoError(s.T(), err)
+	plan, err = s.userService.PlanRotations(s.ctx, uuid.Nil, 0)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), plan)
+
+	require.NoError(s.T(), s.userService.DeleteRotationPolicy(s.ctx, keyPolicy.ID))
+	policies, err := s.userService.ListRotationPolicies(s.ctx, operator.ID)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), policies, 2)
+}
+
+func (s *RotationPolicyTestSuite) TestRotationPolicies_Invalid() {
+	operator := s.createOperator("test-operator")
+	other := s.createOperator("other-operator")
+	account := s.createAccount(operator.ID, "orders")
+
+	for _, req := range []CreateRotationPolicyRequest{
+		{OperatorID: operator.ID, Interval: time.Minute},
+		{OperatorID: operator.ID, Interval: time.Hour, GracePeriod: time.Hour},
+		{OperatorID: operator.ID, Interval: time.Hour, GracePeriod: -time.Second},
+		{OperatorID: other.ID, AccountID: &account.ID, Interval: time.Hour},
+	} {
+		_, err := s.userService.CreateRotationPolicy(s.ctx, req)
+		assert.ErrorIs(s.T(), err, ErrInvalidRotationPolicy)
+	}
+
+	// A zero grace period revokes the retired keys right away
+	_, err := s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{OperatorID: operator.ID, Interval: time.Hour})
+	require.NoError(s.T(), err)
+
+	sysAccount, err := s.accountService.GetAccountByName(s.ctx, operator.ID, "$SYS")
+	require.NoError(s.T(), err)
+	_, err = s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{AccountID: &sysAccount.ID, Interval: time.Hour})
+	assert.ErrorIs(s.T(), err, ErrInvalidRotationPolicy)
+
+	_, err = s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{AccountID: &account.ID, Interval: time.Hour})
+	require.NoError(s.T(), err)
+	_, err = s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{AccountID: &account.ID, Interval: 2 * time.Hour})
+	assert.ErrorIs(s.T(), err, repositories.ErrAlreadyExists)
+}
+
+// TestPlanRotations_SkipsSuspended tests that suspended accounts and revoked users are not planned
+func (s *RotationPolicyTestSuite) TestPlanRotations_SkipsSuspended() {
+	operator := s.createOperator("test-operator")
+	orders := s.createAccount(operator.ID, "orders")
+	_, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: orders.ID, Name: "app"})
+	require.NoError(s.T(), err)
+	_, err = s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{
+		OperatorID: operator.ID, Interval: 24 * time.Hour,
+	})
+	require.NoError(s.T(), err)
+
+	_, err = s.accountService.SuspendAccount(s.ctx, orders.ID, "admin", "incident", true)
+	require.NoError(s.T(), err)
+	plan, err := s.userService.PlanRotations(s.ctx, uuid.Nil, 0)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), plan)
+
+	// The users revoked by the suspension stay out once the account is resumed
+	_, err = s.accountService.ResumeAccount(s.ctx, orders.ID)
+	require.NoError(s.T(), err)
+	plan, err = s.userService.PlanRotations(s.ctx, uuid.Nil, 0)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), plan)
+}
+
+// TestRotationPolicies_MoveAccount tests that account and scoped key policies follow a moved account
+func (s *RotationPolicyTestSuite) TestRotationPolicies_MoveAccount() {
+	teamA := s.createOperator("team-a")
+	teamB := s.createOperator("team-b")
+	orders := s.createAccount(teamA.ID, "orders")
+	scopedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, orders.ID, "default")
+	require.NoError(s.T(), err)
+	app, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: orders.ID, Name: "app", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+
+	operatorPolicy, err := s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{
+		OperatorID: teamA.ID, Interval: 30 * 24 * time.Hour,
+	})
+	require.NoError(s.T(), err)
+	accountPolicy, err := s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{
+		AccountID: &orders.ID, Interval: 7 * 24 * time.Hour,
+	})
+	require.NoError(s.T(), err)
+	keyPolicy, err := s.userService.CreateRotationPolicy(s.ctx, CreateRotationPolicyRequest{
+		ScopedSigningKeyID: &scopedKey.ID, Interval: 24 * time.Hour,
+	})
+	require.NoError(s.T(), err)
+
+	_, err = s.accountService.MoveAccount(s.ctx, orders.ID, teamB.ID)
+	require.NoError(s.T(), err)
+
+	moved, err := s.userService.ListRotationPolicies(s.ctx, teamB.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), moved, 2)
+	assert.Equal(s.T(), accountPolicy.ID, moved[0].ID)
+	assert.Equal(s.T(), keyPolicy.ID, moved[1].ID)
+	kept, err := s.userService.ListRotationPolicies(s.ctx, teamA.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), kept, 1)
+	assert.Equal(s.T(), operatorPolicy.ID, kept[0].ID)
+
+	plan, err := s.userService.PlanRotations(s.ctx, teamB.ID, 0)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), plan, 1)
+	assert.Equal(s.T(), app.ID, plan[0].User.ID)
+	assert.Equal(s.T(), keyPolicy.ID, plan[0].Policy.ID)
+	assert.Equal(s.T(), teamB.ID, plan[0].Operator.ID)
+}
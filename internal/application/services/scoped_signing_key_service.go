@@ -24,8 +24,10 @@ type ScopedSigningKeyService struct {
 	repo         repositories.ScopedSigningKeyRepository
 	accountRepo  repositories.AccountRepository
 	operatorRepo repositories.OperatorRepository
+	userService  *UserService
 	jwtService   *JWTService
 	encryptor    encryption.Encryptor
+	clusters     *ClusterService
 }
 
 // NewScopedSigningKeyService creates a new scoped signing key service
@@ -33,6 +35,7 @@ func NewScopedSigningKeyService(
 	repo repositories.ScopedSigningKeyRepository,
 	accountRepo repositories.AccountRepository,
 	operatorRepo repositories.OperatorRepository,
+	userService *UserService,
 	jwtService *JWTService,
 	encryptor encryption.Encryptor,
 ) *ScopedSigningKeyService {
@@ -40,11 +43,18 @@ func NewScopedSigningKeyService(
 		repo:         repo,
 		accountRepo:  accountRepo,
 		operatorRepo: operatorRepo,
+		userService:  userService,
 		jwtService:   jwtService,
 		encryptor:    encryptor,
 	}
 }
 
+// SetClusterService gives the scoped signing key rotations access to the clusters they
+// push the re-signed accounts to
+func (s *ScopedSigningKeyService) SetClusterService(clusters *ClusterService) {
+	s.clusters = clusters
+}
+
 // regenerateAccountJWT re-signs the account's JWT to reflect the current set of
 // scoped signing keys. Call this after every Create/Update/Delete on a scoped key
 // so the resolver eventually trusts (or stops trusting) the key as a signer.
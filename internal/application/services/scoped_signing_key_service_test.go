@@ -71,8 +71,9 @@ func (s *ScopedSigningKeyServiceTestSuite) SetupSuite() {
 	s.scopedSigningKeyRepo = sql.NewScopedSigningKeyRepo(s.db)
 
 	s.accountService = NewAccountService(s.accountRepo, s.operatorRepo, s.scopedSigningKeyRepo, s.jwtService, s.encryptor)
-	s.operatorService = NewOperatorService(s.operatorRepo, s.accountRepo, s.userRepo, s.accountService, s.jwtService, s.encryptor)
-	s.scopedKeyService = NewScopedSigningKeyService(s.scopedSigningKeyRepo, s.accountRepo, s.operatorRepo, s.jwtService, s.encryptor)
+	userService := NewUserService(s.userRepo, s.accountRepo, s.operatorRepo, s.scopedSigningKeyRepo, sql.NewUserKeyRepo(s.db), sql.NewRotationPolicyRepo(s.db), s.jwtService, s.encryptor)
+	s.operatorService = NewOperatorService(s.operatorRepo, s.accountRepo, s.userRepo, s.accountService, userService, s.jwtService, s.encryptor)
+	s.scopedKeyService = NewScopedSigningKeyService(s.scopedSigningKeyRepo, s.accountRepo, s.operatorRepo, userService, s.jwtService, s.encryptor)
 }
 
 func (s *ScopedSigningKeyServiceTestSuite) TearDownSuite() {
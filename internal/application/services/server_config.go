@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
+)
+
+// ErrInvalidServerConfig is returned when a server profile does not produce a
+// configuration the NATS server accepts
+var ErrInvalidServerConfig = errors.New("invalid NATS server configuration")
+
+// GenerateServerConfigRequest selects the server a configuration is generated for
+type GenerateServerConfigRequest struct {
+	ServerName string
+	Port       int // Overrides the profile's client port when set
+	HTTPPort   int // Overrides the profile's monitoring port when set
+	// Profile replaces the cluster's stored profile, SaveProfile stores it once the
+	// configuration is valid
+	Profile     *entities.ServerProfile
+	SaveProfile bool
+}
+
+// GenerateServerConfig generates the complete configuration of a server of the
+// cluster from its server profile, checked by the nats-server config parser. The
+// system account is preloaded, the other accounts reach the servers via sync.
+func (s *ClusterService) GenerateServerConfig(ctx context.Context, id uuid.UUID, req GenerateServerConfigRequest) (string, error) {
+	cluster, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if req.Profile != nil {
+		if err := validateServerProfile(req.Profile); err != nil {
+			return "", err
+		}
+		cluster.ServerProfile = req.Profile
+	}
+
+	cfg, err := s.serverConfig(ctx, cluster)
+	if err != nil {
+		return "", err
+	}
+	cfg.ServerName = req.ServerName
+	if req.Port != 0 {
+		cfg.Port = req.Port
+	}
+	if req.HTTPPort != 0 {
+		cfg.HTTPPort = req.HTTPPort
+	}
+	if err := nats.ValidateServerConfig(cfg); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidServerConfig, err)
+	}
+
+	config, err := nats.GenerateServerConfig(cfg)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidServerConfig, err)
+	}
+
+	if req.Profile != nil && req.SaveProfile {
+		cluster.UpdatedAt = time.Now()
+		if err := s.repo.Update(ctx, cluster); err != nil {
+			return "", fmt.Errorf("failed to save server profile: %w", err)
+		}
+	}
+	return config, nil
+}
+
+// serverConfig builds the server configuration of a cluster, with the operator's
+// JWT and the system account preloaded, and gateways to the rest of its supercluster
+func (s *ClusterService) serverConfig(ctx context.Context, cluster *entities.Cluster) (nats.ServerConfig, error) {
+	operator, err := s.operatorRepo.GetByID(ctx, cluster.OperatorID)
+	if err != nil {
+		return nats.ServerConfig{}, fmt.Errorf("failed to get operator: %w", err)
+	}
+	sysAccount, err := s.accountRepo.GetByPublicKey(ctx, cluster.SystemAccountPubKey)
+	if err != nil {
+		return nats.ServerConfig{}, fmt.Errorf("failed to get system account: %w", err)
+	}
+	cfg := nats.ServerConfigForCluster(cluster, operator, []*entities.Account{sysAccount})
+	cfg.Gateways, err = s.gatewayRemotes(ctx, cluster)
+	if err != nil {
+		return nats.ServerConfig{}, err
+	}
+	return cfg, nil
+}
+
+// validateServerProfile rejects the values the template would render into a broken
+// configuration; everything else is left to the nats-server parser
+func validateServerProfile(p *entities.ServerProfile) error {
+	ports := []struct {
+		name string
+		port int
+	}{
+		{"port", p.Port},
+		{"HTTP port", p.HTTPPort},
+		{"cluster port", p.ClusterPort},
+		{"websocket port", p.Websocket.Port},
+		{"MQTT port", p.MQTT.Port},
+		{"leafnode port", p.Leafnode.Port},
+		{"gateway port", p.Gateway.Port},
+	}
+	for _, port := range ports {
+		if port.port < 0 || port.port > 65535 {
+			return fmt.Errorf("%w: %s %d out of range", ErrInvalidServerConfig, port.name, port.port)
+		}
+	}
+	if p.Port == 0 {
+		return fmt.Errorf("%w: port is required", ErrInvalidServerConfig)
+	}
+	if len(p.Routes) > 0 && p.ClusterPort == 0 {
+		return fmt.Errorf("%w: routes require a cluster port", ErrInvalidServerConfig)
+	}
+	if p.TLS.Enabled() != (p.TLS.KeyFile != "") {
+		return fmt.Errorf("%w: TLS requires both a certificate and a key file", ErrInvalidServerConfig)
+	}
+	if p.JetStream.MaxMemory < 0 || p.JetStream.MaxStorage < 0 {
+		return fmt.Errorf("%w: JetStream limits must not be negative", ErrInvalidServerConfig)
+	}
+	if p.Resolver.Interval < 0 {
+		return fmt.Errorf("%w: resolver interval must not be negative", ErrInvalidServerConfig)
+	}
+	return nil
+}
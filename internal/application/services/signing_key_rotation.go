@@ -0,0 +1,337 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nkeys"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+)
+
+// DefaultSigningKeyRetirementInterval is how often the scoped signing keys past their
+// grace period are retired
+const DefaultSigningKeyRetirementInterval = time.Minute
+
+// ErrSigningKeyRotationAborted is returned when a scoped signing key rotation failed
+// part way and was rolled back
+var ErrSigningKeyRotationAborted = errors.New("scoped signing key rotation aborted")
+
+// SigningKeyRotation reports the rotation of a scoped signing key
+type SigningKeyRotation struct {
+	Key *entities.ScopedSigningKey
+	// RetiredPublicKey is the public key the scoped signing key held before the rotation
+	RetiredPublicKey string
+	// RetireAt is when the account JWT stops trusting the retired key, the rotation
+	// time without grace period
+	RetireAt time.Time
+	// ReissuedUsers is how many of the users signed by the key were re-signed
+	ReissuedUsers int
+	// RevokedUsers is how many of the users signed by the key were left revoked by
+	// their account
+	RevokedUsers int
+	// Pushed lists the clusters the account JWT trusting the new key was pushed to. A
+	// cluster that could not be reached is given the account by its next sync.
+	Pushed []AccountPush
+}
+
+// SigningKeyRotationProgress reports how far the re-signing of the users signed by a
+// rotated scoped signing key went
+type SigningKeyRotationProgress struct {
+	ReissuedUsers int
+	RevokedUsers  int
+	Users         int
+}
+
+// signingKeyRotationProgressStep is how many users are re-signed between two progress
+// reports of a scoped signing key rotation
+const signingKeyRotationProgressStep = 100
+
+// RotateScopedSigningKey gives a scoped signing key a new key pair, keeping its ID,
+// name and template. The account JWT trusting the new key is pushed to the clusters,
+// then every user signed by the key is given a JWT signed by the new key. The retired
+// key stays trusted with the same template for the grace period, so clients can be
+// given their new credentials; with no grace period, users still holding a JWT signed
+// by it are rejected right away. Users revoked by their account stay revoked. The keys
+// of a locked down operator cannot be rotated.
+//
+// progress, when not nil, is called as the users are re-signed and once they all are.
+//
+// A cluster that cannot be reached does not stop the rotation: it is reported in
+// Pushed, and its next sync pushes the account, as for MoveAccount or LockdownOperator.
+// When another step fails, the key, the account and the re-signed users are restored
+// and the account is pushed again. The error tells which step failed and how far the
+// rotation went.
+func (s *ScopedSigningKeyService) RotateScopedSigningKey(ctx context.Context, keyID uuid.UUID, grace time.Duration, progress func(SigningKeyRotationProgress)) (*SigningKeyRotation, error) {
+	if grace < 0 {
+		return nil, fmt.Errorf("%w: the grace period cannot be negative", ErrInvalidRotation)
+	}
+	key, err := s.repo.GetByID(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOperatorLockdown(ctx, s.accountRepo, s.operatorRepo, key.AccountID); err != nil {
+		return nil, err
+	}
+	account, err := s.accountRepo.GetByID(ctx, key.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+	operator, err := s.operatorRepo.GetByID(ctx, account.OperatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator: %w", err)
+	}
+	users, err := s.userService.ListUsersByScopedKey(ctx, key.ID, repositories.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+
+	seed, publicKey, err := GenerateNKey(nkeys.PrefixByteAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	encryptedSeed, err := s.encryptor.Encrypt(ctx, seed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt signing key seed: %w", err)
+	}
+
+	previous := *key
+	previous.RetiredKeys = maps.Clone(key.RetiredKeys)
+
+	now := time.Now()
+	retired := make(map[string]time.Time, len(key.RetiredKeys)+1)
+	for retiredKey, retireAt := range key.RetiredKeys {
+		if retireAt.After(now) {
+			retired[retiredKey] = retireAt
+		}
+	}
+	if grace > 0 {
+		retired[key.PublicKey] = now.Add(grace)
+	}
+	if len(retired) == 0 {
+		retired = nil
+	}
+
+	result := &SigningKeyRotation{
+		Key:              key,
+		RetiredPublicKey: key.PublicKey,
+		RetireAt:         now.Add(grace),
+	}
+	key.PublicKey = publicKey
+	key.EncryptedSeed = encryptedSeed
+	key.RetiredKeys = retired
+	key.UpdatedAt = now
+	if err := s.repo.Update(ctx, key); err != nil {
+		return nil, fmt.Errorf("failed to update scoped signing key: %w", err)
+	}
+
+	log := logging.LogFromContext(ctx).With("scoped_key", key.Name, "account", account.Name)
+	log.Info("rotating scoped signing key", "retired_key", result.RetiredPublicKey, "new_key", publicKey,
+		"users", len(users), "retire_at", result.RetireAt)
+
+	var reissued []*entities.User
+	var previousJWTs []string
+	abort := func(step string, err error) (*SigningKeyRotation, error) {
+		s.rollbackSigningKeyRotation(ctx, &previous, account, operator, reissued, previousJWTs, result.Pushed != nil)
+		pushed := 0
+		for _, push := range result.Pushed {
+			if push.Error == "" {
+				pushed++
+			}
+		}
+		return nil, fmt.Errorf("%w: %s failed after pushing to %d/%d clusters and re-signing %d/%d users, rolled back: %w",
+			ErrSigningKeyRotationAborted, step, pushed, len(result.Pushed), result.ReissuedUsers, len(users), err)
+	}
+
+	if err := s.clusters.resignAccount(ctx, account, operator); err != nil {
+		return abort("signing the account", err)
+	}
+	result.Pushed = s.clusters.pushAccountToClusters(ctx, account)
+	for _, push := range result.Pushed {
+		if push.Error != "" {
+			log.Warn("failed to push account trusting the new signing key, the next sync retries",
+				"cluster", push.ClusterName, "error", push.Error)
+		}
+	}
+	log.Info("pushed account trusting the new signing key", "clusters", len(result.Pushed))
+
+	for i, user := range users {
+		previousJWT := user.JWT
+		resigned, err := s.userService.resignUser(ctx, user, account, key)
+		if err != nil {
+			return abort(fmt.Sprintf("re-signing user %s", user.Name), err)
+		}
+		if resigned {
+			reissued = append(reissued, user)
+			previousJWTs = append(previousJWTs, previousJWT)
+			result.ReissuedUsers++
+		} else {
+			result.RevokedUsers++
+		}
+		if progress != nil && ((i+1)%signingKeyRotationProgressStep == 0 || i+1 == len(users)) {
+			progress(SigningKeyRotationProgress{
+				ReissuedUsers: result.ReissuedUsers,
+				RevokedUsers:  result.RevokedUsers,
+				Users:         len(users),
+			})
+		}
+	}
+
+	log.Info("rotated scoped signing key", "retired_key", result.RetiredPublicKey, "new_key", publicKey,
+		"users", result.ReissuedUsers, "revoked_users", result.RevokedUsers, "retire_at", result.RetireAt)
+	return result, nil
+}
+
+// rollbackSigningKeyRotation restores a scoped signing key, the JWTs of the users
+// re-signed with its new key and the account trusting the previous key, then pushes
+// the account again when it was pushed. Rolling back is best effort: failures are
+// logged and the next sync of the clusters pushes the restored account.
+func (s *ScopedSigningKeyService) rollbackSigningKeyRotation(ctx context.Context, previous *entities.ScopedSigningKey, account *entities.Account, operator *entities.Operator, users []*entities.User, previousJWTs []string, pushed bool) {
+	log := logging.LogFromContext(ctx).With("scoped_key", previous.Name, "account", account.Name)
+
+	for i, user := range users {
+		if err := s.userService.restoreUserJWT(ctx, user, previousJWTs[i]); err != nil {
+			log.Error("failed to restore user JWT after scoped signing key rotation failure", "user", user.Name, "error", err)
+		}
+	}
+	previous.UpdatedAt = time.Now()
+	if err := s.repo.Update(ctx, previous); err != nil {
+		log.Error("failed to restore scoped signing key after rotation failure", "error", err)
+		return
+	}
+	if err := s.clusters.resignAccount(ctx, account, operator); err != nil {
+		log.Error("failed to restore account JWT after scoped signing key rotation failure", "error", err)
+		return
+	}
+	if pushed {
+		for _, push := range s.clusters.pushAccountToClusters(ctx, account) {
+			if push.Error != "" {
+				log.Warn("failed to push restored account, the next sync retries",
+					"cluster", push.ClusterName, "error", push.Error)
+			}
+		}
+	}
+	log.Warn("rolled back scoped signing key rotation", "restored_users", len(users))
+}
+
+// RetireExpiredSigningKeys stops trusting the scoped signing keys replaced by a
+// rotation whose grace period ended. The accounts holding them are re-signed and
+// pushed to their clusters. It returns how many keys were retired.
+func (s *ScopedSigningKeyService) RetireExpiredSigningKeys(ctx context.Context) (int, error) {
+	keys, err := s.repo.ListWithRetiredKeys(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now()
+	retired := 0
+	var accountIDs []uuid.UUID
+	seen := make(map[uuid.UUID]bool)
+	for _, key := range keys {
+		expired := 0
+		for publicKey, retireAt := range key.RetiredKeys {
+			if !retireAt.After(now) {
+				delete(key.RetiredKeys, publicKey)
+				expired++
+			}
+		}
+		if expired == 0 {
+			continue
+		}
+		if len(key.RetiredKeys) == 0 {
+			key.RetiredKeys = nil
+		}
+		key.UpdatedAt = now
+		if err := s.repo.Update(ctx, key); err != nil {
+			return retired, fmt.Errorf("failed to update scoped signing key: %w", err)
+		}
+		retired += expired
+		if !seen[key.AccountID] {
+			seen[key.AccountID] = true
+			accountIDs = append(accountIDs, key.AccountID)
+		}
+	}
+
+	for _, accountID := range accountIDs {
+		account, err := s.accountRepo.GetByID(ctx, accountID)
+		if err != nil {
+			return retired, fmt.Errorf("failed to get account: %w", err)
+		}
+		operator, err := s.operatorRepo.GetByID(ctx, account.OperatorID)
+		if err != nil {
+			return retired, fmt.Errorf("failed to get operator: %w", err)
+		}
+		if err := s.clusters.resignAccount(ctx, account, operator); err != nil {
+			return retired, err
+		}
+		for _, push := range s.clusters.pushAccountToClusters(ctx, account) {
+			if push.Error != "" {
+				logging.LogFromContext(ctx).Warn("failed to push retired signing keys, the next sync retries",
+					"account", account.Name, "cluster", push.ClusterName, "error", push.Error)
+			}
+		}
+	}
+	return retired, nil
+}
+
+// RunSigningKeyRetirements retires the scoped signing keys past their grace period
+// every interval until ctx is done, while isLeader reports this instance leads
+func (s *ScopedSigningKeyService) RunSigningKeyRetirements(ctx context.Context, interval time.Duration, isLeader func() bool) {
+	if interval <= 0 {
+		interval = DefaultSigningKeyRetirementInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !isLeader() {
+				continue
+			}
+			retired, err := s.RetireExpiredSigningKeys(ctx)
+			if err != nil {
+				logging.LogFromContext(ctx).Error("signing key retirement error", "error", err)
+			}
+			if retired > 0 {
+				logging.LogFromContext(ctx).Info("retired rotated scoped signing keys", "keys", retired)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// resignUser gives a user a JWT signed by the current key pair of its scoped signing
+// key. A user revoked by its account is left revoked: resignUser returns false without
+// changing it.
+func (s *UserService) resignUser(ctx context.Context, user *entities.User, account *entities.Account, key *entities.ScopedSigningKey) (bool, error) {
+	revoked, err := revokedByAccount(user, account)
+	if err != nil || revoked {
+		return false, err
+	}
+	userJWT, err := s.jwtService.GenerateUserJWT(ctx, user, account, key)
+	if err != nil {
+		return false, fmt.Errorf("failed to regenerate JWT of user %s: %w", user.Name, err)
+	}
+	previousJWT := user.JWT
+	user.JWT = userJWT
+	user.UpdatedAt = time.Now()
+	if err := s.repo.Update(ctx, user); err != nil {
+		user.JWT = previousJWT
+		return false, fmt.Errorf("failed to update user %s: %w", user.Name, err)
+	}
+	return true, nil
+}
+
+// restoreUserJWT gives a user back the JWT replaced by resignUser
+func (s *UserService) restoreUserJWT(ctx context.Context, user *entities.User, userJWT string) error {
+	user.JWT = userJWT
+	user.UpdatedAt = time.Now()
+	return s.repo.Update(ctx, user)
+}
@@ -0,0 +1,236 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/jwt/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+)
+
+type SigningKeyRotationTestSuite struct {
+	serviceSuite
+}
+
+func (s *SigningKeyRotationTestSuite) TearDownTest() {
+	s.emptyTables("users", "scoped_signing_keys", "accounts", "clusters", "operators")
+}
+
+func TestSigningKeyRotationSuite(t *testing.T) {
+	suite.Run(t, new(SigningKeyRotationTestSuite))
+}
+
+// signingKeyTrusted reports whether an account JWT declares a public key as a scoped signer
+func (s *SigningKeyRotationTestSuite) signingKeyTrusted(accountID uuid.UUID, publicKey string) bool {
+	account, err := s.accountRepo.GetByID(s.ctx, accountID)
+	require.NoError(s.T(), err)
+	claims, err := jwt.DecodeAccountClaims(account.JWT)
+	require.NoError(s.T(), err)
+	scope, ok := claims.SigningKeys[publicKey]
+	return ok && scope != nil
+}
+
+func (s *SigningKeyRotationTestSuite) TestRotateScopedSigningKey() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+	scopedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, account.ID, "default")
+	require.NoError(s.T(), err)
+	app, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+	worker, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "worker", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+	direct, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "direct"})
+	require.NoError(s.T(), err)
+
+	var progress []SigningKeyRotationProgress
+	rotation, err := s.scopedKeyService.RotateScopedSigningKey(s.ctx, scopedKey.ID, time.Hour, func(p SigningKeyRotationProgress) {
+		progress = append(progress, p)
+	})
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []SigningKeyRotationProgress{{ReissuedUsers: 2, Users: 2}}, progress)
+	assert.Equal(s.T(), scopedKey.ID, rotation.Key.ID)
+	assert.Equal(s.T(), "default", rotation.Key.Name)
+	assert.Equal(s.T(), scopedKey.PubAllow, rotation.Key.PubAllow)
+	assert.NotEqual(s.T(), scopedKey.PublicKey, rotation.Key.PublicKey)
+	assert.Equal(s.T(), scopedKey.PublicKey, rotation.RetiredPublicKey)
+	assert.Equal(s.T(), 2, rotation.ReissuedUsers)
+	assert.Empty(s.T(), rotation.Pushed)
+
+	stored, err := s.scopedSigningKeyRepo.GetByID(s.ctx, scopedKey.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), rotation.Key.PublicKey, stored.PublicKey)
+	require.Contains(s.T(), stored.RetiredKeys, scopedKey.PublicKey)
+	assert.WithinDuration(s.T(), rotation.RetireAt, stored.RetiredKeys[scopedKey.PublicKey], time.Second)
+
+	// The users signed by the key are re-signed by the new key, the others are left alone
+	for _, user := range []*entities.User{app, worker} {
+		current, err := s.userRepo.GetByID(s.ctx, user.ID)
+		require.NoError(s.T(), err)
+		claims, err := jwt.DecodeUserClaims(current.JWT)
+		require.NoError(s.T(), err)
+		assert.Equal(s.T(), rotation.Key.PublicKey, claims.Issuer)
+		assert.Equal(s.T(), user.PublicKey, current.PublicKey)
+	}
+	current, err := s.userRepo.GetByID(s.ctx, direct.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), direct.JWT, current.JWT)
+
+	// Within the grace period, the account trusts both keys
+	assert.True(s.T(), s.signingKeyTrusted(account.ID, rotation.Key.PublicKey))
+	assert.True(s.T(), s.signingKeyTrusted(account.ID, scopedKey.PublicKey))
+	retired, err := s.scopedKeyService.RetireExpiredSigningKeys(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 0, retired)
+
+	// A second rotation with a short grace period keeps both retired keys until it ends
+	second, err := s.scopedKeyService.RotateScopedSigningKey(s.ctx, scopedKey.ID, time.Millisecond, nil)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), second.Key.RetiredKeys, 2)
+	time.Sleep(5 * time.Millisecond)
+	retired, err = s.scopedKeyService.RetireExpiredSigningKeys(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, retired)
+
+	stored, err = s.scopedSigningKeyRepo.GetByID(s.ctx, scopedKey.ID)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), stored.RetiredKeys, 1)
+	assert.True(s.T(), s.signingKeyTrusted(account.ID, second.Key.PublicKey))
+	assert.True(s.T(), s.signingKeyTrusted(account.ID, scopedKey.PublicKey))
+	assert.False(s.T(), s.signingKeyTrusted(account.ID, rotation.Key.PublicKey))
+
+	// Without a grace period, the account stops trusting the key right away
+	third, err := s.scopedKeyService.RotateScopedSigningKey(s.ctx, scopedKey.ID, 0, nil)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), third.Key.RetiredKeys, 1)
+	assert.True(s.T(), s.signingKeyTrusted(account.ID, third.Key.PublicKey))
+	assert.False(s.T(), s.signingKeyTrusted(account.ID, second.Key.PublicKey))
+}
+
+func (s *SigningKeyRotationTestSuite) TestRotateScopedSigningKey_UnreachableCluster() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+	scopedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, account.ID, "default")
+	require.NoError(s.T(), err)
+	user, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+
+	// The account cannot be pushed to a cluster without system account credentials
+	require.NoError(s.T(), s.clusterRepo.Create(s.ctx, &entities.Cluster{
+		ID:         uuid.New(),
+		Name:       "east",
+		ServerURLs: []string{"nats://127.0.0.1:4222"},
+		OperatorID: operator.ID,
+	}))
+
+	// The rotation is kept, the cluster is left to its next sync
+	rotation, err := s.scopedKeyService.RotateScopedSigningKey(s.ctx, scopedKey.ID, time.Hour, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), rotation.Pushed, 1)
+	assert.Equal(s.T(), "east", rotation.Pushed[0].ClusterName)
+	assert.NotEmpty(s.T(), rotation.Pushed[0].Error)
+	assert.Equal(s.T(), 1, rotation.ReissuedUsers)
+
+	stored, err := s.scopedSigningKeyRepo.GetByID(s.ctx, scopedKey.ID)
+	require.NoError(s.T(), err)
+	assert.NotEqual(s.T(), scopedKey.PublicKey, stored.PublicKey)
+	current, err := s.userRepo.GetByID(s.ctx, user.ID)
+	require.NoError(s.T(), err)
+	claims, err := jwt.DecodeUserClaims(current.JWT)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), stored.PublicKey, claims.Issuer)
+}
+
+func (s *SigningKeyRotationTestSuite) TestRotateScopedSigningKey_Rollback() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+	scopedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, account.ID, "default")
+	require.NoError(s.T(), err)
+	user, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+	broken, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "broken", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+	before, err := s.accountRepo.GetByID(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+
+	// A user JWT that cannot be decoded cannot be checked against the account revocation
+	broken.JWT = "not-a-jwt"
+	require.NoError(s.T(), s.userRepo.Update(s.ctx, broken))
+	revokedAt := time.Now().Add(-time.Hour)
+	before.UsersRevokedAt = &revokedAt
+	require.NoError(s.T(), s.accountRepo.Update(s.ctx, before))
+
+	_, err = s.scopedKeyService.RotateScopedSigningKey(s.ctx, scopedKey.ID, time.Hour, nil)
+	require.ErrorIs(s.T(), err, ErrSigningKeyRotationAborted)
+	assert.Contains(s.T(), err.Error(), "re-signing user broken")
+
+	stored, err := s.scopedSigningKeyRepo.GetByID(s.ctx, scopedKey.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), scopedKey.PublicKey, stored.PublicKey)
+	assert.Equal(s.T(), scopedKey.EncryptedSeed, stored.EncryptedSeed)
+	assert.Empty(s.T(), stored.RetiredKeys)
+
+	current, err := s.userRepo.GetByID(s.ctx, user.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), user.JWT, current.JWT)
+
+	after, err := s.accountRepo.GetByID(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	beforeClaims, err := jwt.DecodeAccountClaims(before.JWT)
+	require.NoError(s.T(), err)
+	afterClaims, err := jwt.DecodeAccountClaims(after.JWT)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), beforeClaims.SigningKeys.Keys(), afterClaims.SigningKeys.Keys())
+}
+
+func (s *SigningKeyRotationTestSuite) TestRotateScopedSigningKey_Invalid() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+	scopedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, account.ID, "default")
+	require.NoError(s.T(), err)
+
+	_, err = s.scopedKeyService.RotateScopedSigningKey(s.ctx, scopedKey.ID, -time.Hour, nil)
+	assert.ErrorIs(s.T(), err, ErrInvalidRotation)
+	_, err = s.scopedKeyService.RotateScopedSigningKey(s.ctx, uuid.New(), time.Hour, nil)
+	assert.ErrorIs(s.T(), err, repositories.ErrNotFound)
+
+	_, err = s.operatorService.LockdownOperator(s.ctx, operator.ID, "admin", "incident")
+	require.NoError(s.T(), err)
+	_, err = s.scopedKeyService.RotateScopedSigningKey(s.ctx, scopedKey.ID, time.Hour, nil)
+	assert.ErrorIs(s.T(), err, ErrOperatorLockedDown)
+}
+
+func (s *SigningKeyRotationTestSuite) TestRotateScopedSigningKey_KeepsRevokedUsers() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+	scopedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, account.ID, "default")
+	require.NoError(s.T(), err)
+	revoked, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "revoked", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+	_, err = s.accountService.SuspendAccount(s.ctx, account.ID, "admin", "incident", true)
+	require.NoError(s.T(), err)
+	_, err = s.accountService.ResumeAccount(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	// User JWTs are issued with a one second precision
+	time.Sleep(time.Second)
+	fresh, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "fresh", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+
+	rotation, err := s.scopedKeyService.RotateScopedSigningKey(s.ctx, scopedKey.ID, time.Hour, nil)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, rotation.ReissuedUsers)
+	assert.Equal(s.T(), 1, rotation.RevokedUsers)
+
+	current, err := s.userRepo.GetByID(s.ctx, revoked.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), revoked.JWT, current.JWT)
+	current, err = s.userRepo.GetByID(s.ctx, fresh.ID)
+	require.NoError(s.T(), err)
+	claims, err := jwt.DecodeUserClaims(current.JWT)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), rotation.Key.PublicKey, claims.Issuer)
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/pressly/goose/v3"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/thomas-maurice/nis/internal/config"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/infrastructure/encryption"
+	"github.com/thomas-maurice/nis/internal/infrastructure/persistence/sql"
+	"github.com/thomas-maurice/nis/migrations"
+	"gorm.io/gorm"
+)
+
+// serviceSuite wires every service on an in-memory database, for the suites testing
+// features that span several services. Each suite empties the tables it writes to.
+type serviceSuite struct {
+	suite.Suite
+	ctx                  context.Context
+	db                   *gorm.DB
+	encryptor            encryption.Encryptor
+	jwtService           *JWTService
+	operatorRepo         repositories.OperatorRepository
+	accountRepo          repositories.AccountRepository
+	userRepo             repositories.UserRepository
+	scopedSigningKeyRepo repositories.ScopedSigningKeyRepository
+	clusterRepo          repositories.ClusterRepository
+	accountService       *AccountService
+	operatorService      *OperatorService
+	userService          *UserService
+	scopedKeyService     *ScopedSigningKeyService
+	clusterService       *ClusterService
+}
+
+func (s *serviceSuite) SetupSuite() {
+	s.ctx = context.Background()
+
+	db, err := sql.NewDB(config.DatabaseConfig{
+		Driver: "sqlite",
+		Path:   ":memory:",
+	})
+	require.NoError(s.T(), err)
+	s.db = db
+
+	sqlDB, err := db.DB()
+	require.NoError(s.T(), err)
+	goose.SetBaseFS(migrations.Migrations)
+	require.NoError(s.T(), goose.SetDialect("sqlite3"))
+	require.NoError(s.T(), goose.Up(sqlDB, "."))
+
+	s.encryptor, err = encryption.NewChaChaEncryptor(map[string]string{
+		"test-key": "Lj9yxga5k/zCwSw76UUklT8Jkzgu7ChfY3zUEH8iBM8=",
+	}, "test-key")
+	require.NoError(s.T(), err)
+
+	s.jwtService = NewJWTService(s.encryptor)
+	s.operatorRepo = sql.NewOperatorRepo(s.db)
+	s.accountRepo = sql.NewAccountRepo(s.db)
+	s.userRepo = sql.NewUserRepo(s.db)
+	s.scopedSigningKeyRepo = sql.NewScopedSigningKeyRepo(s.db)
+	s.clusterRepo = sql.NewClusterRepo(s.db)
+
+	userKeyRepo := sql.NewUserKeyRepo(s.db)
+	s.clusterService = NewClusterService(ClusterRepositories{
+		Clusters:     s.clusterRepo,
+		Servers:      sql.NewClusterServerRepo(s.db),
+		HealthChecks: sql.NewClusterHealthCheckRepo(s.db),
+		AuthFailures: sql.NewAuthFailureRepo(s.db),
+		AccountStats: sql.NewAccountStatsRepo(s.db),
+		Leafnodes:    sql.NewLeafnodeProfileRepo(s.db),
+		Placements:   sql.NewAccountPlacementRepo(s.db),
+	}, s.operatorRepo, s.accountRepo, s.userRepo, userKeyRepo, s.scopedSigningKeyRepo, s.encryptor, s.jwtService)
+	s.accountService = NewAccountService(s.accountRepo, s.operatorRepo, s.scopedSigningKeyRepo, s.jwtService, s.encryptor)
+	s.accountService.SetClusterService(s.clusterService)
+	s.userService = NewUserService(s.userRepo, s.accountRepo, s.operatorRepo, s.scopedSigningKeyRepo, userKeyRepo, sql.NewRotationPolicyRepo(s.db), s.jwtService, s.encryptor)
+	s.userService.SetClusterService(s.clusterService)
+	s.accountService.SetUserService(s.userService)
+	s.operatorService = NewOperatorService(s.operatorRepo, s.accountRepo, s.userRepo, s.accountService, s.userService, s.jwtService, s.encryptor)
+	s.operatorService.SetClusterService(s.clusterService)
+	s.scopedKeyService = NewScopedSigningKeyService(s.scopedSigningKeyRepo, s.accountRepo, s.operatorRepo, s.userService, s.jwtService, s.encryptor)
+	s.scopedKeyService.SetClusterService(s.clusterService)
+}
+
+func (s *serviceSuite) TearDownSuite() {
+	_ = sql.Close(s.db)
+}
+
+// emptyTables deletes every row of the given tables, children first
+func (s *serviceSuite) emptyTables(tables ...string) {
+	for _, table := range tables {
+		s.db.Exec("DELETE FROM " + table)
+	}
+}
+
+// createOperator creates an operator with its system account
+func (s *serviceSuite) createOperator(name string) *entities.Operator {
+	operator, err := s.operatorService.CreateOperator(s.ctx, CreateOperatorRequest{Name: name})
+	require.NoError(s.T(), err)
+	return operator
+}
+
+// createAccount creates an account of an operator with its default scoped signing key
+func (s *serviceSuite) createAccount(operatorID uuid.UUID, name string) *entities.Account {
+	account, err := s.accountService.CreateAccount(s.ctx, CreateAccountRequest{OperatorID: operatorID, Name: name})
+	require.NoError(s.T(), err)
+	return account
+}
@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+	"github.com/thomas-maurice/nis/internal/infrastructure/nats"
+)
+
+// ErrInvalidGateway is returned when a cluster cannot join a supercluster as requested
+var ErrInvalidGateway = errors.New("invalid gateway configuration")
+
+// gatewayURLSchemes are the URL schemes nats-server accepts for gateway URLs
+var gatewayURLSchemes = map[string]bool{
+	"nats": true,
+	"tls":  true,
+}
+
+// PeerSyncStatus reports whether the accounts pushed through one cluster of a
+// supercluster reached another member, checked through the member's own connection
+type PeerSyncStatus struct {
+	ClusterID   uuid.UUID
+	ClusterName string
+	Servers     int      // Servers of the member that answered the account listing
+	Verified    int      // Pushed accounts held by all of them
+	Missing     []string // Names of the pushed accounts missing on at least one of them
+	Error       string   // Set when the member could not be checked
+}
+
+// SetClusterGateway makes a cluster join a supercluster, replacing its gateway URLs.
+// The other clusters dial gatewayURLs, derived from the server URLs and the gateway
+// port of the server profile when empty. An empty supercluster leaves it.
+func (s *ClusterService) SetClusterGateway(ctx context.Context, id uuid.UUID, supercluster string, gatewayURLs []string) (*entities.Cluster, error) {
+	cluster, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	supercluster = strings.TrimSpace(supercluster)
+	if supercluster == "" && len(gatewayURLs) > 0 {
+		return nil, fmt.Errorf("%w: gateway URLs require a supercluster", ErrInvalidGateway)
+	}
+	if strings.ContainsAny(supercluster, " /") {
+		return nil, fmt.Errorf("%w: supercluster name must not contain spaces or slashes", ErrInvalidGateway)
+	}
+	for _, u := range gatewayURLs {
+		if err := validateGatewayURL(u); err != nil {
+			return nil, err
+		}
+	}
+
+	cluster.Supercluster = supercluster
+	cluster.GatewayURLs = gatewayURLs
+	// The other members must be able to reach the cluster's gateways
+	if supercluster != "" {
+		if _, err := clusterGatewayURLs(cluster); err != nil {
+			return nil, err
+		}
+	}
+	cluster.UpdatedAt = time.Now()
+	if err := s.repo.Update(ctx, cluster); err != nil {
+		return nil, err
+	}
+
+	return cluster, nil
+}
+
+// superclusterPeers returns the other clusters of the cluster's supercluster
+func (s *ClusterService) superclusterPeers(ctx context.Context, cluster *entities.Cluster) ([]*entities.Cluster, error) {
+	if cluster.Supercluster == "" {
+		return nil, nil
+	}
+
+	members, err := s.repo.ListBySupercluster(ctx, cluster.OperatorID, cluster.Supercluster)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*entities.Cluster, 0, len(members))
+	for _, member := range members {
+		if member.ID != cluster.ID {
+			peers = append(peers, member)
+		}
+	}
+	return peers, nil
+}
+
+// gatewayRemotes builds the gateways a server of the cluster dials, one per other
+// cluster of its supercluster
+func (s *ClusterService) gatewayRemotes(ctx context.Context, cluster *entities.Cluster) ([]nats.GatewayRemote, error) {
+	if cluster.Supercluster == "" {
+		return nil, nil
+	}
+	if cluster.ServerProfile == nil || cluster.ServerProfile.Gateway.Port == 0 {
+		return nil, fmt.Errorf("%w: cluster %s joins supercluster %s without a gateway port",
+			ErrInvalidServerConfig, cluster.Name, cluster.Supercluster)
+	}
+
+	peers, err := s.superclusterPeers(ctx, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list supercluster clusters: %w", err)
+	}
+
+	remotes := make([]nats.GatewayRemote, 0, len(peers))
+	for _, peer := range peers {
+		urls, err := clusterGatewayURLs(peer)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidServerConfig, err)
+		}
+		remotes = append(remotes, nats.GatewayRemote{Name: peer.Name, URLs: urls})
+	}
+	return remotes, nil
+}
+
+// clusterGatewayURLs returns the URLs the other clusters dial to reach the cluster's
+// gateways: the configured ones, or its server hosts on the profile's gateway port
+func clusterGatewayURLs(cluster *entities.Cluster) ([]string, error) {
+	if len(cluster.GatewayURLs) > 0 {
+		return cluster.GatewayURLs, nil
+	}
+	if cluster.ServerProfile == nil || cluster.ServerProfile.Gateway.Port == 0 {
+		return nil, fmt.Errorf("%w: cluster %s has neither gateway URLs nor a gateway port in its server profile",
+			ErrInvalidGateway, cluster.Name)
+	}
+	urls, err := nats.GatewayURLs(cluster.ServerURLs, cluster.ServerProfile.Gateway.Port)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGateway, err)
+	}
+	return urls, nil
+}
+
+// validateGatewayURL rejects URLs nats-server cannot dial a gateway on
+func validateGatewayURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" || u.Port() == "" {
+		return fmt.Errorf("%w: invalid URL %q", ErrInvalidGateway, raw)
+	}
+	if !gatewayURLSchemes[u.Scheme] {
+		return fmt.Errorf("%w: unsupported URL scheme %q in %q", ErrInvalidGateway, u.Scheme, raw)
+	}
+	return nil
+}
+
+// verifySuperclusterPeers checks that the accounts pushed through a cluster reached
+// the other clusters of its supercluster over the gateways. Each member is queried
+// through its own connection, so a broken gateway shows up as missing accounts.
+func (s *ClusterService) verifySuperclusterPeers(ctx context.Context, cluster *entities.Cluster, pushed []*entities.Account) ([]PeerSyncStatus, error) {
+	peers, err := s.superclusterPeers(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]PeerSyncStatus, 0, len(peers))
+	for _, peer := range peers {
+		natsClient, _, err := s.clusterClient(ctx, peer.ID)
+		if err != nil {
+			statuses = append(statuses, PeerSyncStatus{ClusterID: peer.ID, ClusterName: peer.Name, Error: err.Error()})
+			continue
+		}
+
+		servers, err := natsClient.PingServers(ctx)
+		if err != nil {
+			statuses = append(statuses, PeerSyncStatus{ClusterID: peer.ID, ClusterName: peer.Name, Error: err.Error()})
+			continue
+		}
+		lists, err := natsClient.ListAccountsByServer(ctx, len(servers))
+		if err != nil {
+			logging.LogFromContext(ctx).Warn("no resolver answered the account listing",
+				"cluster", peer.Name, "error", err)
+		}
+
+		statuses = append(statuses, buildPeerSyncStatus(peer, pushed, lists))
+	}
+	return statuses, nil
+}
+
+// buildPeerSyncStatus checks the account listings of a supercluster member's servers
+// against the pushed accounts. The listings of a member connection also cover the
+// other clusters, so only the servers reporting the member's cluster name count.
+func buildPeerSyncStatus(peer *entities.Cluster, pushed []*entities.Account, lists []nats.ServerAccounts) PeerSyncStatus {
+	st := PeerSyncStatus{ClusterID: peer.ID, ClusterName: peer.Name}
+
+	var held []map[string]bool
+	for _, list := range lists {
+		if list.Server.ID == "" || list.Server.Cluster != peer.Name {
+			continue
+		}
+		accounts := make(map[string]bool, len(list.Accounts))
+		for _, pubKey := range list.Accounts {
+			accounts[pubKey] = true
+		}
+		held = append(held, accounts)
+	}
+	st.Servers = len(held)
+	if st.Servers == 0 {
+		st.Error = fmt.Sprintf("no server of cluster %s answered the account listing", peer.Name)
+		return st
+	}
+
+	for _, account := range pushed {
+		present := true
+		for _, accounts := range held {
+			if !accounts[account.PublicKey] {
+				present = false
+				break
+			}
+		}
+		if present {
+			st.Verified++
+		} else {
+			st.Missing = append(st.Missing, account.Name)
+		}
+	}
+	return st
+}
@@ -0,0 +1,246 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nats-io/nkeys"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
+)
+
+// DefaultUserKeyRevocationInterval is how often the user keys past their grace period are revoked
+const DefaultUserKeyRevocationInterval = time.Minute
+
+// ErrInvalidRotation is returned when the credentials of a user cannot be rotated
+var ErrInvalidRotation = errors.New("invalid credential rotation")
+
+// UserRotation reports the rotation of a user's credentials
+type UserRotation struct {
+	User *entities.User
+	// RetiredKey is the key the user held before the rotation
+	RetiredKey *entities.UserKey
+	// Pushed lists the clusters the account JWT revoking the retired key was pushed
+	// to, when there was no grace period
+	Pushed []AccountPush
+}
+
+// RotateUserCredentials gives a user a new key and JWT, keeping its ID, name and
+// scoped signing key. The retired key keeps working for the grace period, so clients
+// can be given the new credentials, then it is revoked through the account JWT. With
+// no grace period, it is revoked right away. Users of a suspended account, and users
+// the account revoked, cannot be rotated, as new credentials would undo the revocation.
+func (s *UserService) RotateUserCredentials(ctx context.Context, userID uuid.UUID, grace time.Duration) (*UserRotation, error) {
+	if grace < 0 {
+		return nil, fmt.Errorf("%w: the grace period cannot be negative", ErrInvalidRotation)
+	}
+	user, err := s.repo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// New credentials must not be handed out while the operator is locked down
+	if err := checkOperatorLockdown(ctx, s.accountRepo, s.operatorRepo, user.AccountID); err != nil {
+		return nil, err
+	}
+	account, err := s.accountRepo.GetByID(ctx, user.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account: %w", err)
+	}
+	operator, err := s.operatorRepo.GetByID(ctx, account.OperatorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operator: %w", err)
+	}
+	if account.PublicKey == operator.SystemAccountPubKey {
+		return nil, fmt.Errorf("%w: system account users connect nis to the clusters and cannot be rotated", ErrInvalidRotation)
+	}
+	if account.Suspended() {
+		return nil, fmt.Errorf("%w: account %s is suspended", ErrInvalidRotation, account.Name)
+	}
+	revoked, err := revokedByAccount(user, account)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, fmt.Errorf("%w: user %s was revoked by account %s, recreate it to issue new credentials",
+			ErrInvalidRotation, user.Name, account.Name)
+	}
+
+	var scopedKey *entities.ScopedSigningKey
+	if user.ScopedSigningKeyID != nil {
+		scopedKey, err = s.scopedKeyRepo.GetByID(ctx, *user.ScopedSigningKeyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get scoped signing key: %w", err)
+		}
+		if scopedKey.AccountID != account.ID {
+			return nil, fmt.Errorf("%w: the scoped signing key of user %s does not belong to its account", ErrInvalidRotation, user.Name)
+		}
+	}
+
+	// The current key was issued by the previous rotation, or with the user
+	history, err := s.userKeyRepo.ListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user keys: %w", err)
+	}
+	keyCreatedAt := user.CreatedAt
+	if len(history) > 0 {
+		keyCreatedAt = history[0].RetiredAt
+	}
+
+	seed, publicKey, err := GenerateNKey(nkeys.PrefixByteUser)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate user keys: %w", err)
+	}
+	encryptedSeed, err := s.encryptor.Encrypt(ctx, seed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt user seed: %w", err)
+	}
+
+	now := time.Now()
+	retired := &entities.UserKey{
+		ID:        uuid.New(),
+		UserID:    user.ID,
+		AccountID: account.ID,
+		PublicKey: user.PublicKey,
+		CreatedAt: keyCreatedAt,
+		RetiredAt: now,
+		RevokeAt:  now.Add(grace),
+	}
+
+	user.PublicKey = publicKey
+	user.EncryptedSeed = encryptedSeed
+	user.JWT, err = s.jwtService.GenerateUserJWT(ctx, user, account, scopedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate user JWT: %w", err)
+	}
+	user.UpdatedAt = now
+	// The retired key is recorded first: a user switched to the new key while the
+	// old one is not recorded would keep the old key valid for good
+	if err := s.userKeyRepo.Create(ctx, retired); err != nil {
+		return nil, fmt.Errorf("failed to record retired user key: %w", err)
+	}
+	if err := s.repo.Update(ctx, user); err != nil {
+		// The user keeps its key, which must not be revoked when the grace period ends
+		if delErr := s.userKeyRepo.Delete(ctx, retired.ID); delErr != nil {
+			logging.LogFromContext(ctx).Error("failed to delete the retired key of a failed rotation",
+				"user", user.Name, "key", retired.PublicKey, "error", delErr)
+		}
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+
+	result := &UserRotation{User: user, RetiredKey: retired}
+	if grace == 0 {
+		result.Pushed, err = s.revokeUserKeys(ctx, account, operator, []*entities.UserKey{retired})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	logging.LogFromContext(ctx).Info("rotated user credentials",
+		"user", user.Name, "account", account.Name, "retired_key", retired.PublicKey, "revoke_at", retired.RevokeAt)
+	return result, nil
+}
+
+// ListUserKeys returns the keys a user held before its credential rotations, the latest first
+func (s *UserService) ListUserKeys(ctx context.Context, userID uuid.UUID) ([]*entities.UserKey, error) {
+	if _, err := s.repo.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.userKeyRepo.ListByUser(ctx, userID)
+}
+
+// RevokeExpiredUserKeys revokes the retired user keys whose grace period ended. The
+// accounts holding them are re-signed and pushed to their clusters. It returns how
+// many keys were revoked.
+func (s *UserService) RevokeExpiredUserKeys(ctx context.Context) (int, error) {
+	due, err := s.userKeyRepo.ListDue(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	var accountIDs []uuid.UUID
+	byAccount := make(map[uuid.UUID][]*entities.UserKey)
+	for _, key := range due {
+		if _, ok := byAccount[key.AccountID]; !ok {
+			accountIDs = append(accountIDs, key.AccountID)
+		}
+		byAccount[key.AccountID] = append(byAccount[key.AccountID], key)
+	}
+
+	revoked := 0
+	for _, accountID := range accountIDs {
+		account, err := s.accountRepo.GetByID(ctx, accountID)
+		if err != nil {
+			return revoked, fmt.Errorf("failed to get account: %w", err)
+		}
+		operator, err := s.operatorRepo.GetByID(ctx, account.OperatorID)
+		if err != nil {
+			return revoked, fmt.Errorf("failed to get operator: %w", err)
+		}
+		pushes, err := s.revokeUserKeys(ctx, account, operator, byAccount[accountID])
+		if err != nil {
+			return revoked, err
+		}
+		revoked += len(byAccount[accountID])
+
+		for _, push := range pushes {
+			if push.Error != "" {
+				logging.LogFromContext(ctx).Warn("failed to push user key revocations, the next sync retries",
+					"account", account.Name, "cluster", push.ClusterName, "error", push.Error)
+			}
+		}
+	}
+	return revoked, nil
+}
+
+// RunUserKeyRevocations revokes the user keys past their grace period every interval
+// until ctx is done, while isLeader reports this instance leads
+func (s *UserService) RunUserKeyRevocations(ctx context.Context, interval time.Duration, isLeader func() bool) {
+	if interval <= 0 {
+		interval = DefaultUserKeyRevocationInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !isLeader() {
+				continue
+			}
+			revoked, err := s.RevokeExpiredUserKeys(ctx)
+			if err != nil {
+				logging.LogFromContext(ctx).Error("user key revocation error", "error", err)
+			}
+			if revoked > 0 {
+				logging.LogFromContext(ctx).Info("revoked rotated user keys", "keys", revoked)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// revokeUserKeys adds retired user keys to the revocation list of their account, then
+// re-signs the account and pushes it to its clusters
+func (s *UserService) revokeUserKeys(ctx context.Context, account *entities.Account, operator *entities.Operator, keys []*entities.UserKey) ([]AccountPush, error) {
+	now := time.Now()
+	if account.RevokedUserKeys == nil {
+		account.RevokedUserKeys = make(map[string]time.Time, len(keys))
+	}
+	for _, key := range keys {
+		account.RevokedUserKeys[key.PublicKey] = now
+	}
+	if err := s.clusters.resignAccount(ctx, account, operator); err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if err := s.userKeyRepo.MarkRevoked(ctx, key.ID, now); err != nil {
+			return nil, fmt.Errorf("failed to mark user key revoked: %w", err)
+		}
+		key.RevokedAt = &now
+	}
+	return s.clusters.pushAccountToClusters(ctx, account), nil
+}
@@ -0,0 +1,157 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+)
+
+type UserRotationTestSuite struct {
+	serviceSuite
+}
+
+func (s *UserRotationTestSuite) TearDownTest() {
+	s.emptyTables("user_keys", "users", "scoped_signing_keys", "accounts", "operators")
+}
+
+func TestUserRotationSuite(t *testing.T) {
+	suite.Run(t, new(UserRotationTestSuite))
+}
+
+func (s *UserRotationTestSuite) TestRotateUserCredentials() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+	scopedKey, err := s.scopedSigningKeyRepo.GetByName(s.ctx, account.ID, "default")
+	require.NoError(s.T(), err)
+	user, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app", ScopedSigningKeyID: &scopedKey.ID})
+	require.NoError(s.T(), err)
+
+	rotation, err := s.userService.RotateUserCredentials(s.ctx, user.ID, time.Hour)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), user.ID, rotation.User.ID)
+	assert.Equal(s.T(), "app", rotation.User.Name)
+	assert.NotEqual(s.T(), user.PublicKey, rotation.User.PublicKey)
+	assert.Equal(s.T(), user.PublicKey, rotation.RetiredKey.PublicKey)
+	assert.False(s.T(), rotation.RetiredKey.Revoked())
+	assert.Empty(s.T(), rotation.Pushed)
+
+	newClaims, err := jwt.DecodeUserClaims(rotation.User.JWT)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), rotation.User.PublicKey, newClaims.Subject)
+	assert.Equal(s.T(), scopedKey.PublicKey, newClaims.Issuer)
+	creds, err := s.userService.GetUserCredentials(s.ctx, user.ID)
+	require.NoError(s.T(), err)
+	assert.Contains(s.T(), creds, rotation.User.JWT)
+
+	// Within the grace period, the old key is not revoked
+	oldClaims, err := jwt.DecodeUserClaims(user.JWT)
+	require.NoError(s.T(), err)
+	current, err := s.accountRepo.GetByID(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	accountClaims, err := jwt.DecodeAccountClaims(current.JWT)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), accountClaims.IsClaimRevoked(oldClaims))
+	// and clients still using it are listed and kicked with the user
+	connKeys, err := s.clusterService.connectionKeys(s.ctx, rotation.User)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []string{rotation.User.PublicKey, user.PublicKey}, connKeys)
+	resolver, err := newConnectionResolver(s.ctx, s.clusterService, account)
+	require.NoError(s.T(), err)
+	owner, _, err := resolver.resolve(s.ctx, user.PublicKey)
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), owner)
+	assert.Equal(s.T(), user.ID, owner.ID)
+	revoked, err := s.userService.RevokeExpiredUserKeys(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 0, revoked)
+
+	// A second rotation with a short grace period: both retired keys are kept
+	second, err := s.userService.RotateUserCredentials(s.ctx, user.ID, time.Millisecond)
+	require.NoError(s.T(), err)
+	keys, err := s.userService.ListUserKeys(s.ctx, user.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), keys, 2)
+	assert.Equal(s.T(), rotation.User.PublicKey, keys[0].PublicKey)
+	assert.Equal(s.T(), user.PublicKey, keys[1].PublicKey)
+	assert.Equal(s.T(), keys[1].RetiredAt.Unix(), keys[0].CreatedAt.Unix())
+
+	time.Sleep(5 * time.Millisecond)
+	revoked, err = s.userService.RevokeExpiredUserKeys(s.ctx)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 1, revoked)
+
+	current, err = s.accountRepo.GetByID(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	accountClaims, err = jwt.DecodeAccountClaims(current.JWT)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), accountClaims.IsClaimRevoked(newClaims))
+	assert.False(s.T(), accountClaims.IsClaimRevoked(oldClaims))
+	latestClaims, err := jwt.DecodeUserClaims(second.User.JWT)
+	require.NoError(s.T(), err)
+	assert.False(s.T(), accountClaims.IsClaimRevoked(latestClaims))
+
+	// The revocation list survives a re-sign of the account
+	description := "orders service"
+	_, err = s.accountService.UpdateAccount(s.ctx, account.ID, UpdateAccountRequest{Description: &description})
+	require.NoError(s.T(), err)
+	current, err = s.accountRepo.GetByID(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	accountClaims, err = jwt.DecodeAccountClaims(current.JWT)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), accountClaims.IsClaimRevoked(newClaims))
+
+	// Without a grace period, the key is revoked right away
+	third, err := s.userService.RotateUserCredentials(s.ctx, user.ID, 0)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), third.RetiredKey.Revoked())
+	current, err = s.accountRepo.GetByID(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	accountClaims, err = jwt.DecodeAccountClaims(current.JWT)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), accountClaims.IsClaimRevoked(latestClaims))
+}
+
+func (s *UserRotationTestSuite) TestRotateUserCredentials_Invalid() {
+	operator := s.createOperator("test-operator")
+	account := s.createAccount(operator.ID, "orders")
+	user, err := s.userService.CreateUser(s.ctx, CreateUserRequest{AccountID: account.ID, Name: "app"})
+	require.NoError(s.T(), err)
+
+	_, err = s.userService.RotateUserCredentials(s.ctx, user.ID, -time.Second)
+	assert.ErrorIs(s.T(), err, ErrInvalidRotation)
+
+	sysAccount, err := s.accountService.GetAccountByName(s.ctx, operator.ID, "$SYS")
+	require.NoError(s.T(), err)
+	sysUsers, err := s.userRepo.ListByAccount(s.ctx, sysAccount.ID, repositories.ListOptions{})
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), sysUsers)
+	_, err = s.userService.RotateUserCredentials(s.ctx, sysUsers[0].ID, time.Hour)
+	assert.ErrorIs(s.T(), err, ErrInvalidRotation)
+
+	// Users revoked by a suspension stay revoked, even once the account is resumed
+	_, err = s.accountService.SuspendAccount(s.ctx, account.ID, "admin", "incident", true)
+	require.NoError(s.T(), err)
+	_, err = s.userService.RotateUserCredentials(s.ctx, user.ID, time.Hour)
+	assert.ErrorIs(s.T(), err, ErrInvalidRotation)
+	assert.ErrorContains(s.T(), err, "suspended")
+	_, err = s.accountService.ResumeAccount(s.ctx, account.ID)
+	require.NoError(s.T(), err)
+	_, err = s.userService.RotateUserCredentials(s.ctx, user.ID, time.Hour)
+	assert.ErrorIs(s.T(), err, ErrInvalidRotation)
+	assert.ErrorContains(s.T(), err, "revoked")
+
+	// No new credentials are issued while the operator is locked down
+	_, err = s.operatorService.LockdownOperator(s.ctx, operator.ID, "admin", "incident")
+	require.NoError(s.T(), err)
+	_, err = s.userService.RotateUserCredentials(s.ctx, user.ID, time.Hour)
+	assert.ErrorIs(s.T(), err, ErrOperatorLockedDown)
+
+	keys, err := s.userService.ListUserKeys(s.ctx, user.ID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), keys)
+}
@@ -17,28 +17,45 @@ import (
 type UserService struct {
 	repo          repositories.UserRepository
 	accountRepo   repositories.AccountRepository
+	operatorRepo  repositories.OperatorRepository
 	scopedKeyRepo repositories.ScopedSigningKeyRepository
+	userKeyRepo   repositories.UserKeyRepository
+	rotationRepo  repositories.RotationPolicyRepository
 	jwtService    *JWTService
 	encryptor     encryption.Encryptor
+	clusters      *ClusterService
+	notifier      RotationNotifier
 }
 
 // NewUserService creates a new user service
 func NewUserService(
 	repo repositories.UserRepository,
 	accountRepo repositories.AccountRepository,
+	operatorRepo repositories.OperatorRepository,
 	scopedKeyRepo repositories.ScopedSigningKeyRepository,
+	userKeyRepo repositories.UserKeyRepository,
+	rotationRepo repositories.RotationPolicyRepository,
 	jwtService *JWTService,
 	encryptor encryption.Encryptor,
 ) *UserService {
 	return &UserService{
 		repo:          repo,
 		accountRepo:   accountRepo,
+		operatorRepo:  operatorRepo,
 		scopedKeyRepo: scopedKeyRepo,
+		userKeyRepo:   userKeyRepo,
+		rotationRepo:  rotationRepo,
 		jwtService:    jwtService,
 		encryptor:     encryptor,
 	}
 }
 
+// SetClusterService gives the credential rotations access to the clusters the
+// re-signed accounts are pushed to, and the rotation plans to the operator's accounts
+func (s *UserService) SetClusterService(clusters *ClusterService) {
+	s.clusters = clusters
+}
+
 // CreateUserRequest contains the data needed to create a user
 type CreateUserRequest struct {
 	AccountID          uuid.UUID
@@ -151,19 +168,35 @@ func (s *UserService) ListUsersByScopedKey(ctx context.Context, scopedKeyID uuid
 	return s.repo.ListByScopedSigningKey(ctx, scopedKeyID, opts)
 }
 
+// ListStaleUsers lists the users whose credentials were not seen on any cluster for the
+// given duration, including users that never connected. accountID restricts the list
+// to an account when not nil.
+func (s *UserService) ListStaleUsers(ctx context.Context, accountID *uuid.UUID, notSeenFor time.Duration, opts repositories.ListOptions) ([]*entities.User, error) {
+	if notSeenFor <= 0 {
+		return nil, fmt.Errorf("stale duration must be positive")
+	}
+	return s.repo.ListNotSeenSince(ctx, accountID, time.Now().Add(-notSeenFor), opts)
+}
+
 // UpdateUserRequest contains the fields that can be updated
 type UpdateUserRequest struct {
 	Name        *string
 	Description *string
 }
 
-// UpdateUser updates a user's metadata and regenerates JWT
+// UpdateUser updates a user's metadata and regenerates JWT. Users are not updated
+// while their operator is locked down. The JWT of a user revoked by its account, or
+// of a suspended account, is kept: a new one would escape the revocation.
 func (s *UserService) UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUserRequest) (*entities.User, error) {
 	// Get existing user
 	user, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	// A new JWT must not be issued while the operator is locked down
+	if err := checkOperatorLockdown(ctx, s.accountRepo, s.operatorRepo, user.AccountID); err != nil {
+		return nil, err
+	}
 
 	// Update fields if provided
 	updated := false
@@ -196,21 +229,27 @@ func (s *UserService) UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUs
 	if err != nil {
 		return nil, fmt.Errorf("failed to get account: %w", err)
 	}
+	revoked, err := revokedByAccount(user, account)
+	if err != nil {
+		return nil, err
+	}
 
-	var scopedKey *entities.ScopedSigningKey
-	if user.ScopedSigningKeyID != nil {
-		scopedKey, err = s.scopedKeyRepo.GetByID(ctx, *user.ScopedSigningKeyID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get scoped signing key: %w", err)
+	if !revoked && !account.Suspended() {
+		var scopedKey *entities.ScopedSigningKey
+		if user.ScopedSigningKeyID != nil {
+			scopedKey, err = s.scopedKeyRepo.GetByID(ctx, *user.ScopedSigningKeyID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get scoped signing key: %w", err)
+			}
 		}
-	}
 
-	// Regenerate JWT with updated metadata
-	jwt, err := s.jwtService.GenerateUserJWT(ctx, user, account, scopedKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed to regenerate user JWT: %w", err)
+		// Regenerate JWT with updated metadata
+		jwt, err := s.jwtService.GenerateUserJWT(ctx, user, account, scopedKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to regenerate user JWT: %w", err)
+		}
+		user.JWT = jwt
 	}
-	user.JWT = jwt
 
 	// Save changes
 	if err := s.repo.Update(ctx, user); err != nil {
@@ -220,13 +259,17 @@ func (s *UserService) UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUs
 	return user, nil
 }
 
-// GetUserCredentials returns the complete .creds file content for a user
+// GetUserCredentials returns the complete .creds file content for a user.
+// Credentials are refused while the user's operator is locked down.
 func (s *UserService) GetUserCredentials(ctx context.Context, id uuid.UUID) (string, error) {
 	// Get user
 	user, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return "", err
 	}
+	if err := checkOperatorLockdown(ctx, s.accountRepo, s.operatorRepo, user.AccountID); err != nil {
+		return "", err
+	}
 
 	// Generate credentials using JWT service
 	return s.jwtService.GetUserCredentials(ctx, user)
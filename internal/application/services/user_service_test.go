@@ -77,19 +77,23 @@ func (s *UserServiceTestSuite) SetupSuite() {
 		s.encryptor,
 	)
 
-	s.operatorService = NewOperatorService(
-		s.operatorRepo,
-		s.accountRepo,
+	s.userService = NewUserService(
 		s.userRepo,
-		s.accountService,
+		s.accountRepo,
+		s.operatorRepo,
+		s.scopedKeyRepo,
+		sql.NewUserKeyRepo(s.db),
+		sql.NewRotationPolicyRepo(s.db),
 		jwtService,
 		s.encryptor,
 	)
 
-	s.userService = NewUserService(
-		s.userRepo,
+	s.operatorService = NewOperatorService(
+		s.operatorRepo,
 		s.accountRepo,
-		s.scopedKeyRepo,
+		s.userRepo,
+		s.accountService,
+		s.userService,
 		jwtService,
 		s.encryptor,
 	)
@@ -20,6 +20,16 @@ type Account struct {
 	JetStreamMaxStorage    int64 // -1 = unlimited
 	JetStreamMaxStreams    int64 // -1 = unlimited
 	JetStreamMaxConsumers  int64 // -1 = unlimited
+	SuspendedAt            *time.Time // Set while the account is suspended: its JWT allows no connection
+	SuspendedBy            string     // API user who suspended the account
+	SuspendReason          string
+	UsersRevokedAt         *time.Time // User JWTs issued before are revoked
+	RevokedUserKeys        map[string]time.Time // Keys retired by a rotation: their JWTs issued before are revoked
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
 }
+
+// Suspended reports whether the account is suspended
+func (a *Account) Suspended() bool {
+	return a.SuspendedAt != nil
+}
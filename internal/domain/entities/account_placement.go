@@ -0,0 +1,15 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountPlacement places an account on a cluster. Accounts without any placement
+// are placed on every cluster of their operator.
+type AccountPlacement struct {
+	AccountID uuid.UUID
+	ClusterID uuid.UUID
+	CreatedAt time.Time
+}
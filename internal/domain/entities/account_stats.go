@@ -0,0 +1,34 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountStats is the traffic of an account on a cluster during one time bucket, summed
+// over the cluster's servers from the account STATZ samples that fell in the bucket.
+// Buckets without any connection or traffic are not stored.
+type AccountStats struct {
+	ID             uuid.UUID
+	ClusterID      uuid.UUID
+	AccountID      uuid.UUID
+	Resolution     time.Duration // Length of the bucket
+	BucketStart    time.Time
+	Samples        int64 // Collections that contributed to the bucket
+	ConnectionsSum int64 // Client connections summed over the samples
+	MaxConnections int64 // Highest client connection count of a sample
+	MsgsIn         int64 // Messages published by the account's clients
+	MsgsOut        int64 // Messages delivered to the account's clients
+	BytesIn        int64
+	BytesOut       int64
+	SlowConsumers  int64 // Slow consumers detected in the bucket
+}
+
+// AvgConnections returns the mean client connection count over the bucket's samples
+func (s *AccountStats) AvgConnections() float64 {
+	if s.Samples == 0 {
+		return 0
+	}
+	return float64(s.ConnectionsSum) / float64(s.Samples)
+}
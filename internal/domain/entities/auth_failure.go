@@ -0,0 +1,36 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuthFailure aggregates the failed client authentications of a cluster that share the
+// presented user key, remote address and reason within one time window, as reported
+// by $SYS.SERVER.<id>.CLIENT.AUTH.ERR
+type AuthFailure struct {
+	ID               uuid.UUID
+	ClusterID        uuid.UUID
+	WindowStart      time.Time  // Start of the aggregation window
+	UserPublicKey    string     // Key the client presented, empty if none
+	AccountPublicKey string     // Account the client tried to bind to, if known
+	UserID           *uuid.UUID // NIS user with the presented key, nil for unknown keys
+	AccountID        *uuid.UUID // NIS account of the user or presented account key
+	RemoteIP         string
+	Reason           string
+	ServerName       string // Server that rejected the first failure of the window
+	ClientName       string // Client connection name, if set
+	Count            int64  // Failures in the window
+	FirstSeen        time.Time
+	LastSeen         time.Time
+}
+
+// Rate returns the failures per minute over the span of the window that saw them
+func (f *AuthFailure) Rate() float64 {
+	span := f.LastSeen.Sub(f.FirstSeen)
+	if span < time.Minute {
+		span = time.Minute
+	}
+	return float64(f.Count) / span.Minutes()
+}
@@ -19,6 +19,13 @@ type Cluster struct {
 	Healthy              bool    // Health status of the cluster
 	LastHealthCheck      *time.Time // Last time health check was performed
 	HealthCheckError     string  // Last health check error message (if any)
+	HealthCheckInterval  time.Duration // Time between health checks, 0 = service default
+	HealthCheckTimeout   time.Duration // Deadline for a single health check, 0 = service default
+	HealthCheckFailures  int        // Consecutive failed health checks, drives the backoff
+	NextHealthCheck      *time.Time // When the next health check is due
+	ServerProfile        *ServerProfile // Configuration of the cluster's servers, nil for the defaults
+	Supercluster         string   // Supercluster joined through gateways, empty for a standalone cluster
+	GatewayURLs          []string // URLs the other clusters' gateways dial, derived from ServerURLs when empty
 	CreatedAt            time.Time
 	UpdatedAt            time.Time
 }
@@ -0,0 +1,17 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClusterHealthCheck is the outcome of a single cluster health check
+type ClusterHealthCheck struct {
+	ID        uuid.UUID
+	ClusterID uuid.UUID
+	CheckedAt time.Time
+	Healthy   bool
+	Latency   time.Duration // Time taken to connect and ping the cluster
+	Error     string        // Why the check failed (if it did)
+}
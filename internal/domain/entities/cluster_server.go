@@ -0,0 +1,37 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClusterServer is a NATS server of a cluster as last reported by $SYS.REQ.SERVER.PING
+type ClusterServer struct {
+	ID          uuid.UUID
+	ClusterID   uuid.UUID
+	ServerID    string     // NATS server ID (the server's public nkey)
+	Name        string     // Server name from the server configuration
+	Host        string     // Host the server listens on
+	Version     string     // nats-server version
+	JetStream   bool       // Whether JetStream is enabled on the server
+	ClusterName string     // Name of the NATS cluster the server is routed into
+	Gateways    []string   // Names of the gateways the server is connected to
+	Connections int        // Client connections at the time of the last ping
+	StartedAt   *time.Time // When the server process started
+	LastSeen    time.Time  // Last time the server answered a ping
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	// JetStream capacity reported by JSZ, 0 when unknown
+	JetStreamMaxMemory  int64
+	JetStreamMaxStorage int64
+}
+
+// Uptime returns how long the server had been running when it was last seen
+func (s *ClusterServer) Uptime() time.Duration {
+	if s.StartedAt == nil || s.StartedAt.After(s.LastSeen) {
+		return 0
+	}
+	return s.LastSeen.Sub(*s.StartedAt)
+}
@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+// LeaderLease records which nis serve replica currently holds an elected role
+type LeaderLease struct {
+	Name       string // Role the lease elects a leader for
+	HolderID   string // Instance ID of the replica holding the lease
+	AcquiredAt time.Time
+	RenewedAt  time.Time
+	ExpiresAt  time.Time // The lease can be taken over by another replica after this
+}
+
+// Expired reports whether the lease can be taken over at the given time
+func (l *LeaderLease) Expired(now time.Time) bool {
+	return !now.Before(l.ExpiresAt)
+}
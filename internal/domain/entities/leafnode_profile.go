@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultLeafnodeCredentialsDir is where the leaf servers read the credentials of
+// leafnode profiles without an explicit path
+const DefaultLeafnodeCredentialsDir = "/etc/nats"
+
+// LeafnodeProfile binds a NIS user to a hub cluster: an edge server connects its
+// leafnode remote to the hub as that user, so the remote joins the user's account
+type LeafnodeProfile struct {
+	ID              uuid.UUID
+	ClusterID       uuid.UUID // Hub cluster
+	Name            string    // Unique per hub cluster
+	UserID          uuid.UUID
+	LocalAccount    string   // Account of the leaf server bound to the remote, empty for its default account
+	URLs            []string // Leafnode URLs of the hub, derived from its server URLs when empty
+	CredentialsPath string   // Path of the .creds file on the leaf server
+	CAFile          string   // CA verifying the hub's certificate, on the leaf server
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
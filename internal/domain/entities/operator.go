@@ -17,4 +17,16 @@ type Operator struct {
 	SystemAccountPubKey string // Optional: public key of the designated system account
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
+
+	// Last emergency lockdown of the operator, kept once lifted for auditing
+	LockedDownAt     *time.Time
+	LockedDownBy     string // API user who locked the operator down
+	LockdownReason   string
+	LockdownLiftedAt *time.Time
+	LockdownLiftedBy string // API user who lifted the lockdown
+}
+
+// LockedDown reports whether the operator is under an emergency lockdown
+func (o *Operator) LockedDown() bool {
+	return o.LockedDownAt != nil && o.LockdownLiftedAt == nil
 }
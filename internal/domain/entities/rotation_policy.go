@@ -0,0 +1,43 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RotationPolicyScope is what a rotation policy applies to
+type RotationPolicyScope string
+
+const (
+	RotationPolicyScopeOperator         RotationPolicyScope = "operator"
+	RotationPolicyScopeAccount          RotationPolicyScope = "account"
+	RotationPolicyScopeScopedSigningKey RotationPolicyScope = "scoped_signing_key"
+)
+
+// RotationPolicy rotates the credentials of the users of an operator, an account or
+// a scoped signing key every Interval. The retired keys keep working for GracePeriod.
+// When several policies cover a user, the most specific one applies.
+type RotationPolicy struct {
+	ID                 uuid.UUID
+	OperatorID         uuid.UUID
+	AccountID          *uuid.UUID // Set for account and scoped signing key policies
+	ScopedSigningKeyID *uuid.UUID // Set for scoped signing key policies
+	Interval           time.Duration
+	GracePeriod        time.Duration
+	CreatedBy          string // API user who created the policy
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// Scope returns what the policy applies to
+func (p *RotationPolicy) Scope() RotationPolicyScope {
+	switch {
+	case p.ScopedSigningKeyID != nil:
+		return RotationPolicyScopeScopedSigningKey
+	case p.AccountID != nil:
+		return RotationPolicyScopeAccount
+	default:
+		return RotationPolicyScopeOperator
+	}
+}
@@ -20,6 +20,9 @@ type ScopedSigningKey struct {
 	SubDeny         []string // Subscribe denials (subject patterns)
 	ResponseMaxMsgs int      // Max response messages for request-reply
 	ResponseTTL     time.Duration // Time-to-live for responses
+	// RetiredKeys maps the public keys replaced by a rotation to the end of their
+	// grace period: users they signed are trusted until then
+	RetiredKeys     map[string]time.Time
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 }
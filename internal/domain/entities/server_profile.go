@@ -0,0 +1,100 @@
+package entities
+
+import "time"
+
+// Server profile defaults, matching the include config generated for operators
+const (
+	DefaultServerPort       = 4222
+	DefaultServerHTTPPort   = 8222
+	DefaultResolverDir      = "/resolver"
+	DefaultResolverInterval = 2 * time.Minute
+	DefaultJetStreamDir     = "/data/jetstream"
+)
+
+// ServerProfile describes how the NATS servers of a cluster are configured. It is
+// stored with the cluster and turned into a complete server configuration file.
+// Zero ports disable the corresponding listener.
+type ServerProfile struct {
+	Host     string // Client listen host, empty for all interfaces
+	Port     int
+	HTTPPort int // Monitoring port
+
+	ClusterPort int      // Route port, 0 for a single server
+	Routes      []string // Route URLs of the other servers of the cluster
+
+	TLS       ServerTLS
+	JetStream ServerJetStream
+	Resolver  ServerResolver
+	Websocket ServerWebsocket
+	MQTT      ServerMQTT
+	Leafnode  ServerLeafnode
+	Gateway   ServerGateway
+}
+
+// ServerTLS holds the certificate paths on the NATS servers. The client listener
+// uses TLS when a certificate is set, the other listeners reuse it.
+type ServerTLS struct {
+	CertFile string
+	KeyFile  string
+	CAFile   string
+	Verify   bool // Require client certificates
+}
+
+// Enabled reports whether the servers serve TLS
+func (t ServerTLS) Enabled() bool {
+	return t.CertFile != ""
+}
+
+// ServerJetStream configures JetStream, zero limits let the server size them
+type ServerJetStream struct {
+	Enabled    bool
+	StoreDir   string
+	MaxMemory  int64
+	MaxStorage int64
+	Domain     string
+}
+
+// ServerResolver configures the full account resolver the JWTs are pushed to
+type ServerResolver struct {
+	Dir      string
+	Interval time.Duration // Sync interval between the servers
+}
+
+// ServerWebsocket configures the websocket listener
+type ServerWebsocket struct {
+	Port        int
+	NoTLS       bool // Serve plain websocket even when TLS is configured
+	Compression bool
+}
+
+// ServerMQTT configures the MQTT listener, which requires JetStream
+type ServerMQTT struct {
+	Port int
+}
+
+// ServerLeafnode configures the listener accepting leafnode connections
+type ServerLeafnode struct {
+	Port int
+}
+
+// ServerGateway configures the listener of the gateways to other clusters
+type ServerGateway struct {
+	Port int
+}
+
+// DefaultServerProfile returns the profile used for clusters without one: a single
+// server with JetStream and the full resolver, as in the operator include config
+func DefaultServerProfile() *ServerProfile {
+	return &ServerProfile{
+		Port:     DefaultServerPort,
+		HTTPPort: DefaultServerHTTPPort,
+		JetStream: ServerJetStream{
+			Enabled:  true,
+			StoreDir: DefaultJetStreamDir,
+		},
+		Resolver: ServerResolver{
+			Dir:      DefaultResolverDir,
+			Interval: DefaultResolverInterval,
+		},
+	}
+}
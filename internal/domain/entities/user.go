@@ -19,6 +19,18 @@ type User struct {
 	ScopedSigningKeyID  *uuid.UUID // Optional: if signed by a scoped signing key
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
+
+	// Client activity recorded from the connect/disconnect events of the managed clusters
+	LastConnectedAt *time.Time // Last time a client connected with the user's credentials
+	LastSeenAt      *time.Time // Last connect or disconnect event of the user
+	LastRemoteIP    string     // Remote address of the last connection
+	ConnectionCount int64      // Connections seen since activity tracking started
+}
+
+// StaleSince reports whether the user's credentials were not seen since cutoff,
+// including users that never connected
+func (u *User) StaleSince(cutoff time.Time) bool {
+	return u.LastSeenAt == nil || u.LastSeenAt.Before(cutoff)
 }
 
 // GenerateCredsFile returns the full .creds file content for this user
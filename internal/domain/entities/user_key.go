@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserKey is a public key a user held before a credential rotation. The key keeps
+// working until RevokeAt, the end of the rotation's grace period, then it is revoked
+// through the revocation list of the user's account.
+type UserKey struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	AccountID uuid.UUID
+	PublicKey string
+	CreatedAt time.Time  // When the key was issued to the user
+	RetiredAt time.Time  // When the rotation replaced the key
+	RevokeAt  time.Time  // End of the grace period
+	RevokedAt *time.Time // Set once the account JWT revokes the key
+}
+
+// Revoked reports whether the key was revoked
+func (k *UserKey) Revoked() bool {
+	return k.RevokedAt != nil
+}
@@ -0,0 +1,20 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+// AccountPlacementRepository defines the interface for account placement persistence
+type AccountPlacementRepository interface {
+	// ListByAccount retrieves the placements of an account
+	ListByAccount(ctx context.Context, accountID uuid.UUID) ([]*entities.AccountPlacement, error)
+
+	// ListByOperator retrieves the placements of every account of an operator
+	ListByOperator(ctx context.Context, operatorID uuid.UUID) ([]*entities.AccountPlacement, error)
+
+	// Replace places an account on exactly the given clusters, none removes its placement
+	Replace(ctx context.Context, accountID uuid.UUID, clusterIDs []uuid.UUID) error
+}
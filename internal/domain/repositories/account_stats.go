@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+// AccountStatsFilter selects the account statistics buckets of one account
+type AccountStatsFilter struct {
+	AccountID  uuid.UUID
+	ClusterID  *uuid.UUID    // Nil matches every cluster
+	Resolution time.Duration // Bucket length
+	From       time.Time     // Buckets starting at or after this time, zero for no bound
+	To         time.Time     // Buckets starting before this time, zero for no bound
+}
+
+// AccountStatsRepository defines the interface for account traffic time series persistence
+type AccountStatsRepository interface {
+	// Record adds a sample to the bucket of its cluster, account, resolution and start
+	// time, creating the bucket if needed
+	Record(ctx context.Context, stats *entities.AccountStats) error
+
+	// List retrieves the buckets matching the filter, oldest first
+	List(ctx context.Context, filter AccountStatsFilter) ([]*entities.AccountStats, error)
+
+	// DeleteBefore deletes the buckets of a resolution starting before the given time
+	DeleteBefore(ctx context.Context, resolution time.Duration, before time.Time) (int64, error)
+}
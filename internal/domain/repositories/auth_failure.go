@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+// AuthFailureFilter restricts the auth failures listed. Nil and zero fields match all.
+type AuthFailureFilter struct {
+	ClusterID *uuid.UUID
+	AccountID *uuid.UUID
+	UserID    *uuid.UUID
+	Since     time.Time // Only failures last seen at or after this time
+}
+
+// AuthFailureRepository defines the interface for NATS authentication failure persistence
+type AuthFailureRepository interface {
+	// Record adds a failure to the aggregate of its cluster, window, user key, remote
+	// address and reason, creating the aggregate if needed
+	Record(ctx context.Context, failure *entities.AuthFailure) error
+
+	// List retrieves the aggregates matching the filter, most recently seen first
+	List(ctx context.Context, filter AuthFailureFilter, opts ListOptions) ([]*entities.AuthFailure, error)
+
+	// DeleteBefore deletes the aggregates last seen before the given time
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
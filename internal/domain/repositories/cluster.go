@@ -24,6 +24,9 @@ type ClusterRepository interface {
 	// ListByOperator retrieves clusters for a specific operator
 	ListByOperator(ctx context.Context, operatorID uuid.UUID, opts ListOptions) ([]*entities.Cluster, error)
 
+	// ListBySupercluster retrieves the clusters of an operator joined in a supercluster
+	ListBySupercluster(ctx context.Context, operatorID uuid.UUID, supercluster string) ([]*entities.Cluster, error)
+
 	// Update updates an existing cluster
 	Update(ctx context.Context, cluster *entities.Cluster) error
 
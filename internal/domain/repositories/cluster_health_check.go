@@ -0,0 +1,24 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+// ClusterHealthCheckRepository defines the interface for cluster health check history persistence
+type ClusterHealthCheckRepository interface {
+	// Create records a health check
+	Create(ctx context.Context, check *entities.ClusterHealthCheck) error
+
+	// ListByCluster retrieves the health checks of a cluster, newest first
+	ListByCluster(ctx context.Context, clusterID uuid.UUID, opts ListOptions) ([]*entities.ClusterHealthCheck, error)
+
+	// CountSince counts the health checks of a cluster since the given time, and how many were healthy
+	CountSince(ctx context.Context, clusterID uuid.UUID, since time.Time) (total int64, healthy int64, err error)
+
+	// DeleteBefore deletes the health checks of all clusters older than the given time
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
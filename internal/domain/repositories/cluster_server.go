@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+// ClusterServerRepository defines the interface for per-server cluster topology persistence
+type ClusterServerRepository interface {
+	// Upsert creates the server record or updates the one with the same cluster and server ID
+	Upsert(ctx context.Context, server *entities.ClusterServer) error
+
+	// ListByCluster retrieves all recorded servers of a cluster, ordered by name
+	ListByCluster(ctx context.Context, clusterID uuid.UUID) ([]*entities.ClusterServer, error)
+
+	// DeleteStale deletes the servers of a cluster that were last seen before the given time
+	DeleteStale(ctx context.Context, clusterID uuid.UUID, before time.Time) (int64, error)
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+// LeaderLeaseRepository defines the interface for leader lease persistence
+type LeaderLeaseRepository interface {
+	// TryAcquire takes the named lease for holderID until now+ttl if it is free, expired
+	// or already held by holderID (renewing it). Returns whether holderID holds the lease.
+	TryAcquire(ctx context.Context, name, holderID string, ttl time.Duration, now time.Time) (bool, error)
+
+	// Release gives up the named lease if it is held by holderID
+	Release(ctx context.Context, name, holderID string) error
+
+	// Get retrieves the named lease
+	Get(ctx context.Context, name string) (*entities.LeaderLease, error)
+}
@@ -0,0 +1,23 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+// LeafnodeProfileRepository defines the interface for leafnode profile persistence
+type LeafnodeProfileRepository interface {
+	// Create creates a new leafnode profile
+	Create(ctx context.Context, profile *entities.LeafnodeProfile) error
+
+	// GetByID retrieves a leafnode profile by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.LeafnodeProfile, error)
+
+	// ListByCluster retrieves the leafnode profiles of a hub cluster, ordered by name
+	ListByCluster(ctx context.Context, clusterID uuid.UUID) ([]*entities.LeafnodeProfile, error)
+
+	// Delete deletes a leafnode profile by ID
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,30 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+// RotationPolicyRepository defines the interface for credential rotation policy persistence
+type RotationPolicyRepository interface {
+	// Create creates a new rotation policy
+	Create(ctx context.Context, policy *entities.RotationPolicy) error
+
+	// GetByID retrieves a rotation policy by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.RotationPolicy, error)
+
+	// List retrieves every rotation policy, ordered by creation time
+	List(ctx context.Context) ([]*entities.RotationPolicy, error)
+
+	// ListByOperator retrieves the rotation policies of an operator, ordered by creation time
+	ListByOperator(ctx context.Context, operatorID uuid.UUID) ([]*entities.RotationPolicy, error)
+
+	// MoveAccount moves the policies of an account and of its scoped signing keys to
+	// another operator
+	MoveAccount(ctx context.Context, accountID, operatorID uuid.UUID) error
+
+	// Delete deletes a rotation policy by ID
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -27,6 +27,10 @@ type ScopedSigningKeyRepository interface {
 	// ListByAccount retrieves scoped signing keys for a specific account
 	ListByAccount(ctx context.Context, accountID uuid.UUID, opts ListOptions) ([]*entities.ScopedSigningKey, error)
 
+	// ListWithRetiredKeys retrieves the scoped signing keys holding public keys
+	// replaced by a rotation
+	ListWithRetiredKeys(ctx context.Context) ([]*entities.ScopedSigningKey, error)
+
 	// Update updates an existing scoped signing key
 	Update(ctx context.Context, key *entities.ScopedSigningKey) error
 
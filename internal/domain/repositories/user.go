@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/thomas-maurice/nis/internal/domain/entities"
@@ -30,9 +31,20 @@ type UserRepository interface {
 	// ListByScopedSigningKey retrieves users signed by a specific scoped signing key
 	ListByScopedSigningKey(ctx context.Context, scopedKeyID uuid.UUID, opts ListOptions) ([]*entities.User, error)
 
-	// Update updates an existing user
+	// ListNotSeenSince retrieves users not seen since the cutoff, including users that
+	// never connected. accountID restricts the list to an account when not nil.
+	ListNotSeenSince(ctx context.Context, accountID *uuid.UUID, cutoff time.Time, opts ListOptions) ([]*entities.User, error)
+
+	// Update updates an existing user. The client activity columns are left untouched.
 	Update(ctx context.Context, user *entities.User) error
 
+	// RecordConnect records a client connection of the user with the given public key.
+	// It returns false when no user has that key.
+	RecordConnect(ctx context.Context, publicKey string, at time.Time, remoteIP string) (bool, error)
+
+	// RecordDisconnect records a client disconnection of the user with the given public key
+	RecordDisconnect(ctx context.Context, publicKey string, at time.Time) (bool, error)
+
 	// Delete deletes a user by ID
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -0,0 +1,31 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+// UserKeyRepository defines the interface for the persistence of the keys users
+// held before a credential rotation
+type UserKeyRepository interface {
+	// Create records a retired user key
+	Create(ctx context.Context, key *entities.UserKey) error
+
+	// ListByUser retrieves the retired keys of a user, the latest first
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*entities.UserKey, error)
+
+	// ListByAccount retrieves the retired keys of the users of an account, the latest first
+	ListByAccount(ctx context.Context, accountID uuid.UUID) ([]*entities.UserKey, error)
+
+	// ListDue retrieves the keys not revoked yet whose grace period ended by before
+	ListDue(ctx context.Context, before time.Time) ([]*entities.UserKey, error)
+
+	// MarkRevoked records that a key was revoked at the given time
+	MarkRevoked(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+
+	// Delete deletes a user key record by ID
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 
 	"github.com/thomas-maurice/nis/internal/infrastructure/logging"
@@ -29,16 +30,22 @@ func (c *domainCache) store(inv persistence.Inventory) {
 	c.v.Store(&inv)
 }
 
-func (c *domainCache) load() persistence.Inventory {
+// reset drops the cached inventory, so the gauges stop being reported
+func (c *domainCache) reset() {
+	c.v.Store(nil)
+}
+
+// load returns the cached inventory, false when there is none
+func (c *domainCache) load() (persistence.Inventory, bool) {
 	if p := c.v.Load(); p != nil {
-		return *p
+		return *p, true
 	}
-	return persistence.Inventory{}
+	return persistence.Inventory{}, false
 }
 
 // DomainGauges registers ObservableGauges that publish entity counts. The
 // caller must keep RefreshLoop running (or call Refresh directly) — otherwise
-// every gauge will read zero.
+// no gauge is reported.
 type DomainGauges struct {
 	cache   *domainCache
 	fetcher InventoryFetcher
@@ -63,7 +70,7 @@ func RegisterDomainGauges(fetcher InventoryFetcher) (*DomainGauges, error) {
 		{"nis_users_total", "Number of users in the database.", func(i persistence.Inventory) int64 { return i.Users }},
 		{"nis_scoped_keys_total", "Number of scoped signing keys in the database.", func(i persistence.Inventory) int64 { return i.ScopedKeys }},
 		{"nis_clusters_total", "Number of clusters in the database.", func(i persistence.Inventory) int64 { return i.Clusters }},
-		{"nis_clusters_healthy", "Number of clusters last reported healthy by the 60s health-check loop.", func(i persistence.Inventory) int64 { return i.ClustersHealthy }},
+		{"nis_clusters_healthy", "Number of clusters last reported healthy by the health-check loop.", func(i persistence.Inventory) int64 { return i.ClustersHealthy }},
 	}
 
 	instruments := make([]metric.Int64ObservableGauge, 0, len(gauges))
@@ -77,7 +84,10 @@ func RegisterDomainGauges(fetcher InventoryFetcher) (*DomainGauges, error) {
 
 	// Single batch callback so all six gauges read from the same cache snapshot.
 	_, err := m.RegisterCallback(func(_ context.Context, obs metric.Observer) error {
-		inv := dg.cache.load()
+		inv, ok := dg.cache.load()
+		if !ok {
+			return nil
+		}
 		for i, g := range gauges {
 			obs.ObserveInt64(instruments[i], g.read(inv))
 		}
@@ -111,10 +121,22 @@ func (dg *DomainGauges) Refresh(ctx context.Context) {
 
 // RefreshLoop refreshes the cache every interval until ctx is done. Designed
 // for a single background goroutine — do not start more than one per Provider.
-func (dg *DomainGauges) RefreshLoop(ctx context.Context, interval time.Duration) {
+// When isLeader is set, ticks are skipped while it returns false, so only the
+// elected replica of a multi-replica deployment queries the inventory. A replica
+// that is not the leader stops reporting the gauges instead of exporting the counts
+// of its last term.
+func (dg *DomainGauges) RefreshLoop(ctx context.Context, interval time.Duration, isLeader func() bool) {
+	refresh := func() {
+		if isLeader == nil || isLeader() {
+			dg.Refresh(ctx)
+			return
+		}
+		dg.cache.reset()
+	}
+
 	// Prime once before the first tick so /metrics returns real data within
 	// seconds of startup.
-	dg.Refresh(ctx)
+	refresh()
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 	for {
@@ -122,7 +144,158 @@ func (dg *DomainGauges) RefreshLoop(ctx context.Context, interval time.Duration)
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			dg.Refresh(ctx)
+			refresh()
 		}
 	}
 }
+
+// RegisterLeaderGauge publishes nis_leader: 1 while this replica holds the
+// leader lease, 0 otherwise, labelled with the replica's instance ID.
+func RegisterLeaderGauge(instanceID string, isLeader func() bool) error {
+	m := otel.Meter(scope)
+	gauge, err := m.Int64ObservableGauge("nis_leader",
+		metric.WithDescription("1 if this replica is the elected leader running the periodic tasks, 0 otherwise."))
+	if err != nil {
+		return fmt.Errorf("register gauge nis_leader: %w", err)
+	}
+
+	instance := metric.WithAttributes(attribute.String("instance", instanceID))
+	_, err = m.RegisterCallback(func(_ context.Context, obs metric.Observer) error {
+		var v int64
+		if isLeader() {
+			v = 1
+		}
+		obs.ObserveInt64(gauge, v, instance)
+		return nil
+	}, gauge)
+	if err != nil {
+		return fmt.Errorf("register gauge callback: %w", err)
+	}
+
+	return nil
+}
+
+// AccountJetStreamSample is the JetStream usage and limits of an account on a cluster.
+// Limits are -1 when unlimited.
+type AccountJetStreamSample struct {
+	Cluster          string
+	Account          string
+	AccountPublicKey string
+	Memory           int64
+	Storage          int64
+	Streams          int64
+	Consumers        int64
+	MaxMemory        int64
+	MaxStorage       int64
+	MaxStreams       int64
+	MaxConsumers     int64
+}
+
+// JetStreamGauges publishes the JetStream usage and limits of every account, per
+// cluster. The caller collects the samples and hands them over with Store.
+type JetStreamGauges struct {
+	samples atomic.Pointer[[]AccountJetStreamSample]
+}
+
+// RegisterJetStreamGauges builds the per-account JetStream ObservableGauges. Limit
+// gauges are not observed for unlimited resources.
+func RegisterJetStreamGauges() (*JetStreamGauges, error) {
+	jg := &JetStreamGauges{}
+
+	m := otel.Meter(scope)
+	gauges := []struct {
+		name  string
+		desc  string
+		read  func(AccountJetStreamSample) int64
+		limit bool
+	}{
+		{"nis_account_jetstream_memory_bytes", "JetStream memory used by the account on the cluster.", func(s AccountJetStreamSample) int64 { return s.Memory }, false},
+		{"nis_account_jetstream_storage_bytes", "JetStream storage used by the account on the cluster.", func(s AccountJetStreamSample) int64 { return s.Storage }, false},
+		{"nis_account_jetstream_streams", "Streams of the account on the cluster.", func(s AccountJetStreamSample) int64 { return s.Streams }, false},
+		{"nis_account_jetstream_consumers", "Consumers of the account on the cluster.", func(s AccountJetStreamSample) int64 { return s.Consumers }, false},
+		{"nis_account_jetstream_memory_limit_bytes", "JetStream memory limit of the account.", func(s AccountJetStreamSample) int64 { return s.MaxMemory }, true},
+		{"nis_account_jetstream_storage_limit_bytes", "JetStream storage limit of the account.", func(s AccountJetStreamSample) int64 { return s.MaxStorage }, true},
+		{"nis_account_jetstream_streams_limit", "Stream limit of the account.", func(s AccountJetStreamSample) int64 { return s.MaxStreams }, true},
+		{"nis_account_jetstream_consumers_limit", "Consumer limit of the account.", func(s AccountJetStreamSample) int64 { return s.MaxConsumers }, true},
+	}
+
+	instruments := make([]metric.Int64ObservableGauge, 0, len(gauges))
+	for _, g := range gauges {
+		inst, err := m.Int64ObservableGauge(g.name, metric.WithDescription(g.desc))
+		if err != nil {
+			return nil, fmt.Errorf("register gauge %s: %w", g.name, err)
+		}
+		instruments = append(instruments, inst)
+	}
+
+	_, err := m.RegisterCallback(func(_ context.Context, obs metric.Observer) error {
+		p := jg.samples.Load()
+		if p == nil {
+			return nil
+		}
+		for _, sample := range *p {
+			attrs := metric.WithAttributes(
+				attribute.String("cluster", sample.Cluster),
+				attribute.String("account", sample.Account),
+				attribute.String("account_public_key", sample.AccountPublicKey),
+			)
+			for i, g := range gauges {
+				v := g.read(sample)
+				if g.limit && v < 0 {
+					continue
+				}
+				obs.ObserveInt64(instruments[i], v, attrs)
+			}
+		}
+		return nil
+	}, asObservables(instruments)...)
+	if err != nil {
+		return nil, fmt.Errorf("register gauge callback: %w", err)
+	}
+
+	return jg, nil
+}
+
+// Store replaces the published samples. Accounts missing from samples stop being
+// reported.
+func (jg *JetStreamGauges) Store(samples []AccountJetStreamSample) {
+	jg.samples.Store(&samples)
+}
+
+// NATSConnectionState is the state of the pooled connection of a cluster
+type NATSConnectionState struct {
+	Cluster   string
+	Status    string // Connection status, such as CONNECTED or RECONNECTING
+	Connected bool
+}
+
+// RegisterNATSConnectionGauge publishes nis_nats_connection_up: 1 while the pooled
+// connection of a cluster is connected, 0 while it is down or reconnecting. states is
+// called at scrape time and must not block.
+func RegisterNATSConnectionGauge(states func() []NATSConnectionState) error {
+	m := otel.Meter(scope)
+	gauge, err := m.Int64ObservableGauge("nis_nats_connection_up",
+		metric.WithDescription("1 if the pooled connection to the cluster is connected, 0 otherwise, labelled by cluster and status."))
+	if err != nil {
+		return fmt.Errorf("register gauge nis_nats_connection_up: %w", err)
+	}
+
+	_, err = m.RegisterCallback(func(_ context.Context, obs metric.Observer) error {
+		for _, state := range states() {
+			var v int64
+			if state.Connected {
+				v = 1
+			}
+			obs.ObserveInt64(gauge, v, metric.WithAttributes(
+				attribute.String("cluster", state.Cluster),
+				attribute.String("status", state.Status),
+			))
+		}
+		return nil
+	}, gauge)
+	if err != nil {
+		return fmt.Errorf("register gauge callback: %w", err)
+	}
+
+	return nil
+}
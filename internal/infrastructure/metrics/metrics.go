@@ -127,9 +127,13 @@ func (p *Provider) Registry() *prometheus.Registry {
 type Recorder struct {
 	clusterSyncErrors    metric.Int64Counter
 	clusterSyncDuration  metric.Float64Histogram
+	clusterSyncAccounts  metric.Int64Counter
+	clusterSyncRate      metric.Float64Histogram
 	clusterHealthFailed  metric.Int64Counter
 	encryptionFailures   metric.Int64Counter
 	authRejections       metric.Int64Counter
+	natsAuthFailures     metric.Int64Counter
+	natsConnEvents       metric.Int64Counter
 
 	httpDuration metric.Float64Histogram
 }
@@ -152,6 +156,19 @@ func newRecorder(m metric.Meter) (*Recorder, error) {
 	); err != nil {
 		return nil, err
 	}
+	if r.clusterSyncAccounts, err = m.Int64Counter(
+		"nis_cluster_sync_accounts_total",
+		metric.WithDescription("Account JWTs processed by cluster sync, labelled by outcome (pushed/failed)."),
+	); err != nil {
+		return nil, err
+	}
+	if r.clusterSyncRate, err = m.Float64Histogram(
+		"nis_cluster_sync_throughput_accounts_per_second",
+		metric.WithDescription("Accounts pushed per second during a cluster sync run."),
+		metric.WithExplicitBucketBoundaries(1, 5, 10, 25, 50, 100, 250, 500, 1000, 2500),
+	); err != nil {
+		return nil, err
+	}
 	if r.clusterHealthFailed, err = m.Int64Counter(
 		"nis_cluster_health_check_failures_total",
 		metric.WithDescription("Total number of cluster health checks that returned an error."),
@@ -170,6 +187,18 @@ func newRecorder(m metric.Meter) (*Recorder, error) {
 	); err != nil {
 		return nil, err
 	}
+	if r.natsAuthFailures, err = m.Int64Counter(
+		"nis_nats_auth_failures_total",
+		metric.WithDescription("NATS client authentication failures reported by managed clusters, labelled by cluster and account (unknown when the key matches no NIS entity)."),
+	); err != nil {
+		return nil, err
+	}
+	if r.natsConnEvents, err = m.Int64Counter(
+		"nis_nats_connection_events_total",
+		metric.WithDescription("State changes of the pooled cluster connections, labelled by cluster and event (connected/connect_failed/disconnected/reconnected/closed)."),
+	); err != nil {
+		return nil, err
+	}
 	if r.httpDuration, err = m.Float64Histogram(
 		"nis_http_server_duration_seconds",
 		metric.WithUnit("s"),
@@ -197,6 +226,25 @@ func (r *Recorder) RecordClusterSyncDuration(ctx context.Context, seconds float6
 	r.clusterSyncDuration.Record(ctx, seconds, metric.WithAttributes(attribute.String("outcome", outcome)))
 }
 
+// RecordClusterSyncAccounts adds the number of account JWTs pushed and failed
+// during a single sync run.
+func (r *Recorder) RecordClusterSyncAccounts(ctx context.Context, pushed, failed int) {
+	if r == nil {
+		return
+	}
+	r.clusterSyncAccounts.Add(ctx, int64(pushed), metric.WithAttributes(attribute.String("outcome", "pushed")))
+	r.clusterSyncAccounts.Add(ctx, int64(failed), metric.WithAttributes(attribute.String("outcome", "failed")))
+}
+
+// RecordClusterSyncThroughput records how many accounts per second a sync run
+// pushed to the resolver.
+func (r *Recorder) RecordClusterSyncThroughput(ctx context.Context, accountsPerSecond float64) {
+	if r == nil {
+		return
+	}
+	r.clusterSyncRate.Record(ctx, accountsPerSecond)
+}
+
 // RecordClusterHealthCheckFailure increments the cluster-health-check failure counter.
 func (r *Recorder) RecordClusterHealthCheckFailure(ctx context.Context) {
 	if r == nil {
@@ -223,6 +271,30 @@ func (r *Recorder) RecordAuthRejection(ctx context.Context, reason string) {
 	r.authRejections.Add(ctx, 1, metric.WithAttributes(attribute.String("reason", reason)))
 }
 
+// RecordNATSAuthFailure increments the NATS authentication failure counter of an
+// account. account is the NIS account name, or "unknown".
+func (r *Recorder) RecordNATSAuthFailure(ctx context.Context, cluster, account string) {
+	if r == nil {
+		return
+	}
+	r.natsAuthFailures.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("cluster", cluster),
+		attribute.String("account", account),
+	))
+}
+
+// RecordNATSConnectionEvent increments the state change counter of a cluster's pooled
+// connection
+func (r *Recorder) RecordNATSConnectionEvent(ctx context.Context, cluster, event string) {
+	if r == nil {
+		return
+	}
+	r.natsConnEvents.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("cluster", cluster),
+		attribute.String("event", event),
+	))
+}
+
 // recordHTTPDuration is called from the HTTP middleware. Not exported; the
 // middleware lives in the same package.
 func (r *Recorder) recordHTTPDuration(ctx context.Context, seconds float64, pathClass, method string, status int) {
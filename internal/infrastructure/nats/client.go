@@ -5,6 +5,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/nats-io/nats.go"
@@ -60,12 +61,32 @@ func NewClient(cfg ClientConfig) (*Client, error) {
 
 // NewClientFromCreds creates a NATS client using credentials content directly
 func NewClientFromCreds(serverURLs []string, credsContent string, skipVerifyTLS bool) (*Client, error) {
+	return NewClientFromCredsWithTimeout(serverURLs, credsContent, skipVerifyTLS, 10*time.Second)
+}
+
+// NewClientFromCredsWithTimeout is NewClientFromCreds with a custom connection timeout
+func NewClientFromCredsWithTimeout(serverURLs []string, credsContent string, skipVerifyTLS bool, timeout time.Duration) (*Client, error) {
+	return NewClientFromCredsWithHandlers(serverURLs, credsContent, skipVerifyTLS, timeout, ConnectionHandlers{})
+}
+
+// ConnectionHandlers is notified of the state changes of a connection. Nil handlers
+// are skipped.
+type ConnectionHandlers struct {
+	Disconnected func(err error)
+	Reconnected  func()
+	Closed       func()
+}
+
+// NewClientFromCredsWithHandlers is NewClientFromCredsWithTimeout for long-lived
+// connections, notifying handlers when the connection drops, comes back or is closed.
+// Every server URL is a candidate to connect and reconnect to.
+func NewClientFromCredsWithHandlers(serverURLs []string, credsContent string, skipVerifyTLS bool, timeout time.Duration, handlers ConnectionHandlers) (*Client, error) {
 	if len(serverURLs) == 0 {
 		return nil, fmt.Errorf("at least one server URL is required")
 	}
 
 	opts := []nats.Option{
-		nats.Timeout(10 * time.Second),
+		nats.Timeout(timeout),
 		nats.Name("NATS Identity Service"),
 		nats.MaxReconnects(-1),
 		nats.ReconnectWait(2 * time.Second),
@@ -88,7 +109,23 @@ func NewClientFromCreds(serverURLs []string, credsContent string, skipVerifyTLS
 		opts = append(opts, nats.UserJWTAndSeed(jwt, seed))
 	}
 
-	nc, err := nats.Connect(serverURLs[0], opts...)
+	if handlers.Disconnected != nil {
+		opts = append(opts, nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			handlers.Disconnected(err)
+		}))
+	}
+	if handlers.Reconnected != nil {
+		opts = append(opts, nats.ReconnectHandler(func(*nats.Conn) {
+			handlers.Reconnected()
+		}))
+	}
+	if handlers.Closed != nil {
+		opts = append(opts, nats.ClosedHandler(func(*nats.Conn) {
+			handlers.Closed()
+		}))
+	}
+
+	nc, err := nats.Connect(strings.Join(serverURLs, ","), opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
 	}
@@ -206,39 +243,44 @@ func (c *Client) IsConnected() bool {
 	return c.nc != nil && c.nc.IsConnected()
 }
 
-// PushAccountJWT pushes an account JWT to the NATS resolver
-// The resolver listens on $SYS.REQ.CLAIMS.UPDATE for JWT updates
-// This matches the behavior of `nsc push`
-func (c *Client) PushAccountJWT(ctx context.Context, account *entities.Account) error {
-	if !c.IsConnected() {
-		return fmt.Errorf("not connected to NATS")
-	}
+// IsClosed returns true once the connection is closed for good and will not reconnect
+func (c *Client) IsClosed() bool {
+	return c.nc == nil || c.nc.IsClosed()
+}
 
-	// The subject for pushing account JWTs to the resolver
-	// Using the same subject as nsc push command
-	subject := "$SYS.REQ.CLAIMS.UPDATE"
+// Status returns the connection state, such as CONNECTED or RECONNECTING
+func (c *Client) Status() string {
+	if c.nc == nil {
+		return nats.CLOSED.String()
+	}
+	return c.nc.Status().String()
+}
 
-	// Create context with timeout if not already set
-	reqCtx := ctx
-	if _, ok := ctx.Deadline(); !ok {
-		var cancel context.CancelFunc
-		reqCtx, cancel = context.WithTimeout(ctx, 5*time.Second)
-		defer cancel()
+// Flush performs a round trip to the server, failing if it does not answer before ctx is done
+func (c *Client) Flush(ctx context.Context) error {
+	if !c.IsConnected() {
+		return fmt.Errorf("not connected to NATS")
 	}
+	return c.nc.FlushWithContext(ctx)
+}
 
-	// Push the JWT to the resolver
-	// The resolver expects the JWT as the message payload
-	msg, err := c.nc.RequestWithContext(reqCtx, subject, []byte(account.JWT))
+// PushAccountJWT pushes an account JWT to the NATS resolver
+// The resolver listens on $SYS.REQ.CLAIMS.UPDATE for JWT updates
+// This matches the behavior of `nsc push`, except that every server's reply is
+// collected and the push fails if any of them rejected the JWT. expected is the number
+// of servers reached, 0 if unknown.
+func (c *Client) PushAccountJWT(ctx context.Context, account *entities.Account, expected int) error {
+	acks, err := c.PushAccountJWTToServers(ctx, account, expected)
 	if err != nil {
-		return fmt.Errorf("failed to push account JWT: %w", err)
+		return err
 	}
 
-	// Check response - should be "+OK" or similar
-	if len(msg.Data) > 0 {
-		response := string(msg.Data)
-		// NATS resolver typically returns "+OK" on success or "-ERR ..." on error
-		if response[0] == '-' {
-			return fmt.Errorf("resolver error: %s", response)
+	for _, ack := range acks {
+		if !ack.OK {
+			if ack.Server.ID != "" {
+				return fmt.Errorf("server %s rejected account JWT: %s", ack.Server.Label(), ack.Error)
+			}
+			return fmt.Errorf("resolver error: %s", ack.Error)
 		}
 	}
 
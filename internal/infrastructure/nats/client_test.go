@@ -228,3 +228,199 @@ func TestParseAccountListResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestParseClaimUpdateAck(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected ServerAck
+	}{
+		{
+			name:     "legacy ok",
+			input:    "+OK",
+			expected: ServerAck{OK: true},
+		},
+		{
+			name:     "legacy error",
+			input:    "-ERR 'bad jwt'",
+			expected: ServerAck{Error: "-ERR 'bad jwt'"},
+		},
+		{
+			name:  "json success",
+			input: `{"server":{"name":"nats-1","host":"0.0.0.0","id":"NSRV1","ver":"2.10.0"},"data":{"account":"ACC","code":200,"message":"jwt updated"}}`,
+			expected: ServerAck{
+				Server: ServerInfo{ID: "NSRV1", Name: "nats-1", Host: "0.0.0.0", Version: "2.10.0"},
+				OK:     true,
+			},
+		},
+		{
+			name:  "json error",
+			input: `{"server":{"name":"nats-2","id":"NSRV2"},"error":{"account":"ACC","code":500,"description":"jwt validation failed"}}`,
+			expected: ServerAck{
+				Server: ServerInfo{ID: "NSRV2", Name: "nats-2"},
+				Error:  "resolver error 500: jwt validation failed",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseClaimUpdateAck([]byte(tt.input)))
+		})
+	}
+}
+
+func TestServerInfoLabel(t *testing.T) {
+	assert.Equal(t, "nats-1", ServerInfo{ID: "NSRV1", Name: "nats-1"}.Label())
+	assert.Equal(t, "NSRV1", ServerInfo{ID: "NSRV1", Name: "NSRV1"}.Label())
+	assert.Equal(t, "NSRV1", ServerInfo{ID: "NSRV1"}.Label())
+}
+
+func TestParseServerPing(t *testing.T) {
+	data := `{"server":{"name":"nats-1","host":"0.0.0.0","id":"NSRV1","cluster":"east","ver":"2.10.0","jetstream":true},` +
+		`"statsz":{"start":"2024-01-02T03:04:05Z","connections":12,` +
+		`"routes":[{"rid":1,"name":"nats-2"},{"rid":2,"name":"nats-3"}],"gateways":[{"gwid":1,"name":"west"}]}}`
+
+	st, ok := parseServerPing([]byte(data))
+	assert.True(t, ok)
+	assert.Equal(t, ServerInfo{ID: "NSRV1", Name: "nats-1", Host: "0.0.0.0", Cluster: "east", Version: "2.10.0", JetStream: true}, st.Server)
+	assert.Equal(t, "2024-01-02T03:04:05Z", st.Start.UTC().Format("2006-01-02T15:04:05Z"))
+	assert.Equal(t, 12, st.Connections)
+	assert.Equal(t, 2, st.Routes)
+	assert.Equal(t, []string{"west"}, st.Gateways)
+
+	_, ok = parseServerPing([]byte(`{"statsz":{}}`))
+	assert.False(t, ok)
+	_, ok = parseServerPing([]byte(`not json`))
+	assert.False(t, ok)
+}
+
+func TestParseConnzResponse(t *testing.T) {
+	data := `{"server":{"name":"nats-1","id":"NSRV1"},"data":{"total":3,"connections":[` +
+		`{"cid":7,"ip":"10.0.0.1","port":4222,"rtt":"1.2ms","subscriptions":4,"in_bytes":1024,"out_bytes":2048,` +
+		`"lang":"go","version":"1.31.0","authorized_user":"UABC","account":"AXYZ"}]}}`
+
+	conns, err := parseConnzResponse([]byte(data))
+	assert.NoError(t, err)
+	assert.Equal(t, "NSRV1", conns.Server.ID)
+	assert.Equal(t, 3, conns.Total)
+	assert.Empty(t, conns.Error)
+	if assert.Len(t, conns.Connections, 1) {
+		c := conns.Connections[0]
+		assert.Equal(t, uint64(7), c.CID)
+		assert.Equal(t, "10.0.0.1", c.IP)
+		assert.Equal(t, uint32(4), c.Subscriptions)
+		assert.Equal(t, int64(2048), c.OutBytes)
+		assert.Equal(t, "UABC", c.AuthorizedUser)
+	}
+
+	conns, err = parseConnzResponse([]byte(`{"server":{"id":"NSRV2"},"error":{"code":403,"description":"not allowed"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "server error 403: not allowed", conns.Error)
+
+	_, err = parseConnzResponse([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestParseKickResponse(t *testing.T) {
+	assert.NoError(t, parseKickResponse([]byte(`{"server":{"id":"NSRV1"}}`)))
+
+	err := parseKickResponse([]byte(`{"server":{"id":"NSRV1"},"error":{"code":400,"description":"no such client id 7"}}`))
+	assert.EqualError(t, err, "server error 400: no such client id 7")
+
+	assert.Error(t, parseKickResponse([]byte(`not json`)))
+}
+
+func TestParseJszResponse(t *testing.T) {
+	data := `{"server":{"name":"nats-1","id":"NSRV1","jetstream":true},"data":{"server_id":"NSRV1",` +
+		`"config":{"max_memory":1073741824,"max_storage":10737418240,"store_dir":"/data"},` +
+		`"memory":100,"storage":2000,"reserved_memory":512,"reserved_storage":4096,` +
+		`"account_details":[{"name":"AXYZ","id":"AXYZ","memory":100,"storage":2000,` +
+		`"stream_detail":[{"name":"ORDERS","state":{"messages":10,"bytes":2000,"consumer_count":2}}]}]}}`
+
+	info, err := parseJszResponse([]byte(data))
+	assert.NoError(t, err)
+	assert.Equal(t, "NSRV1", info.Server.ID)
+	assert.False(t, info.Disabled)
+	assert.Equal(t, int64(10737418240), info.Config.MaxStorage)
+	assert.Equal(t, uint64(4096), info.ReservedStorage)
+	if assert.Len(t, info.Accounts, 1) {
+		assert.Equal(t, "AXYZ", info.Accounts[0].Name)
+		assert.Equal(t, uint64(2000), info.Accounts[0].Storage)
+		if assert.Len(t, info.Accounts[0].Streams, 1) {
+			assert.Equal(t, "ORDERS", info.Accounts[0].Streams[0].Name)
+			assert.Equal(t, 2, info.Accounts[0].Streams[0].State.Consumers)
+		}
+	}
+
+	info, err = parseJszResponse([]byte(`{"server":{"id":"NSRV2"},"data":{"disabled":true}}`))
+	assert.NoError(t, err)
+	assert.True(t, info.Disabled)
+
+	_, err = parseJszResponse([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestParseAccountJszResponse(t *testing.T) {
+	info, err := parseAccountJszResponse([]byte(`{"server":{"id":"NSRV1","jetstream":true},"data":{"name":"AXYZ","memory":5,"storage":7}}`))
+	assert.NoError(t, err)
+	if assert.Len(t, info.Accounts, 1) {
+		assert.Equal(t, uint64(7), info.Accounts[0].Storage)
+	}
+
+	info, err = parseAccountJszResponse([]byte(`{"server":{"id":"NSRV2","jetstream":true},"error":{"code":500,"description":"account \"AXYZ\" not jetstream enabled"}}`))
+	assert.NoError(t, err)
+	assert.Empty(t, info.Accounts)
+	assert.Contains(t, info.Error, "not jetstream enabled")
+}
+
+func TestParseClientEvent(t *testing.T) {
+	data := `{"type":"io.nats.server.advisory.v1.client_connect","id":"EVT1","timestamp":"2026-03-01T10:00:00Z",` +
+		`"server":{"name":"nats-1","id":"NSRV1"},` +
+		`"client":{"start":"2026-03-01T10:00:00Z","host":"10.1.2.3","id":42,"acc":"AXYZ","user":"UXYZ","name":"worker","lang":"go","ver":"1.37.0","kind":"Client"}}`
+
+	event, err := ParseClientEvent([]byte(data))
+	assert.NoError(t, err)
+	assert.True(t, event.IsConnect())
+	assert.Equal(t, "NSRV1", event.Server.ID)
+	assert.Equal(t, "UXYZ", event.Client.User)
+	assert.Equal(t, "AXYZ", event.Client.Account)
+	assert.Equal(t, "10.1.2.3", event.Client.Host)
+	assert.Equal(t, uint64(42), event.Client.ID)
+
+	event, err = ParseClientEvent([]byte(`{"type":"io.nats.server.advisory.v1.client_disconnect","client":{"user":"UXYZ"},"reason":"Client Closed"}`))
+	assert.NoError(t, err)
+	assert.False(t, event.IsConnect())
+	assert.Equal(t, "Client Closed", event.Reason)
+
+	_, err = ParseClientEvent([]byte(`{"type":"io.nats.server.advisory.v1.account_connections"}`))
+	assert.Error(t, err)
+
+	_, err = ParseClientEvent([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestParseStatzResponse(t *testing.T) {
+	data := `{"server":{"name":"nats-1","id":"NSRV1"},"data":{"server_id":"NSRV1","now":"2026-03-01T10:00:00Z",` +
+		`"account_statz":[{"acc":"AXYZ","conns":3,"leafnodes":1,"total_conns":4,"num_subscriptions":12,` +
+		`"sent":{"msgs":100,"bytes":2048},"received":{"msgs":40,"bytes":1024},"slow_consumers":2}]}}`
+
+	stats, err := parseStatzResponse([]byte(data))
+	assert.NoError(t, err)
+	assert.Equal(t, "NSRV1", stats.Server.ID)
+	if assert.Len(t, stats.Accounts, 1) {
+		acc := stats.Accounts[0]
+		assert.Equal(t, "AXYZ", acc.Account)
+		assert.Equal(t, 3, acc.Conns)
+		assert.Equal(t, int64(100), acc.Sent.Msgs)
+		assert.Equal(t, int64(1024), acc.Received.Bytes)
+		assert.Equal(t, int64(2), acc.SlowConsumers)
+	}
+
+	stats, err = parseStatzResponse([]byte(`{"server":{"id":"NSRV2"},"error":{"code":500,"description":"boom"}}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "server error 500: boom", stats.Error)
+
+	_, err = parseStatzResponse([]byte(`not json`))
+	assert.Error(t, err)
+}
@@ -2,14 +2,31 @@ package nats
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"text/template"
+	"time"
 
+	"github.com/nats-io/nats-server/v2/server"
 	"github.com/thomas-maurice/nis/internal/domain/entities"
 )
 
 // ServerConfig represents a NATS server configuration
 type ServerConfig struct {
+	ServerName          string
+	Host                string
 	Port                int
 	HTTPPort            int
 	ClusterName         string
@@ -17,68 +34,214 @@ type ServerConfig struct {
 	Routes              []string
 	OperatorJWT         string
 	SystemAccountPubKey string
+	ResolverDir         string            // Defaults to entities.DefaultResolverDir
+	ResolverInterval    time.Duration     // 0 leaves the server default
 	ResolverPreload     map[string]string // account public key -> JWT
+
+	TLS       entities.ServerTLS
+	JetStream entities.ServerJetStream
+	Websocket entities.ServerWebsocket
+	MQTT      entities.ServerMQTT
+	Leafnode  entities.ServerLeafnode
+	Gateway   entities.ServerGateway
+	Gateways  []GatewayRemote // Other clusters of the supercluster
 }
 
-// GenerateServerConfig generates a NATS server configuration file
-func GenerateServerConfig(cfg ServerConfig) (string, error) {
-	const configTemplate = `# NATS Server Configuration
+// GatewayRemote is another cluster of the supercluster the gateway connects to
+type GatewayRemote struct {
+	Name string // Gateway name, the remote cluster's name
+	URLs []string
+}
+
+const configTemplate = `# NATS Server Configuration
 # Generated by NATS Identity Service
+{{- if .ServerName}}
+
+server_name: {{quote .ServerName}}
+{{- end}}
+{{- if .Host}}
+
+host: {{quote .Host}}
+{{- end}}
 
 # Server listening port
 port: {{.Port}}
+{{- if .HTTPPort}}
 
 # HTTP monitoring port
 http_port: {{.HTTPPort}}
+{{- end}}
+{{- if .TLS.Enabled}}
+
+# Client TLS
+tls {{tls .TLS ""}}
+{{- end}}
+{{- if .ClusterName}}
 
-{{if .ClusterName}}
 # Cluster configuration
 cluster {
   name: {{.ClusterName}}
-  {{if .ClusterPort}}
+  {{- if .ClusterPort}}
   port: {{.ClusterPort}}
-  {{end}}
-  {{if .Routes}}
+  {{- end}}
+  {{- if .Routes}}
   routes: [
-    {{range .Routes}}
-    "{{.}}",
-    {{end}}
+    {{- range .Routes}}
+    {{quote .}}
+    {{- end}}
   ]
-  {{end}}
+  {{- end}}
+  {{- if and .ClusterPort .TLS.Enabled}}
+  tls {{tls .TLS "  "}}
+  {{- end}}
 }
-{{end}}
+{{- end}}
 
 # Operator JWT-based authentication
 operator: {{.OperatorJWT}}
+{{- if .SystemAccountPubKey}}
 
-{{if .SystemAccountPubKey}}
 # System account
 system_account: {{.SystemAccountPubKey}}
-{{end}}
+{{- end}}
 
-# JWT Resolver configuration
+# JWT Resolver configuration, supports dynamic updates via $SYS.REQ.CLAIMS.UPDATE
 resolver {
   type: full
-  # Enable caching of resolved accounts
+  dir: {{quote .ResolverDir}}
   allow_delete: true
-  {{if .ResolverPreload}}
-  # Preload account JWTs
-  preload: {
-    {{range $key, $value := .ResolverPreload}}
-    {{$key}}: {{$value}}
-    {{end}}
-  }
-  {{end}}
+  {{- if .ResolverInterval}}
+  interval: {{quote .ResolverInterval.String}}
+  {{- end}}
+}
+{{- if .ResolverPreload}}
+
+# Preload account JWTs
+resolver_preload {
+  {{- range $key, $value := .ResolverPreload}}
+  {{$key}}: {{$value}}
+  {{- end}}
 }
+{{- end}}
+{{- if .JetStream.Enabled}}
+
+# JetStream configuration
+jetstream {
+  {{- if .JetStream.StoreDir}}
+  store_dir: {{quote .JetStream.StoreDir}}
+  {{- end}}
+  {{- if .JetStream.MaxMemory}}
+  max_memory_store: {{.JetStream.MaxMemory}}
+  {{- end}}
+  {{- if .JetStream.MaxStorage}}
+  max_file_store: {{.JetStream.MaxStorage}}
+  {{- end}}
+  {{- if .JetStream.Domain}}
+  domain: {{quote .JetStream.Domain}}
+  {{- end}}
+}
+{{- end}}
+{{- if .Websocket.Port}}
+
+# Websocket listener
+websocket {
+  port: {{.Websocket.Port}}
+  {{- if or .Websocket.NoTLS (not .TLS.Enabled)}}
+  no_tls: true
+  {{- else}}
+  tls {{tls .TLS "  "}}
+  {{- end}}
+  {{- if .Websocket.Compression}}
+  compression: true
+  {{- end}}
+}
+{{- end}}
+{{- if .MQTT.Port}}
+
+# MQTT listener
+mqtt {
+  port: {{.MQTT.Port}}
+  {{- if .TLS.Enabled}}
+  tls {{tls .TLS "  "}}
+  {{- end}}
+}
+{{- end}}
+{{- if .Leafnode.Port}}
+
+# Leafnode listener
+leafnodes {
+  port: {{.Leafnode.Port}}
+  {{- if .TLS.Enabled}}
+  tls {{tls .TLS "  "}}
+  {{- end}}
+}
+{{- end}}
+{{- if .Gateway.Port}}
+
+# Gateway listener
+gateway {
+  name: {{.ClusterName}}
+  port: {{.Gateway.Port}}
+  {{- if .TLS.Enabled}}
+  tls {{tls .TLS "  "}}
+  {{- end}}
+  {{- if .Gateways}}
+  gateways: [
+    {{- range .Gateways}}
+    {
+      name: {{quote .Name}}
+      urls: [
+        {{- range .URLs}}
+        {{quote .}}
+        {{- end}}
+      ]
+    }
+    {{- end}}
+  ]
+  {{- end}}
+}
+{{- end}}
 `
 
-	tmpl, err := template.New("nats-config").Parse(configTemplate)
-	if err != nil {
-		return "", fmt.Errorf("failed to parse config template: %w", err)
+var configTmpl = template.Must(template.New("nats-config").
+	Funcs(template.FuncMap{"quote": strconv.Quote, "tls": tlsBlock}).
+	Parse(configTemplate))
+
+// tlsBlock renders a tls block opened on the current line, indented for its nesting
+func tlsBlock(t entities.ServerTLS, indent string) string {
+	var b strings.Builder
+	b.WriteString("{\n")
+	fmt.Fprintf(&b, "%s  cert_file: %s\n", indent, strconv.Quote(t.CertFile))
+	fmt.Fprintf(&b, "%s  key_file: %s\n", indent, strconv.Quote(t.KeyFile))
+	if t.CAFile != "" {
+		fmt.Fprintf(&b, "%s  ca_file: %s\n", indent, strconv.Quote(t.CAFile))
+	}
+	if t.Verify {
+		fmt.Fprintf(&b, "%s  verify: true\n", indent)
+	}
+	b.WriteString(indent + "}")
+	return b.String()
+}
+
+// GenerateServerConfig generates a NATS server configuration file
+func GenerateServerConfig(cfg ServerConfig) (string, error) {
+	if cfg.ResolverDir == "" {
+		cfg.ResolverDir = entities.DefaultResolverDir
+	}
+	if cfg.Gateway.Port != 0 && cfg.ClusterName == "" {
+		return "", fmt.Errorf("a gateway requires a cluster name")
+	}
+	if len(cfg.Gateways) > 0 && cfg.Gateway.Port == 0 {
+		return "", fmt.Errorf("gateways to other clusters require a gateway port")
+	}
+	for _, gw := range cfg.Gateways {
+		if len(gw.URLs) == 0 {
+			return "", fmt.Errorf("gateway %q has no URL", gw.Name)
+		}
 	}
 
 	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, cfg); err != nil {
+	if err := configTmpl.Execute(&buf, cfg); err != nil {
 		return "", fmt.Errorf("failed to execute config template: %w", err)
 	}
 
@@ -86,6 +249,7 @@ resolver {
 }
 
 // GenerateServerConfigForCluster generates NATS server config for a cluster entity
+// from its server profile. Non-zero ports override the profile's.
 func GenerateServerConfigForCluster(
 	cluster *entities.Cluster,
 	operator *entities.Operator,
@@ -93,20 +257,153 @@ func GenerateServerConfigForCluster(
 	port int,
 	httpPort int,
 ) (string, error) {
+	cfg := ServerConfigForCluster(cluster, operator, accounts)
+	if port != 0 {
+		cfg.Port = port
+	}
+	if httpPort != 0 {
+		cfg.HTTPPort = httpPort
+	}
+
+	return GenerateServerConfig(cfg)
+}
+
+// ServerConfigForCluster builds the server configuration of a cluster from its
+// server profile, the defaults when it has none, preloading the given accounts
+func ServerConfigForCluster(cluster *entities.Cluster, operator *entities.Operator, accounts []*entities.Account) ServerConfig {
+	profile := cluster.ServerProfile
+	if profile == nil {
+		profile = entities.DefaultServerProfile()
+	}
+
 	// Build resolver preload map
 	preload := make(map[string]string)
 	for _, account := range accounts {
 		preload[account.PublicKey] = account.JWT
 	}
 
-	cfg := ServerConfig{
-		Port:                port,
-		HTTPPort:            httpPort,
+	return ServerConfig{
+		Host:                profile.Host,
+		Port:                profile.Port,
+		HTTPPort:            profile.HTTPPort,
 		ClusterName:         cluster.Name,
+		ClusterPort:         profile.ClusterPort,
+		Routes:              profile.Routes,
 		OperatorJWT:         operator.JWT,
 		SystemAccountPubKey: cluster.SystemAccountPubKey,
+		ResolverDir:         profile.Resolver.Dir,
+		ResolverInterval:    profile.Resolver.Interval,
 		ResolverPreload:     preload,
+		TLS:                 profile.TLS,
+		JetStream:           profile.JetStream,
+		Websocket:           profile.Websocket,
+		MQTT:                profile.MQTT,
+		Leafnode:            profile.Leafnode,
+		Gateway:             profile.Gateway,
 	}
+}
 
-	return GenerateServerConfig(cfg)
+// GatewayURLs derives the gateway URLs of a cluster from the client URLs of its
+// servers: same hosts, on the gateway port
+func GatewayURLs(serverURLs []string, port int) ([]string, error) {
+	return listenerURLs(serverURLs, "nats", port)
+}
+
+// listenerURLs moves the hosts of the servers' client URLs to another listener
+func listenerURLs(serverURLs []string, scheme string, port int) ([]string, error) {
+	urls := make([]string, 0, len(serverURLs))
+	for _, serverURL := range serverURLs {
+		u, err := url.Parse(serverURL)
+		if err != nil || u.Hostname() == "" {
+			return nil, fmt.Errorf("invalid server URL %q", serverURL)
+		}
+		urls = append(urls, scheme+"://"+net.JoinHostPort(u.Hostname(), strconv.Itoa(port)))
+	}
+	return urls, nil
+}
+
+// ValidateServerConfig renders the configuration and runs it through the
+// nats-server config parser and option checks. The paths refer to the NATS
+// servers' filesystem, so the check swaps the resolver directory and the TLS
+// files for temporary ones: the parser opens both.
+func ValidateServerConfig(cfg ServerConfig) error {
+	dir, err := os.MkdirTemp("", "nis-server-config-")
+	if err != nil {
+		return fmt.Errorf("failed to create validation directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	cfg.ResolverDir = filepath.Join(dir, "resolver")
+	if cfg.JetStream.StoreDir != "" {
+		cfg.JetStream.StoreDir = filepath.Join(dir, "jetstream")
+	}
+	if cfg.TLS.Enabled() {
+		if cfg.TLS.KeyFile == "" {
+			return fmt.Errorf("TLS requires a key file")
+		}
+		certFile, keyFile, err := writeValidationCertificate(dir)
+		if err != nil {
+			return err
+		}
+		cfg.TLS.CertFile, cfg.TLS.KeyFile = certFile, keyFile
+		if cfg.TLS.CAFile != "" {
+			cfg.TLS.CAFile = certFile
+		}
+	}
+
+	config, err := GenerateServerConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	opts := &server.Options{}
+	if err := opts.ProcessConfigString(config); err != nil {
+		return err
+	}
+	// NewServer runs the cross-option checks without opening any listener
+	srv, err := server.NewServer(opts)
+	if err != nil {
+		return err
+	}
+	if resolver := srv.AccountResolver(); resolver != nil {
+		resolver.Close()
+	}
+	return nil
+}
+
+// writeValidationCertificate writes a throwaway self-signed certificate and its key
+func writeValidationCertificate(dir string) (certFile, keyFile string, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to generate validation key: %w", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "nis-validation"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create validation certificate: %w", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to encode validation key: %w", err)
+	}
+
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		return "", "", err
+	}
+	return certFile, keyFile, nil
 }
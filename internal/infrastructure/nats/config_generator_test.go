@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nkeys"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/thomas-maurice/nis/internal/domain/entities"
@@ -137,3 +139,182 @@ func TestGenerateServerConfig_ValidFormat(t *testing.T) {
 	assert.True(t, strings.Contains(config, "operator:"))
 	assert.True(t, strings.Contains(config, "resolver {"))
 }
+
+// testOperatorJWTs issues an operator JWT and the JWT of its system account
+func testOperatorJWTs(t *testing.T) (operatorJWT, sysAccountPub, sysAccountJWT string) {
+	t.Helper()
+
+	operatorKP, err := nkeys.CreateOperator()
+	require.NoError(t, err)
+	operatorPub, err := operatorKP.PublicKey()
+	require.NoError(t, err)
+	accountKP, err := nkeys.CreateAccount()
+	require.NoError(t, err)
+	sysAccountPub, err = accountKP.PublicKey()
+	require.NoError(t, err)
+
+	operatorClaims := jwt.NewOperatorClaims(operatorPub)
+	operatorClaims.SystemAccount = sysAccountPub
+	operatorJWT, err = operatorClaims.Encode(operatorKP)
+	require.NoError(t, err)
+	sysAccountJWT, err = jwt.NewAccountClaims(sysAccountPub).Encode(operatorKP)
+	require.NoError(t, err)
+	return operatorJWT, sysAccountPub, sysAccountJWT
+}
+
+func TestValidateServerConfig(t *testing.T) {
+	operatorJWT, sysAccountPub, sysAccountJWT := testOperatorJWTs(t)
+	operator := &entities.Operator{Name: "test", JWT: operatorJWT}
+	sysAccount := &entities.Account{PublicKey: sysAccountPub, JWT: sysAccountJWT}
+
+	fullProfile := entities.DefaultServerProfile()
+	fullProfile.ClusterPort = 6222
+	fullProfile.Routes = []string{"nats-route://nats-1:6222", "nats-route://nats-2:6222"}
+	fullProfile.TLS = entities.ServerTLS{CertFile: "/etc/nats/tls.crt", KeyFile: "/etc/nats/tls.key", CAFile: "/etc/nats/ca.crt"}
+	fullProfile.JetStream.MaxMemory = 1 << 30
+	fullProfile.JetStream.MaxStorage = 10 << 30
+	fullProfile.JetStream.Domain = "hub"
+	fullProfile.Resolver.Interval = 5 * time.Minute
+	fullProfile.Websocket = entities.ServerWebsocket{Port: 8080, Compression: true}
+	fullProfile.MQTT.Port = 1883
+	fullProfile.Leafnode.Port = 7422
+	fullProfile.Gateway.Port = 7222
+
+	noJetStream := entities.DefaultServerProfile()
+	noJetStream.JetStream.Enabled = false
+	noJetStream.MQTT.Port = 1883
+
+	tests := []struct {
+		name     string
+		profile  *entities.ServerProfile
+		wantErr  bool
+		contains []string
+	}{
+		{
+			name:     "default profile",
+			contains: []string{"port: 4222", `dir: "/resolver"`, `store_dir: "/data/jetstream"`, "resolver_preload"},
+		},
+		{
+			name:    "every block",
+			profile: fullProfile,
+			contains: []string{
+				`cert_file: "/etc/nats/tls.crt"`, `interval: "5m0s"`, `domain: "hub"`, "max_file_store: 10737418240",
+				"websocket {", "compression: true", "mqtt {", "leafnodes {", "gateway {", `"nats-route://nats-2:6222"`,
+			},
+		},
+		{
+			name:    "MQTT without JetStream",
+			profile: noJetStream,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &entities.Cluster{Name: "hub", SystemAccountPubKey: sysAccountPub, ServerProfile: tt.profile}
+			cfg := ServerConfigForCluster(cluster, operator, []*entities.Account{sysAccount})
+			cfg.ServerName = "nats-1"
+
+			err := ValidateServerConfig(cfg)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			config, err := GenerateServerConfig(cfg)
+			require.NoError(t, err)
+			for _, s := range tt.contains {
+				assert.Contains(t, config, s)
+			}
+		})
+	}
+}
+
+func TestGenerateLeafnodeConfig(t *testing.T) {
+	remote := LeafnodeRemote{
+		URLs:         []string{"nats-leaf://hub-1:7422", "nats-leaf://hub-2:7422"},
+		Credentials:  "/etc/nats/edge.creds",
+		LocalAccount: "LOCAL",
+		CAFile:       "/etc/nats/ca.pem",
+	}
+
+	config, err := GenerateLeafnodeConfig(remote)
+	require.NoError(t, err)
+	assert.Contains(t, config, `"nats-leaf://hub-1:7422"`)
+	assert.Contains(t, config, `credentials: "/etc/nats/edge.creds"`)
+	assert.Contains(t, config, `account: "LOCAL"`)
+	assert.Contains(t, config, `ca_file: "/etc/nats/ca.pem"`)
+	require.NoError(t, ValidateLeafnodeConfig(remote))
+
+	// The account and TLS lines are only emitted when set
+	config, err = GenerateLeafnodeConfig(LeafnodeRemote{URLs: remote.URLs, Credentials: remote.Credentials})
+	require.NoError(t, err)
+	assert.NotContains(t, config, "account:")
+	assert.NotContains(t, config, "tls")
+
+	_, err = GenerateLeafnodeConfig(LeafnodeRemote{Credentials: remote.Credentials})
+	assert.Error(t, err)
+	_, err = GenerateLeafnodeConfig(LeafnodeRemote{URLs: remote.URLs})
+	assert.Error(t, err)
+
+	// The parser rejects URLs it cannot use
+	assert.Error(t, ValidateLeafnodeConfig(LeafnodeRemote{URLs: []string{"nats-leaf://hub:port"}, Credentials: remote.Credentials}))
+}
+
+func TestLeafnodeURLs(t *testing.T) {
+	urls, err := LeafnodeURLs([]string{"nats://hub-1:4222", "tls://10.0.0.2:4222", "nats://[::1]:4222"}, 7422, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nats-leaf://hub-1:7422", "nats-leaf://10.0.0.2:7422", "nats-leaf://[::1]:7422"}, urls)
+
+	urls, err = LeafnodeURLs([]string{"nats://hub-1:4222"}, 7422, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tls://hub-1:7422"}, urls)
+
+	_, err = LeafnodeURLs([]string{"hub-1:4222"}, 7422, false)
+	assert.Error(t, err)
+}
+
+func TestGenerateServerConfig_Gateways(t *testing.T) {
+	operatorJWT, sysAccountPub, sysAccountJWT := testOperatorJWTs(t)
+	operator := &entities.Operator{Name: "test", JWT: operatorJWT}
+	sysAccount := &entities.Account{PublicKey: sysAccountPub, JWT: sysAccountJWT}
+
+	profile := entities.DefaultServerProfile()
+	profile.Gateway.Port = 7222
+	cluster := &entities.Cluster{Name: "west", SystemAccountPubKey: sysAccountPub, ServerProfile: profile}
+
+	cfg := ServerConfigForCluster(cluster, operator, []*entities.Account{sysAccount})
+	cfg.Gateways = []GatewayRemote{
+		{Name: "east", URLs: []string{"nats://east-1:7222", "nats://east-2:7222"}},
+		{Name: "south", URLs: []string{"nats://south-1:7222"}},
+	}
+	require.NoError(t, ValidateServerConfig(cfg))
+
+	config, err := GenerateServerConfig(cfg)
+	require.NoError(t, err)
+	assert.Contains(t, config, "name: west")
+	assert.Contains(t, config, `name: "east"`)
+	assert.Contains(t, config, `"nats://east-2:7222"`)
+	assert.Contains(t, config, `name: "south"`)
+
+	// Remote gateways need the local listener and at least one URL each
+	noPort := cfg
+	noPort.Gateway.Port = 0
+	_, err = GenerateServerConfig(noPort)
+	assert.Error(t, err)
+
+	noURL := cfg
+	noURL.Gateways = []GatewayRemote{{Name: "east"}}
+	_, err = GenerateServerConfig(noURL)
+	assert.Error(t, err)
+}
+
+func TestGatewayURLs(t *testing.T) {
+	urls, err := GatewayURLs([]string{"nats://east-1:4222", "tls://10.0.0.2:4222"}, 7222)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"nats://east-1:7222", "nats://10.0.0.2:7222"}, urls)
+
+	_, err = GatewayURLs([]string{"east-1:4222"}, 7222)
+	assert.Error(t, err)
+}
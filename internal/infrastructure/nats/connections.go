@@ -0,0 +1,168 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Connection is a client connection as reported by CONNZ
+type Connection struct {
+	CID            uint64    `json:"cid"`
+	IP             string    `json:"ip"`
+	Port           int       `json:"port"`
+	Start          time.Time `json:"start"`
+	LastActivity   time.Time `json:"last_activity"`
+	RTT            string    `json:"rtt,omitempty"`
+	Uptime         string    `json:"uptime"`
+	InMsgs         int64     `json:"in_msgs"`
+	OutMsgs        int64     `json:"out_msgs"`
+	InBytes        int64     `json:"in_bytes"`
+	OutBytes       int64     `json:"out_bytes"`
+	Subscriptions  uint32    `json:"subscriptions"`
+	Name           string    `json:"name,omitempty"`
+	Lang           string    `json:"lang,omitempty"`
+	Version        string    `json:"version,omitempty"`
+	AuthorizedUser string    `json:"authorized_user,omitempty"` // User public key for JWT users
+	Account        string    `json:"account,omitempty"`
+	IssuerKey      string    `json:"issuer_key,omitempty"` // Key that signed the user JWT
+}
+
+// ServerConnections is one server's answer to a CONNZ request
+type ServerConnections struct {
+	Server      ServerInfo
+	Total       int // Matching connections on the server, may exceed len(Connections)
+	Connections []Connection
+	Error       string
+}
+
+// connzRequest is the subset of the CONNZ options we send
+type connzRequest struct {
+	Auth  bool   `json:"auth"`
+	Limit int    `json:"limit,omitempty"`
+	User  string `json:"user,omitempty"`
+}
+
+// connzResponse mirrors a single server's reply to a CONNZ request
+type connzResponse struct {
+	Server *ServerInfo `json:"server"`
+	Data   *struct {
+		Total       int          `json:"total"`
+		Connections []Connection `json:"connections"`
+	} `json:"data,omitempty"`
+	Error *struct {
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"error,omitempty"`
+}
+
+// ListAccountConnections asks every server for the client connections of an account via
+// $SYS.REQ.ACCOUNT.<pk>.CONNZ. userPublicKey, when set, only returns that user's
+// connections. limit caps the connections returned per server, 0 for the server default.
+// expected is the number of servers in the cluster, 0 if unknown.
+func (c *Client) ListAccountConnections(ctx context.Context, accountPublicKey, userPublicKey string, limit, expected int) ([]ServerConnections, error) {
+	req, err := json.Marshal(connzRequest{Auth: true, Limit: limit, User: userPublicKey})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode CONNZ request: %w", err)
+	}
+
+	subject := fmt.Sprintf("$SYS.REQ.ACCOUNT.%s.CONNZ", accountPublicKey)
+	msgs, err := c.gather(ctx, subject, req, expected)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list connections: %w", err)
+	}
+
+	seen := make(map[string]bool, len(msgs))
+	result := make([]ServerConnections, 0, len(msgs))
+	for _, msg := range msgs {
+		conns, err := parseConnzResponse(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		if seen[conns.Server.ID] {
+			continue
+		}
+		seen[conns.Server.ID] = true
+		result = append(result, conns)
+	}
+
+	return result, nil
+}
+
+// parseConnzResponse decodes a single CONNZ reply
+func parseConnzResponse(data []byte) (ServerConnections, error) {
+	var resp connzResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return ServerConnections{}, fmt.Errorf("failed to parse CONNZ response: %w", err)
+	}
+
+	var conns ServerConnections
+	if resp.Server != nil {
+		conns.Server = *resp.Server
+	}
+	if resp.Error != nil {
+		conns.Error = fmt.Sprintf("server error %d: %s", resp.Error.Code, resp.Error.Description)
+	}
+	if resp.Data != nil {
+		conns.Total = resp.Data.Total
+		conns.Connections = resp.Data.Connections
+	}
+
+	return conns, nil
+}
+
+// kickRequest is the body of a $SYS.REQ.SERVER.<id>.KICK request
+type kickRequest struct {
+	CID uint64 `json:"cid"`
+}
+
+// kickResponse mirrors a server's reply to a KICK request
+type kickResponse struct {
+	Server *ServerInfo `json:"server"`
+	Error  *struct {
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"error,omitempty"`
+}
+
+// KickClient disconnects the client connection cid from the server serverID via
+// $SYS.REQ.SERVER.<id>.KICK. The client is free to reconnect if its credentials are
+// still valid.
+func (c *Client) KickClient(ctx context.Context, serverID string, cid uint64) error {
+	if !c.IsConnected() {
+		return fmt.Errorf("not connected to NATS")
+	}
+
+	req, err := json.Marshal(kickRequest{CID: cid})
+	if err != nil {
+		return fmt.Errorf("failed to encode KICK request: %w", err)
+	}
+
+	reqCtx := ctx
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		reqCtx, cancel = context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+	}
+
+	subject := fmt.Sprintf("$SYS.REQ.SERVER.%s.KICK", serverID)
+	msg, err := c.nc.RequestWithContext(reqCtx, subject, req)
+	if err != nil {
+		return fmt.Errorf("failed to kick client %d: %w", cid, err)
+	}
+
+	return parseKickResponse(msg.Data)
+}
+
+// parseKickResponse returns the error reported in a KICK reply, if any
+func parseKickResponse(data []byte) error {
+	var resp kickResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return fmt.Errorf("failed to parse KICK response: %w", err)
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("server error %d: %s", resp.Error.Code, resp.Error.Description)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package nats
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// System event subjects published by the servers for every account
+const (
+	ConnectEventSubject    = "$SYS.ACCOUNT.*.CONNECT"
+	DisconnectEventSubject = "$SYS.ACCOUNT.*.DISCONNECT"
+	// AuthErrorEventSubject carries a disconnect event for every client that failed
+	// to authenticate, its reason describes the failure
+	AuthErrorEventSubject = "$SYS.SERVER.*.CLIENT.AUTH.ERR"
+)
+
+// System advisory types carried by the connect and disconnect events
+const (
+	ConnectEventType    = "io.nats.server.advisory.v1.client_connect"
+	DisconnectEventType = "io.nats.server.advisory.v1.client_disconnect"
+)
+
+// EventClient is the client a system event is about
+type EventClient struct {
+	Start   time.Time `json:"start"`
+	Host    string    `json:"host"` // Remote address
+	ID      uint64    `json:"id"`   // Connection ID on the server
+	Account string    `json:"acc"`  // Account public key
+	User    string    `json:"user"` // User public key for JWT users
+	Name    string    `json:"name,omitempty"`
+	Lang    string    `json:"lang,omitempty"`
+	Version string    `json:"ver,omitempty"`
+	Kind    string    `json:"kind,omitempty"`
+}
+
+// ClientEvent is a client connect or disconnect event of $SYS.ACCOUNT.<pk>.CONNECT
+// and $SYS.ACCOUNT.<pk>.DISCONNECT
+type ClientEvent struct {
+	Type      string      `json:"type"`
+	ID        string      `json:"id"`
+	Timestamp time.Time   `json:"timestamp"`
+	Server    ServerInfo  `json:"server"`
+	Client    EventClient `json:"client"`
+	Reason    string      `json:"reason,omitempty"` // Disconnect reason
+}
+
+// IsConnect reports whether the event is a client connection
+func (e *ClientEvent) IsConnect() bool {
+	return e.Type == ConnectEventType
+}
+
+// Subscribe delivers the messages published on subject to handler until the client is
+// closed. The handler runs on the subscription's goroutine, one message at a time.
+func (c *Client) Subscribe(subject string, handler func(subject string, data []byte)) error {
+	if !c.IsConnected() {
+		return fmt.Errorf("not connected to NATS")
+	}
+
+	_, err := c.nc.Subscribe(subject, func(msg *nats.Msg) {
+		handler(msg.Subject, msg.Data)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+	}
+
+	return nil
+}
+
+// ParseClientEvent decodes a connect or disconnect event
+func ParseClientEvent(data []byte) (*ClientEvent, error) {
+	var event ClientEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return nil, fmt.Errorf("failed to parse client event: %w", err)
+	}
+	if event.Type != ConnectEventType && event.Type != DisconnectEventType {
+		return nil, fmt.Errorf("unexpected client event type %q", event.Type)
+	}
+
+	return &event, nil
+}
@@ -0,0 +1,194 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// JetStreamConfig is the JetStream configuration of a server as reported by JSZ
+type JetStreamConfig struct {
+	MaxMemory  int64  `json:"max_memory"`
+	MaxStorage int64  `json:"max_storage"`
+	StoreDir   string `json:"store_dir,omitempty"`
+}
+
+// JetStreamStream is a stream reported in the account details of JSZ
+type JetStreamStream struct {
+	Name  string `json:"name"`
+	State struct {
+		Messages  uint64 `json:"messages"`
+		Bytes     uint64 `json:"bytes"`
+		Consumers int    `json:"consumer_count"`
+	} `json:"state"`
+}
+
+// JetStreamAccount is the JetStream usage of an account on a single server
+type JetStreamAccount struct {
+	Name            string            `json:"name"` // Account public key for JWT accounts
+	Memory          uint64            `json:"memory"`
+	Storage         uint64            `json:"storage"`
+	ReservedMemory  uint64            `json:"reserved_memory"`
+	ReservedStorage uint64            `json:"reserved_storage"`
+	Streams         []JetStreamStream `json:"stream_detail,omitempty"`
+}
+
+// ServerJetStream is one server's answer to a JSZ request
+type ServerJetStream struct {
+	Server          ServerInfo
+	Disabled        bool // JetStream is not enabled on the server
+	Config          JetStreamConfig
+	Memory          uint64
+	Storage         uint64
+	ReservedMemory  uint64
+	ReservedStorage uint64
+	Accounts        []JetStreamAccount
+	Error           string
+}
+
+// jszRequest is the subset of the JSZ options we send
+type jszRequest struct {
+	Accounts bool `json:"accounts,omitempty"`
+	Streams  bool `json:"streams,omitempty"`
+	Limit    int  `json:"limit,omitempty"`
+}
+
+// jszError is the error object of JSZ replies
+type jszError struct {
+	Code        int    `json:"code"`
+	Description string `json:"description"`
+}
+
+// jszResponse mirrors a single server's reply to $SYS.REQ.SERVER.PING.JSZ
+type jszResponse struct {
+	Server *ServerInfo `json:"server"`
+	Data   *struct {
+		Disabled        bool               `json:"disabled,omitempty"`
+		Config          JetStreamConfig    `json:"config"`
+		Memory          uint64             `json:"memory"`
+		Storage         uint64             `json:"storage"`
+		ReservedMemory  uint64             `json:"reserved_memory"`
+		ReservedStorage uint64             `json:"reserved_storage"`
+		Accounts        []JetStreamAccount `json:"account_details,omitempty"`
+	} `json:"data,omitempty"`
+	Error *jszError `json:"error,omitempty"`
+}
+
+// accountJszResponse mirrors a single server's reply to $SYS.REQ.ACCOUNT.<pk>.JSZ
+type accountJszResponse struct {
+	Server *ServerInfo       `json:"server"`
+	Data   *JetStreamAccount `json:"data,omitempty"`
+	Error  *jszError         `json:"error,omitempty"`
+}
+
+// JetStreamInfo asks every server for its JetStream state via $SYS.REQ.SERVER.PING.JSZ.
+// When accounts is true, the per-account usage and streams of up to limit accounts are
+// included. expected is the number of servers in the cluster, 0 if unknown.
+func (c *Client) JetStreamInfo(ctx context.Context, accounts bool, limit, expected int) ([]ServerJetStream, error) {
+	req, err := json.Marshal(jszRequest{Accounts: accounts, Streams: accounts, Limit: limit})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JSZ request: %w", err)
+	}
+
+	msgs, err := c.gather(ctx, "$SYS.REQ.SERVER.PING.JSZ", req, expected)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get JetStream info: %w", err)
+	}
+
+	seen := make(map[string]bool, len(msgs))
+	result := make([]ServerJetStream, 0, len(msgs))
+	for _, msg := range msgs {
+		info, err := parseJszResponse(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		if seen[info.Server.ID] {
+			continue
+		}
+		seen[info.Server.ID] = true
+		result = append(result, info)
+	}
+
+	return result, nil
+}
+
+// AccountJetStreamInfo asks every server for the JetStream usage and streams of an
+// account via $SYS.REQ.ACCOUNT.<pk>.JSZ. Servers where the account has no JetStream
+// state answer with an error, which is returned in ServerJetStream.Error.
+func (c *Client) AccountJetStreamInfo(ctx context.Context, accountPublicKey string, expected int) ([]ServerJetStream, error) {
+	req, err := json.Marshal(jszRequest{Streams: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode JSZ request: %w", err)
+	}
+
+	subject := fmt.Sprintf("$SYS.REQ.ACCOUNT.%s.JSZ", accountPublicKey)
+	msgs, err := c.gather(ctx, subject, req, expected)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account JetStream info: %w", err)
+	}
+
+	seen := make(map[string]bool, len(msgs))
+	result := make([]ServerJetStream, 0, len(msgs))
+	for _, msg := range msgs {
+		info, err := parseAccountJszResponse(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		if seen[info.Server.ID] {
+			continue
+		}
+		seen[info.Server.ID] = true
+		result = append(result, info)
+	}
+
+	return result, nil
+}
+
+// parseJszResponse decodes a single server JSZ reply
+func parseJszResponse(data []byte) (ServerJetStream, error) {
+	var resp jszResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return ServerJetStream{}, fmt.Errorf("failed to parse JSZ response: %w", err)
+	}
+
+	var info ServerJetStream
+	if resp.Server != nil {
+		info.Server = *resp.Server
+	}
+	if resp.Error != nil {
+		info.Error = fmt.Sprintf("server error %d: %s", resp.Error.Code, resp.Error.Description)
+	}
+	if resp.Data != nil {
+		info.Disabled = resp.Data.Disabled
+		info.Config = resp.Data.Config
+		info.Memory = resp.Data.Memory
+		info.Storage = resp.Data.Storage
+		info.ReservedMemory = resp.Data.ReservedMemory
+		info.ReservedStorage = resp.Data.ReservedStorage
+		info.Accounts = resp.Data.Accounts
+	}
+
+	return info, nil
+}
+
+// parseAccountJszResponse decodes a single account JSZ reply
+func parseAccountJszResponse(data []byte) (ServerJetStream, error) {
+	var resp accountJszResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return ServerJetStream{}, fmt.Errorf("failed to parse JSZ response: %w", err)
+	}
+
+	var info ServerJetStream
+	if resp.Server != nil {
+		info.Server = *resp.Server
+		info.Disabled = !resp.Server.JetStream
+	}
+	if resp.Error != nil {
+		info.Error = fmt.Sprintf("server error %d: %s", resp.Error.Code, resp.Error.Description)
+	}
+	if resp.Data != nil {
+		info.Accounts = []JetStreamAccount{*resp.Data}
+	}
+
+	return info, nil
+}
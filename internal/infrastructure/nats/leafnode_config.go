@@ -0,0 +1,102 @@
+package nats
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strconv"
+	"text/template"
+
+	"github.com/nats-io/nats-server/v2/server"
+)
+
+// LeafnodeRemote describes the leafnode remote an edge server opens to a hub cluster
+type LeafnodeRemote struct {
+	URLs         []string
+	Credentials  string // Path of the .creds file on the leaf server
+	LocalAccount string // Account of the leaf server bound to the remote, empty for its default account
+	CAFile       string // CA verifying the hub's certificate
+}
+
+const leafnodeTemplate = `# NATS leafnode remote
+# Generated by NATS Identity Service
+leafnodes {
+  remotes: [
+    {
+      urls: [
+        {{- range .URLs}}
+        {{quote .}}
+        {{- end}}
+      ]
+      credentials: {{quote .Credentials}}
+      {{- if .LocalAccount}}
+      account: {{quote .LocalAccount}}
+      {{- end}}
+      {{- if .CAFile}}
+      tls {
+        ca_file: {{quote .CAFile}}
+      }
+      {{- end}}
+    }
+  ]
+}
+`
+
+var leafnodeTmpl = template.Must(template.New("nats-leafnode").
+	Funcs(template.FuncMap{"quote": strconv.Quote}).
+	Parse(leafnodeTemplate))
+
+// GenerateLeafnodeConfig generates the leafnodes block of an edge server connecting
+// to a hub with the given remote
+func GenerateLeafnodeConfig(remote LeafnodeRemote) (string, error) {
+	if len(remote.URLs) == 0 {
+		return "", fmt.Errorf("a leafnode remote requires at least one URL")
+	}
+	if remote.Credentials == "" {
+		return "", fmt.Errorf("a leafnode remote requires a credentials file")
+	}
+
+	var buf bytes.Buffer
+	if err := leafnodeTmpl.Execute(&buf, remote); err != nil {
+		return "", fmt.Errorf("failed to execute leafnode template: %w", err)
+	}
+
+	return buf.String(), nil
+}
+
+// ValidateLeafnodeConfig renders the leafnodes block and runs it through the
+// nats-server config parser. The CA file refers to the leaf server's filesystem and
+// is opened by the parser, so the check swaps it for a temporary one.
+func ValidateLeafnodeConfig(remote LeafnodeRemote) error {
+	if remote.CAFile != "" {
+		dir, err := os.MkdirTemp("", "nis-leafnode-config-")
+		if err != nil {
+			return fmt.Errorf("failed to create validation directory: %w", err)
+		}
+		defer func() { _ = os.RemoveAll(dir) }()
+
+		certFile, _, err := writeValidationCertificate(dir)
+		if err != nil {
+			return err
+		}
+		remote.CAFile = certFile
+	}
+
+	config, err := GenerateLeafnodeConfig(remote)
+	if err != nil {
+		return err
+	}
+
+	opts := &server.Options{}
+	return opts.ProcessConfigString(config)
+}
+
+// LeafnodeURLs derives the leafnode URLs of a hub from the client URLs of its servers:
+// same hosts, on the leafnode port, over TLS when the hub serves it
+func LeafnodeURLs(serverURLs []string, port int, tls bool) ([]string, error) {
+	scheme := "nats-leaf"
+	if tls {
+		scheme = "tls"
+	}
+	return listenerURLs(serverURLs, scheme, port)
+}
@@ -0,0 +1,227 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+)
+
+const (
+	// gatherTimeout bounds how long a fan-out request waits for replies when the
+	// context carries no deadline, so a server that never answers costs at most this
+	// much per request
+	gatherTimeout = 5 * time.Second
+	// gatherStall is how long a fan-out request keeps listening after the last
+	// reply when the number of servers is not known up front
+	gatherStall = 250 * time.Millisecond
+	// gatherExpectedStall is how long a fan-out request keeps listening after the last
+	// reply when some of the expected servers did not answer yet, so a server that is
+	// down does not hold every request until the deadline
+	gatherExpectedStall = 2 * time.Second
+)
+
+// ServerAck is one server's answer to a $SYS.REQ.CLAIMS.UPDATE request.
+// Server is empty when the resolver answered in the legacy "+OK" format.
+type ServerAck struct {
+	Server ServerInfo
+	OK     bool
+	Error  string
+}
+
+// ServerAccounts is one server's answer to a $SYS.REQ.CLAIMS.LIST request
+type ServerAccounts struct {
+	Server   ServerInfo
+	Accounts []string
+}
+
+// claimUpdateResponse mirrors the JSON reply of the full resolver to claim updates
+type claimUpdateResponse struct {
+	Server *ServerInfo `json:"server"`
+	Data   *struct {
+		Account string `json:"account"`
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"data,omitempty"`
+	Error *struct {
+		Account     string `json:"account"`
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"error,omitempty"`
+}
+
+// serverListResponse mirrors the JSON reply of a single server to $SYS.REQ.CLAIMS.LIST
+type serverListResponse struct {
+	Server *ServerInfo `json:"server"`
+	Data   []string    `json:"data"`
+}
+
+// gather publishes a request and collects every reply instead of only the first one.
+// It returns once expected replies arrived (when expected > 0), when no reply arrived
+// for gatherStall after the last one (gatherExpectedStall when expected > 0), or at
+// the deadline of ctx, gatherTimeout when it has none. Getting no reply at all is an
+// error.
+func (c *Client) gather(ctx context.Context, subject string, data []byte, expected int) ([]*nats.Msg, error) {
+	if !c.IsConnected() {
+		return nil, fmt.Errorf("not connected to NATS")
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, gatherTimeout)
+		defer cancel()
+	}
+
+	inbox := c.nc.NewRespInbox()
+	sub, err := c.nc.SubscribeSync(inbox)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to reply inbox: %w", err)
+	}
+	defer func() { _ = sub.Unsubscribe() }()
+
+	if err := c.nc.PublishRequest(subject, inbox, data); err != nil {
+		return nil, fmt.Errorf("failed to publish request: %w", err)
+	}
+
+	var msgs []*nats.Msg
+	for expected <= 0 || len(msgs) < expected {
+		waitCtx := ctx
+		cancel := context.CancelFunc(func() {})
+		if len(msgs) > 0 {
+			stall := gatherStall
+			if expected > 0 {
+				stall = gatherExpectedStall
+			}
+			waitCtx, cancel = context.WithTimeout(ctx, stall)
+		}
+		msg, err := sub.NextMsgWithContext(waitCtx)
+		cancel()
+		if err != nil {
+			break
+		}
+		if len(msg.Data) == 0 && msg.Header.Get("Status") == "503" {
+			return nil, nats.ErrNoResponders
+		}
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) == 0 {
+		if err := ctx.Err(); err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			return nil, err
+		}
+		return nil, nats.ErrTimeout
+	}
+
+	return msgs, nil
+}
+
+// PushAccountJWTToServers pushes an account JWT via $SYS.REQ.CLAIMS.UPDATE and returns
+// the answer of every server that replied. expected is the number of servers in the
+// cluster, 0 if unknown. The returned error only covers transport failures; servers
+// rejecting the JWT are reported in the acks.
+func (c *Client) PushAccountJWTToServers(ctx context.Context, account *entities.Account, expected int) ([]ServerAck, error) {
+	msgs, err := c.gather(ctx, "$SYS.REQ.CLAIMS.UPDATE", []byte(account.JWT), expected)
+	if err != nil {
+		return nil, fmt.Errorf("failed to push account JWT: %w", err)
+	}
+
+	acks := make([]ServerAck, 0, len(msgs))
+	for _, msg := range msgs {
+		acks = append(acks, parseClaimUpdateAck(msg.Data))
+	}
+
+	return acks, nil
+}
+
+// parseClaimUpdateAck decodes a single claim update reply, either in the JSON format
+// of the full resolver or in the legacy "+OK" / "-ERR" format
+func parseClaimUpdateAck(data []byte) ServerAck {
+	if len(data) == 0 {
+		return ServerAck{OK: true}
+	}
+
+	if data[0] != '{' {
+		response := string(data)
+		if response[0] == '-' {
+			return ServerAck{Error: response}
+		}
+		return ServerAck{OK: true}
+	}
+
+	var resp claimUpdateResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return ServerAck{Error: fmt.Sprintf("invalid resolver response: %v", err)}
+	}
+
+	ack := ServerAck{OK: resp.Error == nil}
+	if resp.Server != nil {
+		ack.Server = *resp.Server
+	}
+	if resp.Error != nil {
+		ack.Error = fmt.Sprintf("resolver error %d: %s", resp.Error.Code, resp.Error.Description)
+	}
+
+	return ack
+}
+
+// LookupAccountJWTs asks every resolver for its copy of an account JWT. An empty string
+// in the result means a server replied that it does not hold the account. The resolver
+// does not say which server sent which copy; use ListAccountsByServer for that.
+func (c *Client) LookupAccountJWTs(ctx context.Context, publicKey string, expected int) ([]string, error) {
+	subject := fmt.Sprintf("$SYS.REQ.ACCOUNT.%s.CLAIMS.LOOKUP", publicKey)
+	msgs, err := c.gather(ctx, subject, nil, expected)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up account JWT: %w", err)
+	}
+
+	copies := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		copies = append(copies, trim(string(msg.Data)))
+	}
+
+	return copies, nil
+}
+
+// ListAccountsByServer asks every resolver for the accounts it holds and returns one
+// entry per server. Replies in the legacy format carry no server information.
+func (c *Client) ListAccountsByServer(ctx context.Context, expected int) ([]ServerAccounts, error) {
+	msgs, err := c.gather(ctx, "$SYS.REQ.CLAIMS.LIST", nil, expected)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accounts from resolver: %w", err)
+	}
+
+	lists := make([]ServerAccounts, 0, len(msgs))
+	for _, msg := range msgs {
+		if len(msg.Data) == 0 || msg.Data[0] != '{' {
+			lists = append(lists, ServerAccounts{Accounts: splitNonEmptyLines(string(msg.Data))})
+			continue
+		}
+
+		var resp serverListResponse
+		if err := json.Unmarshal(msg.Data, &resp); err != nil {
+			return nil, fmt.Errorf("failed to parse claims list response: %w", err)
+		}
+		list := ServerAccounts{Accounts: resp.Data}
+		if resp.Server != nil {
+			list.Server = *resp.Server
+		}
+		lists = append(lists, list)
+	}
+
+	return lists, nil
+}
+
+// splitNonEmptyLines splits a newline separated list and drops blank entries
+func splitNonEmptyLines(s string) []string {
+	var lines []string
+	for _, line := range splitLines(s) {
+		if line = trim(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,39 @@
+package nats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGather_StopsWhenRepliesStall(t *testing.T) {
+	srv, err := server.NewServer(&server.Options{Host: "127.0.0.1", Port: -1, NoLog: true, NoSigs: true})
+	require.NoError(t, err)
+	srv.Start()
+	defer srv.Shutdown()
+	require.True(t, srv.ReadyForConnections(5*time.Second))
+
+	nc, err := nats.Connect(srv.ClientURL())
+	require.NoError(t, err)
+	defer nc.Close()
+	_, err = nc.Subscribe("test.gather", func(msg *nats.Msg) {
+		_ = msg.Respond([]byte("+OK"))
+	})
+	require.NoError(t, err)
+	require.NoError(t, nc.Flush())
+
+	// One server answers out of three expected: gather gives up after the stall,
+	// well before the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	start := time.Now()
+	msgs, err := (&Client{nc: nc}).gather(ctx, "test.gather", nil, 3)
+	require.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	assert.Less(t, time.Since(start), gatherExpectedStall+time.Second)
+}
@@ -0,0 +1,107 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ServerInfo identifies the NATS server that answered a system request
+type ServerInfo struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Host      string `json:"host"`
+	Cluster   string `json:"cluster,omitempty"`
+	Version   string `json:"ver"`
+	JetStream bool   `json:"jetstream"`
+}
+
+// Label returns a human readable identifier for the server
+func (s ServerInfo) Label() string {
+	if s.Name != "" && s.Name != s.ID {
+		return s.Name
+	}
+	return s.ID
+}
+
+// ServerStats is a server's answer to $SYS.REQ.SERVER.PING
+type ServerStats struct {
+	Server      ServerInfo
+	Start       time.Time
+	Connections int
+	Routes      int
+	Gateways    []string
+}
+
+// serverPingResponse mirrors the part of the $SYS.REQ.SERVER.PING reply we use
+type serverPingResponse struct {
+	Server ServerInfo `json:"server"`
+	Stats  struct {
+		Start       time.Time `json:"start"`
+		Connections int       `json:"connections"`
+		Routes      []struct {
+			Name string `json:"name"`
+		} `json:"routes"`
+		Gateways []struct {
+			Name string `json:"name"`
+		} `json:"gateways"`
+	} `json:"statsz"`
+}
+
+// PingServerStats asks every server of the cluster for its identity and statistics
+// via $SYS.REQ.SERVER.PING. Servers answering twice are only reported once.
+func (c *Client) PingServerStats(ctx context.Context) ([]ServerStats, error) {
+	msgs, err := c.gather(ctx, "$SYS.REQ.SERVER.PING", nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to ping servers: %w", err)
+	}
+
+	seen := make(map[string]bool, len(msgs))
+	stats := make([]ServerStats, 0, len(msgs))
+	for _, msg := range msgs {
+		st, ok := parseServerPing(msg.Data)
+		if !ok || seen[st.Server.ID] {
+			continue
+		}
+		seen[st.Server.ID] = true
+		stats = append(stats, st)
+	}
+
+	return stats, nil
+}
+
+// parseServerPing decodes a single $SYS.REQ.SERVER.PING reply
+func parseServerPing(data []byte) (ServerStats, bool) {
+	var resp serverPingResponse
+	if err := json.Unmarshal(data, &resp); err != nil || resp.Server.ID == "" {
+		return ServerStats{}, false
+	}
+
+	st := ServerStats{
+		Server:      resp.Server,
+		Start:       resp.Stats.Start,
+		Connections: resp.Stats.Connections,
+		Routes:      len(resp.Stats.Routes),
+	}
+	for _, gw := range resp.Stats.Gateways {
+		st.Gateways = append(st.Gateways, gw.Name)
+	}
+
+	return st, true
+}
+
+// PingServers asks every server of the cluster to identify itself via $SYS.REQ.SERVER.PING
+func (c *Client) PingServers(ctx context.Context) ([]ServerInfo, error) {
+	stats, err := c.PingServerStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	servers := make([]ServerInfo, len(stats))
+	for i, st := range stats {
+		servers[i] = st.Server
+	}
+
+	return servers, nil
+}
@@ -0,0 +1,100 @@
+package nats
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// DataStats counts the messages and bytes in one direction
+type DataStats struct {
+	Msgs  int64 `json:"msgs"`
+	Bytes int64 `json:"bytes"`
+}
+
+// AccountStatz is the traffic of an account on a single server as reported by STATZ.
+// Message and byte counters are cumulative since the server started.
+type AccountStatz struct {
+	Account       string    `json:"acc"`
+	Conns         int       `json:"conns"`
+	LeafNodes     int       `json:"leafnodes"`
+	TotalConns    int       `json:"total_conns"`
+	Subscriptions uint32    `json:"num_subscriptions"`
+	Sent          DataStats `json:"sent"`     // Delivered to the account's clients
+	Received      DataStats `json:"received"` // Published by the account's clients
+	SlowConsumers int64     `json:"slow_consumers"`
+}
+
+// ServerAccountStatz is one server's answer to an account STATZ request
+type ServerAccountStatz struct {
+	Server   ServerInfo
+	Accounts []AccountStatz
+	Error    string
+}
+
+// statzRequest is the subset of the account STATZ options we send
+type statzRequest struct {
+	Accounts      []string `json:"accounts"`
+	IncludeUnused bool     `json:"include_unused"`
+}
+
+// statzResponse mirrors a single server's reply to $SYS.REQ.ACCOUNT.PING.STATZ
+type statzResponse struct {
+	Server *ServerInfo `json:"server"`
+	Data   *struct {
+		Accounts []AccountStatz `json:"account_statz"`
+	} `json:"data,omitempty"`
+	Error *jszError `json:"error,omitempty"`
+}
+
+// AccountStatz asks every server for the traffic of the given accounts via
+// $SYS.REQ.ACCOUNT.PING.STATZ. Unused accounts are included so every server answers
+// and expected, the number of servers in the cluster, can be waited for.
+func (c *Client) AccountStatz(ctx context.Context, accounts []string, expected int) ([]ServerAccountStatz, error) {
+	req, err := json.Marshal(statzRequest{Accounts: accounts, IncludeUnused: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode STATZ request: %w", err)
+	}
+
+	msgs, err := c.gather(ctx, "$SYS.REQ.ACCOUNT.PING.STATZ", req, expected)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get account statistics: %w", err)
+	}
+
+	seen := make(map[string]bool, len(msgs))
+	result := make([]ServerAccountStatz, 0, len(msgs))
+	for _, msg := range msgs {
+		stats, err := parseStatzResponse(msg.Data)
+		if err != nil {
+			return nil, err
+		}
+		if seen[stats.Server.ID] {
+			continue
+		}
+		seen[stats.Server.ID] = true
+		result = append(result, stats)
+	}
+
+	return result, nil
+}
+
+// parseStatzResponse decodes a single account STATZ reply
+func parseStatzResponse(data []byte) (ServerAccountStatz, error) {
+	var resp statzResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return ServerAccountStatz{}, fmt.Errorf("failed to parse STATZ response: %w", err)
+	}
+
+	var stats ServerAccountStatz
+	if resp.Server != nil {
+		stats.Server = *resp.Server
+	}
+	if resp.Error != nil {
+		stats.Error = fmt.Sprintf("server error %d: %s", resp.Error.Code, resp.Error.Description)
+	}
+	if resp.Data != nil {
+		stats.Accounts = resp.Data.Accounts
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,137 @@
+// Package natsserver runs a nats-server inside the NIS process. It backs `nis dev`
+// and the end-to-end tests, so neither needs a NATS binary or Docker.
+package natsserver
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nats-server/v2/server"
+)
+
+const (
+	// DefaultReadyTimeout is how long Start waits for the server to accept clients
+	DefaultReadyTimeout = 10 * time.Second
+	// resolverSyncInterval matches the interval of the generated include config
+	resolverSyncInterval = 2 * time.Minute
+)
+
+// Config describes an embedded server trusting a NIS operator. The server runs the
+// same full resolver as the include config generated for the operator, with the
+// system account preloaded, so clusters backed by it behave like real ones.
+type Config struct {
+	ServerName string
+	Host       string // Defaults to 127.0.0.1
+	Port       int    // 0 picks a free port
+	HTTPPort   int    // Monitoring port, 0 disables it
+
+	OperatorJWT      string
+	SystemAccountJWT string
+
+	// DataDir holds the resolver directory and the JetStream store
+	DataDir string
+
+	// Logs enables the server's own logging to stderr
+	Logs         bool
+	ReadyTimeout time.Duration
+}
+
+// Server is a running embedded nats-server
+type Server struct {
+	srv *server.Server
+}
+
+// Start validates the configuration, starts the server and waits until it accepts
+// client connections
+func Start(cfg Config) (*Server, error) {
+	if cfg.DataDir == "" {
+		return nil, fmt.Errorf("data directory is required")
+	}
+	if cfg.Host == "" {
+		cfg.Host = "127.0.0.1"
+	}
+	if cfg.ReadyTimeout <= 0 {
+		cfg.ReadyTimeout = DefaultReadyTimeout
+	}
+
+	operator, err := jwt.DecodeOperatorClaims(cfg.OperatorJWT)
+	if err != nil {
+		return nil, fmt.Errorf("invalid operator JWT: %w", err)
+	}
+	sysAccount, err := jwt.DecodeAccountClaims(cfg.SystemAccountJWT)
+	if err != nil {
+		return nil, fmt.Errorf("invalid system account JWT: %w", err)
+	}
+	if operator.SystemAccount != "" && operator.SystemAccount != sysAccount.Subject {
+		return nil, fmt.Errorf("system account %s is not the operator's system account %s",
+			sysAccount.Subject, operator.SystemAccount)
+	}
+
+	resolver, err := server.NewDirAccResolver(filepath.Join(cfg.DataDir, "resolver"), 0,
+		resolverSyncInterval, server.RenameDeleted)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create account resolver: %w", err)
+	}
+	if err := resolver.Store(sysAccount.Subject, cfg.SystemAccountJWT); err != nil {
+		resolver.Close()
+		return nil, fmt.Errorf("failed to preload system account: %w", err)
+	}
+
+	opts := &server.Options{
+		ServerName:       cfg.ServerName,
+		Host:             cfg.Host,
+		Port:             cfg.Port,
+		HTTPHost:         cfg.Host,
+		HTTPPort:         cfg.HTTPPort,
+		TrustedOperators: []*jwt.OperatorClaims{operator},
+		SystemAccount:    sysAccount.Subject,
+		AccountResolver:  resolver,
+		JetStream:        true,
+		StoreDir:         filepath.Join(cfg.DataDir, "jetstream"),
+		NoSigs:           true,
+	}
+	if opts.Port == 0 {
+		opts.Port = server.RANDOM_PORT
+	}
+
+	srv, err := server.NewServer(opts)
+	if err != nil {
+		resolver.Close()
+		return nil, fmt.Errorf("failed to create NATS server: %w", err)
+	}
+	if cfg.Logs {
+		srv.ConfigureLogger()
+	}
+
+	srv.Start()
+	if !srv.ReadyForConnections(cfg.ReadyTimeout) {
+		srv.Shutdown()
+		srv.WaitForShutdown()
+		return nil, fmt.Errorf("NATS server not ready after %s", cfg.ReadyTimeout)
+	}
+	return &Server{srv: srv}, nil
+}
+
+// ClientURL returns the nats:// URL clients connect to
+func (s *Server) ClientURL() string {
+	return s.srv.ClientURL()
+}
+
+// MonitorURL returns the http:// URL of the monitoring endpoint, empty when disabled
+func (s *Server) MonitorURL() string {
+	addr := s.srv.MonitorAddr()
+	if addr == nil {
+		return ""
+	}
+	return "http://" + net.JoinHostPort(addr.IP.String(), strconv.Itoa(addr.Port))
+}
+
+// Shutdown stops the server and waits for it to exit
+func (s *Server) Shutdown() {
+	s.srv.Shutdown()
+	s.srv.WaitForShutdown()
+}
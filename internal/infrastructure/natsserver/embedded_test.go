@@ -0,0 +1,94 @@
+package natsserver
+
+import (
+	"testing"
+
+	"github.com/nats-io/jwt/v2"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nkeys"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testOperator issues an operator with its system account and a user of that account
+func testOperator(t *testing.T) (operatorJWT, sysAccountJWT, creds string) {
+	t.Helper()
+
+	operatorKP, err := nkeys.CreateOperator()
+	require.NoError(t, err)
+	operatorPub, err := operatorKP.PublicKey()
+	require.NoError(t, err)
+
+	accountKP, err := nkeys.CreateAccount()
+	require.NoError(t, err)
+	accountPub, err := accountKP.PublicKey()
+	require.NoError(t, err)
+
+	operatorClaims := jwt.NewOperatorClaims(operatorPub)
+	operatorClaims.SystemAccount = accountPub
+	operatorJWT, err = operatorClaims.Encode(operatorKP)
+	require.NoError(t, err)
+
+	sysAccountJWT, err = jwt.NewAccountClaims(accountPub).Encode(operatorKP)
+	require.NoError(t, err)
+
+	userKP, err := nkeys.CreateUser()
+	require.NoError(t, err)
+	userPub, err := userKP.PublicKey()
+	require.NoError(t, err)
+	userClaims := jwt.NewUserClaims(userPub)
+	userClaims.IssuerAccount = accountPub
+	userJWT, err := userClaims.Encode(accountKP)
+	require.NoError(t, err)
+	userSeed, err := userKP.Seed()
+	require.NoError(t, err)
+	credsBytes, err := jwt.FormatUserConfig(userJWT, userSeed)
+	require.NoError(t, err)
+
+	return operatorJWT, sysAccountJWT, string(credsBytes)
+}
+
+func TestStart(t *testing.T) {
+	operatorJWT, sysAccountJWT, creds := testOperator(t)
+
+	srv, err := Start(Config{
+		OperatorJWT:      operatorJWT,
+		SystemAccountJWT: sysAccountJWT,
+		DataDir:          t.TempDir(),
+	})
+	require.NoError(t, err)
+	defer srv.Shutdown()
+
+	assert.Empty(t, srv.MonitorURL())
+
+	// The system account is preloaded, so its users can reach the server's services
+	nc, err := nats.Connect(srv.ClientURL(), nats.UserJWTAndSeed(userFromCreds(t, creds)))
+	require.NoError(t, err)
+	defer nc.Close()
+
+	_, err = nc.Request("$SYS.REQ.SERVER.PING", nil, DefaultReadyTimeout)
+	assert.NoError(t, err)
+}
+
+func TestStart_RejectsForeignSystemAccount(t *testing.T) {
+	operatorJWT, _, _ := testOperator(t)
+	_, otherSysAccountJWT, _ := testOperator(t)
+
+	_, err := Start(Config{
+		OperatorJWT:      operatorJWT,
+		SystemAccountJWT: otherSysAccountJWT,
+		DataDir:          t.TempDir(),
+	})
+	assert.Error(t, err)
+}
+
+func userFromCreds(t *testing.T, creds string) (string, string) {
+	t.Helper()
+	userJWT, err := jwt.ParseDecoratedJWT([]byte(creds))
+	require.NoError(t, err)
+	kp, err := jwt.ParseDecoratedUserNKey([]byte(creds))
+	require.NoError(t, err)
+	seed, err := kp.Seed()
+	require.NoError(t, err)
+	return userJWT, string(seed)
+}
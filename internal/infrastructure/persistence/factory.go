@@ -16,7 +16,16 @@ type RepositoryFactory interface {
 	UserRepository() repositories.UserRepository
 	ScopedSigningKeyRepository() repositories.ScopedSigningKeyRepository
 	ClusterRepository() repositories.ClusterRepository
+	ClusterServerRepository() repositories.ClusterServerRepository
+	ClusterHealthCheckRepository() repositories.ClusterHealthCheckRepository
+	LeaderLeaseRepository() repositories.LeaderLeaseRepository
 	APIUserRepository() repositories.APIUserRepository
+	AuthFailureRepository() repositories.AuthFailureRepository
+	AccountStatsRepository() repositories.AccountStatsRepository
+	LeafnodeProfileRepository() repositories.LeafnodeProfileRepository
+	AccountPlacementRepository() repositories.AccountPlacementRepository
+	UserKeyRepository() repositories.UserKeyRepository
+	RotationPolicyRepository() repositories.RotationPolicyRepository
 
 	// Database lifecycle methods
 	Connect(ctx context.Context) error
@@ -0,0 +1,87 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"gorm.io/gorm"
+)
+
+// AccountPlacementRepo implements repositories.AccountPlacementRepository using GORM
+type AccountPlacementRepo struct {
+	db *gorm.DB
+}
+
+// NewAccountPlacementRepo creates a new account placement repository
+func NewAccountPlacementRepo(db *gorm.DB) *AccountPlacementRepo {
+	return &AccountPlacementRepo{db: db}
+}
+
+// ListByAccount retrieves the placements of an account
+func (r *AccountPlacementRepo) ListByAccount(ctx context.Context, accountID uuid.UUID) ([]*entities.AccountPlacement, error) {
+	var models []AccountPlacementModel
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID.String()).
+		Order("created_at").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account placements: %w", err)
+	}
+
+	return accountPlacementsToEntities(models), nil
+}
+
+// ListByOperator retrieves the placements of every account of an operator
+func (r *AccountPlacementRepo) ListByOperator(ctx context.Context, operatorID uuid.UUID) ([]*entities.AccountPlacement, error) {
+	var models []AccountPlacementModel
+
+	err := r.db.WithContext(ctx).
+		Joins("JOIN accounts ON accounts.id = account_placements.account_id").
+		Where("accounts.operator_id = ?", operatorID.String()).
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list account placements by operator: %w", err)
+	}
+
+	return accountPlacementsToEntities(models), nil
+}
+
+// Replace places an account on exactly the given clusters, none removes its placement
+func (r *AccountPlacementRepo) Replace(ctx context.Context, accountID uuid.UUID, clusterIDs []uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("account_id = ?", accountID.String()).Delete(&AccountPlacementModel{}).Error; err != nil {
+			return err
+		}
+		if len(clusterIDs) == 0 {
+			return nil
+		}
+
+		now := time.Now()
+		models := make([]AccountPlacementModel, 0, len(clusterIDs))
+		for _, clusterID := range clusterIDs {
+			models = append(models, AccountPlacementModel{
+				AccountID: accountID.String(),
+				ClusterID: clusterID.String(),
+				CreatedAt: now,
+			})
+		}
+		return tx.Create(&models).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to replace account placements: %w", err)
+	}
+
+	return nil
+}
+
+func accountPlacementsToEntities(models []AccountPlacementModel) []*entities.AccountPlacement {
+	placements := make([]*entities.AccountPlacement, len(models))
+	for i, model := range models {
+		placements[i] = model.ToEntity()
+	}
+	return placements
+}
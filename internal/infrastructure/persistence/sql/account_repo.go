@@ -118,7 +118,8 @@ func (r *AccountRepo) ListByOperator(ctx context.Context, operatorID uuid.UUID,
 		query = query.Offset(opts.Offset)
 	}
 
-	if err := query.Order("created_at DESC").Find(&models).Error; err != nil {
+	// Tie-break on id so offset pagination is stable when accounts share a created_at
+	if err := query.Order("created_at DESC, id").Find(&models).Error; err != nil {
 		return nil, fmt.Errorf("failed to list accounts by operator: %w", err)
 	}
 
@@ -0,0 +1,90 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AccountStatsRepo implements repositories.AccountStatsRepository using GORM
+type AccountStatsRepo struct {
+	db *gorm.DB
+}
+
+// NewAccountStatsRepo creates a new account stats repository
+func NewAccountStatsRepo(db *gorm.DB) *AccountStatsRepo {
+	return &AccountStatsRepo{db: db}
+}
+
+// Record adds a sample to the bucket of its cluster, account, resolution and start time.
+// Counters are summed and the connection peak is kept; an existing bucket keeps its ID.
+func (r *AccountStatsRepo) Record(ctx context.Context, stats *entities.AccountStats) error {
+	model := AccountStatsModelFromEntity(stats)
+	model.BucketStart = model.BucketStart.UTC()
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "account_id"}, {Name: "resolution_seconds"}, {Name: "bucket_start"}, {Name: "cluster_id"},
+		},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"samples":         gorm.Expr("account_stats.samples + excluded.samples"),
+			"connections_sum": gorm.Expr("account_stats.connections_sum + excluded.connections_sum"),
+			"max_connections": gorm.Expr("CASE WHEN excluded.max_connections > account_stats.max_connections THEN excluded.max_connections ELSE account_stats.max_connections END"),
+			"msgs_in":         gorm.Expr("account_stats.msgs_in + excluded.msgs_in"),
+			"msgs_out":        gorm.Expr("account_stats.msgs_out + excluded.msgs_out"),
+			"bytes_in":        gorm.Expr("account_stats.bytes_in + excluded.bytes_in"),
+			"bytes_out":       gorm.Expr("account_stats.bytes_out + excluded.bytes_out"),
+			"slow_consumers":  gorm.Expr("account_stats.slow_consumers + excluded.slow_consumers"),
+		}),
+	}).Create(model).Error
+	if err != nil {
+		return fmt.Errorf("failed to record account stats: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves the buckets matching the filter, oldest first
+func (r *AccountStatsRepo) List(ctx context.Context, filter repositories.AccountStatsFilter) ([]*entities.AccountStats, error) {
+	var models []AccountStatsModel
+
+	query := r.db.WithContext(ctx).
+		Where("account_id = ? AND resolution_seconds = ?", filter.AccountID.String(), int64(filter.Resolution/time.Second))
+	if filter.ClusterID != nil {
+		query = query.Where("cluster_id = ?", filter.ClusterID.String())
+	}
+	if !filter.From.IsZero() {
+		query = query.Where("bucket_start >= ?", filter.From.UTC())
+	}
+	if !filter.To.IsZero() {
+		query = query.Where("bucket_start < ?", filter.To.UTC())
+	}
+
+	if err := query.Order("bucket_start, cluster_id").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list account stats: %w", err)
+	}
+
+	stats := make([]*entities.AccountStats, len(models))
+	for i, model := range models {
+		stats[i] = model.ToEntity()
+	}
+
+	return stats, nil
+}
+
+// DeleteBefore deletes the buckets of a resolution starting before the given time
+func (r *AccountStatsRepo) DeleteBefore(ctx context.Context, resolution time.Duration, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("resolution_seconds = ? AND bucket_start < ?", int64(resolution/time.Second), before.UTC()).
+		Delete(&AccountStatsModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete account stats: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
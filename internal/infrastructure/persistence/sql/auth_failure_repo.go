@@ -0,0 +1,97 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AuthFailureRepo implements repositories.AuthFailureRepository using GORM
+type AuthFailureRepo struct {
+	db *gorm.DB
+}
+
+// NewAuthFailureRepo creates a new auth failure repository
+func NewAuthFailureRepo(db *gorm.DB) *AuthFailureRepo {
+	return &AuthFailureRepo{db: db}
+}
+
+// Record adds a failure to the aggregate of its cluster, window, user key, remote address
+// and reason. The aggregate keeps its ID, first failure and correlation when it exists.
+func (r *AuthFailureRepo) Record(ctx context.Context, failure *entities.AuthFailure) error {
+	model := AuthFailureModelFromEntity(failure)
+	model.WindowStart = model.WindowStart.UTC()
+	model.FirstSeen = model.FirstSeen.UTC()
+	model.LastSeen = model.LastSeen.UTC()
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "cluster_id"}, {Name: "window_start"}, {Name: "user_public_key"},
+			{Name: "remote_ip"}, {Name: "reason"},
+		},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"count":      gorm.Expr("auth_failures.count + excluded.count"),
+			"first_seen": gorm.Expr("CASE WHEN excluded.first_seen < auth_failures.first_seen THEN excluded.first_seen ELSE auth_failures.first_seen END"),
+			"last_seen":  gorm.Expr("CASE WHEN excluded.last_seen > auth_failures.last_seen THEN excluded.last_seen ELSE auth_failures.last_seen END"),
+		}),
+	}).Create(model).Error
+	if err != nil {
+		return fmt.Errorf("failed to record auth failure: %w", err)
+	}
+
+	return nil
+}
+
+// List retrieves the aggregates matching the filter, most recently seen first
+func (r *AuthFailureRepo) List(ctx context.Context, filter repositories.AuthFailureFilter, opts repositories.ListOptions) ([]*entities.AuthFailure, error) {
+	var models []AuthFailureModel
+
+	query := r.db.WithContext(ctx)
+	if filter.ClusterID != nil {
+		query = query.Where("cluster_id = ?", filter.ClusterID.String())
+	}
+	if filter.AccountID != nil {
+		query = query.Where("account_id = ?", filter.AccountID.String())
+	}
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", filter.UserID.String())
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("last_seen >= ?", filter.Since.UTC())
+	}
+
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+	if opts.Offset > 0 {
+		query = query.Offset(opts.Offset)
+	}
+
+	if err := query.Order("last_seen DESC, id").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list auth failures: %w", err)
+	}
+
+	failures := make([]*entities.AuthFailure, len(models))
+	for i, model := range models {
+		failures[i] = model.ToEntity()
+	}
+
+	return failures, nil
+}
+
+// DeleteBefore deletes the aggregates last seen before the given time
+func (r *AuthFailureRepo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("last_seen < ?", before.UTC()).
+		Delete(&AuthFailureModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete auth failures: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,88 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+// ClusterHealthCheckRepo implements repositories.ClusterHealthCheckRepository using GORM
+type ClusterHealthCheckRepo struct {
+	db *gorm.DB
+}
+
+// NewClusterHealthCheckRepo creates a new cluster health check repository
+func NewClusterHealthCheckRepo(db *gorm.DB) *ClusterHealthCheckRepo {
+	return &ClusterHealthCheckRepo{db: db}
+}
+
+// Create records a health check
+func (r *ClusterHealthCheckRepo) Create(ctx context.Context, check *entities.ClusterHealthCheck) error {
+	model := ClusterHealthCheckModelFromEntity(check)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		return fmt.Errorf("failed to create cluster health check: %w", err)
+	}
+
+	return nil
+}
+
+// ListByCluster retrieves the health checks of a cluster, newest first
+func (r *ClusterHealthCheckRepo) ListByCluster(ctx context.Context, clusterID uuid.UUID, opts repositories.ListOptions) ([]*entities.ClusterHealthCheck, error) {
+	var models []ClusterHealthCheckModel
+
+	query := r.db.WithContext(ctx).Where("cluster_id = ?", clusterID.String())
+
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+	if opts.Offset > 0 {
+		query = query.Offset(opts.Offset)
+	}
+
+	if err := query.Order("checked_at DESC, id").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list cluster health checks: %w", err)
+	}
+
+	checks := make([]*entities.ClusterHealthCheck, len(models))
+	for i, model := range models {
+		checks[i] = model.ToEntity()
+	}
+
+	return checks, nil
+}
+
+// CountSince counts the health checks of a cluster since the given time, and how many were healthy
+func (r *ClusterHealthCheckRepo) CountSince(ctx context.Context, clusterID uuid.UUID, since time.Time) (int64, int64, error) {
+	var counts struct {
+		Total   int64
+		Healthy int64
+	}
+
+	err := r.db.WithContext(ctx).Model(&ClusterHealthCheckModel{}).
+		Select("COUNT(*) AS total, COALESCE(SUM(CASE WHEN healthy THEN 1 ELSE 0 END), 0) AS healthy").
+		Where("cluster_id = ? AND checked_at >= ?", clusterID.String(), since).
+		Scan(&counts).Error
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count cluster health checks: %w", err)
+	}
+
+	return counts.Total, counts.Healthy, nil
+}
+
+// DeleteBefore deletes the health checks of all clusters older than the given time
+func (r *ClusterHealthCheckRepo) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("checked_at < ?", before).
+		Delete(&ClusterHealthCheckModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete cluster health checks: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -115,6 +115,25 @@ func (r *ClusterRepo) ListByOperator(ctx context.Context, operatorID uuid.UUID,
 	return clusters, nil
 }
 
+// ListBySupercluster retrieves the clusters of an operator joined in a supercluster
+func (r *ClusterRepo) ListBySupercluster(ctx context.Context, operatorID uuid.UUID, supercluster string) ([]*entities.Cluster, error) {
+	var models []ClusterModel
+
+	err := r.db.WithContext(ctx).
+		Where("operator_id = ? AND supercluster = ?", operatorID.String(), supercluster).
+		Order("name ASC").Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters by supercluster: %w", err)
+	}
+
+	clusters := make([]*entities.Cluster, len(models))
+	for i, model := range models {
+		clusters[i] = model.ToEntity()
+	}
+
+	return clusters, nil
+}
+
 // Update updates an existing cluster
 func (r *ClusterRepo) Update(ctx context.Context, cluster *entities.Cluster) error {
 	model := ClusterModelFromEntity(cluster)
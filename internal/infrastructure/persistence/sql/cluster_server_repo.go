@@ -0,0 +1,74 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ClusterServerRepo implements repositories.ClusterServerRepository using GORM
+type ClusterServerRepo struct {
+	db *gorm.DB
+}
+
+// NewClusterServerRepo creates a new cluster server repository
+func NewClusterServerRepo(db *gorm.DB) *ClusterServerRepo {
+	return &ClusterServerRepo{db: db}
+}
+
+// Upsert creates the server record or updates the one with the same cluster and server ID.
+// The record keeps its original ID and CreatedAt when it already exists.
+func (r *ClusterServerRepo) Upsert(ctx context.Context, server *entities.ClusterServer) error {
+	model := ClusterServerModelFromEntity(server)
+
+	err := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "cluster_id"}, {Name: "server_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"name", "host", "version", "jetstream", "cluster_name", "gateways",
+			"connections", "started_at", "last_seen", "updated_at",
+			"jetstream_max_memory", "jetstream_max_storage",
+		}),
+	}).Create(model).Error
+	if err != nil {
+		return fmt.Errorf("failed to upsert cluster server: %w", err)
+	}
+
+	return nil
+}
+
+// ListByCluster retrieves all recorded servers of a cluster, ordered by name
+func (r *ClusterServerRepo) ListByCluster(ctx context.Context, clusterID uuid.UUID) ([]*entities.ClusterServer, error) {
+	var models []ClusterServerModel
+
+	err := r.db.WithContext(ctx).
+		Where("cluster_id = ?", clusterID.String()).
+		Order("name, server_id").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cluster servers: %w", err)
+	}
+
+	servers := make([]*entities.ClusterServer, len(models))
+	for i, model := range models {
+		servers[i] = model.ToEntity()
+	}
+
+	return servers, nil
+}
+
+// DeleteStale deletes the servers of a cluster that were last seen before the given time
+func (r *ClusterServerRepo) DeleteStale(ctx context.Context, clusterID uuid.UUID, before time.Time) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Where("cluster_id = ? AND last_seen < ?", clusterID.String(), before).
+		Delete(&ClusterServerModel{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete stale cluster servers: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
@@ -62,6 +62,15 @@ func TestMigrations(t *testing.T) {
 		"scoped_signing_keys",
 		"clusters",
 		"api_users",
+		"cluster_servers",
+		"cluster_health_checks",
+		"leader_leases",
+		"auth_failures",
+		"account_stats",
+		"leafnode_profiles",
+		"account_placements",
+		"user_keys",
+		"rotation_policies",
 	}
 
 	for _, table := range tables {
@@ -76,8 +85,19 @@ func TestMigrations(t *testing.T) {
 		"idx_accounts_operator_id",
 		"idx_users_account_id",
 		"idx_users_scoped_signing_key_id",
+		"idx_users_last_seen_at",
 		"idx_scoped_signing_keys_account_id",
 		"idx_clusters_operator_id",
+		"idx_cluster_servers_cluster_id",
+		"idx_cluster_health_checks_cluster_id",
+		"idx_cluster_health_checks_checked_at",
+		"idx_auth_failures_window",
+		"idx_auth_failures_last_seen",
+		"idx_account_stats_bucket",
+		"idx_account_placements_cluster_id",
+		"idx_user_keys_user_id",
+		"idx_user_keys_revoke_at",
+		"idx_rotation_policies_operator_id",
 	}
 
 	for _, index := range indexes {
@@ -88,7 +108,7 @@ func TestMigrations(t *testing.T) {
 	}
 
 	// Test migration down
-	err = goose.Down(sqlDB, ".")
+	err = goose.DownTo(sqlDB, ".", 0)
 	require.NoError(t, err)
 
 	// Verify tables are dropped
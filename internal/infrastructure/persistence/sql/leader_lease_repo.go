@@ -0,0 +1,88 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LeaderLeaseRepo implements repositories.LeaderLeaseRepository using GORM
+type LeaderLeaseRepo struct {
+	db *gorm.DB
+}
+
+// NewLeaderLeaseRepo creates a new leader lease repository
+func NewLeaderLeaseRepo(db *gorm.DB) *LeaderLeaseRepo {
+	return &LeaderLeaseRepo{db: db}
+}
+
+// TryAcquire takes the named lease for holderID if it is free, expired or already held by
+// holderID. Both statements are single-row atomic writes, so two replicas racing for an
+// expired lease cannot both win. Times are stored in UTC so they compare across replicas.
+func (r *LeaderLeaseRepo) TryAcquire(ctx context.Context, name, holderID string, ttl time.Duration, now time.Time) (bool, error) {
+	now = now.UTC()
+	expires := now.Add(ttl)
+
+	// Renew our own lease or take over an expired one
+	result := r.db.WithContext(ctx).Model(&LeaderLeaseModel{}).
+		Where("name = ? AND (holder_id = ? OR expires_at <= ?)", name, holderID, now).
+		Updates(map[string]interface{}{
+			"acquired_at": gorm.Expr("CASE WHEN holder_id = ? THEN acquired_at ELSE ? END", holderID, now),
+			"holder_id":   holderID,
+			"renewed_at":  now,
+			"expires_at":  expires,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to renew leader lease: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return true, nil
+	}
+
+	// Nobody held the lease yet
+	result = r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&LeaderLeaseModel{
+		Name:       name,
+		HolderID:   holderID,
+		AcquiredAt: now,
+		RenewedAt:  now,
+		ExpiresAt:  expires,
+	})
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to create leader lease: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// Release gives up the named lease if it is held by holderID, so another replica can
+// take over without waiting for it to expire
+func (r *LeaderLeaseRepo) Release(ctx context.Context, name, holderID string) error {
+	err := r.db.WithContext(ctx).
+		Where("name = ? AND holder_id = ?", name, holderID).
+		Delete(&LeaderLeaseModel{}).Error
+	if err != nil {
+		return fmt.Errorf("failed to release leader lease: %w", err)
+	}
+
+	return nil
+}
+
+// Get retrieves the named lease
+func (r *LeaderLeaseRepo) Get(ctx context.Context, name string) (*entities.LeaderLease, error) {
+	var model LeaderLeaseModel
+
+	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get leader lease: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
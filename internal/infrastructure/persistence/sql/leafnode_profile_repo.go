@@ -0,0 +1,86 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+// LeafnodeProfileRepo implements repositories.LeafnodeProfileRepository using GORM
+type LeafnodeProfileRepo struct {
+	db *gorm.DB
+}
+
+// NewLeafnodeProfileRepo creates a new leafnode profile repository
+func NewLeafnodeProfileRepo(db *gorm.DB) *LeafnodeProfileRepo {
+	return &LeafnodeProfileRepo{db: db}
+}
+
+// Create creates a new leafnode profile
+func (r *LeafnodeProfileRepo) Create(ctx context.Context, profile *entities.LeafnodeProfile) error {
+	model := LeafnodeProfileModelFromEntity(profile)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return repositories.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to create leafnode profile: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a leafnode profile by ID
+func (r *LeafnodeProfileRepo) GetByID(ctx context.Context, id uuid.UUID) (*entities.LeafnodeProfile, error) {
+	var model LeafnodeProfileModel
+
+	err := r.db.WithContext(ctx).First(&model, "id = ?", id.String()).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get leafnode profile: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// ListByCluster retrieves the leafnode profiles of a hub cluster, ordered by name
+func (r *LeafnodeProfileRepo) ListByCluster(ctx context.Context, clusterID uuid.UUID) ([]*entities.LeafnodeProfile, error) {
+	var models []LeafnodeProfileModel
+
+	err := r.db.WithContext(ctx).
+		Where("cluster_id = ?", clusterID.String()).
+		Order("name").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leafnode profiles: %w", err)
+	}
+
+	profiles := make([]*entities.LeafnodeProfile, len(models))
+	for i, model := range models {
+		profiles[i] = model.ToEntity()
+	}
+
+	return profiles, nil
+}
+
+// Delete deletes a leafnode profile by ID
+func (r *LeafnodeProfileRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&LeafnodeProfileModel{}, "id = ?", id.String())
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete leafnode profile: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
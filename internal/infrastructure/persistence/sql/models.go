@@ -18,6 +18,11 @@ type OperatorModel struct {
 	SystemAccountPubKey string `gorm:"type:text"`
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
+	LockedDownAt        *time.Time `gorm:"type:datetime"`
+	LockedDownBy        string     `gorm:"type:text;not null;default:''"`
+	LockdownReason      string     `gorm:"type:text;not null;default:''"`
+	LockdownLiftedAt    *time.Time `gorm:"type:datetime"`
+	LockdownLiftedBy    string     `gorm:"type:text;not null;default:''"`
 }
 
 func (OperatorModel) TableName() string {
@@ -36,6 +41,11 @@ func (m *OperatorModel) ToEntity() *entities.Operator {
 		SystemAccountPubKey: m.SystemAccountPubKey,
 		CreatedAt:           m.CreatedAt,
 		UpdatedAt:           m.UpdatedAt,
+		LockedDownAt:        m.LockedDownAt,
+		LockedDownBy:        m.LockedDownBy,
+		LockdownReason:      m.LockdownReason,
+		LockdownLiftedAt:    m.LockdownLiftedAt,
+		LockdownLiftedBy:    m.LockdownLiftedBy,
 	}
 }
 
@@ -51,23 +61,33 @@ func OperatorModelFromEntity(e *entities.Operator) *OperatorModel {
 		SystemAccountPubKey: e.SystemAccountPubKey,
 		CreatedAt:           e.CreatedAt,
 		UpdatedAt:           e.UpdatedAt,
+		LockedDownAt:        e.LockedDownAt,
+		LockedDownBy:        e.LockedDownBy,
+		LockdownReason:      e.LockdownReason,
+		LockdownLiftedAt:    e.LockdownLiftedAt,
+		LockdownLiftedBy:    e.LockdownLiftedBy,
 	}
 }
 
 // AccountModel represents the GORM model for accounts
 type AccountModel struct {
-	ID                    string `gorm:"primaryKey;type:text"`
-	OperatorID            string `gorm:"type:text;not null;index:idx_accounts_operator_id"`
-	Name                  string `gorm:"type:text;not null"`
-	Description           string `gorm:"type:text"`
-	EncryptedSeed         string `gorm:"type:text;not null"`
-	PublicKey             string `gorm:"type:text;uniqueIndex;not null"`
-	JWT                   string `gorm:"type:text;not null"`
-	JetStreamEnabled      bool   `gorm:"column:jetstream_enabled;not null;default:false"`
-	JetStreamMaxMemory    int64  `gorm:"column:jetstream_max_memory;not null;default:-1"`
-	JetStreamMaxStorage   int64  `gorm:"column:jetstream_max_storage;not null;default:-1"`
-	JetStreamMaxStreams   int64  `gorm:"column:jetstream_max_streams;not null;default:-1"`
-	JetStreamMaxConsumers int64  `gorm:"column:jetstream_max_consumers;not null;default:-1"`
+	ID                    string               `gorm:"primaryKey;type:text"`
+	OperatorID            string               `gorm:"type:text;not null;index:idx_accounts_operator_id"`
+	Name                  string               `gorm:"type:text;not null"`
+	Description           string               `gorm:"type:text"`
+	EncryptedSeed         string               `gorm:"type:text;not null"`
+	PublicKey             string               `gorm:"type:text;uniqueIndex;not null"`
+	JWT                   string               `gorm:"type:text;not null"`
+	JetStreamEnabled      bool                 `gorm:"column:jetstream_enabled;not null;default:false"`
+	JetStreamMaxMemory    int64                `gorm:"column:jetstream_max_memory;not null;default:-1"`
+	JetStreamMaxStorage   int64                `gorm:"column:jetstream_max_storage;not null;default:-1"`
+	JetStreamMaxStreams   int64                `gorm:"column:jetstream_max_streams;not null;default:-1"`
+	JetStreamMaxConsumers int64                `gorm:"column:jetstream_max_consumers;not null;default:-1"`
+	SuspendedAt           *time.Time           `gorm:"type:datetime"`
+	SuspendedBy           string               `gorm:"type:text;not null;default:''"`
+	SuspendReason         string               `gorm:"type:text;not null;default:''"`
+	UsersRevokedAt        *time.Time           `gorm:"type:datetime"`
+	RevokedUserKeys       map[string]time.Time `gorm:"type:text;serializer:json"`
 	CreatedAt             time.Time
 	UpdatedAt             time.Time
 }
@@ -90,6 +110,11 @@ func (m *AccountModel) ToEntity() *entities.Account {
 		JetStreamMaxStorage:   m.JetStreamMaxStorage,
 		JetStreamMaxStreams:   m.JetStreamMaxStreams,
 		JetStreamMaxConsumers: m.JetStreamMaxConsumers,
+		SuspendedAt:           m.SuspendedAt,
+		SuspendedBy:           m.SuspendedBy,
+		SuspendReason:         m.SuspendReason,
+		UsersRevokedAt:        m.UsersRevokedAt,
+		RevokedUserKeys:       m.RevokedUserKeys,
 		CreatedAt:             m.CreatedAt,
 		UpdatedAt:             m.UpdatedAt,
 	}
@@ -109,6 +134,11 @@ func AccountModelFromEntity(e *entities.Account) *AccountModel {
 		JetStreamMaxStorage:   e.JetStreamMaxStorage,
 		JetStreamMaxStreams:   e.JetStreamMaxStreams,
 		JetStreamMaxConsumers: e.JetStreamMaxConsumers,
+		SuspendedAt:           e.SuspendedAt,
+		SuspendedBy:           e.SuspendedBy,
+		SuspendReason:         e.SuspendReason,
+		UsersRevokedAt:        e.UsersRevokedAt,
+		RevokedUserKeys:       e.RevokedUserKeys,
 		CreatedAt:             e.CreatedAt,
 		UpdatedAt:             e.UpdatedAt,
 	}
@@ -128,6 +158,7 @@ type ScopedSigningKeyModel struct {
 	SubDeny          []string `gorm:"type:text;serializer:json"`
 	ResponseMaxMsgs  int      `gorm:"not null;default:0"`
 	ResponseTTLSecs  int64    `gorm:"column:response_ttl_seconds;not null;default:0"`
+	RetiredKeys      map[string]time.Time `gorm:"type:text;serializer:json"`
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
@@ -150,6 +181,7 @@ func (m *ScopedSigningKeyModel) ToEntity() *entities.ScopedSigningKey {
 		SubDeny:         m.SubDeny,
 		ResponseMaxMsgs: m.ResponseMaxMsgs,
 		ResponseTTL:     time.Duration(m.ResponseTTLSecs) * time.Second,
+		RetiredKeys:     m.RetiredKeys,
 		CreatedAt:       m.CreatedAt,
 		UpdatedAt:       m.UpdatedAt,
 	}
@@ -169,6 +201,7 @@ func ScopedSigningKeyModelFromEntity(e *entities.ScopedSigningKey) *ScopedSignin
 		SubDeny:         e.SubDeny,
 		ResponseMaxMsgs: e.ResponseMaxMsgs,
 		ResponseTTLSecs: int64(e.ResponseTTL.Seconds()),
+		RetiredKeys:     e.RetiredKeys,
 		CreatedAt:       e.CreatedAt,
 		UpdatedAt:       e.UpdatedAt,
 	}
@@ -186,6 +219,11 @@ type UserModel struct {
 	ScopedSigningKeyID  *string `gorm:"type:text;index:idx_users_scoped_signing_key_id"`
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
+
+	LastConnectedAt *time.Time `gorm:"type:datetime"`
+	LastSeenAt      *time.Time `gorm:"type:datetime;index:idx_users_last_seen_at"`
+	LastRemoteIP    string     `gorm:"column:last_remote_ip;type:text;not null;default:''"`
+	ConnectionCount int64      `gorm:"type:bigint;not null;default:0"`
 }
 
 func (UserModel) TableName() string {
@@ -210,6 +248,10 @@ func (m *UserModel) ToEntity() *entities.User {
 		ScopedSigningKeyID: scopedKeyID,
 		CreatedAt:          m.CreatedAt,
 		UpdatedAt:          m.UpdatedAt,
+		LastConnectedAt:    m.LastConnectedAt,
+		LastSeenAt:         m.LastSeenAt,
+		LastRemoteIP:       m.LastRemoteIP,
+		ConnectionCount:    m.ConnectionCount,
 	}
 }
 
@@ -231,6 +273,10 @@ func UserModelFromEntity(e *entities.User) *UserModel {
 		ScopedSigningKeyID: scopedKeyID,
 		CreatedAt:          e.CreatedAt,
 		UpdatedAt:          e.UpdatedAt,
+		LastConnectedAt:    e.LastConnectedAt,
+		LastSeenAt:         e.LastSeenAt,
+		LastRemoteIP:       e.LastRemoteIP,
+		ConnectionCount:    e.ConnectionCount,
 	}
 }
 
@@ -247,6 +293,13 @@ type ClusterModel struct {
 	Healthy             bool     `gorm:"type:boolean;not null;default:false"`
 	LastHealthCheck     *time.Time `gorm:"type:datetime"`
 	HealthCheckError    string   `gorm:"type:text;not null;default:''"`
+	HealthCheckIntervalSeconds int `gorm:"type:integer;not null;default:0"`
+	HealthCheckTimeoutSeconds  int `gorm:"type:integer;not null;default:0"`
+	HealthCheckFailures        int `gorm:"type:integer;not null;default:0"`
+	NextHealthCheck     *time.Time `gorm:"type:datetime"`
+	ServerProfile       *entities.ServerProfile `gorm:"type:text;serializer:json"`
+	Supercluster        string   `gorm:"type:text;not null;default:''"`
+	GatewayURLs         []string `gorm:"type:text;serializer:json"`
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
@@ -268,6 +321,13 @@ func (m *ClusterModel) ToEntity() *entities.Cluster {
 		Healthy:             m.Healthy,
 		LastHealthCheck:     m.LastHealthCheck,
 		HealthCheckError:    m.HealthCheckError,
+		HealthCheckInterval: time.Duration(m.HealthCheckIntervalSeconds) * time.Second,
+		HealthCheckTimeout:  time.Duration(m.HealthCheckTimeoutSeconds) * time.Second,
+		HealthCheckFailures: m.HealthCheckFailures,
+		NextHealthCheck:     m.NextHealthCheck,
+		ServerProfile:       m.ServerProfile,
+		Supercluster:        m.Supercluster,
+		GatewayURLs:         m.GatewayURLs,
 		CreatedAt:           m.CreatedAt,
 		UpdatedAt:           m.UpdatedAt,
 	}
@@ -286,11 +346,335 @@ func ClusterModelFromEntity(e *entities.Cluster) *ClusterModel {
 		Healthy:             e.Healthy,
 		LastHealthCheck:     e.LastHealthCheck,
 		HealthCheckError:    e.HealthCheckError,
+		HealthCheckIntervalSeconds: int(e.HealthCheckInterval / time.Second),
+		HealthCheckTimeoutSeconds:  int(e.HealthCheckTimeout / time.Second),
+		HealthCheckFailures:        e.HealthCheckFailures,
+		NextHealthCheck:     e.NextHealthCheck,
+		ServerProfile:       e.ServerProfile,
+		Supercluster:        e.Supercluster,
+		GatewayURLs:         e.GatewayURLs,
 		CreatedAt:           e.CreatedAt,
 		UpdatedAt:           e.UpdatedAt,
 	}
 }
 
+// ClusterServerModel represents the GORM model for cluster servers
+type ClusterServerModel struct {
+	ID          string     `gorm:"primaryKey;type:text"`
+	ClusterID   string     `gorm:"type:text;not null;index:idx_cluster_servers_cluster_id"`
+	ServerID    string     `gorm:"type:text;not null"`
+	Name        string     `gorm:"type:text;not null;default:''"`
+	Host        string     `gorm:"type:text;not null;default:''"`
+	Version     string     `gorm:"type:text;not null;default:''"`
+	JetStream   bool       `gorm:"column:jetstream;type:boolean;not null;default:false"`
+	ClusterName string     `gorm:"type:text;not null;default:''"`
+	Gateways    []string   `gorm:"type:text;serializer:json"`
+	Connections int        `gorm:"type:integer;not null;default:0"`
+	StartedAt   *time.Time `gorm:"type:datetime"`
+	LastSeen    time.Time  `gorm:"type:datetime;not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	JetStreamMaxMemory  int64 `gorm:"column:jetstream_max_memory;type:bigint;not null;default:0"`
+	JetStreamMaxStorage int64 `gorm:"column:jetstream_max_storage;type:bigint;not null;default:0"`
+}
+
+func (ClusterServerModel) TableName() string {
+	return "cluster_servers"
+}
+
+func (m *ClusterServerModel) ToEntity() *entities.ClusterServer {
+	return &entities.ClusterServer{
+		ID:          uuid.MustParse(m.ID),
+		ClusterID:   uuid.MustParse(m.ClusterID),
+		ServerID:    m.ServerID,
+		Name:        m.Name,
+		Host:        m.Host,
+		Version:     m.Version,
+		JetStream:   m.JetStream,
+		ClusterName: m.ClusterName,
+		Gateways:    m.Gateways,
+		Connections: m.Connections,
+		StartedAt:   m.StartedAt,
+		LastSeen:    m.LastSeen,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+
+		JetStreamMaxMemory:  m.JetStreamMaxMemory,
+		JetStreamMaxStorage: m.JetStreamMaxStorage,
+	}
+}
+
+func ClusterServerModelFromEntity(e *entities.ClusterServer) *ClusterServerModel {
+	return &ClusterServerModel{
+		ID:          e.ID.String(),
+		ClusterID:   e.ClusterID.String(),
+		ServerID:    e.ServerID,
+		Name:        e.Name,
+		Host:        e.Host,
+		Version:     e.Version,
+		JetStream:   e.JetStream,
+		ClusterName: e.ClusterName,
+		Gateways:    e.Gateways,
+		Connections: e.Connections,
+		StartedAt:   e.StartedAt,
+		LastSeen:    e.LastSeen,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+
+		JetStreamMaxMemory:  e.JetStreamMaxMemory,
+		JetStreamMaxStorage: e.JetStreamMaxStorage,
+	}
+}
+
+// LeafnodeProfileModel represents the GORM model for leafnode profiles
+type LeafnodeProfileModel struct {
+	ID              string   `gorm:"primaryKey;type:text"`
+	ClusterID       string   `gorm:"type:text;not null"`
+	Name            string   `gorm:"type:text;not null"`
+	UserID          string   `gorm:"type:text;not null;index:idx_leafnode_profiles_user_id"`
+	LocalAccount    string   `gorm:"type:text;not null;default:''"`
+	URLs            []string `gorm:"column:urls;type:text;serializer:json"`
+	CredentialsPath string   `gorm:"type:text;not null;default:''"`
+	CAFile          string   `gorm:"column:ca_file;type:text;not null;default:''"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func (LeafnodeProfileModel) TableName() string {
+	return "leafnode_profiles"
+}
+
+func (m *LeafnodeProfileModel) ToEntity() *entities.LeafnodeProfile {
+	return &entities.LeafnodeProfile{
+		ID:              uuid.MustParse(m.ID),
+		ClusterID:       uuid.MustParse(m.ClusterID),
+		Name:            m.Name,
+		UserID:          uuid.MustParse(m.UserID),
+		LocalAccount:    m.LocalAccount,
+		URLs:            m.URLs,
+		CredentialsPath: m.CredentialsPath,
+		CAFile:          m.CAFile,
+		CreatedAt:       m.CreatedAt,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+func LeafnodeProfileModelFromEntity(e *entities.LeafnodeProfile) *LeafnodeProfileModel {
+	return &LeafnodeProfileModel{
+		ID:              e.ID.String(),
+		ClusterID:       e.ClusterID.String(),
+		Name:            e.Name,
+		UserID:          e.UserID.String(),
+		LocalAccount:    e.LocalAccount,
+		URLs:            e.URLs,
+		CredentialsPath: e.CredentialsPath,
+		CAFile:          e.CAFile,
+		CreatedAt:       e.CreatedAt,
+		UpdatedAt:       e.UpdatedAt,
+	}
+}
+
+// ClusterHealthCheckModel represents the GORM model for cluster health checks
+type ClusterHealthCheckModel struct {
+	ID        string    `gorm:"primaryKey;type:text"`
+	ClusterID string    `gorm:"type:text;not null;index:idx_cluster_health_checks_cluster_id"`
+	CheckedAt time.Time `gorm:"type:datetime;not null"`
+	Healthy   bool      `gorm:"type:boolean;not null;default:false"`
+	LatencyMs int64     `gorm:"column:latency_ms;type:bigint;not null;default:0"`
+	Error     string    `gorm:"type:text;not null;default:''"`
+}
+
+func (ClusterHealthCheckModel) TableName() string {
+	return "cluster_health_checks"
+}
+
+func (m *ClusterHealthCheckModel) ToEntity() *entities.ClusterHealthCheck {
+	return &entities.ClusterHealthCheck{
+		ID:        uuid.MustParse(m.ID),
+		ClusterID: uuid.MustParse(m.ClusterID),
+		CheckedAt: m.CheckedAt,
+		Healthy:   m.Healthy,
+		Latency:   time.Duration(m.LatencyMs) * time.Millisecond,
+		Error:     m.Error,
+	}
+}
+
+func ClusterHealthCheckModelFromEntity(e *entities.ClusterHealthCheck) *ClusterHealthCheckModel {
+	return &ClusterHealthCheckModel{
+		ID:        e.ID.String(),
+		ClusterID: e.ClusterID.String(),
+		CheckedAt: e.CheckedAt,
+		Healthy:   e.Healthy,
+		LatencyMs: e.Latency.Milliseconds(),
+		Error:     e.Error,
+	}
+}
+
+// LeaderLeaseModel represents the GORM model for leader leases
+type LeaderLeaseModel struct {
+	Name       string    `gorm:"primaryKey;type:text"`
+	HolderID   string    `gorm:"type:text;not null"`
+	AcquiredAt time.Time `gorm:"type:datetime;not null"`
+	RenewedAt  time.Time `gorm:"type:datetime;not null"`
+	ExpiresAt  time.Time `gorm:"type:datetime;not null"`
+}
+
+func (LeaderLeaseModel) TableName() string {
+	return "leader_leases"
+}
+
+func (m *LeaderLeaseModel) ToEntity() *entities.LeaderLease {
+	return &entities.LeaderLease{
+		Name:       m.Name,
+		HolderID:   m.HolderID,
+		AcquiredAt: m.AcquiredAt,
+		RenewedAt:  m.RenewedAt,
+		ExpiresAt:  m.ExpiresAt,
+	}
+}
+
+// AuthFailureModel represents the GORM model for aggregated NATS authentication failures
+type AuthFailureModel struct {
+	ID               string    `gorm:"primaryKey;type:text"`
+	ClusterID        string    `gorm:"type:text;not null"`
+	WindowStart      time.Time `gorm:"type:datetime;not null"`
+	UserPublicKey    string    `gorm:"type:text;not null;default:''"`
+	AccountPublicKey string    `gorm:"type:text;not null;default:''"`
+	UserID           *string   `gorm:"type:text"`
+	AccountID        *string   `gorm:"type:text"`
+	RemoteIP         string    `gorm:"column:remote_ip;type:text;not null;default:''"`
+	Reason           string    `gorm:"type:text;not null;default:''"`
+	ServerName       string    `gorm:"type:text;not null;default:''"`
+	ClientName       string    `gorm:"type:text;not null;default:''"`
+	Count            int64     `gorm:"type:bigint;not null;default:0"`
+	FirstSeen        time.Time `gorm:"type:datetime;not null"`
+	LastSeen         time.Time `gorm:"type:datetime;not null"`
+}
+
+func (AuthFailureModel) TableName() string {
+	return "auth_failures"
+}
+
+func (m *AuthFailureModel) ToEntity() *entities.AuthFailure {
+	var userID *uuid.UUID
+	if m.UserID != nil {
+		id := uuid.MustParse(*m.UserID)
+		userID = &id
+	}
+
+	var accountID *uuid.UUID
+	if m.AccountID != nil {
+		id := uuid.MustParse(*m.AccountID)
+		accountID = &id
+	}
+
+	return &entities.AuthFailure{
+		ID:               uuid.MustParse(m.ID),
+		ClusterID:        uuid.MustParse(m.ClusterID),
+		WindowStart:      m.WindowStart,
+		UserPublicKey:    m.UserPublicKey,
+		AccountPublicKey: m.AccountPublicKey,
+		UserID:           userID,
+		AccountID:        accountID,
+		RemoteIP:         m.RemoteIP,
+		Reason:           m.Reason,
+		ServerName:       m.ServerName,
+		ClientName:       m.ClientName,
+		Count:            m.Count,
+		FirstSeen:        m.FirstSeen,
+		LastSeen:         m.LastSeen,
+	}
+}
+
+func AuthFailureModelFromEntity(e *entities.AuthFailure) *AuthFailureModel {
+	var userID *string
+	if e.UserID != nil {
+		id := e.UserID.String()
+		userID = &id
+	}
+
+	var accountID *string
+	if e.AccountID != nil {
+		id := e.AccountID.String()
+		accountID = &id
+	}
+
+	return &AuthFailureModel{
+		ID:               e.ID.String(),
+		ClusterID:        e.ClusterID.String(),
+		WindowStart:      e.WindowStart,
+		UserPublicKey:    e.UserPublicKey,
+		AccountPublicKey: e.AccountPublicKey,
+		UserID:           userID,
+		AccountID:        accountID,
+		RemoteIP:         e.RemoteIP,
+		Reason:           e.Reason,
+		ServerName:       e.ServerName,
+		ClientName:       e.ClientName,
+		Count:            e.Count,
+		FirstSeen:        e.FirstSeen,
+		LastSeen:         e.LastSeen,
+	}
+}
+
+// AccountStatsModel represents the GORM model for account traffic buckets
+type AccountStatsModel struct {
+	ID                string    `gorm:"primaryKey;type:text"`
+	ClusterID         string    `gorm:"type:text;not null"`
+	AccountID         string    `gorm:"type:text;not null"`
+	ResolutionSeconds int64     `gorm:"type:integer;not null"`
+	BucketStart       time.Time `gorm:"type:datetime;not null"`
+	Samples           int64     `gorm:"type:bigint;not null;default:0"`
+	ConnectionsSum    int64     `gorm:"type:bigint;not null;default:0"`
+	MaxConnections    int64     `gorm:"type:bigint;not null;default:0"`
+	MsgsIn            int64     `gorm:"type:bigint;not null;default:0"`
+	MsgsOut           int64     `gorm:"type:bigint;not null;default:0"`
+	BytesIn           int64     `gorm:"type:bigint;not null;default:0"`
+	BytesOut          int64     `gorm:"type:bigint;not null;default:0"`
+	SlowConsumers     int64     `gorm:"type:bigint;not null;default:0"`
+}
+
+func (AccountStatsModel) TableName() string {
+	return "account_stats"
+}
+
+func (m *AccountStatsModel) ToEntity() *entities.AccountStats {
+	return &entities.AccountStats{
+		ID:             uuid.MustParse(m.ID),
+		ClusterID:      uuid.MustParse(m.ClusterID),
+		AccountID:      uuid.MustParse(m.AccountID),
+		Resolution:     time.Duration(m.ResolutionSeconds) * time.Second,
+		BucketStart:    m.BucketStart,
+		Samples:        m.Samples,
+		ConnectionsSum: m.ConnectionsSum,
+		MaxConnections: m.MaxConnections,
+		MsgsIn:         m.MsgsIn,
+		MsgsOut:        m.MsgsOut,
+		BytesIn:        m.BytesIn,
+		BytesOut:       m.BytesOut,
+		SlowConsumers:  m.SlowConsumers,
+	}
+}
+
+func AccountStatsModelFromEntity(e *entities.AccountStats) *AccountStatsModel {
+	return &AccountStatsModel{
+		ID:                e.ID.String(),
+		ClusterID:         e.ClusterID.String(),
+		AccountID:         e.AccountID.String(),
+		ResolutionSeconds: int64(e.Resolution / time.Second),
+		BucketStart:       e.BucketStart,
+		Samples:           e.Samples,
+		ConnectionsSum:    e.ConnectionsSum,
+		MaxConnections:    e.MaxConnections,
+		MsgsIn:            e.MsgsIn,
+		MsgsOut:           e.MsgsOut,
+		BytesIn:           e.BytesIn,
+		BytesOut:          e.BytesOut,
+		SlowConsumers:     e.SlowConsumers,
+	}
+}
+
 // APIUserModel represents the GORM model for API users
 type APIUserModel struct {
 	ID           string  `gorm:"primaryKey;type:text"`
@@ -357,3 +741,123 @@ func APIUserModelFromEntity(e *entities.APIUser) *APIUserModel {
 	}
 }
 
+
+// AccountPlacementModel represents the GORM model for account placements
+type AccountPlacementModel struct {
+	AccountID string `gorm:"primaryKey;type:text"`
+	ClusterID string `gorm:"primaryKey;type:text;index:idx_account_placements_cluster_id"`
+	CreatedAt time.Time
+}
+
+func (AccountPlacementModel) TableName() string {
+	return "account_placements"
+}
+
+func (m *AccountPlacementModel) ToEntity() *entities.AccountPlacement {
+	return &entities.AccountPlacement{
+		AccountID: uuid.MustParse(m.AccountID),
+		ClusterID: uuid.MustParse(m.ClusterID),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+// UserKeyModel represents the GORM model for the keys users held before a rotation
+type UserKeyModel struct {
+	ID        string     `gorm:"primaryKey;type:text"`
+	UserID    string     `gorm:"type:text;not null;index:idx_user_keys_user_id"`
+	AccountID string     `gorm:"type:text;not null"`
+	PublicKey string     `gorm:"type:text;not null"`
+	CreatedAt time.Time  `gorm:"not null"`
+	RetiredAt time.Time  `gorm:"not null"`
+	RevokeAt  time.Time  `gorm:"not null;index:idx_user_keys_revoke_at"`
+	RevokedAt *time.Time `gorm:"type:datetime"`
+}
+
+func (UserKeyModel) TableName() string {
+	return "user_keys"
+}
+
+func (m *UserKeyModel) ToEntity() *entities.UserKey {
+	return &entities.UserKey{
+		ID:        uuid.MustParse(m.ID),
+		UserID:    uuid.MustParse(m.UserID),
+		AccountID: uuid.MustParse(m.AccountID),
+		PublicKey: m.PublicKey,
+		CreatedAt: m.CreatedAt,
+		RetiredAt: m.RetiredAt,
+		RevokeAt:  m.RevokeAt,
+		RevokedAt: m.RevokedAt,
+	}
+}
+
+func UserKeyModelFromEntity(e *entities.UserKey) *UserKeyModel {
+	return &UserKeyModel{
+		ID:        e.ID.String(),
+		UserID:    e.UserID.String(),
+		AccountID: e.AccountID.String(),
+		PublicKey: e.PublicKey,
+		CreatedAt: e.CreatedAt,
+		RetiredAt: e.RetiredAt,
+		RevokeAt:  e.RevokeAt,
+		RevokedAt: e.RevokedAt,
+	}
+}
+
+// RotationPolicyModel represents the GORM model for credential rotation policies
+type RotationPolicyModel struct {
+	ID                 string  `gorm:"primaryKey;type:text"`
+	OperatorID         string  `gorm:"type:text;not null;index:idx_rotation_policies_operator_id"`
+	AccountID          *string `gorm:"type:text"`
+	ScopedSigningKeyID *string `gorm:"type:text"`
+	IntervalSeconds    int64   `gorm:"not null"`
+	GracePeriodSeconds int64   `gorm:"not null;default:0"`
+	CreatedBy          string  `gorm:"type:text;not null;default:''"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+func (RotationPolicyModel) TableName() string {
+	return "rotation_policies"
+}
+
+func (m *RotationPolicyModel) ToEntity() *entities.RotationPolicy {
+	policy := &entities.RotationPolicy{
+		ID:          uuid.MustParse(m.ID),
+		OperatorID:  uuid.MustParse(m.OperatorID),
+		Interval:    time.Duration(m.IntervalSeconds) * time.Second,
+		GracePeriod: time.Duration(m.GracePeriodSeconds) * time.Second,
+		CreatedBy:   m.CreatedBy,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+	if m.AccountID != nil {
+		id := uuid.MustParse(*m.AccountID)
+		policy.AccountID = &id
+	}
+	if m.ScopedSigningKeyID != nil {
+		id := uuid.MustParse(*m.ScopedSigningKeyID)
+		policy.ScopedSigningKeyID = &id
+	}
+	return policy
+}
+
+func RotationPolicyModelFromEntity(e *entities.RotationPolicy) *RotationPolicyModel {
+	model := &RotationPolicyModel{
+		ID:                 e.ID.String(),
+		OperatorID:         e.OperatorID.String(),
+		IntervalSeconds:    int64(e.Interval.Seconds()),
+		GracePeriodSeconds: int64(e.GracePeriod.Seconds()),
+		CreatedBy:          e.CreatedBy,
+		CreatedAt:          e.CreatedAt,
+		UpdatedAt:          e.UpdatedAt,
+	}
+	if e.AccountID != nil {
+		id := e.AccountID.String()
+		model.AccountID = &id
+	}
+	if e.ScopedSigningKeyID != nil {
+		id := e.ScopedSigningKeyID.String()
+		model.ScopedSigningKeyID = &id
+	}
+	return model
+}
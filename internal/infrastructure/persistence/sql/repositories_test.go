@@ -2,6 +2,7 @@ package sql
 
 import (
 	"context"
+	"strconv"
 	"testing"
 	"time"
 
@@ -25,7 +26,16 @@ type RepositoryTestSuite struct {
 	userRepo     *UserRepo
 	scopedKeyRepo *ScopedSigningKeyRepo
 	clusterRepo  *ClusterRepo
+	clusterServerRepo *ClusterServerRepo
+	clusterHealthRepo *ClusterHealthCheckRepo
+	leaderLeaseRepo   *LeaderLeaseRepo
 	apiUserRepo  *APIUserRepo
+	authFailureRepo *AuthFailureRepo
+	accountStatsRepo *AccountStatsRepo
+	leafnodeRepo     *LeafnodeProfileRepo
+	placementRepo    *AccountPlacementRepo
+	userKeyRepo      *UserKeyRepo
+	rotationRepo     *RotationPolicyRepo
 }
 
 func (s *RepositoryTestSuite) SetupSuite() {
@@ -56,7 +66,16 @@ func (s *RepositoryTestSuite) SetupSuite() {
 	s.userRepo = NewUserRepo(db)
 	s.scopedKeyRepo = NewScopedSigningKeyRepo(db)
 	s.clusterRepo = NewClusterRepo(db)
+	s.clusterServerRepo = NewClusterServerRepo(db)
+	s.clusterHealthRepo = NewClusterHealthCheckRepo(db)
+	s.leaderLeaseRepo = NewLeaderLeaseRepo(db)
 	s.apiUserRepo = NewAPIUserRepo(db)
+	s.authFailureRepo = NewAuthFailureRepo(db)
+	s.accountStatsRepo = NewAccountStatsRepo(db)
+	s.leafnodeRepo = NewLeafnodeProfileRepo(db)
+	s.placementRepo = NewAccountPlacementRepo(db)
+	s.userKeyRepo = NewUserKeyRepo(db)
+	s.rotationRepo = NewRotationPolicyRepo(db)
 }
 
 func (s *RepositoryTestSuite) TearDownSuite() {
@@ -66,12 +85,21 @@ func (s *RepositoryTestSuite) TearDownSuite() {
 
 func (s *RepositoryTestSuite) SetupTest() {
 	// Clean all tables before each test
+	s.db.Exec("DELETE FROM user_keys")
+	s.db.Exec("DELETE FROM rotation_policies")
+	s.db.Exec("DELETE FROM account_placements")
+	s.db.Exec("DELETE FROM leafnode_profiles")
+	s.db.Exec("DELETE FROM auth_failures")
+	s.db.Exec("DELETE FROM account_stats")
 	s.db.Exec("DELETE FROM users")
 	s.db.Exec("DELETE FROM scoped_signing_keys")
 	s.db.Exec("DELETE FROM accounts")
+	s.db.Exec("DELETE FROM cluster_servers")
+	s.db.Exec("DELETE FROM cluster_health_checks")
 	s.db.Exec("DELETE FROM clusters")
 	s.db.Exec("DELETE FROM operators")
 	s.db.Exec("DELETE FROM api_users")
+	s.db.Exec("DELETE FROM leader_leases")
 }
 
 func (s *RepositoryTestSuite) TestOperatorCRUD() {
@@ -263,6 +291,104 @@ func (s *RepositoryTestSuite) TestUserCRUD() {
 	require.NoError(s.T(), err)
 }
 
+func (s *RepositoryTestSuite) TestUserActivity() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "activity-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OACT123",
+		JWT:           "jwt.token.here",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	account := &entities.Account{
+		ID:            uuid.New(),
+		OperatorID:    operator.ID,
+		Name:          "activity-account",
+		EncryptedSeed: "encrypted:key-1:xyz",
+		PublicKey:     "AACT456",
+		JWT:           "account.jwt.here",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.accountRepo.Create(ctx, account))
+
+	newUser := func(name, publicKey string) *entities.User {
+		user := &entities.User{
+			ID:            uuid.New(),
+			AccountID:     account.ID,
+			Name:          name,
+			EncryptedSeed: "encrypted:key-1:" + name,
+			PublicKey:     publicKey,
+			JWT:           "user.jwt.here",
+			CreatedAt:     time.Now(),
+			UpdatedAt:     time.Now(),
+		}
+		require.NoError(s.T(), s.userRepo.Create(ctx, user))
+		return user
+	}
+	active := newUser("active", "UACTIVE")
+	idle := newUser("idle", "UIDLE")
+	newUser("never", "UNEVER")
+
+	now := time.Now()
+
+	// Unknown public keys are not NIS users
+	found, err := s.userRepo.RecordConnect(ctx, "UUNKNOWN", now, "10.0.0.1")
+	require.NoError(s.T(), err)
+	assert.False(s.T(), found)
+
+	found, err = s.userRepo.RecordConnect(ctx, active.PublicKey, now.Add(-time.Hour), "10.0.0.1")
+	require.NoError(s.T(), err)
+	assert.True(s.T(), found)
+	_, err = s.userRepo.RecordConnect(ctx, active.PublicKey, now, "10.0.0.2")
+	require.NoError(s.T(), err)
+	// A late disconnect of the first connection does not move last seen backwards
+	_, err = s.userRepo.RecordDisconnect(ctx, active.PublicKey, now.Add(-30*time.Minute))
+	require.NoError(s.T(), err)
+
+	_, err = s.userRepo.RecordConnect(ctx, idle.PublicKey, now.Add(-40*24*time.Hour), "10.0.0.3")
+	require.NoError(s.T(), err)
+	_, err = s.userRepo.RecordDisconnect(ctx, idle.PublicKey, now.Add(-39*24*time.Hour))
+	require.NoError(s.T(), err)
+
+	retrieved, err := s.userRepo.GetByID(ctx, active.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), retrieved.ConnectionCount)
+	assert.Equal(s.T(), "10.0.0.2", retrieved.LastRemoteIP)
+	require.NotNil(s.T(), retrieved.LastSeenAt)
+	assert.WithinDuration(s.T(), now, *retrieved.LastSeenAt, time.Second)
+	require.NotNil(s.T(), retrieved.LastConnectedAt)
+	assert.WithinDuration(s.T(), now, *retrieved.LastConnectedAt, time.Second)
+
+	// Updating the user's metadata keeps the recorded activity
+	retrieved.Description = "updated"
+	retrieved.ConnectionCount = 0
+	retrieved.LastSeenAt = nil
+	require.NoError(s.T(), s.userRepo.Update(ctx, retrieved))
+	retrieved, err = s.userRepo.GetByID(ctx, active.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), retrieved.ConnectionCount)
+	assert.NotNil(s.T(), retrieved.LastSeenAt)
+
+	stale, err := s.userRepo.ListNotSeenSince(ctx, &account.ID, now.Add(-30*24*time.Hour), repositories.ListOptions{})
+	require.NoError(s.T(), err)
+	names := make([]string, len(stale))
+	for i, user := range stale {
+		names[i] = user.Name
+	}
+	assert.ElementsMatch(s.T(), []string{"idle", "never"}, names)
+
+	stale, err = s.userRepo.ListNotSeenSince(ctx, nil, now.Add(-60*24*time.Hour), repositories.ListOptions{})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), stale, 1)
+	assert.Equal(s.T(), "never", stale[0].Name)
+}
+
 func (s *RepositoryTestSuite) TestCascadeDelete() {
 	ctx := context.Background()
 
@@ -387,6 +513,897 @@ func (s *RepositoryTestSuite) TestPagination() {
 	assert.Len(s.T(), operators, 2)
 }
 
+func (s *RepositoryTestSuite) TestClusterServerProfile() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "profile-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OPROFILE",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	cluster := &entities.Cluster{
+		ID:         uuid.New(),
+		Name:       "profile-cluster",
+		ServerURLs: []string{"nats://a:4222"},
+		OperatorID: operator.ID,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	require.NoError(s.T(), s.clusterRepo.Create(ctx, cluster))
+
+	// Clusters without a profile use the defaults
+	found, err := s.clusterRepo.GetByID(ctx, cluster.ID)
+	require.NoError(s.T(), err)
+	assert.Nil(s.T(), found.ServerProfile)
+
+	profile := entities.DefaultServerProfile()
+	profile.ClusterPort = 6222
+	profile.Routes = []string{"nats-route://a:6222", "nats-route://b:6222"}
+	profile.TLS = entities.ServerTLS{CertFile: "/tls/cert.pem", KeyFile: "/tls/key.pem", Verify: true}
+	profile.JetStream.MaxStorage = 10 << 30
+	profile.Resolver.Interval = 5 * time.Minute
+	profile.Websocket = entities.ServerWebsocket{Port: 8080, NoTLS: true}
+	profile.Gateway.Port = 7222
+	cluster.ServerProfile = profile
+	require.NoError(s.T(), s.clusterRepo.Update(ctx, cluster))
+
+	found, err = s.clusterRepo.GetByID(ctx, cluster.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), profile, found.ServerProfile)
+}
+
+func (s *RepositoryTestSuite) TestClusterSupercluster() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "super-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OSUPER",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	for _, c := range []struct {
+		name, supercluster string
+		gatewayURLs        []string
+	}{
+		{"west", "global", []string{"nats://west:7222"}},
+		{"east", "global", nil},
+		{"lab", "", nil},
+	} {
+		require.NoError(s.T(), s.clusterRepo.Create(ctx, &entities.Cluster{
+			ID:           uuid.New(),
+			Name:         c.name,
+			ServerURLs:   []string{"nats://" + c.name + ":4222"},
+			OperatorID:   operator.ID,
+			Supercluster: c.supercluster,
+			GatewayURLs:  c.gatewayURLs,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		}))
+	}
+
+	members, err := s.clusterRepo.ListBySupercluster(ctx, operator.ID, "global")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), members, 2)
+	assert.Equal(s.T(), "east", members[0].Name)
+	assert.Empty(s.T(), members[0].GatewayURLs)
+	assert.Equal(s.T(), "west", members[1].Name)
+	assert.Equal(s.T(), []string{"nats://west:7222"}, members[1].GatewayURLs)
+
+	members, err = s.clusterRepo.ListBySupercluster(ctx, uuid.New(), "global")
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), members)
+}
+
+func (s *RepositoryTestSuite) TestLeafnodeProfiles() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "leaf-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OLEAF",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	account := &entities.Account{
+		ID:            uuid.New(),
+		OperatorID:    operator.ID,
+		Name:          "leaf-account",
+		EncryptedSeed: "encrypted:key-1:xyz",
+		PublicKey:     "ALEAF",
+		JWT:           "account.jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.accountRepo.Create(ctx, account))
+
+	user := &entities.User{
+		ID:            uuid.New(),
+		AccountID:     account.ID,
+		Name:          "leaf-user",
+		EncryptedSeed: "encrypted:key-1:user",
+		PublicKey:     "ULEAF",
+		JWT:           "user.jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.userRepo.Create(ctx, user))
+
+	cluster := &entities.Cluster{
+		ID:         uuid.New(),
+		Name:       "hub",
+		ServerURLs: []string{"nats://hub-1:4222"},
+		OperatorID: operator.ID,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	require.NoError(s.T(), s.clusterRepo.Create(ctx, cluster))
+
+	edge := &entities.LeafnodeProfile{
+		ID:              uuid.New(),
+		ClusterID:       cluster.ID,
+		Name:            "edge",
+		UserID:          user.ID,
+		LocalAccount:    "LOCAL",
+		URLs:            []string{"nats-leaf://hub-1:7422", "nats-leaf://hub-2:7422"},
+		CredentialsPath: "/etc/nats/edge.creds",
+		CAFile:          "/etc/nats/ca.pem",
+		CreatedAt:       time.Now().UTC().Truncate(time.Second),
+		UpdatedAt:       time.Now().UTC().Truncate(time.Second),
+	}
+	require.NoError(s.T(), s.leafnodeRepo.Create(ctx, edge))
+	branch := &entities.LeafnodeProfile{
+		ID:        uuid.New(),
+		ClusterID: cluster.ID,
+		Name:      "branch",
+		UserID:    user.ID,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	require.NoError(s.T(), s.leafnodeRepo.Create(ctx, branch))
+
+	found, err := s.leafnodeRepo.GetByID(ctx, edge.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), edge.URLs, found.URLs)
+	assert.Equal(s.T(), edge.LocalAccount, found.LocalAccount)
+	assert.Equal(s.T(), edge.CredentialsPath, found.CredentialsPath)
+	assert.Equal(s.T(), edge.CAFile, found.CAFile)
+
+	// Ordered by name
+	profiles, err := s.leafnodeRepo.ListByCluster(ctx, cluster.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), profiles, 2)
+	assert.Equal(s.T(), "branch", profiles[0].Name)
+	assert.Equal(s.T(), "edge", profiles[1].Name)
+
+	require.NoError(s.T(), s.leafnodeRepo.Delete(ctx, branch.ID))
+	assert.ErrorIs(s.T(), s.leafnodeRepo.Delete(ctx, branch.ID), repositories.ErrNotFound)
+
+	// Profiles go away with their user
+	require.NoError(s.T(), s.userRepo.Delete(ctx, user.ID))
+	_, err = s.leafnodeRepo.GetByID(ctx, edge.ID)
+	assert.ErrorIs(s.T(), err, repositories.ErrNotFound)
+}
+
+func (s *RepositoryTestSuite) TestAccountPlacements() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "placement-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OPLACE",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	account := &entities.Account{
+		ID:            uuid.New(),
+		OperatorID:    operator.ID,
+		Name:          "eu-tenant",
+		EncryptedSeed: "encrypted:key-1:xyz",
+		PublicKey:     "APLACE",
+		JWT:           "account.jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.accountRepo.Create(ctx, account))
+
+	var clusterIDs []uuid.UUID
+	for _, name := range []string{"eu-west", "eu-central", "us-east"} {
+		cluster := &entities.Cluster{
+			ID:         uuid.New(),
+			Name:       name,
+			ServerURLs: []string{"nats://" + name + ":4222"},
+			OperatorID: operator.ID,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		}
+		require.NoError(s.T(), s.clusterRepo.Create(ctx, cluster))
+		clusterIDs = append(clusterIDs, cluster.ID)
+	}
+
+	placements, err := s.placementRepo.ListByAccount(ctx, account.ID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), placements)
+
+	require.NoError(s.T(), s.placementRepo.Replace(ctx, account.ID, clusterIDs[:2]))
+	placements, err = s.placementRepo.ListByAccount(ctx, account.ID)
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), placements, 2)
+
+	// Replacing drops the clusters that are not listed anymore
+	require.NoError(s.T(), s.placementRepo.Replace(ctx, account.ID, clusterIDs[1:]))
+	placements, err = s.placementRepo.ListByOperator(ctx, operator.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), placements, 2)
+	placed := []uuid.UUID{placements[0].ClusterID, placements[1].ClusterID}
+	assert.ElementsMatch(s.T(), clusterIDs[1:], placed)
+
+	placements, err = s.placementRepo.ListByOperator(ctx, uuid.New())
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), placements)
+
+	// Placements go away with their cluster
+	require.NoError(s.T(), s.clusterRepo.Delete(ctx, clusterIDs[2]))
+	placements, err = s.placementRepo.ListByAccount(ctx, account.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), placements, 1)
+	assert.Equal(s.T(), clusterIDs[1], placements[0].ClusterID)
+
+	require.NoError(s.T(), s.placementRepo.Replace(ctx, account.ID, nil))
+	placements, err = s.placementRepo.ListByAccount(ctx, account.ID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), placements)
+}
+
+func (s *RepositoryTestSuite) TestUserKeys() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "rotation-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OROTATE",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	account := &entities.Account{
+		ID:              uuid.New(),
+		OperatorID:      operator.ID,
+		Name:            "tenant",
+		EncryptedSeed:   "encrypted:key-1:xyz",
+		PublicKey:       "AROTATE",
+		JWT:             "account.jwt",
+		RevokedUserKeys: map[string]time.Time{"UOLD": time.Now().UTC().Truncate(time.Second)},
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+	require.NoError(s.T(), s.accountRepo.Create(ctx, account))
+	stored, err := s.accountRepo.GetByID(ctx, account.ID)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), stored.RevokedUserKeys["UOLD"].Equal(account.RevokedUserKeys["UOLD"]))
+
+	user := &entities.User{
+		ID:            uuid.New(),
+		AccountID:     account.ID,
+		Name:          "app",
+		EncryptedSeed: "encrypted:key-1:user",
+		PublicKey:     "UROTATE",
+		JWT:           "user.jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.userRepo.Create(ctx, user))
+
+	now := time.Now()
+	for i, revokeAt := range []time.Time{now.Add(-time.Minute), now.Add(time.Hour)} {
+		require.NoError(s.T(), s.userKeyRepo.Create(ctx, &entities.UserKey{
+			ID:        uuid.New(),
+			UserID:    user.ID,
+			AccountID: account.ID,
+			PublicKey: "UKEY" + strconv.Itoa(i),
+			CreatedAt: user.CreatedAt,
+			RetiredAt: now.Add(time.Duration(i) * time.Second),
+			RevokeAt:  revokeAt,
+		}))
+	}
+
+	keys, err := s.userKeyRepo.ListByUser(ctx, user.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), keys, 2)
+	assert.Equal(s.T(), "UKEY1", keys[0].PublicKey)
+	keys, err = s.userKeyRepo.ListByAccount(ctx, account.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), keys, 2)
+	assert.Equal(s.T(), "UKEY1", keys[0].PublicKey)
+
+	// Only the key past its grace period is due
+	due, err := s.userKeyRepo.ListDue(ctx, now)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), due, 1)
+	assert.Equal(s.T(), "UKEY0", due[0].PublicKey)
+
+	require.NoError(s.T(), s.userKeyRepo.MarkRevoked(ctx, due[0].ID, now))
+	due, err = s.userKeyRepo.ListDue(ctx, now)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), due)
+	assert.ErrorIs(s.T(), s.userKeyRepo.MarkRevoked(ctx, uuid.New(), now), repositories.ErrNotFound)
+
+	// Keys go away with their user
+	require.NoError(s.T(), s.userRepo.Delete(ctx, user.ID))
+	keys, err = s.userKeyRepo.ListByUser(ctx, user.ID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), keys)
+}
+
+func (s *RepositoryTestSuite) TestRotationPolicies() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "policy-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OPOLICY",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	account := &entities.Account{
+		ID:            uuid.New(),
+		OperatorID:    operator.ID,
+		Name:          "tenant",
+		EncryptedSeed: "encrypted:key-1:xyz",
+		PublicKey:     "APOLICY",
+		JWT:           "account.jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.accountRepo.Create(ctx, account))
+
+	operatorPolicy := &entities.RotationPolicy{
+		ID:          uuid.New(),
+		OperatorID:  operator.ID,
+		Interval:    30 * 24 * time.Hour,
+		GracePeriod: 24 * time.Hour,
+		CreatedBy:   "admin",
+		CreatedAt:   time.Now().Add(-time.Minute),
+		UpdatedAt:   time.Now(),
+	}
+	accountPolicy := &entities.RotationPolicy{
+		ID:         uuid.New(),
+		OperatorID: operator.ID,
+		AccountID:  &account.ID,
+		Interval:   7 * 24 * time.Hour,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	require.NoError(s.T(), s.rotationRepo.Create(ctx, operatorPolicy))
+	require.NoError(s.T(), s.rotationRepo.Create(ctx, accountPolicy))
+
+	stored, err := s.rotationRepo.GetByID(ctx, operatorPolicy.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), entities.RotationPolicyScopeOperator, stored.Scope())
+	assert.Equal(s.T(), 30*24*time.Hour, stored.Interval)
+	assert.Equal(s.T(), 24*time.Hour, stored.GracePeriod)
+	assert.Equal(s.T(), "admin", stored.CreatedBy)
+
+	policies, err := s.rotationRepo.ListByOperator(ctx, operator.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), policies, 2)
+	assert.Equal(s.T(), operatorPolicy.ID, policies[0].ID)
+	assert.Equal(s.T(), entities.RotationPolicyScopeAccount, policies[1].Scope())
+	assert.Equal(s.T(), account.ID, *policies[1].AccountID)
+
+	// Policies go away with what they apply to
+	require.NoError(s.T(), s.accountRepo.Delete(ctx, account.ID))
+	policies, err = s.rotationRepo.List(ctx)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), policies, 1)
+	assert.Equal(s.T(), operatorPolicy.ID, policies[0].ID)
+
+	require.NoError(s.T(), s.rotationRepo.Delete(ctx, operatorPolicy.ID))
+	assert.ErrorIs(s.T(), s.rotationRepo.Delete(ctx, operatorPolicy.ID), repositories.ErrNotFound)
+	_, err = s.rotationRepo.GetByID(ctx, operatorPolicy.ID)
+	assert.ErrorIs(s.T(), err, repositories.ErrNotFound)
+}
+
+func (s *RepositoryTestSuite) TestScopedSigningKeyRetiredKeys() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "retired-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "ORETIRED",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	account := &entities.Account{
+		ID:            uuid.New(),
+		OperatorID:    operator.ID,
+		Name:          "tenant",
+		EncryptedSeed: "encrypted:key-1:xyz",
+		PublicKey:     "ARETIRED",
+		JWT:           "account.jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.accountRepo.Create(ctx, account))
+
+	rotated := &entities.ScopedSigningKey{
+		ID:            uuid.New(),
+		AccountID:     account.ID,
+		Name:          "rotated",
+		EncryptedSeed: "encrypted:key-1:rotated",
+		PublicKey:     "AROTATED2",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	plain := &entities.ScopedSigningKey{
+		ID:            uuid.New(),
+		AccountID:     account.ID,
+		Name:          "plain",
+		EncryptedSeed: "encrypted:key-1:plain",
+		PublicKey:     "APLAIN",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.scopedKeyRepo.Create(ctx, rotated))
+	require.NoError(s.T(), s.scopedKeyRepo.Create(ctx, plain))
+
+	keys, err := s.scopedKeyRepo.ListWithRetiredKeys(ctx)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), keys)
+
+	retireAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	rotated.RetiredKeys = map[string]time.Time{"AROTATED1": retireAt}
+	require.NoError(s.T(), s.scopedKeyRepo.Update(ctx, rotated))
+
+	keys, err = s.scopedKeyRepo.ListWithRetiredKeys(ctx)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), keys, 1)
+	assert.Equal(s.T(), rotated.ID, keys[0].ID)
+	require.Contains(s.T(), keys[0].RetiredKeys, "AROTATED1")
+	assert.True(s.T(), retireAt.Equal(keys[0].RetiredKeys["AROTATED1"]))
+
+	// A key whose retired keys were all dropped is no longer listed
+	rotated.RetiredKeys = nil
+	require.NoError(s.T(), s.scopedKeyRepo.Update(ctx, rotated))
+	keys, err = s.scopedKeyRepo.ListWithRetiredKeys(ctx)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), keys)
+}
+
+func (s *RepositoryTestSuite) TestClusterServerUpsert() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "topology-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OTOPO",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	cluster := &entities.Cluster{
+		ID:         uuid.New(),
+		Name:       "topology-cluster",
+		ServerURLs: []string{"nats://a:4222", "nats://b:4222"},
+		OperatorID: operator.ID,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	require.NoError(s.T(), s.clusterRepo.Create(ctx, cluster))
+
+	firstSeen := time.Now().Add(-time.Hour).UTC()
+	started := firstSeen.Add(-24 * time.Hour)
+	server := &entities.ClusterServer{
+		ID:          uuid.New(),
+		ClusterID:   cluster.ID,
+		ServerID:    "NSERVERA",
+		Name:        "nats-a",
+		Version:     "2.10.0",
+		JetStream:   true,
+		ClusterName: "east",
+		Gateways:    []string{"west"},
+		Connections: 3,
+		StartedAt:   &started,
+		LastSeen:    firstSeen,
+		CreatedAt:   firstSeen,
+		UpdatedAt:   firstSeen,
+	}
+	require.NoError(s.T(), s.clusterServerRepo.Upsert(ctx, server))
+
+	// A second ping of the same server updates the record in place
+	seen := time.Now().UTC()
+	update := *server
+	update.ID = uuid.New()
+	update.Version = "2.11.0"
+	update.Connections = 7
+	update.JetStreamMaxStorage = 10 << 30
+	update.LastSeen = seen
+	update.UpdatedAt = seen
+	require.NoError(s.T(), s.clusterServerRepo.Upsert(ctx, &update))
+
+	require.NoError(s.T(), s.clusterServerRepo.Upsert(ctx, &entities.ClusterServer{
+		ID:        uuid.New(),
+		ClusterID: cluster.ID,
+		ServerID:  "NSERVERB",
+		Name:      "nats-b",
+		Version:   "2.11.0",
+		LastSeen:  firstSeen,
+		CreatedAt: firstSeen,
+		UpdatedAt: firstSeen,
+	}))
+
+	servers, err := s.clusterServerRepo.ListByCluster(ctx, cluster.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), servers, 2)
+	assert.Equal(s.T(), server.ID, servers[0].ID)
+	assert.Equal(s.T(), "2.11.0", servers[0].Version)
+	assert.Equal(s.T(), 7, servers[0].Connections)
+	assert.Equal(s.T(), []string{"west"}, servers[0].Gateways)
+	assert.True(s.T(), servers[0].JetStream)
+	assert.Equal(s.T(), int64(10<<30), servers[0].JetStreamMaxStorage)
+	require.NotNil(s.T(), servers[0].StartedAt)
+	assert.WithinDuration(s.T(), seen, servers[0].LastSeen, time.Second)
+	assert.Equal(s.T(), "nats-b", servers[1].Name)
+
+	// Only the server not seen since the cutoff is pruned
+	deleted, err := s.clusterServerRepo.DeleteStale(ctx, cluster.ID, seen.Add(-time.Minute))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), deleted)
+
+	servers, err = s.clusterServerRepo.ListByCluster(ctx, cluster.ID)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), servers, 1)
+	assert.Equal(s.T(), "NSERVERA", servers[0].ServerID)
+
+	// Deleting the cluster removes its server records
+	require.NoError(s.T(), s.clusterRepo.Delete(ctx, cluster.ID))
+	servers, err = s.clusterServerRepo.ListByCluster(ctx, cluster.ID)
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), servers)
+}
+
+func (s *RepositoryTestSuite) TestClusterHealthChecks() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "health-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OHEALTH",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	cluster := &entities.Cluster{
+		ID:                  uuid.New(),
+		Name:                "health-cluster",
+		ServerURLs:          []string{"nats://a:4222"},
+		OperatorID:          operator.ID,
+		HealthCheckInterval: 30 * time.Second,
+		HealthCheckTimeout:  5 * time.Second,
+		CreatedAt:           time.Now(),
+		UpdatedAt:           time.Now(),
+	}
+	require.NoError(s.T(), s.clusterRepo.Create(ctx, cluster))
+
+	stored, err := s.clusterRepo.GetByID(ctx, cluster.ID)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), 30*time.Second, stored.HealthCheckInterval)
+	assert.Equal(s.T(), 5*time.Second, stored.HealthCheckTimeout)
+	assert.Nil(s.T(), stored.NextHealthCheck)
+
+	now := time.Now().UTC()
+	checks := []*entities.ClusterHealthCheck{
+		{CheckedAt: now.Add(-48 * time.Hour), Healthy: true, Latency: 4 * time.Millisecond},
+		{CheckedAt: now.Add(-2 * time.Hour), Healthy: true, Latency: 3 * time.Millisecond},
+		{CheckedAt: now.Add(-time.Hour), Healthy: false, Error: "nats: timeout"},
+		{CheckedAt: now, Healthy: true, Latency: 5 * time.Millisecond},
+	}
+	for _, check := range checks {
+		check.ID = uuid.New()
+		check.ClusterID = cluster.ID
+		require.NoError(s.T(), s.clusterHealthRepo.Create(ctx, check))
+	}
+
+	// Newest first
+	listed, err := s.clusterHealthRepo.ListByCluster(ctx, cluster.ID, repositories.ListOptions{Limit: 2})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), listed, 2)
+	assert.True(s.T(), listed[0].Healthy)
+	assert.Equal(s.T(), 5*time.Millisecond, listed[0].Latency)
+	assert.False(s.T(), listed[1].Healthy)
+	assert.Equal(s.T(), "nats: timeout", listed[1].Error)
+
+	total, healthy, err := s.clusterHealthRepo.CountSince(ctx, cluster.ID, now.Add(-24*time.Hour))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(3), total)
+	assert.Equal(s.T(), int64(2), healthy)
+
+	// Retention pruning only drops checks past the cutoff
+	deleted, err := s.clusterHealthRepo.DeleteBefore(ctx, now.Add(-24*time.Hour))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(1), deleted)
+
+	listed, err = s.clusterHealthRepo.ListByCluster(ctx, cluster.ID, repositories.ListOptions{})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), listed, 3)
+
+	// Deleting the cluster removes its history
+	require.NoError(s.T(), s.clusterRepo.Delete(ctx, cluster.ID))
+	listed, err = s.clusterHealthRepo.ListByCluster(ctx, cluster.ID, repositories.ListOptions{})
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), listed)
+}
+
+func (s *RepositoryTestSuite) TestAuthFailures() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "authfail-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OAUTHFAIL",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	cluster := &entities.Cluster{
+		ID:         uuid.New(),
+		Name:       "authfail-cluster",
+		ServerURLs: []string{"nats://a:4222"},
+		OperatorID: operator.ID,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	require.NoError(s.T(), s.clusterRepo.Create(ctx, cluster))
+
+	accountID := uuid.New()
+	window := time.Now().UTC().Truncate(time.Minute).Add(-10 * time.Minute)
+	record := func(userKey, remoteIP string, at time.Time, account *uuid.UUID) {
+		require.NoError(s.T(), s.authFailureRepo.Record(ctx, &entities.AuthFailure{
+			ID:            uuid.New(),
+			ClusterID:     cluster.ID,
+			WindowStart:   at.Truncate(time.Minute),
+			UserPublicKey: userKey,
+			AccountID:     account,
+			RemoteIP:      remoteIP,
+			Reason:        "Authentication Failure",
+			Count:         1,
+			FirstSeen:     at,
+			LastSeen:      at,
+		}))
+	}
+
+	// Failures of the same key and address in the same window are aggregated
+	record("UREVOKED", "10.0.0.1", window.Add(20*time.Second), &accountID)
+	record("UREVOKED", "10.0.0.1", window.Add(5*time.Second), &accountID)
+	record("UREVOKED", "10.0.0.1", window.Add(40*time.Second), &accountID)
+	record("UREVOKED", "10.0.0.1", window.Add(time.Minute), &accountID)
+	record("UFORGED", "10.0.0.2", window.Add(2*time.Minute), nil)
+
+	listed, err := s.authFailureRepo.List(ctx, repositories.AuthFailureFilter{ClusterID: &cluster.ID}, repositories.ListOptions{})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), listed, 3)
+	assert.Equal(s.T(), "UFORGED", listed[0].UserPublicKey)
+	assert.Nil(s.T(), listed[0].AccountID)
+	assert.Equal(s.T(), int64(1), listed[1].Count)
+	assert.Equal(s.T(), int64(3), listed[2].Count)
+	assert.WithinDuration(s.T(), window.Add(5*time.Second), listed[2].FirstSeen, time.Millisecond)
+	assert.WithinDuration(s.T(), window.Add(40*time.Second), listed[2].LastSeen, time.Millisecond)
+	require.NotNil(s.T(), listed[2].AccountID)
+	assert.Equal(s.T(), accountID, *listed[2].AccountID)
+
+	listed, err = s.authFailureRepo.List(ctx, repositories.AuthFailureFilter{
+		AccountID: &accountID,
+		Since:     window.Add(50 * time.Second),
+	}, repositories.ListOptions{})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), listed, 1)
+	assert.Equal(s.T(), window.Add(time.Minute), listed[0].WindowStart.UTC())
+
+	// Retention pruning only drops aggregates last seen past the cutoff
+	deleted, err := s.authFailureRepo.DeleteBefore(ctx, window.Add(90*time.Second))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), deleted)
+
+	// Deleting the cluster removes its log
+	require.NoError(s.T(), s.clusterRepo.Delete(ctx, cluster.ID))
+	listed, err = s.authFailureRepo.List(ctx, repositories.AuthFailureFilter{}, repositories.ListOptions{})
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), listed)
+}
+
+func (s *RepositoryTestSuite) TestAccountStats() {
+	ctx := context.Background()
+
+	operator := &entities.Operator{
+		ID:            uuid.New(),
+		Name:          "stats-operator",
+		EncryptedSeed: "encrypted:key-1:abcdef",
+		PublicKey:     "OSTATS",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.operatorRepo.Create(ctx, operator))
+
+	account := &entities.Account{
+		ID:            uuid.New(),
+		OperatorID:    operator.ID,
+		Name:          "stats-account",
+		EncryptedSeed: "encrypted:key-1:xyz",
+		PublicKey:     "ASTATS",
+		JWT:           "jwt",
+		CreatedAt:     time.Now(),
+		UpdatedAt:     time.Now(),
+	}
+	require.NoError(s.T(), s.accountRepo.Create(ctx, account))
+
+	var clusters []*entities.Cluster
+	for _, name := range []string{"stats-east", "stats-west"} {
+		cluster := &entities.Cluster{
+			ID:         uuid.New(),
+			Name:       name,
+			ServerURLs: []string{"nats://a:4222"},
+			OperatorID: operator.ID,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		}
+		require.NoError(s.T(), s.clusterRepo.Create(ctx, cluster))
+		clusters = append(clusters, cluster)
+	}
+
+	hour := time.Now().UTC().Truncate(time.Hour).Add(-2 * time.Hour)
+	record := func(cluster *entities.Cluster, resolution time.Duration, start time.Time, conns, msgsIn int64) {
+		require.NoError(s.T(), s.accountStatsRepo.Record(ctx, &entities.AccountStats{
+			ID:             uuid.New(),
+			ClusterID:      cluster.ID,
+			AccountID:      account.ID,
+			Resolution:     resolution,
+			BucketStart:    start,
+			Samples:        1,
+			ConnectionsSum: conns,
+			MaxConnections: conns,
+			MsgsIn:         msgsIn,
+			SlowConsumers:  1,
+		}))
+	}
+
+	// Samples of the same bucket are summed, keeping the connection peak
+	record(clusters[0], time.Hour, hour, 4, 100)
+	record(clusters[0], time.Hour, hour, 8, 50)
+	record(clusters[0], time.Hour, hour.Add(time.Hour), 2, 10)
+	record(clusters[1], time.Hour, hour, 1, 5)
+	record(clusters[0], time.Minute, hour.Add(90*time.Minute), 2, 10)
+
+	listed, err := s.accountStatsRepo.List(ctx, repositories.AccountStatsFilter{
+		AccountID:  account.ID,
+		ClusterID:  &clusters[0].ID,
+		Resolution: time.Hour,
+	})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), listed, 2)
+	assert.Equal(s.T(), hour, listed[0].BucketStart.UTC())
+	assert.Equal(s.T(), time.Hour, listed[0].Resolution)
+	assert.Equal(s.T(), int64(2), listed[0].Samples)
+	assert.Equal(s.T(), int64(8), listed[0].MaxConnections)
+	assert.Equal(s.T(), 6.0, listed[0].AvgConnections())
+	assert.Equal(s.T(), int64(150), listed[0].MsgsIn)
+	assert.Equal(s.T(), int64(2), listed[0].SlowConsumers)
+
+	// Without a cluster every cluster's buckets are listed, bounded by the range
+	listed, err = s.accountStatsRepo.List(ctx, repositories.AccountStatsFilter{
+		AccountID:  account.ID,
+		Resolution: time.Hour,
+		From:       hour,
+		To:         hour.Add(time.Hour),
+	})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), listed, 2)
+
+	// Pruning only drops the buckets of the given resolution
+	deleted, err := s.accountStatsRepo.DeleteBefore(ctx, time.Hour, hour.Add(time.Hour))
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), int64(2), deleted)
+	listed, err = s.accountStatsRepo.List(ctx, repositories.AccountStatsFilter{AccountID: account.ID, Resolution: time.Minute})
+	require.NoError(s.T(), err)
+	assert.Len(s.T(), listed, 1)
+
+	// Deleting the account removes its statistics
+	require.NoError(s.T(), s.accountRepo.Delete(ctx, account.ID))
+	listed, err = s.accountStatsRepo.List(ctx, repositories.AccountStatsFilter{AccountID: account.ID, Resolution: time.Hour})
+	require.NoError(s.T(), err)
+	assert.Empty(s.T(), listed)
+}
+
+func (s *RepositoryTestSuite) TestLeaderLease() {
+	ctx := context.Background()
+	ttl := 15 * time.Second
+	now := time.Now()
+
+	_, err := s.leaderLeaseRepo.Get(ctx, "nis-serve")
+	assert.ErrorIs(s.T(), err, repositories.ErrNotFound)
+
+	// First replica takes the free lease
+	acquired, err := s.leaderLeaseRepo.TryAcquire(ctx, "nis-serve", "replica-a", ttl, now)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), acquired)
+
+	// Second replica cannot take it while it is valid
+	acquired, err = s.leaderLeaseRepo.TryAcquire(ctx, "nis-serve", "replica-b", ttl, now.Add(5*time.Second))
+	require.NoError(s.T(), err)
+	assert.False(s.T(), acquired)
+
+	// The holder renews it, keeping its acquisition time
+	acquired, err = s.leaderLeaseRepo.TryAcquire(ctx, "nis-serve", "replica-a", ttl, now.Add(10*time.Second))
+	require.NoError(s.T(), err)
+	assert.True(s.T(), acquired)
+
+	lease, err := s.leaderLeaseRepo.Get(ctx, "nis-serve")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "replica-a", lease.HolderID)
+	assert.WithinDuration(s.T(), now, lease.AcquiredAt, time.Second)
+	assert.WithinDuration(s.T(), now.Add(10*time.Second+ttl), lease.ExpiresAt, time.Second)
+
+	// Once the holder stops renewing, another replica takes over
+	later := now.Add(10*time.Second + ttl + time.Second)
+	acquired, err = s.leaderLeaseRepo.TryAcquire(ctx, "nis-serve", "replica-b", ttl, later)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), acquired)
+
+	lease, err = s.leaderLeaseRepo.Get(ctx, "nis-serve")
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), "replica-b", lease.HolderID)
+	assert.WithinDuration(s.T(), later, lease.AcquiredAt, time.Second)
+
+	// Releasing someone else's lease is a no-op
+	require.NoError(s.T(), s.leaderLeaseRepo.Release(ctx, "nis-serve", "replica-a"))
+	_, err = s.leaderLeaseRepo.Get(ctx, "nis-serve")
+	require.NoError(s.T(), err)
+
+	// After a release the lease is immediately free
+	require.NoError(s.T(), s.leaderLeaseRepo.Release(ctx, "nis-serve", "replica-b"))
+	acquired, err = s.leaderLeaseRepo.TryAcquire(ctx, "nis-serve", "replica-a", ttl, later)
+	require.NoError(s.T(), err)
+	assert.True(s.T(), acquired)
+}
+
 func TestRepositoryTestSuite(t *testing.T) {
 	suite.Run(t, new(RepositoryTestSuite))
 }
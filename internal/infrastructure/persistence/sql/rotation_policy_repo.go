@@ -0,0 +1,104 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+// RotationPolicyRepo implements repositories.RotationPolicyRepository using GORM
+type RotationPolicyRepo struct {
+	db *gorm.DB
+}
+
+// NewRotationPolicyRepo creates a new rotation policy repository
+func NewRotationPolicyRepo(db *gorm.DB) *RotationPolicyRepo {
+	return &RotationPolicyRepo{db: db}
+}
+
+// Create creates a new rotation policy
+func (r *RotationPolicyRepo) Create(ctx context.Context, policy *entities.RotationPolicy) error {
+	model := RotationPolicyModelFromEntity(policy)
+
+	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return repositories.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to create rotation policy: %w", err)
+	}
+
+	return nil
+}
+
+// GetByID retrieves a rotation policy by ID
+func (r *RotationPolicyRepo) GetByID(ctx context.Context, id uuid.UUID) (*entities.RotationPolicy, error) {
+	var model RotationPolicyModel
+
+	err := r.db.WithContext(ctx).First(&model, "id = ?", id.String()).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, repositories.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get rotation policy: %w", err)
+	}
+
+	return model.ToEntity(), nil
+}
+
+// List retrieves every rotation policy, ordered by creation time
+func (r *RotationPolicyRepo) List(ctx context.Context) ([]*entities.RotationPolicy, error) {
+	return r.find(r.db.WithContext(ctx))
+}
+
+// ListByOperator retrieves the rotation policies of an operator, ordered by creation time
+func (r *RotationPolicyRepo) ListByOperator(ctx context.Context, operatorID uuid.UUID) ([]*entities.RotationPolicy, error) {
+	return r.find(r.db.WithContext(ctx).Where("operator_id = ?", operatorID.String()))
+}
+
+func (r *RotationPolicyRepo) find(query *gorm.DB) ([]*entities.RotationPolicy, error) {
+	var models []RotationPolicyModel
+
+	if err := query.Order("created_at").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list rotation policies: %w", err)
+	}
+
+	policies := make([]*entities.RotationPolicy, len(models))
+	for i, model := range models {
+		policies[i] = model.ToEntity()
+	}
+
+	return policies, nil
+}
+
+// MoveAccount moves the policies of an account and of its scoped signing keys to
+// another operator
+func (r *RotationPolicyRepo) MoveAccount(ctx context.Context, accountID, operatorID uuid.UUID) error {
+	err := r.db.WithContext(ctx).Model(&RotationPolicyModel{}).
+		Where("account_id = ?", accountID.String()).
+		Updates(map[string]any{"operator_id": operatorID.String(), "updated_at": time.Now()}).Error
+	if err != nil {
+		return fmt.Errorf("failed to move rotation policies: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes a rotation policy by ID
+func (r *RotationPolicyRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&RotationPolicyModel{}, "id = ?", id.String())
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete rotation policy: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
@@ -130,6 +130,22 @@ func (r *ScopedSigningKeyRepo) ListByAccount(ctx context.Context, accountID uuid
 	return keys, nil
 }
 
+// ListWithRetiredKeys retrieves the scoped signing keys holding public keys replaced by a rotation
+func (r *ScopedSigningKeyRepo) ListWithRetiredKeys(ctx context.Context) ([]*entities.ScopedSigningKey, error) {
+	var models []ScopedSigningKeyModel
+
+	if err := r.db.WithContext(ctx).Where("retired_keys IS NOT NULL").Order("created_at").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list scoped signing keys with retired keys: %w", err)
+	}
+
+	keys := make([]*entities.ScopedSigningKey, len(models))
+	for i, model := range models {
+		keys[i] = model.ToEntity()
+	}
+
+	return keys, nil
+}
+
 // Update updates an existing scoped signing key
 func (r *ScopedSigningKeyRepo) Update(ctx context.Context, key *entities.ScopedSigningKey) error {
 	model := ScopedSigningKeyModelFromEntity(key)
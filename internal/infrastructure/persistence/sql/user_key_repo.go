@@ -0,0 +1,112 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"gorm.io/gorm"
+)
+
+// UserKeyRepo implements repositories.UserKeyRepository using GORM
+type UserKeyRepo struct {
+	db *gorm.DB
+}
+
+// NewUserKeyRepo creates a new user key repository
+func NewUserKeyRepo(db *gorm.DB) *UserKeyRepo {
+	return &UserKeyRepo{db: db}
+}
+
+// Create records a retired user key
+func (r *UserKeyRepo) Create(ctx context.Context, key *entities.UserKey) error {
+	if err := r.db.WithContext(ctx).Create(UserKeyModelFromEntity(key)).Error; err != nil {
+		return fmt.Errorf("failed to create user key: %w", err)
+	}
+	return nil
+}
+
+// ListByUser retrieves the retired keys of a user, the latest first
+func (r *UserKeyRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]*entities.UserKey, error) {
+	var models []UserKeyModel
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID.String()).
+		Order("retired_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user keys: %w", err)
+	}
+
+	return userKeysToEntities(models), nil
+}
+
+// ListByAccount retrieves the retired keys of the users of an account, the latest first
+func (r *UserKeyRepo) ListByAccount(ctx context.Context, accountID uuid.UUID) ([]*entities.UserKey, error) {
+	var models []UserKeyModel
+
+	err := r.db.WithContext(ctx).
+		Where("account_id = ?", accountID.String()).
+		Order("retired_at DESC").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list user keys: %w", err)
+	}
+
+	return userKeysToEntities(models), nil
+}
+
+// ListDue retrieves the keys not revoked yet whose grace period ended by before
+func (r *UserKeyRepo) ListDue(ctx context.Context, before time.Time) ([]*entities.UserKey, error) {
+	var models []UserKeyModel
+
+	err := r.db.WithContext(ctx).
+		Where("revoked_at IS NULL AND revoke_at <= ?", before).
+		Order("revoke_at").
+		Find(&models).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due user keys: %w", err)
+	}
+
+	return userKeysToEntities(models), nil
+}
+
+// MarkRevoked records that a key was revoked at the given time
+func (r *UserKeyRepo) MarkRevoked(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	result := r.db.WithContext(ctx).Model(&UserKeyModel{}).
+		Where("id = ?", id.String()).
+		Update("revoked_at", revokedAt)
+	if result.Error != nil {
+		return fmt.Errorf("failed to mark user key revoked: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+	return nil
+}
+
+// Delete deletes a user key record by ID
+func (r *UserKeyRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	result := r.db.WithContext(ctx).Delete(&UserKeyModel{}, "id = ?", id.String())
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete user key: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return repositories.ErrNotFound
+	}
+
+	return nil
+}
+
+func userKeysToEntities(models []UserKeyModel) []*entities.UserKey {
+	keys := make([]*entities.UserKey, len(models))
+	for i, model := range models {
+		keys[i] = model.ToEntity()
+	}
+	return keys
+}
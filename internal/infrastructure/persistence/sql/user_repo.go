@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/thomas-maurice/nis/internal/domain/entities"
@@ -155,13 +156,45 @@ func (r *UserRepo) ListByScopedSigningKey(ctx context.Context, scopedKeyID uuid.
 	return users, nil
 }
 
+// ListNotSeenSince retrieves users not seen since the cutoff, including users that never
+// connected
+func (r *UserRepo) ListNotSeenSince(ctx context.Context, accountID *uuid.UUID, cutoff time.Time, opts repositories.ListOptions) ([]*entities.User, error) {
+	var models []UserModel
+
+	query := r.db.WithContext(ctx).Where("last_seen_at IS NULL OR last_seen_at < ?", cutoff.UTC())
+	if accountID != nil {
+		query = query.Where("account_id = ?", accountID.String())
+	}
+
+	if opts.Limit > 0 {
+		query = query.Limit(opts.Limit)
+	}
+	if opts.Offset > 0 {
+		query = query.Offset(opts.Offset)
+	}
+
+	if err := query.Order("created_at DESC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list users not seen since %s: %w", cutoff.Format(time.RFC3339), err)
+	}
+
+	users := make([]*entities.User, len(models))
+	for i, model := range models {
+		users[i] = model.ToEntity()
+	}
+
+	return users, nil
+}
+
 // Update updates an existing user
 func (r *UserRepo) Update(ctx context.Context, user *entities.User) error {
 	model := UserModelFromEntity(user)
 
+	// The activity columns are owned by RecordConnect and RecordDisconnect, writing
+	// them back here would lose the events recorded since the user was loaded
 	result := r.db.WithContext(ctx).Model(&UserModel{}).
 		Where("id = ?", model.ID).
-		Select("*").Omit("CreatedAt").Updates(model)
+		Select("*").Omit("CreatedAt", "LastConnectedAt", "LastSeenAt", "LastRemoteIP", "ConnectionCount").
+		Updates(model)
 
 	if result.Error != nil {
 		return fmt.Errorf("failed to update user: %w", result.Error)
@@ -174,6 +207,52 @@ func (r *UserRepo) Update(ctx context.Context, user *entities.User) error {
 	return nil
 }
 
+// RecordConnect records a client connection of the user with the given public key.
+// Events arriving out of order never move the last seen time backwards.
+func (r *UserRepo) RecordConnect(ctx context.Context, publicKey string, at time.Time, remoteIP string) (bool, error) {
+	at = at.UTC()
+
+	result := r.db.WithContext(ctx).Model(&UserModel{}).
+		Where("public_key = ?", publicKey).
+		UpdateColumn("connection_count", gorm.Expr("connection_count + 1"))
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to record user connection: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	err := r.db.WithContext(ctx).Model(&UserModel{}).
+		Where("public_key = ? AND (last_connected_at IS NULL OR last_connected_at <= ?)", publicKey, at).
+		UpdateColumns(map[string]interface{}{
+			"last_connected_at": at,
+			"last_remote_ip":    remoteIP,
+		}).Error
+	if err != nil {
+		return false, fmt.Errorf("failed to record user connection: %w", err)
+	}
+
+	if _, err := r.RecordDisconnect(ctx, publicKey, at); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RecordDisconnect records a client disconnection of the user with the given public key
+func (r *UserRepo) RecordDisconnect(ctx context.Context, publicKey string, at time.Time) (bool, error) {
+	at = at.UTC()
+
+	result := r.db.WithContext(ctx).Model(&UserModel{}).
+		Where("public_key = ? AND (last_seen_at IS NULL OR last_seen_at < ?)", publicKey, at).
+		UpdateColumn("last_seen_at", at)
+	if result.Error != nil {
+		return false, fmt.Errorf("failed to record user activity: %w", result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
 // Delete deletes a user by ID
 func (r *UserRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	result := r.db.WithContext(ctx).Delete(&UserModel{}, "id = ?", id.String())
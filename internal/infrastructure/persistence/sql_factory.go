@@ -34,7 +34,16 @@ type sqlRepositoryFactory struct {
 	userRepo             repositories.UserRepository
 	scopedSigningKeyRepo repositories.ScopedSigningKeyRepository
 	clusterRepo          repositories.ClusterRepository
+	clusterServerRepo    repositories.ClusterServerRepository
+	clusterHealthRepo    repositories.ClusterHealthCheckRepository
+	leaderLeaseRepo      repositories.LeaderLeaseRepository
 	apiUserRepo          repositories.APIUserRepository
+	authFailureRepo      repositories.AuthFailureRepository
+	accountStatsRepo     repositories.AccountStatsRepository
+	leafnodeProfileRepo  repositories.LeafnodeProfileRepository
+	accountPlacementRepo repositories.AccountPlacementRepository
+	userKeyRepo          repositories.UserKeyRepository
+	rotationPolicyRepo   repositories.RotationPolicyRepository
 }
 
 func newSQLRepositoryFactory(cfg Config) (RepositoryFactory, error) {
@@ -268,9 +277,72 @@ func (f *sqlRepositoryFactory) ClusterRepository() repositories.ClusterRepositor
 	return f.clusterRepo
 }
 
+func (f *sqlRepositoryFactory) ClusterServerRepository() repositories.ClusterServerRepository {
+	if f.clusterServerRepo == nil {
+		f.clusterServerRepo = sqlRepo.NewClusterServerRepo(f.gormDB)
+	}
+	return f.clusterServerRepo
+}
+
+func (f *sqlRepositoryFactory) ClusterHealthCheckRepository() repositories.ClusterHealthCheckRepository {
+	if f.clusterHealthRepo == nil {
+		f.clusterHealthRepo = sqlRepo.NewClusterHealthCheckRepo(f.gormDB)
+	}
+	return f.clusterHealthRepo
+}
+
+func (f *sqlRepositoryFactory) LeaderLeaseRepository() repositories.LeaderLeaseRepository {
+	if f.leaderLeaseRepo == nil {
+		f.leaderLeaseRepo = sqlRepo.NewLeaderLeaseRepo(f.gormDB)
+	}
+	return f.leaderLeaseRepo
+}
+
 func (f *sqlRepositoryFactory) APIUserRepository() repositories.APIUserRepository {
 	if f.apiUserRepo == nil {
 		f.apiUserRepo = sqlRepo.NewAPIUserRepo(f.gormDB)
 	}
 	return f.apiUserRepo
 }
+
+func (f *sqlRepositoryFactory) AuthFailureRepository() repositories.AuthFailureRepository {
+	if f.authFailureRepo == nil {
+		f.authFailureRepo = sqlRepo.NewAuthFailureRepo(f.gormDB)
+	}
+	return f.authFailureRepo
+}
+
+func (f *sqlRepositoryFactory) AccountStatsRepository() repositories.AccountStatsRepository {
+	if f.accountStatsRepo == nil {
+		f.accountStatsRepo = sqlRepo.NewAccountStatsRepo(f.gormDB)
+	}
+	return f.accountStatsRepo
+}
+
+func (f *sqlRepositoryFactory) LeafnodeProfileRepository() repositories.LeafnodeProfileRepository {
+	if f.leafnodeProfileRepo == nil {
+		f.leafnodeProfileRepo = sqlRepo.NewLeafnodeProfileRepo(f.gormDB)
+	}
+	return f.leafnodeProfileRepo
+}
+
+func (f *sqlRepositoryFactory) AccountPlacementRepository() repositories.AccountPlacementRepository {
+	if f.accountPlacementRepo == nil {
+		f.accountPlacementRepo = sqlRepo.NewAccountPlacementRepo(f.gormDB)
+	}
+	return f.accountPlacementRepo
+}
+
+func (f *sqlRepositoryFactory) UserKeyRepository() repositories.UserKeyRepository {
+	if f.userKeyRepo == nil {
+		f.userKeyRepo = sqlRepo.NewUserKeyRepo(f.gormDB)
+	}
+	return f.userKeyRepo
+}
+
+func (f *sqlRepositoryFactory) RotationPolicyRepository() repositories.RotationPolicyRepository {
+	if f.rotationPolicyRepo == nil {
+		f.rotationPolicyRepo = sqlRepo.NewRotationPolicyRepo(f.gormDB)
+	}
+	return f.rotationPolicyRepo
+}
@@ -89,19 +89,23 @@ func (s *RBACIsolationTestSuite) SetupSuite() {
 		encryptor,
 	)
 
-	s.operatorService = services.NewOperatorService(
-		repoFactory.OperatorRepository(),
-		repoFactory.AccountRepository(),
+	s.userService = services.NewUserService(
 		repoFactory.UserRepository(),
-		s.accountService,
+		repoFactory.AccountRepository(),
+		repoFactory.OperatorRepository(),
+		repoFactory.ScopedSigningKeyRepository(),
+		repoFactory.UserKeyRepository(),
+		repoFactory.RotationPolicyRepository(),
 		s.jwtService,
 		encryptor,
 	)
 
-	s.userService = services.NewUserService(
-		repoFactory.UserRepository(),
+	s.operatorService = services.NewOperatorService(
+		repoFactory.OperatorRepository(),
 		repoFactory.AccountRepository(),
-		repoFactory.ScopedSigningKeyRepository(),
+		repoFactory.UserRepository(),
+		s.accountService,
+		s.userService,
 		s.jwtService,
 		encryptor,
 	)
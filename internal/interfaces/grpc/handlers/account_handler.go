@@ -2,8 +2,11 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 	pb "github.com/thomas-maurice/nis/gen/nis/v1"
 	"github.com/thomas-maurice/nis/gen/nis/v1/nisv1connect"
 	"github.com/thomas-maurice/nis/internal/application/services"
@@ -60,11 +63,12 @@ func (h *AccountHandler) CreateAccount(
 		JetStreamMaxConsumers: maxCons,
 	})
 	if err != nil {
-		return nil, err
+		return nil, repoErrToConnect(err)
 	}
 
 	return connect.NewResponse(&pb.CreateAccountResponse{
-		Account: mappers.AccountToProto(account),
+		Account:  mappers.AccountToProto(account),
+		Warnings: h.service.JetStreamCapacityWarnings(ctx, account),
 	}), nil
 }
 
@@ -220,7 +224,8 @@ func (h *AccountHandler) UpdateJetStreamLimits(
 	}
 
 	return connect.NewResponse(&pb.UpdateJetStreamLimitsResponse{
-		Account: mappers.AccountToProto(account),
+		Account:  mappers.AccountToProto(account),
+		Warnings: h.service.JetStreamCapacityWarnings(ctx, account),
 	}), nil
 }
 
@@ -250,3 +255,392 @@ func (h *AccountHandler) PushAccountJWT(
 	// TODO: Implement when NATS client integration is added
 	return nil, connect.NewError(connect.CodeUnimplemented, nil)
 }
+
+// PromoteAccount copies an account's configuration onto another operator
+func (h *AccountHandler) PromoteAccount(
+	ctx context.Context,
+	req *connect.Request[pb.PromoteAccountRequest],
+) (*connect.Response[pb.PromoteAccountResponse], error) {
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := mappers.ParseUUID(req.Msg.AccountId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	targetOperatorID, err := mappers.ParseUUID(req.Msg.TargetOperatorId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Reading the source is enough to copy it; the target is created or updated
+	if err := h.permService.CanReadAccount(ctx, requestingUser, accountID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if err := h.permService.CanCreateAccount(requestingUser, targetOperatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	promotion, err := h.service.PromoteAccount(ctx, accountID, targetOperatorID, req.Msg.DryRun)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	resp := &pb.PromoteAccountResponse{
+		Created:  promotion.Created,
+		Warnings: promotion.Warnings,
+	}
+	if promotion.Target != nil {
+		resp.Account = mappers.AccountToProto(promotion.Target)
+	}
+	for _, change := range promotion.Changes {
+		resp.Changes = append(resp.Changes, &pb.PromotionChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// GetAccountUsage reports the JetStream usage of an account against its limits
+func (h *AccountHandler) GetAccountUsage(
+	ctx context.Context,
+	req *connect.Request[pb.GetAccountUsageRequest],
+) (*connect.Response[pb.GetAccountUsageResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := mappers.ParseUUID(req.Msg.AccountId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := h.permService.CanReadAccount(ctx, requestingUser, accountID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	var clusterID *uuid.UUID
+	if req.Msg.ClusterId != "" {
+		id, err := mappers.ParseUUID(req.Msg.ClusterId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		clusterID = &id
+	}
+
+	report, err := h.service.GetAccountUsage(ctx, accountID, clusterID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	usage := make([]*pb.AccountUsage, 0, len(report.Usage))
+	for _, u := range report.Usage {
+		usage = append(usage, &pb.AccountUsage{
+			ClusterId:        mappers.UUIDToString(u.ClusterID),
+			ClusterName:      u.ClusterName,
+			AccountId:        mappers.UUIDToString(u.Account.ID),
+			AccountName:      u.Account.Name,
+			JetstreamEnabled: u.Account.JetStreamEnabled,
+			MemoryBytes:      u.Memory,
+			MemoryLimit:      u.Account.JetStreamMaxMemory,
+			StorageBytes:     u.Storage,
+			StorageLimit:     u.Account.JetStreamMaxStorage,
+			Streams:          u.Streams,
+			StreamsLimit:     u.Account.JetStreamMaxStreams,
+			Consumers:        u.Consumers,
+			ConsumersLimit:   u.Account.JetStreamMaxConsumers,
+			Servers:          int32(u.Servers),
+		})
+	}
+
+	return connect.NewResponse(&pb.GetAccountUsageResponse{
+		Usage:    usage,
+		Warnings: report.Warnings,
+	}), nil
+}
+
+// GetAccountStats returns the traffic statistics of an account over a time range
+func (h *AccountHandler) GetAccountStats(
+	ctx context.Context,
+	req *connect.Request[pb.GetAccountStatsRequest],
+) (*connect.Response[pb.GetAccountStatsResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := mappers.ParseUUID(req.Msg.AccountId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := h.permService.CanReadAccount(ctx, requestingUser, accountID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	var clusterID *uuid.UUID
+	if req.Msg.ClusterId != "" {
+		id, err := mappers.ParseUUID(req.Msg.ClusterId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		clusterID = &id
+	}
+
+	resolution := time.Duration(req.Msg.ResolutionSeconds) * time.Second
+	switch resolution {
+	case 0, services.AccountStatsRawResolution, services.AccountStatsHourlyResolution:
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("resolution_seconds must be 0, 60 or 3600"))
+	}
+
+	to := time.Now()
+	if req.Msg.To != nil {
+		to = req.Msg.To.AsTime()
+	}
+	if req.Msg.From == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("from is required"))
+	}
+	from := req.Msg.From.AsTime()
+	if !from.Before(to) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("from must be before to"))
+	}
+
+	series, err := h.service.GetAccountStats(ctx, accountID, clusterID, from, to, resolution)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	resp := &pb.GetAccountStatsResponse{
+		ResolutionSeconds: int32(series.Resolution / time.Second),
+		Points:            make([]*pb.AccountStatsPoint, len(series.Points)),
+	}
+	for i, point := range series.Points {
+		resp.Points[i] = mappers.AccountStatsToProto(point)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// GetAccountPlacement lists the clusters an account is pushed to
+func (h *AccountHandler) GetAccountPlacement(
+	ctx context.Context,
+	req *connect.Request[pb.GetAccountPlacementRequest],
+) (*connect.Response[pb.GetAccountPlacementResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := mappers.ParseUUID(req.Msg.AccountId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := h.permService.CanReadAccount(ctx, requestingUser, accountID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	status, err := h.service.GetAccountPlacement(ctx, accountID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.GetAccountPlacementResponse{
+		Placement: accountPlacementToProto(status),
+	}), nil
+}
+
+// SetAccountPlacement places an account on clusters of its operator
+func (h *AccountHandler) SetAccountPlacement(
+	ctx context.Context,
+	req *connect.Request[pb.SetAccountPlacementRequest],
+) (*connect.Response[pb.SetAccountPlacementResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := mappers.ParseUUID(req.Msg.AccountId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	clusterIDs := make([]uuid.UUID, 0, len(req.Msg.ClusterIds))
+	for _, raw := range req.Msg.ClusterIds {
+		id, err := mappers.ParseUUID(raw)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		clusterIDs = append(clusterIDs, id)
+	}
+
+	current, err := h.service.GetAccountPlacement(ctx, accountID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	// Placement decides where the tenant's data lives, it is up to the operator
+	if err := h.permService.CanUpdateOperator(requestingUser, current.OperatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	status, err := h.service.SetAccountPlacement(ctx, accountID, clusterIDs)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.SetAccountPlacementResponse{
+		Placement: accountPlacementToProto(status),
+		Removed:   placementRemovalsToProto(status.Removed),
+	}), nil
+}
+
+// accountPlacementToProto converts an account placement to protobuf
+func accountPlacementToProto(status *services.AccountPlacementStatus) *pb.AccountPlacement {
+	if status == nil {
+		return nil
+	}
+
+	assigned := make(map[uuid.UUID]bool, len(status.Assigned))
+	for _, cluster := range status.Assigned {
+		assigned[cluster.ID] = true
+	}
+	clusters := make([]*pb.PlacementCluster, 0, len(status.Clusters))
+	for _, cluster := range status.Clusters {
+		clusters = append(clusters, &pb.PlacementCluster{
+			Id:       mappers.UUIDToString(cluster.ID),
+			Name:     cluster.Name,
+			Assigned: assigned[cluster.ID],
+		})
+	}
+
+	return &pb.AccountPlacement{
+		AccountId:  mappers.UUIDToString(status.AccountID),
+		Everywhere: len(status.Assigned) == 0,
+		Clusters:   clusters,
+	}
+}
+
+// placementRemovalsToProto converts the delete claims sent by a placement change to protobuf
+func placementRemovalsToProto(removals []services.PlacementRemoval) []*pb.PlacementRemoval {
+	result := make([]*pb.PlacementRemoval, len(removals))
+	for i, removal := range removals {
+		result[i] = &pb.PlacementRemoval{
+			ClusterId:   mappers.UUIDToString(removal.ClusterID),
+			ClusterName: removal.ClusterName,
+			Error:       removal.Error,
+		}
+	}
+	return result
+}
+
+// MoveAccount moves an account to another operator, re-signing its JWT and updating
+// the clusters of both operators
+func (h *AccountHandler) MoveAccount(
+	ctx context.Context,
+	req *connect.Request[pb.MoveAccountRequest],
+) (*connect.Response[pb.MoveAccountResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := mappers.ParseUUID(req.Msg.AccountId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	targetOperatorID, err := mappers.ParseUUID(req.Msg.TargetOperatorId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// The account leaves one operator and joins the other
+	if err := h.permService.CanUpdateAccount(ctx, requestingUser, accountID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if err := h.permService.CanCreateAccount(requestingUser, targetOperatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	move, err := h.service.MoveAccount(ctx, accountID, targetOperatorID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.MoveAccountResponse{
+		Account:  mappers.AccountToProto(move.Account),
+		Removed:  placementRemovalsToProto(move.Removed),
+		Pushed:   accountPushesToProto(move.Pushed),
+		Warnings: move.Warnings,
+	}), nil
+}
+
+// SuspendAccount suspends an account, disconnecting its clients
+func (h *AccountHandler) SuspendAccount(
+	ctx context.Context,
+	req *connect.Request[pb.SuspendAccountRequest],
+) (*connect.Response[pb.SuspendAccountResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := mappers.ParseUUID(req.Msg.AccountId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.permService.CanUpdateAccount(ctx, requestingUser, accountID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	suspension, err := h.service.SuspendAccount(ctx, accountID, requestingUser.Username, req.Msg.Reason, req.Msg.RevokeUsers)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.SuspendAccountResponse{
+		Account: mappers.AccountToProto(suspension.Account),
+		Pushed:  accountPushesToProto(suspension.Pushed),
+	}), nil
+}
+
+// ResumeAccount resumes a suspended account
+func (h *AccountHandler) ResumeAccount(
+	ctx context.Context,
+	req *connect.Request[pb.ResumeAccountRequest],
+) (*connect.Response[pb.ResumeAccountResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID, err := mappers.ParseUUID(req.Msg.AccountId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.permService.CanUpdateAccount(ctx, requestingUser, accountID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	suspension, err := h.service.ResumeAccount(ctx, accountID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.ResumeAccountResponse{
+		Account: mappers.AccountToProto(suspension.Account),
+		Pushed:  accountPushesToProto(suspension.Pushed),
+	}), nil
+}
@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/google/uuid"
@@ -9,7 +11,9 @@ import (
 	"github.com/thomas-maurice/nis/gen/nis/v1/nisv1connect"
 	"github.com/thomas-maurice/nis/internal/application/services"
 	"github.com/thomas-maurice/nis/internal/domain/entities"
+	"github.com/thomas-maurice/nis/internal/domain/repositories"
 	"github.com/thomas-maurice/nis/internal/interfaces/grpc/mappers"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ClusterHandler implements the ClusterService gRPC service
@@ -66,6 +70,8 @@ func (h *ClusterHandler) CreateCluster(
 		SystemAccountPubKey: req.Msg.SystemAccountPubKey,
 		SystemAccountUserID: systemAccountUserID,
 		SkipVerifyTLS:       req.Msg.SkipVerifyTls,
+		HealthCheckInterval: time.Duration(req.Msg.HealthCheckIntervalSeconds) * time.Second,
+		HealthCheckTimeout:  time.Duration(req.Msg.HealthCheckTimeoutSeconds) * time.Second,
 	})
 	if err != nil {
 		return nil, err
@@ -102,8 +108,17 @@ func (h *ClusterHandler) GetCluster(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
+	uptime, checks, err := h.service.ClusterUptime(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	pbCluster := mappers.ClusterToProto(cluster)
+	pbCluster.UptimePercent = uptime
+	pbCluster.HealthCheckCount = checks
+
 	return connect.NewResponse(&pb.GetClusterResponse{
-		Cluster: mappers.ClusterToProto(cluster),
+		Cluster: pbCluster,
 	}), nil
 }
 
@@ -219,6 +234,14 @@ func (h *ClusterHandler) UpdateCluster(
 	if req.Msg.SkipVerifyTls != nil {
 		updateReq.SkipVerifyTLS = req.Msg.SkipVerifyTls
 	}
+	if req.Msg.HealthCheckIntervalSeconds != nil {
+		interval := time.Duration(*req.Msg.HealthCheckIntervalSeconds) * time.Second
+		updateReq.HealthCheckInterval = &interval
+	}
+	if req.Msg.HealthCheckTimeoutSeconds != nil {
+		timeout := time.Duration(*req.Msg.HealthCheckTimeoutSeconds) * time.Second
+		updateReq.HealthCheckTimeout = &timeout
+	}
 
 	cluster, err := h.service.UpdateCluster(ctx, id, updateReq)
 	if err != nil {
@@ -351,9 +374,50 @@ func (h *ClusterHandler) GenerateServerConfig(
 	ctx context.Context,
 	req *connect.Request[pb.GenerateServerConfigRequest],
 ) (*connect.Response[pb.GenerateServerConfigResponse], error) {
-	// TODO: Implement server config generation
-	// This requires integration with the NATS config generator from internal/infrastructure/nats
-	return nil, connect.NewError(connect.CodeUnimplemented, nil)
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := mappers.ParseUUID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// First get the cluster to check which operator it belongs to
+	cluster, err := h.service.GetCluster(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	// Reading the configuration requires read permission, storing the profile update
+	if req.Msg.SaveProfile {
+		if req.Msg.Profile == nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("save_profile requires a profile"))
+		}
+		err = h.permService.CanUpdateOperator(requestingUser, cluster.OperatorID)
+	} else {
+		err = h.permService.CanReadOperator(ctx, requestingUser, cluster.OperatorID)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	config, err := h.service.GenerateServerConfig(ctx, id, services.GenerateServerConfigRequest{
+		ServerName:  req.Msg.ServerName,
+		Port:        int(req.Msg.Port),
+		HTTPPort:    int(req.Msg.HttpPort),
+		Profile:     mappers.ServerProfileFromProto(req.Msg.Profile),
+		SaveProfile: req.Msg.SaveProfile,
+	})
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.GenerateServerConfigResponse{
+		Config: config,
+	}), nil
 }
 
 // SyncCluster pushes all account JWTs to the NATS cluster resolver
@@ -398,6 +462,30 @@ func (h *ClusterHandler) SyncCluster(
 		})
 	}
 
+	servers := make([]*pb.ServerSyncStatus, 0, len(result.Servers))
+	for _, srv := range result.Servers {
+		servers = append(servers, &pb.ServerSyncStatus{
+			ServerId:     srv.ServerID,
+			ServerName:   srv.ServerName,
+			Acknowledged: int32(srv.Acknowledged),
+			Rejected:     int32(srv.Rejected),
+			Missing:      int32(srv.Missing),
+			Cluster:      srv.Cluster,
+		})
+	}
+
+	peers := make([]*pb.SuperclusterPeerSync, 0, len(result.Peers))
+	for _, peer := range result.Peers {
+		peers = append(peers, &pb.SuperclusterPeerSync{
+			ClusterId:   mappers.UUIDToString(peer.ClusterID),
+			ClusterName: peer.ClusterName,
+			Servers:     int32(peer.Servers),
+			Verified:    int32(peer.Verified),
+			Missing:     peer.Missing,
+			Error:       peer.Error,
+		})
+	}
+
 	return connect.NewResponse(&pb.SyncClusterResponse{
 		AccountCount:    int32(len(result.Accounts)),
 		Accounts:        result.Accounts,
@@ -406,6 +494,8 @@ func (h *ClusterHandler) SyncCluster(
 		AccountsUpdated: int32(result.AccountsUpdated),
 		RemovedAccounts: result.RemovedAccounts,
 		Errors:          syncErrors,
+		Servers:         servers,
+		Peers:           peers,
 	}), nil
 }
 
@@ -480,3 +570,621 @@ func (h *ClusterHandler) DeleteResolverAccount(
 
 	return connect.NewResponse(&pb.DeleteResolverAccountResponse{}), nil
 }
+
+// VerifyAccount reports which servers of the cluster hold the account's current JWT
+func (h *ClusterHandler) VerifyAccount(
+	ctx context.Context,
+	req *connect.Request[pb.VerifyAccountRequest],
+) (*connect.Response[pb.VerifyAccountResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterID, err := mappers.ParseUUID(req.Msg.ClusterId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	accountID, err := mappers.ParseUUID(req.Msg.AccountId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// First get the cluster to check which operator it belongs to
+	cluster, err := h.service.GetCluster(ctx, clusterID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	// Check permission to read the operator that owns this cluster
+	if err := h.permService.CanReadOperator(ctx, requestingUser, cluster.OperatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	result, err := h.service.VerifyAccount(ctx, clusterID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	servers := make([]*pb.ServerVerification, 0, len(result.Servers))
+	for _, srv := range result.Servers {
+		servers = append(servers, &pb.ServerVerification{
+			ServerId:   srv.ServerID,
+			ServerName: srv.ServerName,
+			Responded:  srv.Responded,
+			HasAccount: srv.HasAccount,
+		})
+	}
+
+	return connect.NewResponse(&pb.VerifyAccountResponse{
+		AccountPublicKey: result.AccountPublicKey,
+		AccountName:      result.AccountName,
+		Propagated:       result.Propagated,
+		CurrentCopies:    int32(result.CurrentCopies),
+		MismatchedCopies: int32(result.MismatchedCopies),
+		MissingCopies:    int32(result.MissingCopies),
+		Servers:          servers,
+	}), nil
+}
+
+// GetClusterTopology lists the servers recorded for the cluster by the health check
+func (h *ClusterHandler) GetClusterTopology(
+	ctx context.Context,
+	req *connect.Request[pb.GetClusterTopologyRequest],
+) (*connect.Response[pb.GetClusterTopologyResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := mappers.ParseUUID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	topology, err := h.service.GetClusterTopology(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	// Check permission to read the operator that owns this cluster
+	if err := h.permService.CanReadOperator(ctx, requestingUser, topology.Cluster.OperatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	servers := make([]*pb.ClusterServer, 0, len(topology.Servers))
+	for _, srv := range topology.Servers {
+		servers = append(servers, mappers.ClusterServerToProto(srv.ClusterServer, srv.Missing))
+	}
+
+	var lastHealthCheck *timestamppb.Timestamp
+	if topology.Cluster.LastHealthCheck != nil {
+		lastHealthCheck = timestamppb.New(*topology.Cluster.LastHealthCheck)
+	}
+
+	return connect.NewResponse(&pb.GetClusterTopologyResponse{
+		Servers:         servers,
+		Warnings:        topology.Warnings,
+		LastHealthCheck: lastHealthCheck,
+	}), nil
+}
+
+// ListClusterHealthChecks returns the recorded health checks of the cluster, newest first
+func (h *ClusterHandler) ListClusterHealthChecks(
+	ctx context.Context,
+	req *connect.Request[pb.ListClusterHealthChecksRequest],
+) (*connect.Response[pb.ListClusterHealthChecksResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := mappers.ParseUUID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	cluster, err := h.service.GetCluster(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	// Check permission to read the operator that owns this cluster
+	if err := h.permService.CanReadOperator(ctx, requestingUser, cluster.OperatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	checks, err := h.service.ListHealthChecks(ctx, id, mappers.ProtoToListOptions(req.Msg.Options))
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	result := make([]*pb.ClusterHealthCheck, 0, len(checks))
+	for _, check := range checks {
+		result = append(result, mappers.ClusterHealthCheckToProto(check))
+	}
+
+	return connect.NewResponse(&pb.ListClusterHealthChecksResponse{
+		Checks: result,
+	}), nil
+}
+
+// ListConnections lists the live client connections of an account or user via CONNZ
+func (h *ClusterHandler) ListConnections(
+	ctx context.Context,
+	req *connect.Request[pb.ListConnectionsRequest],
+) (*connect.Response[pb.ListConnectionsResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := services.ConnectionQuery{Limit: int(req.Msg.Limit)}
+	if req.Msg.AccountId != "" {
+		if query.AccountID, err = mappers.ParseUUID(req.Msg.AccountId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := h.permService.CanReadAccount(ctx, requestingUser, query.AccountID); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+	}
+	if req.Msg.UserId != "" {
+		userID, err := mappers.ParseUUID(req.Msg.UserId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := h.permService.CanReadUser(ctx, requestingUser, userID); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		query.UserID = &userID
+	}
+	if req.Msg.AccountId == "" && req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account_id or user_id is required"))
+	}
+	if req.Msg.ClusterId != "" {
+		clusterID, err := mappers.ParseUUID(req.Msg.ClusterId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		query.ClusterID = &clusterID
+	}
+
+	listing, err := h.service.ListConnections(ctx, query)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	connections := make([]*pb.ClientConnection, 0, len(listing.Connections))
+	for i := range listing.Connections {
+		connections = append(connections, clientConnectionToProto(&listing.Connections[i]))
+	}
+
+	return connect.NewResponse(&pb.ListConnectionsResponse{
+		Connections: connections,
+		Truncated:   listing.Truncated,
+		Warnings:    listing.Warnings,
+	}), nil
+}
+
+// DisconnectUser kicks every live connection of a user
+func (h *ClusterHandler) DisconnectUser(
+	ctx context.Context,
+	req *connect.Request[pb.DisconnectUserRequest],
+) (*connect.Response[pb.DisconnectUserResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := mappers.ParseUUID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if err := h.permService.CanUpdateUser(ctx, requestingUser, userID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	var clusterID *uuid.UUID
+	if req.Msg.ClusterId != "" {
+		id, err := mappers.ParseUUID(req.Msg.ClusterId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		clusterID = &id
+	}
+
+	result, err := h.service.DisconnectUser(ctx, userID, clusterID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	servers := make([]*pb.ServerDisconnect, 0, len(result.Servers))
+	for _, srv := range result.Servers {
+		servers = append(servers, &pb.ServerDisconnect{
+			ClusterId:    mappers.UUIDToString(srv.ClusterID),
+			ClusterName:  srv.ClusterName,
+			ServerId:     srv.Server.ID,
+			ServerName:   srv.Server.Name,
+			Found:        int32(srv.Found),
+			Disconnected: int32(srv.Disconnected),
+			Errors:       srv.Errors,
+		})
+	}
+
+	return connect.NewResponse(&pb.DisconnectUserResponse{
+		Servers:      servers,
+		Disconnected: int32(result.Disconnected()),
+		Warnings:     result.Warnings,
+	}), nil
+}
+
+// GetClusterCapacity reports the JetStream capacity of a cluster against the limits
+// assigned to the operator's accounts
+func (h *ClusterHandler) GetClusterCapacity(
+	ctx context.Context,
+	req *connect.Request[pb.GetClusterCapacityRequest],
+) (*connect.Response[pb.GetClusterCapacityResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := mappers.ParseUUID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	cluster, err := h.service.GetCluster(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+	if err := h.permService.CanReadCluster(ctx, requestingUser, cluster); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	capacity, err := h.service.GetClusterCapacity(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.GetClusterCapacityResponse{
+		ClusterId:                mappers.UUIDToString(capacity.Cluster.ID),
+		ClusterName:              capacity.Cluster.Name,
+		JetstreamServers:         int32(capacity.JetStreamServers),
+		MemoryCapacity:           capacity.MemoryCapacity,
+		StorageCapacity:          capacity.StorageCapacity,
+		MemoryAssigned:           capacity.MemoryAssigned,
+		StorageAssigned:          capacity.StorageAssigned,
+		MemoryReserved:           capacity.MemoryReserved,
+		StorageReserved:          capacity.StorageReserved,
+		MemoryUsed:               capacity.MemoryUsed,
+		StorageUsed:              capacity.StorageUsed,
+		Accounts:                 int32(capacity.Accounts),
+		UnlimitedMemoryAccounts:  capacity.UnlimitedMemory,
+		UnlimitedStorageAccounts: capacity.UnlimitedStorage,
+		Warnings:                 capacity.Overcommitted(),
+	}), nil
+}
+
+// clientConnectionToProto converts a live connection to protobuf
+func clientConnectionToProto(conn *services.ClientConnection) *pb.ClientConnection {
+	result := &pb.ClientConnection{
+		ClusterId:     mappers.UUIDToString(conn.ClusterID),
+		ClusterName:   conn.ClusterName,
+		ServerId:      conn.ServerID,
+		ServerName:    conn.ServerName,
+		Cid:           int64(conn.CID),
+		Ip:            conn.IP,
+		Port:          int32(conn.Port),
+		Rtt:           conn.RTT,
+		Uptime:        conn.Uptime,
+		InMsgs:        conn.InMsgs,
+		OutMsgs:       conn.OutMsgs,
+		InBytes:       conn.InBytes,
+		OutBytes:      conn.OutBytes,
+		Subscriptions: int32(conn.Subscriptions),
+		ClientName:    conn.Name,
+		Lang:          conn.Lang,
+		Version:       conn.Version,
+		UserPublicKey: conn.AuthorizedUser,
+	}
+	if !conn.Start.IsZero() {
+		result.Start = timestamppb.New(conn.Start)
+	}
+	if !conn.LastActivity.IsZero() {
+		result.LastActivity = timestamppb.New(conn.LastActivity)
+	}
+	if conn.User != nil {
+		result.UserId = mappers.UUIDToString(conn.User.ID)
+		result.UserName = conn.User.Name
+	}
+	if conn.ScopedSigningKey != nil {
+		result.ScopedSigningKeyId = mappers.UUIDToString(conn.ScopedSigningKey.ID)
+		result.ScopedSigningKeyName = conn.ScopedSigningKey.Name
+	}
+
+	return result
+}
+
+// ListAuthFailures lists the NATS authentication failures reported by the clusters
+func (h *ClusterHandler) ListAuthFailures(
+	ctx context.Context,
+	req *connect.Request[pb.ListAuthFailuresRequest],
+) (*connect.Response[pb.ListAuthFailuresResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Msg.ClusterId == "" && req.Msg.AccountId == "" && req.Msg.UserId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("one of cluster_id, account_id or user_id is required"))
+	}
+
+	var filter repositories.AuthFailureFilter
+	if req.Msg.UserId != "" {
+		userID, err := mappers.ParseUUID(req.Msg.UserId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := h.permService.CanReadUser(ctx, requestingUser, userID); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		filter.UserID = &userID
+	}
+	if req.Msg.AccountId != "" {
+		accountID, err := mappers.ParseUUID(req.Msg.AccountId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := h.permService.CanReadAccount(ctx, requestingUser, accountID); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		filter.AccountID = &accountID
+	}
+	if req.Msg.ClusterId != "" {
+		clusterID, err := mappers.ParseUUID(req.Msg.ClusterId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		cluster, err := h.service.GetCluster(ctx, clusterID)
+		if err != nil {
+			return nil, repoErrToConnect(err)
+		}
+		// Unfiltered, the failures of a cluster include every account's and unknown keys
+		check := h.permService.CanReadClusterEvents
+		if filter.UserID != nil || filter.AccountID != nil {
+			check = h.permService.CanReadCluster
+		}
+		if err := check(ctx, requestingUser, cluster); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		filter.ClusterID = &clusterID
+	}
+	if req.Msg.Since != nil {
+		filter.Since = req.Msg.Since.AsTime()
+	}
+
+	failures, err := h.service.ListAuthFailures(ctx, filter, mappers.ProtoToListOptions(req.Msg.Options))
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	resp := &pb.ListAuthFailuresResponse{
+		Failures: make([]*pb.AuthFailure, len(failures)),
+	}
+	for i, failure := range failures {
+		resp.Failures[i] = mappers.AuthFailureToProto(failure)
+	}
+
+	return connect.NewResponse(resp), nil
+}
+
+// CreateLeafnodeProfile binds a user to a hub cluster for the leafnode remotes of edge servers
+func (h *ClusterHandler) CreateLeafnodeProfile(
+	ctx context.Context,
+	req *connect.Request[pb.CreateLeafnodeProfileRequest],
+) (*connect.Response[pb.CreateLeafnodeProfileResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterID, err := mappers.ParseUUID(req.Msg.ClusterId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	userID, err := mappers.ParseUUID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	cluster, err := h.service.GetCluster(ctx, clusterID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	// The profile hands out the user's credentials, so binding one takes operator rights
+	if err := h.permService.CanUpdateOperator(requestingUser, cluster.OperatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	profile, err := h.service.CreateLeafnodeProfile(ctx, services.CreateLeafnodeProfileRequest{
+		ClusterID:       clusterID,
+		Name:            req.Msg.Name,
+		UserID:          userID,
+		LocalAccount:    req.Msg.LocalAccount,
+		URLs:            req.Msg.Urls,
+		CredentialsPath: req.Msg.CredentialsPath,
+		CAFile:          req.Msg.CaFile,
+	})
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.CreateLeafnodeProfileResponse{
+		Profile: mappers.LeafnodeProfileToProto(profile),
+	}), nil
+}
+
+// ListLeafnodeProfiles lists the leafnode profiles of a hub cluster
+func (h *ClusterHandler) ListLeafnodeProfiles(
+	ctx context.Context,
+	req *connect.Request[pb.ListLeafnodeProfilesRequest],
+) (*connect.Response[pb.ListLeafnodeProfilesResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterID, err := mappers.ParseUUID(req.Msg.ClusterId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	cluster, err := h.service.GetCluster(ctx, clusterID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	if err := h.permService.CanReadCluster(ctx, requestingUser, cluster); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	profiles, err := h.service.ListLeafnodeProfiles(ctx, clusterID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.ListLeafnodeProfilesResponse{
+		Profiles: mappers.LeafnodeProfilesToProto(profiles),
+	}), nil
+}
+
+// DeleteLeafnodeProfile deletes a leafnode profile
+func (h *ClusterHandler) DeleteLeafnodeProfile(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteLeafnodeProfileRequest],
+) (*connect.Response[pb.DeleteLeafnodeProfileResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := mappers.ParseUUID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	profile, err := h.service.GetLeafnodeProfile(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+	cluster, err := h.service.GetCluster(ctx, profile.ClusterID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	if err := h.permService.CanUpdateOperator(requestingUser, cluster.OperatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if err := h.service.DeleteLeafnodeProfile(ctx, id); err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.DeleteLeafnodeProfileResponse{}), nil
+}
+
+// GenerateLeafnodeConfig generates the leafnode remote block and credentials of a profile
+func (h *ClusterHandler) GenerateLeafnodeConfig(
+	ctx context.Context,
+	req *connect.Request[pb.GenerateLeafnodeConfigRequest],
+) (*connect.Response[pb.GenerateLeafnodeConfigResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := mappers.ParseUUID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	profile, err := h.service.GetLeafnodeProfile(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+	cluster, err := h.service.GetCluster(ctx, profile.ClusterID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	// The response carries the user's credentials, as GetUserCredentials does
+	if err := h.permService.CanReadCluster(ctx, requestingUser, cluster); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+	if err := h.permService.CanReadUser(ctx, requestingUser, profile.UserID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	config, err := h.service.GenerateLeafnodeConfig(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.GenerateLeafnodeConfigResponse{
+		Config:          config.Config,
+		Credentials:     config.Credentials,
+		CredentialsPath: config.Profile.CredentialsPath,
+	}), nil
+}
+
+// SetClusterGateway makes a cluster join or leave a supercluster
+func (h *ClusterHandler) SetClusterGateway(
+	ctx context.Context,
+	req *connect.Request[pb.SetClusterGatewayRequest],
+) (*connect.Response[pb.SetClusterGatewayResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := mappers.ParseUUID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	existingCluster, err := h.service.GetCluster(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	// Gateways change the configuration of every cluster of the supercluster, all
+	// of which belong to the same operator
+	if err := h.permService.CanUpdateOperator(requestingUser, existingCluster.OperatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	cluster, err := h.service.SetClusterGateway(ctx, id, req.Msg.Supercluster, req.Msg.GatewayUrls)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.SetClusterGatewayResponse{
+		Cluster: mappers.ClusterToProto(cluster),
+	}), nil
+}
@@ -50,7 +50,7 @@ func (h *ExportHandler) ExportOperator(
 
 	data, err := h.service.ExportOperatorJSON(ctx, operatorID, req.Msg.IncludeSecrets)
 	if err != nil {
-		return nil, err
+		return nil, repoErrToConnect(err)
 	}
 
 	return connect.NewResponse(&pb.ExportOperatorResponse{
@@ -251,3 +251,69 @@ func (h *OperatorHandler) GenerateInclude(
 		Config: config,
 	}), nil
 }
+
+// LockdownOperator revokes every user of an operator and blocks their credential downloads
+func (h *OperatorHandler) LockdownOperator(
+	ctx context.Context,
+	req *connect.Request[pb.LockdownOperatorRequest],
+) (*connect.Response[pb.LockdownOperatorResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorID, err := mappers.ParseUUID(req.Msg.OperatorId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.permService.CanUpdateOperator(requestingUser, operatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	lockdown, err := h.service.LockdownOperator(ctx, operatorID, requestingUser.Username, req.Msg.Reason)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.LockdownOperatorResponse{
+		Operator: mappers.OperatorToProto(lockdown.Operator),
+		Accounts: lockdown.Accounts,
+		Pushed:   accountPushesToProto(lockdown.Pushed),
+	}), nil
+}
+
+// LiftOperatorLockdown lifts the lockdown of an operator, reissuing its users' JWTs
+func (h *OperatorHandler) LiftOperatorLockdown(
+	ctx context.Context,
+	req *connect.Request[pb.LiftOperatorLockdownRequest],
+) (*connect.Response[pb.LiftOperatorLockdownResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorID, err := mappers.ParseUUID(req.Msg.OperatorId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.permService.CanUpdateOperator(requestingUser, operatorID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	lockdown, err := h.service.LiftOperatorLockdown(ctx, operatorID, requestingUser.Username)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.LiftOperatorLockdownResponse{
+		Operator:      mappers.OperatorToProto(lockdown.Operator),
+		Accounts:      lockdown.Accounts,
+		ReissuedUsers: int32(lockdown.ReissuedUsers),
+		Skipped:       lockdown.Skipped,
+		RevokedUsers:  int32(lockdown.RevokedUsers),
+	}), nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/thomas-maurice/nis/internal/application/services"
 	"github.com/thomas-maurice/nis/internal/domain/entities"
 	"github.com/thomas-maurice/nis/internal/interfaces/grpc/mappers"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ScopedSigningKeyHandler implements the ScopedSigningKeyService gRPC service
@@ -321,3 +322,57 @@ func (h *ScopedSigningKeyHandler) DeleteScopedSigningKey(
 
 	return connect.NewResponse(&pb.DeleteScopedSigningKeyResponse{}), nil
 }
+
+// RotateScopedSigningKey gives a scoped signing key a new key pair and re-signs the
+// users it signed, rolling back when a step fails. The progress of the re-signing is
+// streamed before the result.
+func (h *ScopedSigningKeyHandler) RotateScopedSigningKey(
+	ctx context.Context,
+	req *connect.Request[pb.RotateScopedSigningKeyRequest],
+	stream *connect.ServerStream[pb.RotateScopedSigningKeyResponse],
+) error {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	keyID, err := mappers.ParseUUID(req.Msg.KeyId)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Get the key to check which account it belongs to
+	key, err := h.service.GetScopedSigningKey(ctx, keyID)
+	if err != nil {
+		return repoErrToConnect(err)
+	}
+	if err := h.permService.CanUpdateAccount(ctx, requestingUser, key.AccountID); err != nil {
+		return connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	// The rotation goes on, or is rolled back, when the client goes away: it only
+	// misses the progress
+	grace := time.Duration(req.Msg.GracePeriodSeconds) * time.Second
+	rotation, err := h.service.RotateScopedSigningKey(context.WithoutCancel(ctx), keyID, grace, func(progress services.SigningKeyRotationProgress) {
+		_ = stream.Send(&pb.RotateScopedSigningKeyResponse{
+			Progress: &pb.RotateScopedSigningKeyProgress{
+				ReissuedUsers: int32(progress.ReissuedUsers),
+				RevokedUsers:  int32(progress.RevokedUsers),
+				Users:         int32(progress.Users),
+			},
+		})
+	})
+	if err != nil {
+		return repoErrToConnect(err)
+	}
+
+	return stream.Send(&pb.RotateScopedSigningKeyResponse{
+		Key:              mappers.ScopedSigningKeyToProto(rotation.Key),
+		RetiredPublicKey: rotation.RetiredPublicKey,
+		RetireAt:         timestamppb.New(rotation.RetireAt),
+		ReissuedUsers:    int32(rotation.ReissuedUsers),
+		Pushed:           accountPushesToProto(rotation.Pushed),
+		RevokedUsers:     int32(rotation.RevokedUsers),
+	})
+}
@@ -2,12 +2,17 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 	pb "github.com/thomas-maurice/nis/gen/nis/v1"
 	"github.com/thomas-maurice/nis/gen/nis/v1/nisv1connect"
 	"github.com/thomas-maurice/nis/internal/application/services"
+	"github.com/thomas-maurice/nis/internal/domain/entities"
 	"github.com/thomas-maurice/nis/internal/interfaces/grpc/mappers"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // UserHandler implements the UserService gRPC service
@@ -138,9 +143,19 @@ func (h *UserHandler) ListUsers(
 		return nil, err
 	}
 
+	if req.Msg.StaleDays < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("stale_days must not be negative"))
+	}
+	staleFor := time.Duration(req.Msg.StaleDays) * 24 * time.Hour
+
 	// If account_id is empty, list all users across all accounts (filtered by permissions)
 	if req.Msg.AccountId == "" {
-		users, err := h.service.ListAllUsers(ctx, mappers.ProtoToListOptions(req.Msg.Options))
+		var users []*entities.User
+		if staleFor > 0 {
+			users, err = h.service.ListStaleUsers(ctx, nil, staleFor, mappers.ProtoToListOptions(req.Msg.Options))
+		} else {
+			users, err = h.service.ListAllUsers(ctx, mappers.ProtoToListOptions(req.Msg.Options))
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -166,7 +181,12 @@ func (h *UserHandler) ListUsers(
 		return nil, connect.NewError(connect.CodePermissionDenied, err)
 	}
 
-	users, err := h.service.ListUsersByAccount(ctx, accountID, mappers.ProtoToListOptions(req.Msg.Options))
+	var users []*entities.User
+	if staleFor > 0 {
+		users, err = h.service.ListStaleUsers(ctx, &accountID, staleFor, mappers.ProtoToListOptions(req.Msg.Options))
+	} else {
+		users, err = h.service.ListUsersByAccount(ctx, accountID, mappers.ProtoToListOptions(req.Msg.Options))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -287,3 +307,252 @@ func (h *UserHandler) GetUserCredentials(
 		Credentials: creds,
 	}), nil
 }
+
+// RotateUserCredentials gives a user a new key and JWT, revoking the old key after a grace period
+func (h *UserHandler) RotateUserCredentials(
+	ctx context.Context,
+	req *connect.Request[pb.RotateUserCredentialsRequest],
+) (*connect.Response[pb.RotateUserCredentialsResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := mappers.ParseUUID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.permService.CanUpdateUser(ctx, requestingUser, userID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	grace := time.Duration(req.Msg.GracePeriodSeconds) * time.Second
+	rotation, err := h.service.RotateUserCredentials(ctx, userID, grace)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.RotateUserCredentialsResponse{
+		User:       mappers.UserToProto(rotation.User),
+		RetiredKey: mappers.UserKeyToProto(rotation.RetiredKey),
+		Pushed:     accountPushesToProto(rotation.Pushed),
+	}), nil
+}
+
+// ListUserKeys lists the keys a user held before its credential rotations
+func (h *UserHandler) ListUserKeys(
+	ctx context.Context,
+	req *connect.Request[pb.ListUserKeysRequest],
+) (*connect.Response[pb.ListUserKeysResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := mappers.ParseUUID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.permService.CanReadUser(ctx, requestingUser, userID); err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	keys, err := h.service.ListUserKeys(ctx, userID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.ListUserKeysResponse{
+		Keys: mappers.UserKeysToProto(keys),
+	}), nil
+}
+
+// CreateRotationPolicy schedules the credential rotation of the users of an operator,
+// an account or a scoped signing key
+func (h *UserHandler) CreateRotationPolicy(
+	ctx context.Context,
+	req *connect.Request[pb.CreateRotationPolicyRequest],
+) (*connect.Response[pb.CreateRotationPolicyResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	createReq := services.CreateRotationPolicyRequest{
+		Interval:    time.Duration(req.Msg.IntervalSeconds) * time.Second,
+		GracePeriod: time.Duration(req.Msg.GracePeriodSeconds) * time.Second,
+		CreatedBy:   requestingUser.Username,
+	}
+	if req.Msg.ScopedSigningKeyId != "" {
+		if req.Msg.AccountId == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("account_id is required with scoped_signing_key_id"))
+		}
+		id, err := mappers.ParseUUID(req.Msg.ScopedSigningKeyId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		createReq.ScopedSigningKeyID = &id
+	}
+	if req.Msg.AccountId != "" {
+		id, err := mappers.ParseUUID(req.Msg.AccountId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		createReq.AccountID = &id
+		if err := h.permService.CanUpdateAccount(ctx, requestingUser, id); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+	} else {
+		createReq.OperatorID, err = mappers.ParseUUID(req.Msg.OperatorId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if err := h.permService.CanUpdateOperator(requestingUser, createReq.OperatorID); err != nil {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+	}
+
+	policy, err := h.service.CreateRotationPolicy(ctx, createReq)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.CreateRotationPolicyResponse{
+		Policy: mappers.RotationPolicyToProto(policy),
+	}), nil
+}
+
+// ListRotationPolicies lists the rotation policies visible to the requesting user
+func (h *UserHandler) ListRotationPolicies(
+	ctx context.Context,
+	req *connect.Request[pb.ListRotationPoliciesRequest],
+) (*connect.Response[pb.ListRotationPoliciesResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorID := uuid.Nil
+	if req.Msg.OperatorId != "" {
+		if operatorID, err = mappers.ParseUUID(req.Msg.OperatorId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	policies, err := h.service.ListRotationPolicies(ctx, operatorID)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	visible := make([]*entities.RotationPolicy, 0, len(policies))
+	for _, policy := range policies {
+		if policy.AccountID != nil {
+			err = h.permService.CanReadAccount(ctx, requestingUser, *policy.AccountID)
+		} else {
+			err = h.permService.CanReadOperator(ctx, requestingUser, policy.OperatorID)
+		}
+		if err == nil {
+			visible = append(visible, policy)
+		}
+	}
+
+	return connect.NewResponse(&pb.ListRotationPoliciesResponse{
+		Policies: mappers.RotationPoliciesToProto(visible),
+	}), nil
+}
+
+// DeleteRotationPolicy deletes a rotation policy
+func (h *UserHandler) DeleteRotationPolicy(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteRotationPolicyRequest],
+) (*connect.Response[pb.DeleteRotationPolicyResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := mappers.ParseUUID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	policy, err := h.service.GetRotationPolicy(ctx, id)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+	if policy.AccountID != nil {
+		err = h.permService.CanUpdateAccount(ctx, requestingUser, *policy.AccountID)
+	} else {
+		err = h.permService.CanUpdateOperator(requestingUser, policy.OperatorID)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, err)
+	}
+
+	if err := h.service.DeleteRotationPolicy(ctx, id); err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	return connect.NewResponse(&pb.DeleteRotationPolicyResponse{}), nil
+}
+
+// PlanRotations lists the upcoming scheduled rotations of the users visible to the
+// requesting user
+func (h *UserHandler) PlanRotations(
+	ctx context.Context,
+	req *connect.Request[pb.PlanRotationsRequest],
+) (*connect.Response[pb.PlanRotationsResponse], error) {
+	// Get requesting user from context
+	requestingUser, err := authedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	operatorID := uuid.Nil
+	if req.Msg.OperatorId != "" {
+		if operatorID, err = mappers.ParseUUID(req.Msg.OperatorId); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	plan, err := h.service.PlanRotations(ctx, operatorID, time.Duration(req.Msg.WithinSeconds)*time.Second)
+	if err != nil {
+		return nil, repoErrToConnect(err)
+	}
+
+	readable := make(map[uuid.UUID]bool)
+	rotations := make([]*pb.PlannedRotation, 0, len(plan))
+	for _, p := range plan {
+		ok, checked := readable[p.Account.ID]
+		if !checked {
+			ok = h.permService.CanReadAccount(ctx, requestingUser, p.Account.ID) == nil
+			readable[p.Account.ID] = ok
+		}
+		if !ok {
+			continue
+		}
+		rotations = append(rotations, &pb.PlannedRotation{
+			UserId:        mappers.UUIDToString(p.User.ID),
+			UserName:      p.User.Name,
+			AccountId:     mappers.UUIDToString(p.Account.ID),
+			AccountName:   p.Account.Name,
+			OperatorId:    mappers.UUIDToString(p.Operator.ID),
+			OperatorName:  p.Operator.Name,
+			PolicyId:      mappers.UUIDToString(p.Policy.ID),
+			Scope:         string(p.Policy.Scope()),
+			LastRotatedAt: timestamppb.New(p.LastRotatedAt),
+			DueAt:         timestamppb.New(p.DueAt),
+		})
+	}
+
+	return connect.NewResponse(&pb.PlanRotationsResponse{
+		Rotations: rotations,
+	}), nil
+}
@@ -6,8 +6,11 @@ import (
 
 	"connectrpc.com/connect"
 
+	pb "github.com/thomas-maurice/nis/gen/nis/v1"
+	"github.com/thomas-maurice/nis/internal/application/services"
 	"github.com/thomas-maurice/nis/internal/domain/entities"
 	"github.com/thomas-maurice/nis/internal/domain/repositories"
+	"github.com/thomas-maurice/nis/internal/interfaces/grpc/mappers"
 	"github.com/thomas-maurice/nis/internal/interfaces/grpc/middleware"
 )
 
@@ -24,6 +27,22 @@ func repoErrToConnect(err error) error {
 		return connect.NewError(connect.CodeNotFound, err)
 	case errors.Is(err, repositories.ErrAlreadyExists):
 		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, services.ErrJetStreamOvercommit),
+		errors.Is(err, services.ErrOperatorLockedDown):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, services.ErrInvalidServerConfig),
+		errors.Is(err, services.ErrInvalidLeafnodeProfile),
+		errors.Is(err, services.ErrInvalidGateway),
+		errors.Is(err, services.ErrInvalidPlacement),
+		errors.Is(err, services.ErrInvalidPromotion),
+		errors.Is(err, services.ErrInvalidMove),
+		errors.Is(err, services.ErrInvalidSuspension),
+		errors.Is(err, services.ErrInvalidLockdown),
+		errors.Is(err, services.ErrInvalidRotation),
+		errors.Is(err, services.ErrInvalidRotationPolicy):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, services.ErrSigningKeyRotationAborted):
+		return connect.NewError(connect.CodeAborted, err)
 	default:
 		return err
 	}
@@ -39,3 +58,16 @@ func authedUser(ctx context.Context) (*entities.APIUser, error) {
 	}
 	return user, nil
 }
+
+// accountPushesToProto converts account JWT pushes to protobuf
+func accountPushesToProto(pushes []services.AccountPush) []*pb.AccountPush {
+	result := make([]*pb.AccountPush, 0, len(pushes))
+	for _, push := range pushes {
+		result = append(result, &pb.AccountPush{
+			ClusterId:   mappers.UUIDToString(push.ClusterID),
+			ClusterName: push.ClusterName,
+			Error:       push.Error,
+		})
+	}
+	return result
+}
@@ -11,6 +11,15 @@ func AccountToProto(acc *entities.Account) *pb.Account {
 	if acc == nil {
 		return nil
 	}
+
+	var suspendedAt, usersRevokedAt *timestamppb.Timestamp
+	if acc.SuspendedAt != nil {
+		suspendedAt = timestamppb.New(*acc.SuspendedAt)
+	}
+	if acc.UsersRevokedAt != nil {
+		usersRevokedAt = timestamppb.New(*acc.UsersRevokedAt)
+	}
+
 	return &pb.Account{
 		Id:          UUIDToString(acc.ID),
 		OperatorId:  UUIDToString(acc.OperatorID),
@@ -25,8 +34,12 @@ func AccountToProto(acc *entities.Account) *pb.Account {
 			MaxStreams:   int32(acc.JetStreamMaxStreams),
 			MaxConsumers: int32(acc.JetStreamMaxConsumers),
 		},
-		CreatedAt: timestamppb.New(acc.CreatedAt),
-		UpdatedAt: timestamppb.New(acc.UpdatedAt),
+		CreatedAt:      timestamppb.New(acc.CreatedAt),
+		UpdatedAt:      timestamppb.New(acc.UpdatedAt),
+		SuspendedAt:    suspendedAt,
+		SuspendedBy:    acc.SuspendedBy,
+		SuspendReason:  acc.SuspendReason,
+		UsersRevokedAt: usersRevokedAt,
 	}
 }
 
@@ -1,6 +1,8 @@
 package mappers
 
 import (
+	"time"
+
 	"github.com/thomas-maurice/nis/internal/domain/entities"
 	pb "github.com/thomas-maurice/nis/gen/nis/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -17,19 +19,30 @@ func ClusterToProto(cluster *entities.Cluster) *pb.Cluster {
 		lastHealthCheck = timestamppb.New(*cluster.LastHealthCheck)
 	}
 
+	var nextHealthCheck *timestamppb.Timestamp
+	if cluster.NextHealthCheck != nil {
+		nextHealthCheck = timestamppb.New(*cluster.NextHealthCheck)
+	}
+
 	return &pb.Cluster{
-		Id:                   UUIDToString(cluster.ID),
-		OperatorId:           UUIDToString(cluster.OperatorID),
-		Name:                 cluster.Name,
-		Description:          cluster.Description,
-		ServerUrls:           cluster.ServerURLs,
-		SystemAccountPubKey:  cluster.SystemAccountPubKey,
-		CreatedAt:            timestamppb.New(cluster.CreatedAt),
-		UpdatedAt:            timestamppb.New(cluster.UpdatedAt),
-		Healthy:              cluster.Healthy,
-		LastHealthCheck:      lastHealthCheck,
-		HealthCheckError:     cluster.HealthCheckError,
-		SkipVerifyTls:        cluster.SkipVerifyTLS,
+		Id:                         UUIDToString(cluster.ID),
+		OperatorId:                 UUIDToString(cluster.OperatorID),
+		Name:                       cluster.Name,
+		Description:                cluster.Description,
+		ServerUrls:                 cluster.ServerURLs,
+		SystemAccountPubKey:        cluster.SystemAccountPubKey,
+		CreatedAt:                  timestamppb.New(cluster.CreatedAt),
+		UpdatedAt:                  timestamppb.New(cluster.UpdatedAt),
+		Healthy:                    cluster.Healthy,
+		LastHealthCheck:            lastHealthCheck,
+		HealthCheckError:           cluster.HealthCheckError,
+		SkipVerifyTls:              cluster.SkipVerifyTLS,
+		HealthCheckIntervalSeconds: int64(cluster.HealthCheckInterval.Seconds()),
+		HealthCheckTimeoutSeconds:  int64(cluster.HealthCheckTimeout.Seconds()),
+		NextHealthCheck:            nextHealthCheck,
+		ServerProfile:              ServerProfileToProto(cluster.ServerProfile),
+		Supercluster:               cluster.Supercluster,
+		GatewayUrls:                cluster.GatewayURLs,
 	}
 }
 
@@ -41,3 +54,207 @@ func ClustersToProto(clusters []*entities.Cluster) []*pb.Cluster {
 	}
 	return result
 }
+
+// ClusterServerToProto converts a recorded cluster server to protobuf. missing tells
+// whether the server failed to answer the last health check.
+func ClusterServerToProto(server *entities.ClusterServer, missing bool) *pb.ClusterServer {
+	if server == nil {
+		return nil
+	}
+
+	var startedAt *timestamppb.Timestamp
+	if server.StartedAt != nil {
+		startedAt = timestamppb.New(*server.StartedAt)
+	}
+
+	return &pb.ClusterServer{
+		ServerId:      server.ServerID,
+		Name:          server.Name,
+		Host:          server.Host,
+		Version:       server.Version,
+		Jetstream:     server.JetStream,
+		ClusterName:   server.ClusterName,
+		Gateways:      server.Gateways,
+		Connections:   int32(server.Connections),
+		StartedAt:     startedAt,
+		UptimeSeconds: int64(server.Uptime().Seconds()),
+		LastSeen:      timestamppb.New(server.LastSeen),
+		Missing:       missing,
+	}
+}
+
+// ClusterHealthCheckToProto converts a recorded health check to protobuf
+func ClusterHealthCheckToProto(check *entities.ClusterHealthCheck) *pb.ClusterHealthCheck {
+	if check == nil {
+		return nil
+	}
+
+	return &pb.ClusterHealthCheck{
+		CheckedAt: timestamppb.New(check.CheckedAt),
+		Healthy:   check.Healthy,
+		LatencyMs: check.Latency.Milliseconds(),
+		Error:     check.Error,
+	}
+}
+
+// LeafnodeProfileToProto converts a domain LeafnodeProfile to protobuf
+func LeafnodeProfileToProto(profile *entities.LeafnodeProfile) *pb.LeafnodeProfile {
+	if profile == nil {
+		return nil
+	}
+
+	return &pb.LeafnodeProfile{
+		Id:              UUIDToString(profile.ID),
+		ClusterId:       UUIDToString(profile.ClusterID),
+		Name:            profile.Name,
+		UserId:          UUIDToString(profile.UserID),
+		LocalAccount:    profile.LocalAccount,
+		Urls:            profile.URLs,
+		CredentialsPath: profile.CredentialsPath,
+		CaFile:          profile.CAFile,
+		CreatedAt:       timestamppb.New(profile.CreatedAt),
+		UpdatedAt:       timestamppb.New(profile.UpdatedAt),
+	}
+}
+
+// LeafnodeProfilesToProto converts a slice of domain LeafnodeProfiles to protobuf
+func LeafnodeProfilesToProto(profiles []*entities.LeafnodeProfile) []*pb.LeafnodeProfile {
+	result := make([]*pb.LeafnodeProfile, len(profiles))
+	for i, profile := range profiles {
+		result[i] = LeafnodeProfileToProto(profile)
+	}
+	return result
+}
+
+// AuthFailureToProto converts a domain AuthFailure to protobuf
+func AuthFailureToProto(failure *entities.AuthFailure) *pb.AuthFailure {
+	if failure == nil {
+		return nil
+	}
+
+	userID := ""
+	if failure.UserID != nil {
+		userID = UUIDToString(*failure.UserID)
+	}
+	accountID := ""
+	if failure.AccountID != nil {
+		accountID = UUIDToString(*failure.AccountID)
+	}
+
+	return &pb.AuthFailure{
+		Id:               UUIDToString(failure.ID),
+		ClusterId:        UUIDToString(failure.ClusterID),
+		WindowStart:      timestamppb.New(failure.WindowStart),
+		UserPublicKey:    failure.UserPublicKey,
+		AccountPublicKey: failure.AccountPublicKey,
+		UserId:           userID,
+		AccountId:        accountID,
+		RemoteIp:         failure.RemoteIP,
+		Reason:           failure.Reason,
+		ServerName:       failure.ServerName,
+		ClientName:       failure.ClientName,
+		Count:            failure.Count,
+		FirstSeen:        timestamppb.New(failure.FirstSeen),
+		LastSeen:         timestamppb.New(failure.LastSeen),
+	}
+}
+
+// AccountStatsToProto converts a domain AccountStats bucket to protobuf
+func AccountStatsToProto(stats *entities.AccountStats) *pb.AccountStatsPoint {
+	if stats == nil {
+		return nil
+	}
+
+	return &pb.AccountStatsPoint{
+		ClusterId:      UUIDToString(stats.ClusterID),
+		BucketStart:    timestamppb.New(stats.BucketStart),
+		Samples:        stats.Samples,
+		AvgConnections: stats.AvgConnections(),
+		MaxConnections: stats.MaxConnections,
+		MsgsIn:         stats.MsgsIn,
+		BytesIn:        stats.BytesIn,
+		MsgsOut:        stats.MsgsOut,
+		BytesOut:       stats.BytesOut,
+		SlowConsumers:  stats.SlowConsumers,
+	}
+}
+
+// ServerProfileToProto converts a domain ServerProfile to protobuf, nil stays nil
+func ServerProfileToProto(profile *entities.ServerProfile) *pb.ServerProfile {
+	if profile == nil {
+		return nil
+	}
+
+	return &pb.ServerProfile{
+		Host:        profile.Host,
+		Port:        int32(profile.Port),
+		HttpPort:    int32(profile.HTTPPort),
+		ClusterPort: int32(profile.ClusterPort),
+		Routes:      profile.Routes,
+		Tls: &pb.ServerTLS{
+			CertFile: profile.TLS.CertFile,
+			KeyFile:  profile.TLS.KeyFile,
+			CaFile:   profile.TLS.CAFile,
+			Verify:   profile.TLS.Verify,
+		},
+		Jetstream: &pb.ServerJetStream{
+			Enabled:    profile.JetStream.Enabled,
+			StoreDir:   profile.JetStream.StoreDir,
+			MaxMemory:  profile.JetStream.MaxMemory,
+			MaxStorage: profile.JetStream.MaxStorage,
+			Domain:     profile.JetStream.Domain,
+		},
+		Resolver: &pb.ServerResolver{
+			Dir:             profile.Resolver.Dir,
+			IntervalSeconds: int64(profile.Resolver.Interval.Seconds()),
+		},
+		Websocket: &pb.ServerWebsocket{
+			Port:        int32(profile.Websocket.Port),
+			NoTls:       profile.Websocket.NoTLS,
+			Compression: profile.Websocket.Compression,
+		},
+		MqttPort:     int32(profile.MQTT.Port),
+		LeafnodePort: int32(profile.Leafnode.Port),
+		GatewayPort:  int32(profile.Gateway.Port),
+	}
+}
+
+// ServerProfileFromProto converts a protobuf ServerProfile to the domain, nil stays nil
+func ServerProfileFromProto(profile *pb.ServerProfile) *entities.ServerProfile {
+	if profile == nil {
+		return nil
+	}
+
+	return &entities.ServerProfile{
+		Host:        profile.Host,
+		Port:        int(profile.Port),
+		HTTPPort:    int(profile.HttpPort),
+		ClusterPort: int(profile.ClusterPort),
+		Routes:      profile.Routes,
+		TLS: entities.ServerTLS{
+			CertFile: profile.GetTls().GetCertFile(),
+			KeyFile:  profile.GetTls().GetKeyFile(),
+			CAFile:   profile.GetTls().GetCaFile(),
+			Verify:   profile.GetTls().GetVerify(),
+		},
+		JetStream: entities.ServerJetStream{
+			Enabled:    profile.GetJetstream().GetEnabled(),
+			StoreDir:   profile.GetJetstream().GetStoreDir(),
+			MaxMemory:  profile.GetJetstream().GetMaxMemory(),
+			MaxStorage: profile.GetJetstream().GetMaxStorage(),
+			Domain:     profile.GetJetstream().GetDomain(),
+		},
+		Resolver: entities.ServerResolver{
+			Dir:      profile.GetResolver().GetDir(),
+			Interval: time.Duration(profile.GetResolver().GetIntervalSeconds()) * time.Second,
+		},
+		Websocket: entities.ServerWebsocket{
+			Port:        int(profile.GetWebsocket().GetPort()),
+			NoTLS:       profile.GetWebsocket().GetNoTls(),
+			Compression: profile.GetWebsocket().GetCompression(),
+		},
+		MQTT:     entities.ServerMQTT{Port: int(profile.MqttPort)},
+		Leafnode: entities.ServerLeafnode{Port: int(profile.LeafnodePort)},
+		Gateway:  entities.ServerGateway{Port: int(profile.GatewayPort)},
+	}
+}
@@ -11,6 +11,15 @@ func OperatorToProto(op *entities.Operator) *pb.Operator {
 	if op == nil {
 		return nil
 	}
+
+	var lockedDownAt, lockdownLiftedAt *timestamppb.Timestamp
+	if op.LockedDownAt != nil {
+		lockedDownAt = timestamppb.New(*op.LockedDownAt)
+	}
+	if op.LockdownLiftedAt != nil {
+		lockdownLiftedAt = timestamppb.New(*op.LockdownLiftedAt)
+	}
+
 	return &pb.Operator{
 		Id:                   UUIDToString(op.ID),
 		Name:                 op.Name,
@@ -20,6 +29,11 @@ func OperatorToProto(op *entities.Operator) *pb.Operator {
 		SystemAccountPubKey:  op.SystemAccountPubKey,
 		CreatedAt:            timestamppb.New(op.CreatedAt),
 		UpdatedAt:            timestamppb.New(op.UpdatedAt),
+		LockedDownAt:         lockedDownAt,
+		LockedDownBy:         op.LockedDownBy,
+		LockdownReason:       op.LockdownReason,
+		LockdownLiftedAt:     lockdownLiftedAt,
+		LockdownLiftedBy:     op.LockdownLiftedBy,
 	}
 }
 
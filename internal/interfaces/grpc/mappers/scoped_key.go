@@ -20,6 +20,14 @@ func ScopedSigningKeyToProto(key *entities.ScopedSigningKey) *pb.ScopedSigningKe
 		}
 	}
 
+	var retiredKeys map[string]*timestamppb.Timestamp
+	if len(key.RetiredKeys) > 0 {
+		retiredKeys = make(map[string]*timestamppb.Timestamp, len(key.RetiredKeys))
+		for publicKey, retireAt := range key.RetiredKeys {
+			retiredKeys[publicKey] = timestamppb.New(retireAt)
+		}
+	}
+
 	return &pb.ScopedSigningKey{
 		Id:          UUIDToString(key.ID),
 		AccountId:   UUIDToString(key.AccountID),
@@ -35,6 +43,7 @@ func ScopedSigningKeyToProto(key *entities.ScopedSigningKey) *pb.ScopedSigningKe
 		ResponsePermission: respPerm,
 		CreatedAt:          timestamppb.New(key.CreatedAt),
 		UpdatedAt:          timestamppb.New(key.UpdatedAt),
+		RetiredKeys:        retiredKeys,
 	}
 }
 
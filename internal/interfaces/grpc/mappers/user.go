@@ -18,6 +18,14 @@ func UserToProto(user *entities.User) *pb.User {
 		scopedKeyID = UUIDToString(*user.ScopedSigningKeyID)
 	}
 
+	var lastConnectedAt, lastSeenAt *timestamppb.Timestamp
+	if user.LastConnectedAt != nil {
+		lastConnectedAt = timestamppb.New(*user.LastConnectedAt)
+	}
+	if user.LastSeenAt != nil {
+		lastSeenAt = timestamppb.New(*user.LastSeenAt)
+	}
+
 	return &pb.User{
 		Id:                  UUIDToString(user.ID),
 		AccountId:           UUIDToString(user.AccountID),
@@ -28,6 +36,10 @@ func UserToProto(user *entities.User) *pb.User {
 		ScopedSigningKeyId:  scopedKeyID,
 		CreatedAt:           timestamppb.New(user.CreatedAt),
 		UpdatedAt:           timestamppb.New(user.UpdatedAt),
+		LastConnectedAt:     lastConnectedAt,
+		LastSeenAt:          lastSeenAt,
+		LastRemoteIp:        user.LastRemoteIP,
+		ConnectionCount:     user.ConnectionCount,
 	}
 }
 
@@ -51,3 +63,69 @@ func ProtoToScopedKeyID(id string) (*uuid.UUID, error) {
 	}
 	return &parsed, nil
 }
+
+// UserKeyToProto converts a retired domain UserKey to protobuf UserKey
+func UserKeyToProto(key *entities.UserKey) *pb.UserKey {
+	if key == nil {
+		return nil
+	}
+
+	var revokedAt *timestamppb.Timestamp
+	if key.RevokedAt != nil {
+		revokedAt = timestamppb.New(*key.RevokedAt)
+	}
+
+	return &pb.UserKey{
+		PublicKey: key.PublicKey,
+		CreatedAt: timestamppb.New(key.CreatedAt),
+		RetiredAt: timestamppb.New(key.RetiredAt),
+		RevokeAt:  timestamppb.New(key.RevokeAt),
+		RevokedAt: revokedAt,
+	}
+}
+
+// UserKeysToProto converts slice of domain UserKeys to protobuf UserKeys
+func UserKeysToProto(keys []*entities.UserKey) []*pb.UserKey {
+	result := make([]*pb.UserKey, len(keys))
+	for i, key := range keys {
+		result[i] = UserKeyToProto(key)
+	}
+	return result
+}
+
+// RotationPolicyToProto converts domain RotationPolicy to protobuf RotationPolicy
+func RotationPolicyToProto(policy *entities.RotationPolicy) *pb.RotationPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	accountID, scopedKeyID := "", ""
+	if policy.AccountID != nil {
+		accountID = UUIDToString(*policy.AccountID)
+	}
+	if policy.ScopedSigningKeyID != nil {
+		scopedKeyID = UUIDToString(*policy.ScopedSigningKeyID)
+	}
+
+	return &pb.RotationPolicy{
+		Id:                 UUIDToString(policy.ID),
+		OperatorId:         UUIDToString(policy.OperatorID),
+		AccountId:          accountID,
+		ScopedSigningKeyId: scopedKeyID,
+		Scope:              string(policy.Scope()),
+		IntervalSeconds:    int64(policy.Interval.Seconds()),
+		GracePeriodSeconds: int64(policy.GracePeriod.Seconds()),
+		CreatedBy:          policy.CreatedBy,
+		CreatedAt:          timestamppb.New(policy.CreatedAt),
+		UpdatedAt:          timestamppb.New(policy.UpdatedAt),
+	}
+}
+
+// RotationPoliciesToProto converts slice of domain RotationPolicies to protobuf RotationPolicies
+func RotationPoliciesToProto(policies []*entities.RotationPolicy) []*pb.RotationPolicy {
+	result := make([]*pb.RotationPolicy, len(policies))
+	for i, policy := range policies {
+		result[i] = RotationPolicyToProto(policy)
+	}
+	return result
+}
@@ -39,6 +39,9 @@ type ServerConfig struct {
 	// not registered and only otelconnect-emitted RPC metrics are tracked
 	// (which themselves are no-ops without a meter provider).
 	MetricsProvider *metrics.Provider
+	// Leader, if set, is reported by /readyz so operators can see which replica
+	// runs the periodic tasks. Being a follower does not make a replica unready.
+	Leader *services.LeaderElector
 }
 
 // Server wraps the HTTP server for gRPC/ConnectRPC
@@ -201,6 +204,8 @@ func (s *Server) AttachMetricsHandler(h http.Handler) {
 type readyzResponse struct {
 	Status     string            `json:"status"`
 	Components map[string]string `json:"components"`
+	Instance   string            `json:"instance,omitempty"`
+	Leader     string            `json:"leader,omitempty"`
 }
 
 func handleReadyz(w http.ResponseWriter, r *http.Request, cfg ServerConfig) {
@@ -244,6 +249,16 @@ func handleReadyz(w http.ResponseWriter, r *http.Request, cfg ServerConfig) {
 		allOK = false
 	}
 
+	if cfg.Leader != nil {
+		resp.Instance = cfg.Leader.ID()
+		resp.Leader = cfg.Leader.Leader()
+		role := "follower"
+		if cfg.Leader.IsLeader() {
+			role = "leader"
+		}
+		resp.Components["leader_election"] = role + " (" + cfg.Leader.Mode() + ")"
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	if allOK {
 		w.WriteHeader(http.StatusOK)
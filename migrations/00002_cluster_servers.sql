@@ -0,0 +1,27 @@
+-- +goose Up
+
+-- Cluster servers table (per-server topology recorded by the cluster health check)
+CREATE TABLE cluster_servers (
+    id TEXT PRIMARY KEY,
+    cluster_id TEXT NOT NULL,
+    server_id TEXT NOT NULL,
+    name TEXT NOT NULL DEFAULT '',
+    host TEXT NOT NULL DEFAULT '',
+    version TEXT NOT NULL DEFAULT '',
+    jetstream BOOLEAN NOT NULL DEFAULT FALSE,
+    cluster_name TEXT NOT NULL DEFAULT '',
+    gateways TEXT,  -- JSON array
+    connections INTEGER NOT NULL DEFAULT 0,
+    started_at TIMESTAMP,
+    last_seen TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (cluster_id) REFERENCES clusters(id) ON DELETE CASCADE,
+    UNIQUE(cluster_id, server_id)
+);
+
+CREATE INDEX idx_cluster_servers_cluster_id ON cluster_servers(cluster_id);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS cluster_servers;
@@ -0,0 +1,30 @@
+-- +goose Up
+
+-- Per-cluster health check schedule. Zero interval/timeout means the service default.
+ALTER TABLE clusters ADD COLUMN health_check_interval_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE clusters ADD COLUMN health_check_timeout_seconds INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE clusters ADD COLUMN health_check_failures INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE clusters ADD COLUMN next_health_check TIMESTAMP;
+
+-- Cluster health checks table (one row per health check run)
+CREATE TABLE cluster_health_checks (
+    id TEXT PRIMARY KEY,
+    cluster_id TEXT NOT NULL,
+    checked_at TIMESTAMP NOT NULL,
+    healthy BOOLEAN NOT NULL DEFAULT FALSE,
+    latency_ms BIGINT NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    FOREIGN KEY (cluster_id) REFERENCES clusters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_cluster_health_checks_cluster_id ON cluster_health_checks(cluster_id, checked_at);
+CREATE INDEX idx_cluster_health_checks_checked_at ON cluster_health_checks(checked_at);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS cluster_health_checks;
+
+ALTER TABLE clusters DROP COLUMN next_health_check;
+ALTER TABLE clusters DROP COLUMN health_check_failures;
+ALTER TABLE clusters DROP COLUMN health_check_timeout_seconds;
+ALTER TABLE clusters DROP COLUMN health_check_interval_seconds;
@@ -0,0 +1,14 @@
+-- +goose Up
+
+-- Leader leases table (one row per elected role, held by one nis serve replica at a time)
+CREATE TABLE leader_leases (
+    name TEXT PRIMARY KEY,
+    holder_id TEXT NOT NULL,
+    acquired_at TIMESTAMP NOT NULL,
+    renewed_at TIMESTAMP NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS leader_leases;
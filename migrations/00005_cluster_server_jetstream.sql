@@ -0,0 +1,10 @@
+-- +goose Up
+
+-- JetStream capacity of each server as reported by JSZ, 0 when unknown
+ALTER TABLE cluster_servers ADD COLUMN jetstream_max_memory BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE cluster_servers ADD COLUMN jetstream_max_storage BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+
+ALTER TABLE cluster_servers DROP COLUMN jetstream_max_storage;
+ALTER TABLE cluster_servers DROP COLUMN jetstream_max_memory;
@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- Client activity of each user, recorded from the $SYS.ACCOUNT.*.CONNECT and
+-- DISCONNECT events of the managed clusters
+ALTER TABLE users ADD COLUMN last_connected_at TIMESTAMP;
+ALTER TABLE users ADD COLUMN last_seen_at TIMESTAMP;
+ALTER TABLE users ADD COLUMN last_remote_ip TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN connection_count BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_users_last_seen_at ON users(last_seen_at);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_users_last_seen_at;
+
+ALTER TABLE users DROP COLUMN connection_count;
+ALTER TABLE users DROP COLUMN last_remote_ip;
+ALTER TABLE users DROP COLUMN last_seen_at;
+ALTER TABLE users DROP COLUMN last_connected_at;
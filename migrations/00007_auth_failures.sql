@@ -0,0 +1,31 @@
+-- +goose Up
+
+-- NATS client authentication failures reported by $SYS.SERVER.*.CLIENT.AUTH.ERR,
+-- aggregated per cluster, time window, presented user key, remote address and reason.
+-- user_id and account_id are not foreign keys so the log outlives deleted users.
+CREATE TABLE auth_failures (
+    id TEXT PRIMARY KEY,
+    cluster_id TEXT NOT NULL,
+    window_start TIMESTAMP NOT NULL,
+    user_public_key TEXT NOT NULL DEFAULT '',
+    account_public_key TEXT NOT NULL DEFAULT '',
+    user_id TEXT,
+    account_id TEXT,
+    remote_ip TEXT NOT NULL DEFAULT '',
+    reason TEXT NOT NULL DEFAULT '',
+    server_name TEXT NOT NULL DEFAULT '',
+    client_name TEXT NOT NULL DEFAULT '',
+    count BIGINT NOT NULL DEFAULT 0,
+    first_seen TIMESTAMP NOT NULL,
+    last_seen TIMESTAMP NOT NULL,
+    FOREIGN KEY (cluster_id) REFERENCES clusters(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_auth_failures_window ON auth_failures(cluster_id, window_start, user_public_key, remote_ip, reason);
+CREATE INDEX idx_auth_failures_account_id ON auth_failures(account_id, last_seen);
+CREATE INDEX idx_auth_failures_user_id ON auth_failures(user_id, last_seen);
+CREATE INDEX idx_auth_failures_last_seen ON auth_failures(last_seen);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth_failures;
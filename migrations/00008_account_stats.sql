@@ -0,0 +1,30 @@
+-- +goose Up
+
+-- Account traffic collected from $SYS.REQ.ACCOUNT.PING.STATZ, summed over the servers
+-- of a cluster into fixed size buckets. Every sample is added to a bucket of each
+-- resolution, so the fine buckets can be pruned early while the coarse ones are kept.
+CREATE TABLE account_stats (
+    id TEXT PRIMARY KEY,
+    cluster_id TEXT NOT NULL,
+    account_id TEXT NOT NULL,
+    resolution_seconds INTEGER NOT NULL,
+    bucket_start TIMESTAMP NOT NULL,
+    samples BIGINT NOT NULL DEFAULT 0,
+    connections_sum BIGINT NOT NULL DEFAULT 0,
+    max_connections BIGINT NOT NULL DEFAULT 0,
+    msgs_in BIGINT NOT NULL DEFAULT 0,
+    msgs_out BIGINT NOT NULL DEFAULT 0,
+    bytes_in BIGINT NOT NULL DEFAULT 0,
+    bytes_out BIGINT NOT NULL DEFAULT 0,
+    slow_consumers BIGINT NOT NULL DEFAULT 0,
+    FOREIGN KEY (cluster_id) REFERENCES clusters(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_account_stats_bucket ON account_stats(account_id, resolution_seconds, bucket_start, cluster_id);
+CREATE INDEX idx_account_stats_cluster_id ON account_stats(cluster_id);
+CREATE INDEX idx_account_stats_resolution ON account_stats(resolution_seconds, bucket_start);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS account_stats;
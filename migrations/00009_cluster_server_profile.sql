@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Configuration profile of the cluster's NATS servers as JSON, NULL for the defaults
+ALTER TABLE clusters ADD COLUMN server_profile TEXT;
+
+-- +goose Down
+
+ALTER TABLE clusters DROP COLUMN server_profile;
@@ -0,0 +1,25 @@
+-- +goose Up
+
+-- Leafnode profiles bind a NIS user to a hub cluster for the leafnode remotes of
+-- edge servers. Profiles go away with their hub cluster or their user.
+CREATE TABLE leafnode_profiles (
+    id TEXT PRIMARY KEY,
+    cluster_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    local_account TEXT NOT NULL DEFAULT '',
+    urls TEXT,  -- JSON array
+    credentials_path TEXT NOT NULL DEFAULT '',
+    ca_file TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (cluster_id) REFERENCES clusters(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE(cluster_id, name)
+);
+
+CREATE INDEX idx_leafnode_profiles_user_id ON leafnode_profiles(user_id);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS leafnode_profiles;
@@ -0,0 +1,15 @@
+-- +goose Up
+
+-- Supercluster the cluster joins through its gateways, '' for a standalone cluster
+ALTER TABLE clusters ADD COLUMN supercluster TEXT NOT NULL DEFAULT '';
+
+-- Gateway URLs of the cluster as a JSON array, NULL to derive them from server_urls
+ALTER TABLE clusters ADD COLUMN gateway_urls TEXT;
+
+CREATE INDEX idx_clusters_supercluster ON clusters(operator_id, supercluster);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_clusters_supercluster;
+ALTER TABLE clusters DROP COLUMN gateway_urls;
+ALTER TABLE clusters DROP COLUMN supercluster;
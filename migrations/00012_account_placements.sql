@@ -0,0 +1,18 @@
+-- +goose Up
+
+-- Clusters an account is pushed to. Accounts without placements are pushed to every
+-- cluster of their operator. Placements go away with their account or cluster.
+CREATE TABLE account_placements (
+    account_id TEXT NOT NULL,
+    cluster_id TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (account_id, cluster_id),
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (cluster_id) REFERENCES clusters(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_account_placements_cluster_id ON account_placements(cluster_id);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS account_placements;
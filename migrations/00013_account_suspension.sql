@@ -0,0 +1,16 @@
+-- +goose Up
+
+-- Suspension of the account: while suspended_at is set, its JWT allows no connection
+ALTER TABLE accounts ADD COLUMN suspended_at TIMESTAMP;
+ALTER TABLE accounts ADD COLUMN suspended_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE accounts ADD COLUMN suspend_reason TEXT NOT NULL DEFAULT '';
+
+-- User JWTs issued before users_revoked_at are revoked by the account JWT
+ALTER TABLE accounts ADD COLUMN users_revoked_at TIMESTAMP;
+
+-- +goose Down
+
+ALTER TABLE accounts DROP COLUMN users_revoked_at;
+ALTER TABLE accounts DROP COLUMN suspend_reason;
+ALTER TABLE accounts DROP COLUMN suspended_by;
+ALTER TABLE accounts DROP COLUMN suspended_at;
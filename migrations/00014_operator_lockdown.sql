@@ -0,0 +1,17 @@
+-- +goose Up
+
+-- Last emergency lockdown of the operator: while lockdown_lifted_at is unset,
+-- credential downloads of its users are refused
+ALTER TABLE operators ADD COLUMN locked_down_at TIMESTAMP;
+ALTER TABLE operators ADD COLUMN locked_down_by TEXT NOT NULL DEFAULT '';
+ALTER TABLE operators ADD COLUMN lockdown_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE operators ADD COLUMN lockdown_lifted_at TIMESTAMP;
+ALTER TABLE operators ADD COLUMN lockdown_lifted_by TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+
+ALTER TABLE operators DROP COLUMN lockdown_lifted_by;
+ALTER TABLE operators DROP COLUMN lockdown_lifted_at;
+ALTER TABLE operators DROP COLUMN lockdown_reason;
+ALTER TABLE operators DROP COLUMN locked_down_by;
+ALTER TABLE operators DROP COLUMN locked_down_at;
@@ -0,0 +1,27 @@
+-- +goose Up
+
+-- Keys users held before a credential rotation. A key keeps working until revoke_at,
+-- the end of the rotation's grace period, then the account JWT revokes it. Keys go
+-- away with their user.
+CREATE TABLE user_keys (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    account_id TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    retired_at TIMESTAMP NOT NULL,
+    revoke_at TIMESTAMP NOT NULL,
+    revoked_at TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_keys_user_id ON user_keys(user_id);
+CREATE INDEX idx_user_keys_revoke_at ON user_keys(revoke_at);
+
+-- Keys revoked by the account JWT, as a JSON object of public key to revocation time
+ALTER TABLE accounts ADD COLUMN revoked_user_keys TEXT;
+
+-- +goose Down
+
+ALTER TABLE accounts DROP COLUMN revoked_user_keys;
+DROP TABLE IF EXISTS user_keys;
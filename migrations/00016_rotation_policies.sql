@@ -0,0 +1,24 @@
+-- +goose Up
+
+-- Policies rotating the credentials of the users of an operator, an account or a
+-- scoped signing key on a schedule. A policy goes away with what it applies to.
+CREATE TABLE rotation_policies (
+    id TEXT PRIMARY KEY,
+    operator_id TEXT NOT NULL,
+    account_id TEXT,
+    scoped_signing_key_id TEXT,
+    interval_seconds BIGINT NOT NULL,
+    grace_period_seconds BIGINT NOT NULL DEFAULT 0,
+    created_by TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    FOREIGN KEY (operator_id) REFERENCES operators(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    FOREIGN KEY (scoped_signing_key_id) REFERENCES scoped_signing_keys(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_rotation_policies_operator_id ON rotation_policies(operator_id);
+
+-- +goose Down
+
+DROP TABLE IF EXISTS rotation_policies;
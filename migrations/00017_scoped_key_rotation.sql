@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- Public keys replaced by a scoped signing key rotation, as a JSON object of public
+-- key to the end of its grace period. The account JWT keeps trusting them until then.
+ALTER TABLE scoped_signing_keys ADD COLUMN retired_keys TEXT;
+
+-- +goose Down
+
+ALTER TABLE scoped_signing_keys DROP COLUMN retired_keys;
@@ -1,14 +1,13 @@
 //go:build e2e
 
 // Package e2e is the end-to-end test suite for NIS. It boots NIS from a fresh database,
-// stands up a real NATS server in Docker authenticated against that operator's JWTs, then
+// stands up an embedded NATS server authenticated against that operator's JWTs, then
 // exercises the identity flow over Connect-RPC and asserts NATS-side permissions.
 //
 // Run with:    make test-e2e
 // Or:          go test -tags=e2e -v ./tests/e2e/...
 //
 // Requirements:
-//   - docker daemon running (for the NATS container).
 //   - free TCP ports (test picks unused ports for NIS and NATS).
 package e2e
 
@@ -31,6 +30,7 @@ import (
 
 	nisv1 "github.com/thomas-maurice/nis/gen/nis/v1"
 	"github.com/thomas-maurice/nis/gen/nis/v1/nisv1connect"
+	"github.com/thomas-maurice/nis/internal/infrastructure/natsserver"
 )
 
 const (
@@ -82,12 +82,18 @@ func TestE2E_FullLifecycle(t *testing.T) {
 	if err != nil {
 		t.Fatalf("GenerateInclude: %v", err)
 	}
-	natsConfPath := filepath.Join(h.workDir, "nats-server.conf")
-	if err := os.WriteFile(natsConfPath, []byte(includeResp.Msg.Config), 0o644); err != nil {
-		t.Fatalf("write nats config: %v", err)
+	if !strings.Contains(includeResp.Msg.Config, operatorResp.Msg.Operator.Jwt) {
+		t.Fatalf("include config does not carry the operator JWT:\n%s", includeResp.Msg.Config)
+	}
+	sysAccountResp, err := h.accountCli.GetAccountByName(ctx, connect.NewRequest(&nisv1.GetAccountByNameRequest{
+		OperatorId: operatorID,
+		Name:       "$SYS",
+	}))
+	if err != nil {
+		t.Fatalf("GetAccountByName($SYS): %v", err)
 	}
 
-	h.startNATS(t, natsConfPath)
+	h.startNATS(t, operatorResp.Msg.Operator.Jwt, sysAccountResp.Msg.Account.Jwt)
 
 	// --- Cluster ----------------------------------------------------------------
 	clusterResp, err := h.clusterCli.CreateCluster(ctx, connect.NewRequest(&nisv1.CreateClusterRequest{
@@ -126,6 +132,22 @@ func TestE2E_FullLifecycle(t *testing.T) {
 		t.Fatalf("SyncCluster: %v", err)
 	}
 
+	t.Run("VerifyAccount_Propagated", func(t *testing.T) {
+		resp, err := h.clusterCli.VerifyAccount(ctx, connect.NewRequest(&nisv1.VerifyAccountRequest{
+			ClusterId: clusterID,
+			AccountId: accountID,
+		}))
+		if err != nil {
+			t.Fatalf("VerifyAccount: %v", err)
+		}
+		if !resp.Msg.Propagated {
+			t.Fatalf("account not propagated: %+v", resp.Msg)
+		}
+		if len(resp.Msg.Servers) != 1 || !resp.Msg.Servers[0].HasAccount {
+			t.Fatalf("expected one server holding the account, got %+v", resp.Msg.Servers)
+		}
+	})
+
 	defaultCredsPath := h.fetchUserCreds(t, defaultUserID, "default-user")
 
 	t.Run("AuthorizedConnection_DefaultUser", func(t *testing.T) {
@@ -519,16 +541,15 @@ type harness struct {
 	repoDir string
 	nisBin  string
 
-	nisPort      int
-	natsPort     int
-	natsMgmtPort int
+	nisPort  int
+	natsPort int
 
 	serverURL string
 	natsURL   string
 
-	nisProcess    *exec.Cmd
-	nisLogPath    string
-	natsContainer string
+	nisProcess *exec.Cmd
+	nisLogPath string
+	natsServer *natsserver.Server
 
 	httpClient *http.Client
 	authToken  string
@@ -546,12 +567,6 @@ func newHarness(t *testing.T) *harness {
 	if runtime.GOOS == "windows" {
 		t.Skip("e2e suite is POSIX-only")
 	}
-	if _, err := exec.LookPath("docker"); err != nil {
-		t.Skipf("docker not found in PATH: %v", err)
-	}
-	if err := exec.Command("docker", "info").Run(); err != nil {
-		t.Skipf("docker daemon not reachable: %v", err)
-	}
 
 	repoDir, err := findRepoRoot()
 	if err != nil {
@@ -564,16 +579,14 @@ func newHarness(t *testing.T) *harness {
 	}
 
 	return &harness{
-		t:             t,
-		workDir:       workDir,
-		repoDir:       repoDir,
-		nisBin:        nisBin,
-		nisPort:       pickFreePort(t),
-		natsPort:      pickFreePort(t),
-		natsMgmtPort:  pickFreePort(t),
-		nisLogPath:    filepath.Join(workDir, "nis.log"),
-		natsContainer: fmt.Sprintf("nis-e2e-%d", time.Now().UnixNano()),
-		httpClient:    &http.Client{Timeout: 10 * time.Second},
+		t:          t,
+		workDir:    workDir,
+		repoDir:    repoDir,
+		nisBin:     nisBin,
+		nisPort:    pickFreePort(t),
+		natsPort:   pickFreePort(t),
+		nisLogPath: filepath.Join(workDir, "nis.log"),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
 	}
 }
 
@@ -658,27 +671,20 @@ func (h *harness) start(t *testing.T) {
 	h.keyCli = nisv1connect.NewScopedSigningKeyServiceClient(h.httpClient, h.serverURL, authOpt)
 }
 
-func (h *harness) startNATS(t *testing.T, confPath string) {
+func (h *harness) startNATS(t *testing.T, operatorJWT, sysAccountJWT string) {
 	t.Helper()
-	args := []string{
-		"run", "-d",
-		"--name", h.natsContainer,
-		"-p", fmt.Sprintf("127.0.0.1:%d:4222", h.natsPort),
-		"-p", fmt.Sprintf("127.0.0.1:%d:8222", h.natsMgmtPort),
-		"-v", fmt.Sprintf("%s:/nats-server.conf:ro", confPath),
-		"nats:2.10-alpine",
-		"-c", "/nats-server.conf",
-		"-m", "8222",
-	}
-	if out, err := exec.Command("docker", args...).CombinedOutput(); err != nil {
-		t.Fatalf("start nats container: %v\n%s", err, out)
-	}
-
-	mgmtURL := fmt.Sprintf("http://127.0.0.1:%d/healthz", h.natsMgmtPort)
-	if err := waitForHTTP(mgmtURL, natsReadyTimeout); err != nil {
-		dump, _ := exec.Command("docker", "logs", h.natsContainer).CombinedOutput()
-		t.Fatalf("nats did not become healthy: %v\nnats logs:\n%s", err, dump)
+	srv, err := natsserver.Start(natsserver.Config{
+		ServerName:       "nis-e2e",
+		Port:             h.natsPort,
+		OperatorJWT:      operatorJWT,
+		SystemAccountJWT: sysAccountJWT,
+		DataDir:          filepath.Join(h.workDir, "nats"),
+		ReadyTimeout:     natsReadyTimeout,
+	})
+	if err != nil {
+		t.Fatalf("start nats: %v", err)
 	}
+	h.natsServer = srv
 }
 
 func (h *harness) fetchUserCreds(t *testing.T, userID, name string) string {
@@ -701,8 +707,8 @@ func (h *harness) teardown() {
 		_ = h.nisProcess.Process.Kill()
 		_, _ = h.nisProcess.Process.Wait()
 	}
-	if h.natsContainer != "" {
-		_ = exec.Command("docker", "rm", "-f", h.natsContainer).Run()
+	if h.natsServer != nil {
+		h.natsServer.Shutdown()
 	}
 	if h.t.Failed() {
 		if b, err := os.ReadFile(h.nisLogPath); err == nil {
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateAccountRequest, CreateAccountResponse, DeleteAccountRequest, DeleteAccountResponse, GetAccountByNameRequest, GetAccountByNameResponse, GetAccountRequest, GetAccountResponse, ListAccountsRequest, ListAccountsResponse, PushAccountJWTRequest, PushAccountJWTResponse, UpdateAccountRequest, UpdateAccountResponse, UpdateJetStreamLimitsRequest, UpdateJetStreamLimitsResponse } from "./account_pb.js";
+import { CreateAccountRequest, CreateAccountResponse, DeleteAccountRequest, DeleteAccountResponse, GetAccountByNameRequest, GetAccountByNameResponse, GetAccountPlacementRequest, GetAccountPlacementResponse, GetAccountRequest, GetAccountResponse, GetAccountStatsRequest, GetAccountStatsResponse, GetAccountUsageRequest, GetAccountUsageResponse, ListAccountsRequest, ListAccountsResponse, MoveAccountRequest, MoveAccountResponse, PromoteAccountRequest, PromoteAccountResponse, PushAccountJWTRequest, PushAccountJWTResponse, ResumeAccountRequest, ResumeAccountResponse, SetAccountPlacementRequest, SetAccountPlacementResponse, SuspendAccountRequest, SuspendAccountResponse, UpdateAccountRequest, UpdateAccountResponse, UpdateJetStreamLimitsRequest, UpdateJetStreamLimitsResponse } from "./account_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -86,6 +86,86 @@ export const AccountService = {
       O: PushAccountJWTResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc nis.v1.AccountService.PromoteAccount
+     */
+    promoteAccount: {
+      name: "PromoteAccount",
+      I: PromoteAccountRequest,
+      O: PromoteAccountResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetAccountUsage reports the JetStream usage of an account against its limits via JSZ
+     *
+     * @generated from rpc nis.v1.AccountService.GetAccountUsage
+     */
+    getAccountUsage: {
+      name: "GetAccountUsage",
+      I: GetAccountUsageRequest,
+      O: GetAccountUsageResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetAccountStats returns the account traffic collected from the clusters via STATZ
+     *
+     * @generated from rpc nis.v1.AccountService.GetAccountStats
+     */
+    getAccountStats: {
+      name: "GetAccountStats",
+      I: GetAccountStatsRequest,
+      O: GetAccountStatsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetAccountPlacement lists the clusters an account is pushed to
+     *
+     * @generated from rpc nis.v1.AccountService.GetAccountPlacement
+     */
+    getAccountPlacement: {
+      name: "GetAccountPlacement",
+      I: GetAccountPlacementRequest,
+      O: GetAccountPlacementResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetAccountPlacement places an account on clusters and deletes it from the clusters it leaves
+     *
+     * @generated from rpc nis.v1.AccountService.SetAccountPlacement
+     */
+    setAccountPlacement: {
+      name: "SetAccountPlacement",
+      I: SetAccountPlacementRequest,
+      O: SetAccountPlacementResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc nis.v1.AccountService.MoveAccount
+     */
+    moveAccount: {
+      name: "MoveAccount",
+      I: MoveAccountRequest,
+      O: MoveAccountResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc nis.v1.AccountService.SuspendAccount
+     */
+    suspendAccount: {
+      name: "SuspendAccount",
+      I: SuspendAccountRequest,
+      O: SuspendAccountResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc nis.v1.AccountService.ResumeAccount
+     */
+    resumeAccount: {
+      name: "ResumeAccount",
+      I: ResumeAccountRequest,
+      O: ResumeAccountResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,8 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
-import { JetStreamLimits, ListOptions } from "./common_pb.js";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+import { AccountPush, JetStreamLimits, ListOptions } from "./common_pb.js";
 
 /**
  * Account represents a NATS account
@@ -58,6 +58,32 @@ export class Account extends Message<Account> {
    */
   updatedAt?: Timestamp;
 
+  /**
+   * Set while the account is suspended: its JWT allows no connection
+   *
+   * @generated from field: google.protobuf.Timestamp suspended_at = 10;
+   */
+  suspendedAt?: Timestamp;
+
+  /**
+   * API user who suspended the account
+   *
+   * @generated from field: string suspended_by = 11;
+   */
+  suspendedBy = "";
+
+  /**
+   * @generated from field: string suspend_reason = 12;
+   */
+  suspendReason = "";
+
+  /**
+   * User JWTs issued before are revoked
+   *
+   * @generated from field: google.protobuf.Timestamp users_revoked_at = 13;
+   */
+  usersRevokedAt?: Timestamp;
+
   constructor(data?: PartialMessage<Account>) {
     super();
     proto3.util.initPartial(data, this);
@@ -75,6 +101,10 @@ export class Account extends Message<Account> {
     { no: 7, name: "jetstream_limits", kind: "message", T: JetStreamLimits },
     { no: 8, name: "created_at", kind: "message", T: Timestamp },
     { no: 9, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 10, name: "suspended_at", kind: "message", T: Timestamp },
+    { no: 11, name: "suspended_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "suspend_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "users_revoked_at", kind: "message", T: Timestamp },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Account {
@@ -162,6 +192,13 @@ export class CreateAccountResponse extends Message<CreateAccountResponse> {
    */
   account?: Account;
 
+  /**
+   * Clusters whose JetStream capacity the account's limits over-commit
+   *
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[] = [];
+
   constructor(data?: PartialMessage<CreateAccountResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -171,6 +208,7 @@ export class CreateAccountResponse extends Message<CreateAccountResponse> {
   static readonly typeName = "nis.v1.CreateAccountResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "account", kind: "message", T: Account },
+    { no: 2, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAccountResponse {
@@ -582,6 +620,13 @@ export class UpdateJetStreamLimitsResponse extends Message<UpdateJetStreamLimits
    */
   account?: Account;
 
+  /**
+   * Clusters whose JetStream capacity the account's limits over-commit
+   *
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[] = [];
+
   constructor(data?: PartialMessage<UpdateJetStreamLimitsResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -591,6 +636,7 @@ export class UpdateJetStreamLimitsResponse extends Message<UpdateJetStreamLimits
   static readonly typeName = "nis.v1.UpdateJetStreamLimitsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "account", kind: "message", T: Account },
+    { no: 2, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateJetStreamLimitsResponse {
@@ -754,3 +800,1229 @@ export class PushAccountJWTResponse extends Message<PushAccountJWTResponse> {
   }
 }
 
+/**
+ * PromotionChange is one configuration value a promotion changes on the target account
+ *
+ * @generated from message nis.v1.PromotionChange
+ */
+export class PromotionChange extends Message<PromotionChange> {
+  /**
+   * e.g. "jetstream.max_memory" or "scoped_keys.app.pub_allow"
+   *
+   * @generated from field: string field = 1;
+   */
+  field = "";
+
+  /**
+   * Empty when the target does not have it yet
+   *
+   * @generated from field: string from = 2;
+   */
+  from = "";
+
+  /**
+   * @generated from field: string to = 3;
+   */
+  to = "";
+
+  constructor(data?: PartialMessage<PromotionChange>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.PromotionChange";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "field", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "from", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "to", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromotionChange {
+    return new PromotionChange().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromotionChange {
+    return new PromotionChange().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromotionChange {
+    return new PromotionChange().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromotionChange | PlainMessage<PromotionChange> | undefined, b: PromotionChange | PlainMessage<PromotionChange> | undefined): boolean {
+    return proto3.util.equals(PromotionChange, a, b);
+  }
+}
+
+/**
+ * PromoteAccountRequest is the request to copy an account's configuration to another operator
+ *
+ * @generated from message nis.v1.PromoteAccountRequest
+ */
+export class PromoteAccountRequest extends Message<PromoteAccountRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string target_operator_id = 2;
+   */
+  targetOperatorId = "";
+
+  /**
+   * Only compute the changes
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun = false;
+
+  constructor(data?: PartialMessage<PromoteAccountRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.PromoteAccountRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "target_operator_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "dry_run", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteAccountRequest {
+    return new PromoteAccountRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteAccountRequest {
+    return new PromoteAccountRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteAccountRequest {
+    return new PromoteAccountRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteAccountRequest | PlainMessage<PromoteAccountRequest> | undefined, b: PromoteAccountRequest | PlainMessage<PromoteAccountRequest> | undefined): boolean {
+    return proto3.util.equals(PromoteAccountRequest, a, b);
+  }
+}
+
+/**
+ * PromoteAccountResponse is the response from promoting an account
+ *
+ * @generated from message nis.v1.PromoteAccountResponse
+ */
+export class PromoteAccountResponse extends Message<PromoteAccountResponse> {
+  /**
+   * Account of the target operator, unset on a dry run creating it
+   *
+   * @generated from field: nis.v1.Account account = 1;
+   */
+  account?: Account;
+
+  /**
+   * The target account did not exist
+   *
+   * @generated from field: bool created = 2;
+   */
+  created = false;
+
+  /**
+   * @generated from field: repeated nis.v1.PromotionChange changes = 3;
+   */
+  changes: PromotionChange[] = [];
+
+  /**
+   * Clusters whose JetStream capacity the promoted limits over-commit
+   *
+   * @generated from field: repeated string warnings = 4;
+   */
+  warnings: string[] = [];
+
+  constructor(data?: PartialMessage<PromoteAccountResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.PromoteAccountResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account", kind: "message", T: Account },
+    { no: 2, name: "created", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "changes", kind: "message", T: PromotionChange, repeated: true },
+    { no: 4, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PromoteAccountResponse {
+    return new PromoteAccountResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PromoteAccountResponse {
+    return new PromoteAccountResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PromoteAccountResponse {
+    return new PromoteAccountResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PromoteAccountResponse | PlainMessage<PromoteAccountResponse> | undefined, b: PromoteAccountResponse | PlainMessage<PromoteAccountResponse> | undefined): boolean {
+    return proto3.util.equals(PromoteAccountResponse, a, b);
+  }
+}
+
+/**
+ * GetAccountUsageRequest asks for the JetStream usage of an account
+ *
+ * @generated from message nis.v1.GetAccountUsageRequest
+ */
+export class GetAccountUsageRequest extends Message<GetAccountUsageRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Optional - every cluster of the account's operator when empty
+   *
+   * @generated from field: string cluster_id = 2;
+   */
+  clusterId = "";
+
+  constructor(data?: PartialMessage<GetAccountUsageRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GetAccountUsageRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountUsageRequest {
+    return new GetAccountUsageRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountUsageRequest {
+    return new GetAccountUsageRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountUsageRequest {
+    return new GetAccountUsageRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountUsageRequest | PlainMessage<GetAccountUsageRequest> | undefined, b: GetAccountUsageRequest | PlainMessage<GetAccountUsageRequest> | undefined): boolean {
+    return proto3.util.equals(GetAccountUsageRequest, a, b);
+  }
+}
+
+/**
+ * GetAccountUsageResponse reports the JetStream usage of an account per cluster
+ *
+ * @generated from message nis.v1.GetAccountUsageResponse
+ */
+export class GetAccountUsageResponse extends Message<GetAccountUsageResponse> {
+  /**
+   * @generated from field: repeated nis.v1.AccountUsage usage = 1;
+   */
+  usage: AccountUsage[] = [];
+
+  /**
+   * Clusters that could not be queried
+   *
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[] = [];
+
+  constructor(data?: PartialMessage<GetAccountUsageResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GetAccountUsageResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "usage", kind: "message", T: AccountUsage, repeated: true },
+    { no: 2, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountUsageResponse {
+    return new GetAccountUsageResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountUsageResponse {
+    return new GetAccountUsageResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountUsageResponse {
+    return new GetAccountUsageResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountUsageResponse | PlainMessage<GetAccountUsageResponse> | undefined, b: GetAccountUsageResponse | PlainMessage<GetAccountUsageResponse> | undefined): boolean {
+    return proto3.util.equals(GetAccountUsageResponse, a, b);
+  }
+}
+
+/**
+ * AccountUsage is the JetStream usage of an account on one cluster next to its limits.
+ * Limits are -1 when unlimited.
+ *
+ * @generated from message nis.v1.AccountUsage
+ */
+export class AccountUsage extends Message<AccountUsage> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string cluster_name = 2;
+   */
+  clusterName = "";
+
+  /**
+   * @generated from field: string account_id = 3;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string account_name = 4;
+   */
+  accountName = "";
+
+  /**
+   * @generated from field: bool jetstream_enabled = 5;
+   */
+  jetstreamEnabled = false;
+
+  /**
+   * Bytes, summed over the servers so replicas count in full
+   *
+   * @generated from field: int64 memory_bytes = 6;
+   */
+  memoryBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 memory_limit = 7;
+   */
+  memoryLimit = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 storage_bytes = 8;
+   */
+  storageBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 storage_limit = 9;
+   */
+  storageLimit = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 streams = 10;
+   */
+  streams = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 streams_limit = 11;
+   */
+  streamsLimit = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 consumers = 12;
+   */
+  consumers = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 consumers_limit = 13;
+   */
+  consumersLimit = protoInt64.zero;
+
+  /**
+   * Servers that hold JetStream state for the account
+   *
+   * @generated from field: int32 servers = 14;
+   */
+  servers = 0;
+
+  constructor(data?: PartialMessage<AccountUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.AccountUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "account_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "jetstream_enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "memory_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "memory_limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "storage_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "storage_limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "streams", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "streams_limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 12, name: "consumers", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 13, name: "consumers_limit", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 14, name: "servers", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountUsage {
+    return new AccountUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountUsage {
+    return new AccountUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountUsage {
+    return new AccountUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccountUsage | PlainMessage<AccountUsage> | undefined, b: AccountUsage | PlainMessage<AccountUsage> | undefined): boolean {
+    return proto3.util.equals(AccountUsage, a, b);
+  }
+}
+
+/**
+ * GetAccountStatsRequest selects the traffic statistics of an account
+ *
+ * @generated from message nis.v1.GetAccountStatsRequest
+ */
+export class GetAccountStatsRequest extends Message<GetAccountStatsRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Restricts the statistics to one cluster, every cluster when empty
+   *
+   * @generated from field: string cluster_id = 2;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp from = 3;
+   */
+  from?: Timestamp;
+
+  /**
+   * Defaults to now
+   *
+   * @generated from field: google.protobuf.Timestamp to = 4;
+   */
+  to?: Timestamp;
+
+  /**
+   * Bucket length, 60 or 3600. 0 picks per-minute buckets when they cover from, and
+   * hourly ones otherwise.
+   *
+   * @generated from field: int32 resolution_seconds = 5;
+   */
+  resolutionSeconds = 0;
+
+  constructor(data?: PartialMessage<GetAccountStatsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GetAccountStatsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "from", kind: "message", T: Timestamp },
+    { no: 4, name: "to", kind: "message", T: Timestamp },
+    { no: 5, name: "resolution_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountStatsRequest {
+    return new GetAccountStatsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountStatsRequest {
+    return new GetAccountStatsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountStatsRequest {
+    return new GetAccountStatsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountStatsRequest | PlainMessage<GetAccountStatsRequest> | undefined, b: GetAccountStatsRequest | PlainMessage<GetAccountStatsRequest> | undefined): boolean {
+    return proto3.util.equals(GetAccountStatsRequest, a, b);
+  }
+}
+
+/**
+ * AccountStatsPoint is the traffic of an account on a cluster during one bucket, summed
+ * over the cluster's servers
+ *
+ * @generated from message nis.v1.AccountStatsPoint
+ */
+export class AccountStatsPoint extends Message<AccountStatsPoint> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp bucket_start = 2;
+   */
+  bucketStart?: Timestamp;
+
+  /**
+   * Collections that contributed to the bucket
+   *
+   * @generated from field: int64 samples = 3;
+   */
+  samples = protoInt64.zero;
+
+  /**
+   * @generated from field: double avg_connections = 4;
+   */
+  avgConnections = 0;
+
+  /**
+   * @generated from field: int64 max_connections = 5;
+   */
+  maxConnections = protoInt64.zero;
+
+  /**
+   * Messages and bytes published by the account's clients
+   *
+   * @generated from field: int64 msgs_in = 6;
+   */
+  msgsIn = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 bytes_in = 7;
+   */
+  bytesIn = protoInt64.zero;
+
+  /**
+   * Messages and bytes delivered to the account's clients
+   *
+   * @generated from field: int64 msgs_out = 8;
+   */
+  msgsOut = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 bytes_out = 9;
+   */
+  bytesOut = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 slow_consumers = 10;
+   */
+  slowConsumers = protoInt64.zero;
+
+  constructor(data?: PartialMessage<AccountStatsPoint>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.AccountStatsPoint";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "bucket_start", kind: "message", T: Timestamp },
+    { no: 3, name: "samples", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "avg_connections", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 5, name: "max_connections", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "msgs_in", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "bytes_in", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "msgs_out", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "bytes_out", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "slow_consumers", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountStatsPoint {
+    return new AccountStatsPoint().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountStatsPoint {
+    return new AccountStatsPoint().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountStatsPoint {
+    return new AccountStatsPoint().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccountStatsPoint | PlainMessage<AccountStatsPoint> | undefined, b: AccountStatsPoint | PlainMessage<AccountStatsPoint> | undefined): boolean {
+    return proto3.util.equals(AccountStatsPoint, a, b);
+  }
+}
+
+/**
+ * GetAccountStatsResponse lists the buckets oldest first. Buckets without connections
+ * or traffic are omitted.
+ *
+ * @generated from message nis.v1.GetAccountStatsResponse
+ */
+export class GetAccountStatsResponse extends Message<GetAccountStatsResponse> {
+  /**
+   * @generated from field: int32 resolution_seconds = 1;
+   */
+  resolutionSeconds = 0;
+
+  /**
+   * @generated from field: repeated nis.v1.AccountStatsPoint points = 2;
+   */
+  points: AccountStatsPoint[] = [];
+
+  constructor(data?: PartialMessage<GetAccountStatsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GetAccountStatsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "resolution_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "points", kind: "message", T: AccountStatsPoint, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountStatsResponse {
+    return new GetAccountStatsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountStatsResponse {
+    return new GetAccountStatsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountStatsResponse {
+    return new GetAccountStatsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountStatsResponse | PlainMessage<GetAccountStatsResponse> | undefined, b: GetAccountStatsResponse | PlainMessage<GetAccountStatsResponse> | undefined): boolean {
+    return proto3.util.equals(GetAccountStatsResponse, a, b);
+  }
+}
+
+/**
+ * PlacementCluster is a cluster an account is pushed to
+ *
+ * @generated from message nis.v1.PlacementCluster
+ */
+export class PlacementCluster extends Message<PlacementCluster> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * False for the members of an assigned cluster's supercluster, reached over the gateways
+   *
+   * @generated from field: bool assigned = 3;
+   */
+  assigned = false;
+
+  constructor(data?: PartialMessage<PlacementCluster>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.PlacementCluster";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "assigned", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlacementCluster {
+    return new PlacementCluster().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlacementCluster {
+    return new PlacementCluster().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlacementCluster {
+    return new PlacementCluster().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlacementCluster | PlainMessage<PlacementCluster> | undefined, b: PlacementCluster | PlainMessage<PlacementCluster> | undefined): boolean {
+    return proto3.util.equals(PlacementCluster, a, b);
+  }
+}
+
+/**
+ * PlacementRemoval reports the delete claim sent to a cluster the account left
+ *
+ * @generated from message nis.v1.PlacementRemoval
+ */
+export class PlacementRemoval extends Message<PlacementRemoval> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string cluster_name = 2;
+   */
+  clusterName = "";
+
+  /**
+   * Set when the cluster could not be reached, the next pruning sync retries
+   *
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<PlacementRemoval>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.PlacementRemoval";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlacementRemoval {
+    return new PlacementRemoval().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlacementRemoval {
+    return new PlacementRemoval().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlacementRemoval {
+    return new PlacementRemoval().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlacementRemoval | PlainMessage<PlacementRemoval> | undefined, b: PlacementRemoval | PlainMessage<PlacementRemoval> | undefined): boolean {
+    return proto3.util.equals(PlacementRemoval, a, b);
+  }
+}
+
+/**
+ * AccountPlacement lists the clusters an account is pushed to
+ *
+ * @generated from message nis.v1.AccountPlacement
+ */
+export class AccountPlacement extends Message<AccountPlacement> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * True when the account has no placement and goes to every cluster of its operator
+   *
+   * @generated from field: bool everywhere = 2;
+   */
+  everywhere = false;
+
+  /**
+   * @generated from field: repeated nis.v1.PlacementCluster clusters = 3;
+   */
+  clusters: PlacementCluster[] = [];
+
+  constructor(data?: PartialMessage<AccountPlacement>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.AccountPlacement";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "everywhere", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "clusters", kind: "message", T: PlacementCluster, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountPlacement {
+    return new AccountPlacement().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountPlacement {
+    return new AccountPlacement().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountPlacement {
+    return new AccountPlacement().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccountPlacement | PlainMessage<AccountPlacement> | undefined, b: AccountPlacement | PlainMessage<AccountPlacement> | undefined): boolean {
+    return proto3.util.equals(AccountPlacement, a, b);
+  }
+}
+
+/**
+ * GetAccountPlacementRequest selects the account
+ *
+ * @generated from message nis.v1.GetAccountPlacementRequest
+ */
+export class GetAccountPlacementRequest extends Message<GetAccountPlacementRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<GetAccountPlacementRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GetAccountPlacementRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountPlacementRequest {
+    return new GetAccountPlacementRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountPlacementRequest {
+    return new GetAccountPlacementRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountPlacementRequest {
+    return new GetAccountPlacementRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountPlacementRequest | PlainMessage<GetAccountPlacementRequest> | undefined, b: GetAccountPlacementRequest | PlainMessage<GetAccountPlacementRequest> | undefined): boolean {
+    return proto3.util.equals(GetAccountPlacementRequest, a, b);
+  }
+}
+
+/**
+ * GetAccountPlacementResponse returns the placement of the account
+ *
+ * @generated from message nis.v1.GetAccountPlacementResponse
+ */
+export class GetAccountPlacementResponse extends Message<GetAccountPlacementResponse> {
+  /**
+   * @generated from field: nis.v1.AccountPlacement placement = 1;
+   */
+  placement?: AccountPlacement;
+
+  constructor(data?: PartialMessage<GetAccountPlacementResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GetAccountPlacementResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "placement", kind: "message", T: AccountPlacement },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAccountPlacementResponse {
+    return new GetAccountPlacementResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAccountPlacementResponse {
+    return new GetAccountPlacementResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAccountPlacementResponse {
+    return new GetAccountPlacementResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAccountPlacementResponse | PlainMessage<GetAccountPlacementResponse> | undefined, b: GetAccountPlacementResponse | PlainMessage<GetAccountPlacementResponse> | undefined): boolean {
+    return proto3.util.equals(GetAccountPlacementResponse, a, b);
+  }
+}
+
+/**
+ * SetAccountPlacementRequest places an account on clusters of its operator
+ *
+ * @generated from message nis.v1.SetAccountPlacementRequest
+ */
+export class SetAccountPlacementRequest extends Message<SetAccountPlacementRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Clusters to place the account on, empty for every cluster
+   *
+   * @generated from field: repeated string cluster_ids = 2;
+   */
+  clusterIds: string[] = [];
+
+  constructor(data?: PartialMessage<SetAccountPlacementRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.SetAccountPlacementRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetAccountPlacementRequest {
+    return new SetAccountPlacementRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetAccountPlacementRequest {
+    return new SetAccountPlacementRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetAccountPlacementRequest {
+    return new SetAccountPlacementRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetAccountPlacementRequest | PlainMessage<SetAccountPlacementRequest> | undefined, b: SetAccountPlacementRequest | PlainMessage<SetAccountPlacementRequest> | undefined): boolean {
+    return proto3.util.equals(SetAccountPlacementRequest, a, b);
+  }
+}
+
+/**
+ * SetAccountPlacementResponse returns the new placement and the clusters the account left
+ *
+ * @generated from message nis.v1.SetAccountPlacementResponse
+ */
+export class SetAccountPlacementResponse extends Message<SetAccountPlacementResponse> {
+  /**
+   * @generated from field: nis.v1.AccountPlacement placement = 1;
+   */
+  placement?: AccountPlacement;
+
+  /**
+   * @generated from field: repeated nis.v1.PlacementRemoval removed = 2;
+   */
+  removed: PlacementRemoval[] = [];
+
+  constructor(data?: PartialMessage<SetAccountPlacementResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.SetAccountPlacementResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "placement", kind: "message", T: AccountPlacement },
+    { no: 2, name: "removed", kind: "message", T: PlacementRemoval, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetAccountPlacementResponse {
+    return new SetAccountPlacementResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetAccountPlacementResponse {
+    return new SetAccountPlacementResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetAccountPlacementResponse {
+    return new SetAccountPlacementResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetAccountPlacementResponse | PlainMessage<SetAccountPlacementResponse> | undefined, b: SetAccountPlacementResponse | PlainMessage<SetAccountPlacementResponse> | undefined): boolean {
+    return proto3.util.equals(SetAccountPlacementResponse, a, b);
+  }
+}
+
+/**
+ * MoveAccountRequest is the request to move an account to another operator
+ *
+ * @generated from message nis.v1.MoveAccountRequest
+ */
+export class MoveAccountRequest extends Message<MoveAccountRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string target_operator_id = 2;
+   */
+  targetOperatorId = "";
+
+  constructor(data?: PartialMessage<MoveAccountRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.MoveAccountRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "target_operator_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MoveAccountRequest {
+    return new MoveAccountRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MoveAccountRequest {
+    return new MoveAccountRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MoveAccountRequest {
+    return new MoveAccountRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MoveAccountRequest | PlainMessage<MoveAccountRequest> | undefined, b: MoveAccountRequest | PlainMessage<MoveAccountRequest> | undefined): boolean {
+    return proto3.util.equals(MoveAccountRequest, a, b);
+  }
+}
+
+/**
+ * MoveAccountResponse is the response from moving an account
+ *
+ * @generated from message nis.v1.MoveAccountResponse
+ */
+export class MoveAccountResponse extends Message<MoveAccountResponse> {
+  /**
+   * @generated from field: nis.v1.Account account = 1;
+   */
+  account?: Account;
+
+  /**
+   * Clusters of the old operator the account was deleted from
+   *
+   * @generated from field: repeated nis.v1.PlacementRemoval removed = 2;
+   */
+  removed: PlacementRemoval[] = [];
+
+  /**
+   * Clusters of the new operator the account was pushed to
+   *
+   * @generated from field: repeated nis.v1.AccountPush pushed = 3;
+   */
+  pushed: AccountPush[] = [];
+
+  /**
+   * Clusters whose JetStream capacity the account's limits over-commit
+   *
+   * @generated from field: repeated string warnings = 4;
+   */
+  warnings: string[] = [];
+
+  constructor(data?: PartialMessage<MoveAccountResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.MoveAccountResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account", kind: "message", T: Account },
+    { no: 2, name: "removed", kind: "message", T: PlacementRemoval, repeated: true },
+    { no: 3, name: "pushed", kind: "message", T: AccountPush, repeated: true },
+    { no: 4, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MoveAccountResponse {
+    return new MoveAccountResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MoveAccountResponse {
+    return new MoveAccountResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MoveAccountResponse {
+    return new MoveAccountResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MoveAccountResponse | PlainMessage<MoveAccountResponse> | undefined, b: MoveAccountResponse | PlainMessage<MoveAccountResponse> | undefined): boolean {
+    return proto3.util.equals(MoveAccountResponse, a, b);
+  }
+}
+
+/**
+ * SuspendAccountRequest is the request to suspend an account
+ *
+ * @generated from message nis.v1.SuspendAccountRequest
+ */
+export class SuspendAccountRequest extends Message<SuspendAccountRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  /**
+   * Revoke every user JWT issued so far, the users need new credentials
+   *
+   * @generated from field: bool revoke_users = 3;
+   */
+  revokeUsers = false;
+
+  constructor(data?: PartialMessage<SuspendAccountRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.SuspendAccountRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "revoke_users", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SuspendAccountRequest {
+    return new SuspendAccountRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SuspendAccountRequest {
+    return new SuspendAccountRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SuspendAccountRequest {
+    return new SuspendAccountRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SuspendAccountRequest | PlainMessage<SuspendAccountRequest> | undefined, b: SuspendAccountRequest | PlainMessage<SuspendAccountRequest> | undefined): boolean {
+    return proto3.util.equals(SuspendAccountRequest, a, b);
+  }
+}
+
+/**
+ * SuspendAccountResponse is the response from suspending an account
+ *
+ * @generated from message nis.v1.SuspendAccountResponse
+ */
+export class SuspendAccountResponse extends Message<SuspendAccountResponse> {
+  /**
+   * @generated from field: nis.v1.Account account = 1;
+   */
+  account?: Account;
+
+  /**
+   * Clusters the re-signed account JWT was pushed to
+   *
+   * @generated from field: repeated nis.v1.AccountPush pushed = 2;
+   */
+  pushed: AccountPush[] = [];
+
+  constructor(data?: PartialMessage<SuspendAccountResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.SuspendAccountResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account", kind: "message", T: Account },
+    { no: 2, name: "pushed", kind: "message", T: AccountPush, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SuspendAccountResponse {
+    return new SuspendAccountResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SuspendAccountResponse {
+    return new SuspendAccountResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SuspendAccountResponse {
+    return new SuspendAccountResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SuspendAccountResponse | PlainMessage<SuspendAccountResponse> | undefined, b: SuspendAccountResponse | PlainMessage<SuspendAccountResponse> | undefined): boolean {
+    return proto3.util.equals(SuspendAccountResponse, a, b);
+  }
+}
+
+/**
+ * ResumeAccountRequest is the request to resume a suspended account
+ *
+ * @generated from message nis.v1.ResumeAccountRequest
+ */
+export class ResumeAccountRequest extends Message<ResumeAccountRequest> {
+  /**
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<ResumeAccountRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ResumeAccountRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeAccountRequest {
+    return new ResumeAccountRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeAccountRequest {
+    return new ResumeAccountRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeAccountRequest {
+    return new ResumeAccountRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeAccountRequest | PlainMessage<ResumeAccountRequest> | undefined, b: ResumeAccountRequest | PlainMessage<ResumeAccountRequest> | undefined): boolean {
+    return proto3.util.equals(ResumeAccountRequest, a, b);
+  }
+}
+
+/**
+ * ResumeAccountResponse is the response from resuming an account
+ *
+ * @generated from message nis.v1.ResumeAccountResponse
+ */
+export class ResumeAccountResponse extends Message<ResumeAccountResponse> {
+  /**
+   * @generated from field: nis.v1.Account account = 1;
+   */
+  account?: Account;
+
+  /**
+   * Clusters the re-signed account JWT was pushed to
+   *
+   * @generated from field: repeated nis.v1.AccountPush pushed = 2;
+   */
+  pushed: AccountPush[] = [];
+
+  constructor(data?: PartialMessage<ResumeAccountResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ResumeAccountResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account", kind: "message", T: Account },
+    { no: 2, name: "pushed", kind: "message", T: AccountPush, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeAccountResponse {
+    return new ResumeAccountResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeAccountResponse {
+    return new ResumeAccountResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeAccountResponse {
+    return new ResumeAccountResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResumeAccountResponse | PlainMessage<ResumeAccountResponse> | undefined, b: ResumeAccountResponse | PlainMessage<ResumeAccountResponse> | undefined): boolean {
+    return proto3.util.equals(ResumeAccountResponse, a, b);
+  }
+}
+
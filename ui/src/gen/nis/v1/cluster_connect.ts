@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateClusterRequest, CreateClusterResponse, DeleteClusterRequest, DeleteClusterResponse, DeleteResolverAccountRequest, DeleteResolverAccountResponse, GenerateServerConfigRequest, GenerateServerConfigResponse, GetClusterByNameRequest, GetClusterByNameResponse, GetClusterCredentialsRequest, GetClusterCredentialsResponse, GetClusterRequest, GetClusterResponse, ListClustersRequest, ListClustersResponse, ListResolverAccountsRequest, ListResolverAccountsResponse, SyncClusterRequest, SyncClusterResponse, UpdateClusterCredentialsRequest, UpdateClusterCredentialsResponse, UpdateClusterRequest, UpdateClusterResponse } from "./cluster_pb.js";
+import { CreateClusterRequest, CreateClusterResponse, CreateLeafnodeProfileRequest, CreateLeafnodeProfileResponse, DeleteClusterRequest, DeleteClusterResponse, DeleteLeafnodeProfileRequest, DeleteLeafnodeProfileResponse, DeleteResolverAccountRequest, DeleteResolverAccountResponse, DisconnectUserRequest, DisconnectUserResponse, GenerateLeafnodeConfigRequest, GenerateLeafnodeConfigResponse, GenerateServerConfigRequest, GenerateServerConfigResponse, GetClusterByNameRequest, GetClusterByNameResponse, GetClusterCapacityRequest, GetClusterCapacityResponse, GetClusterCredentialsRequest, GetClusterCredentialsResponse, GetClusterRequest, GetClusterResponse, GetClusterTopologyRequest, GetClusterTopologyResponse, ListAuthFailuresRequest, ListAuthFailuresResponse, ListClusterHealthChecksRequest, ListClusterHealthChecksResponse, ListClustersRequest, ListClustersResponse, ListConnectionsRequest, ListConnectionsResponse, ListLeafnodeProfilesRequest, ListLeafnodeProfilesResponse, ListResolverAccountsRequest, ListResolverAccountsResponse, SetClusterGatewayRequest, SetClusterGatewayResponse, SyncClusterRequest, SyncClusterResponse, UpdateClusterCredentialsRequest, UpdateClusterCredentialsResponse, UpdateClusterRequest, UpdateClusterResponse, VerifyAccountRequest, VerifyAccountResponse } from "./cluster_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -129,6 +129,138 @@ export const ClusterService = {
       O: DeleteResolverAccountResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * VerifyAccount reports which servers of the cluster hold the account's current JWT
+     *
+     * @generated from rpc nis.v1.ClusterService.VerifyAccount
+     */
+    verifyAccount: {
+      name: "VerifyAccount",
+      I: VerifyAccountRequest,
+      O: VerifyAccountResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetClusterTopology lists the servers recorded for the cluster by the health check
+     *
+     * @generated from rpc nis.v1.ClusterService.GetClusterTopology
+     */
+    getClusterTopology: {
+      name: "GetClusterTopology",
+      I: GetClusterTopologyRequest,
+      O: GetClusterTopologyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListClusterHealthChecks returns the recorded health checks of the cluster
+     *
+     * @generated from rpc nis.v1.ClusterService.ListClusterHealthChecks
+     */
+    listClusterHealthChecks: {
+      name: "ListClusterHealthChecks",
+      I: ListClusterHealthChecksRequest,
+      O: ListClusterHealthChecksResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListConnections lists the live client connections of an account or user via CONNZ
+     *
+     * @generated from rpc nis.v1.ClusterService.ListConnections
+     */
+    listConnections: {
+      name: "ListConnections",
+      I: ListConnectionsRequest,
+      O: ListConnectionsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DisconnectUser kicks every live connection of a user via $SYS.REQ.SERVER.<id>.KICK
+     *
+     * @generated from rpc nis.v1.ClusterService.DisconnectUser
+     */
+    disconnectUser: {
+      name: "DisconnectUser",
+      I: DisconnectUserRequest,
+      O: DisconnectUserResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetClusterCapacity reports JetStream capacity against the limits assigned to accounts
+     *
+     * @generated from rpc nis.v1.ClusterService.GetClusterCapacity
+     */
+    getClusterCapacity: {
+      name: "GetClusterCapacity",
+      I: GetClusterCapacityRequest,
+      O: GetClusterCapacityResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListAuthFailures lists the NATS authentication failures reported by the clusters
+     *
+     * @generated from rpc nis.v1.ClusterService.ListAuthFailures
+     */
+    listAuthFailures: {
+      name: "ListAuthFailures",
+      I: ListAuthFailuresRequest,
+      O: ListAuthFailuresResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateLeafnodeProfile binds a user to a hub cluster for the leafnode remotes of edge servers
+     *
+     * @generated from rpc nis.v1.ClusterService.CreateLeafnodeProfile
+     */
+    createLeafnodeProfile: {
+      name: "CreateLeafnodeProfile",
+      I: CreateLeafnodeProfileRequest,
+      O: CreateLeafnodeProfileResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListLeafnodeProfiles lists the leafnode profiles of a hub cluster
+     *
+     * @generated from rpc nis.v1.ClusterService.ListLeafnodeProfiles
+     */
+    listLeafnodeProfiles: {
+      name: "ListLeafnodeProfiles",
+      I: ListLeafnodeProfilesRequest,
+      O: ListLeafnodeProfilesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteLeafnodeProfile deletes a leafnode profile, the user is kept
+     *
+     * @generated from rpc nis.v1.ClusterService.DeleteLeafnodeProfile
+     */
+    deleteLeafnodeProfile: {
+      name: "DeleteLeafnodeProfile",
+      I: DeleteLeafnodeProfileRequest,
+      O: DeleteLeafnodeProfileResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GenerateLeafnodeConfig generates the leafnode remote block and the matching credentials
+     *
+     * @generated from rpc nis.v1.ClusterService.GenerateLeafnodeConfig
+     */
+    generateLeafnodeConfig: {
+      name: "GenerateLeafnodeConfig",
+      I: GenerateLeafnodeConfigRequest,
+      O: GenerateLeafnodeConfigResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * SetClusterGateway makes a cluster join or leave a supercluster of the operator's clusters
+     *
+     * @generated from rpc nis.v1.ClusterService.SetClusterGateway
+     */
+    setClusterGateway: {
+      name: "SetClusterGateway",
+      I: SetClusterGatewayRequest,
+      O: SetClusterGatewayResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
 import { ListOptions } from "./common_pb.js";
 
 /**
@@ -73,6 +73,60 @@ export class Cluster extends Message<Cluster> {
    */
   skipVerifyTls = false;
 
+  /**
+   * Seconds between health checks, 0 for the server default
+   *
+   * @generated from field: int64 health_check_interval_seconds = 13;
+   */
+  healthCheckIntervalSeconds = protoInt64.zero;
+
+  /**
+   * Seconds a single health check may take, 0 for the server default
+   *
+   * @generated from field: int64 health_check_timeout_seconds = 14;
+   */
+  healthCheckTimeoutSeconds = protoInt64.zero;
+
+  /**
+   * Share of healthy checks over the last 24 hours, only set by GetCluster
+   *
+   * @generated from field: double uptime_percent = 15;
+   */
+  uptimePercent = 0;
+
+  /**
+   * Number of checks the uptime percentage is based on
+   *
+   * @generated from field: int64 health_check_count = 16;
+   */
+  healthCheckCount = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp next_health_check = 17;
+   */
+  nextHealthCheck?: Timestamp;
+
+  /**
+   * Configuration profile of the cluster's servers, unset for the defaults
+   *
+   * @generated from field: nis.v1.ServerProfile server_profile = 18;
+   */
+  serverProfile?: ServerProfile;
+
+  /**
+   * Supercluster the cluster joins through its gateways, empty for a standalone cluster
+   *
+   * @generated from field: string supercluster = 19;
+   */
+  supercluster = "";
+
+  /**
+   * URLs the other clusters' gateways dial, derived from the server URLs when empty
+   *
+   * @generated from field: repeated string gateway_urls = 20;
+   */
+  gatewayUrls: string[] = [];
+
   constructor(data?: PartialMessage<Cluster>) {
     super();
     proto3.util.initPartial(data, this);
@@ -93,6 +147,14 @@ export class Cluster extends Message<Cluster> {
     { no: 10, name: "last_health_check", kind: "message", T: Timestamp },
     { no: 11, name: "health_check_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 12, name: "skip_verify_tls", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 13, name: "health_check_interval_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 14, name: "health_check_timeout_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 15, name: "uptime_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 16, name: "health_check_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 17, name: "next_health_check", kind: "message", T: Timestamp },
+    { no: 18, name: "server_profile", kind: "message", T: ServerProfile },
+    { no: 19, name: "supercluster", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 20, name: "gateway_urls", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Cluster {
@@ -112,6 +174,341 @@ export class Cluster extends Message<Cluster> {
   }
 }
 
+/**
+ * ServerProfile describes how the NATS servers of a cluster are configured. Zero
+ * ports disable the corresponding listener.
+ *
+ * @generated from message nis.v1.ServerProfile
+ */
+export class ServerProfile extends Message<ServerProfile> {
+  /**
+   * Client listen host, empty for all interfaces
+   *
+   * @generated from field: string host = 1;
+   */
+  host = "";
+
+  /**
+   * @generated from field: int32 port = 2;
+   */
+  port = 0;
+
+  /**
+   * @generated from field: int32 http_port = 3;
+   */
+  httpPort = 0;
+
+  /**
+   * Route port, 0 for a single server
+   *
+   * @generated from field: int32 cluster_port = 4;
+   */
+  clusterPort = 0;
+
+  /**
+   * @generated from field: repeated string routes = 5;
+   */
+  routes: string[] = [];
+
+  /**
+   * @generated from field: nis.v1.ServerTLS tls = 6;
+   */
+  tls?: ServerTLS;
+
+  /**
+   * @generated from field: nis.v1.ServerJetStream jetstream = 7;
+   */
+  jetstream?: ServerJetStream;
+
+  /**
+   * @generated from field: nis.v1.ServerResolver resolver = 8;
+   */
+  resolver?: ServerResolver;
+
+  /**
+   * @generated from field: nis.v1.ServerWebsocket websocket = 9;
+   */
+  websocket?: ServerWebsocket;
+
+  /**
+   * MQTT requires JetStream and a server name
+   *
+   * @generated from field: int32 mqtt_port = 10;
+   */
+  mqttPort = 0;
+
+  /**
+   * @generated from field: int32 leafnode_port = 11;
+   */
+  leafnodePort = 0;
+
+  /**
+   * @generated from field: int32 gateway_port = 12;
+   */
+  gatewayPort = 0;
+
+  constructor(data?: PartialMessage<ServerProfile>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ServerProfile";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "http_port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "cluster_port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "routes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "tls", kind: "message", T: ServerTLS },
+    { no: 7, name: "jetstream", kind: "message", T: ServerJetStream },
+    { no: 8, name: "resolver", kind: "message", T: ServerResolver },
+    { no: 9, name: "websocket", kind: "message", T: ServerWebsocket },
+    { no: 10, name: "mqtt_port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "leafnode_port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "gateway_port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServerProfile {
+    return new ServerProfile().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServerProfile {
+    return new ServerProfile().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServerProfile {
+    return new ServerProfile().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServerProfile | PlainMessage<ServerProfile> | undefined, b: ServerProfile | PlainMessage<ServerProfile> | undefined): boolean {
+    return proto3.util.equals(ServerProfile, a, b);
+  }
+}
+
+/**
+ * ServerTLS holds the certificate paths on the NATS servers, the other listeners
+ * reuse the client certificate
+ *
+ * @generated from message nis.v1.ServerTLS
+ */
+export class ServerTLS extends Message<ServerTLS> {
+  /**
+   * @generated from field: string cert_file = 1;
+   */
+  certFile = "";
+
+  /**
+   * @generated from field: string key_file = 2;
+   */
+  keyFile = "";
+
+  /**
+   * @generated from field: string ca_file = 3;
+   */
+  caFile = "";
+
+  /**
+   * Require client certificates
+   *
+   * @generated from field: bool verify = 4;
+   */
+  verify = false;
+
+  constructor(data?: PartialMessage<ServerTLS>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ServerTLS";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cert_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "key_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "ca_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "verify", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServerTLS {
+    return new ServerTLS().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServerTLS {
+    return new ServerTLS().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServerTLS {
+    return new ServerTLS().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServerTLS | PlainMessage<ServerTLS> | undefined, b: ServerTLS | PlainMessage<ServerTLS> | undefined): boolean {
+    return proto3.util.equals(ServerTLS, a, b);
+  }
+}
+
+/**
+ * ServerJetStream configures JetStream, zero limits let the server size them
+ *
+ * @generated from message nis.v1.ServerJetStream
+ */
+export class ServerJetStream extends Message<ServerJetStream> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  /**
+   * @generated from field: string store_dir = 2;
+   */
+  storeDir = "";
+
+  /**
+   * @generated from field: int64 max_memory = 3;
+   */
+  maxMemory = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 max_storage = 4;
+   */
+  maxStorage = protoInt64.zero;
+
+  /**
+   * @generated from field: string domain = 5;
+   */
+  domain = "";
+
+  constructor(data?: PartialMessage<ServerJetStream>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ServerJetStream";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "store_dir", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "max_memory", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "max_storage", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServerJetStream {
+    return new ServerJetStream().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServerJetStream {
+    return new ServerJetStream().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServerJetStream {
+    return new ServerJetStream().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServerJetStream | PlainMessage<ServerJetStream> | undefined, b: ServerJetStream | PlainMessage<ServerJetStream> | undefined): boolean {
+    return proto3.util.equals(ServerJetStream, a, b);
+  }
+}
+
+/**
+ * ServerResolver configures the full account resolver
+ *
+ * @generated from message nis.v1.ServerResolver
+ */
+export class ServerResolver extends Message<ServerResolver> {
+  /**
+   * @generated from field: string dir = 1;
+   */
+  dir = "";
+
+  /**
+   * Seconds between resolver syncs, 0 for the server default
+   *
+   * @generated from field: int64 interval_seconds = 2;
+   */
+  intervalSeconds = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ServerResolver>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ServerResolver";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dir", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "interval_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServerResolver {
+    return new ServerResolver().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServerResolver {
+    return new ServerResolver().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServerResolver {
+    return new ServerResolver().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServerResolver | PlainMessage<ServerResolver> | undefined, b: ServerResolver | PlainMessage<ServerResolver> | undefined): boolean {
+    return proto3.util.equals(ServerResolver, a, b);
+  }
+}
+
+/**
+ * ServerWebsocket configures the websocket listener
+ *
+ * @generated from message nis.v1.ServerWebsocket
+ */
+export class ServerWebsocket extends Message<ServerWebsocket> {
+  /**
+   * @generated from field: int32 port = 1;
+   */
+  port = 0;
+
+  /**
+   * Serve plain websocket even when TLS is configured
+   *
+   * @generated from field: bool no_tls = 2;
+   */
+  noTls = false;
+
+  /**
+   * @generated from field: bool compression = 3;
+   */
+  compression = false;
+
+  constructor(data?: PartialMessage<ServerWebsocket>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ServerWebsocket";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "no_tls", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "compression", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServerWebsocket {
+    return new ServerWebsocket().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServerWebsocket {
+    return new ServerWebsocket().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServerWebsocket {
+    return new ServerWebsocket().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServerWebsocket | PlainMessage<ServerWebsocket> | undefined, b: ServerWebsocket | PlainMessage<ServerWebsocket> | undefined): boolean {
+    return proto3.util.equals(ServerWebsocket, a, b);
+  }
+}
+
 /**
  * CreateClusterRequest is the request to create a new cluster
  *
@@ -153,6 +550,16 @@ export class CreateClusterRequest extends Message<CreateClusterRequest> {
    */
   skipVerifyTls = false;
 
+  /**
+   * @generated from field: int64 health_check_interval_seconds = 8;
+   */
+  healthCheckIntervalSeconds = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 health_check_timeout_seconds = 9;
+   */
+  healthCheckTimeoutSeconds = protoInt64.zero;
+
   constructor(data?: PartialMessage<CreateClusterRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -168,6 +575,8 @@ export class CreateClusterRequest extends Message<CreateClusterRequest> {
     { no: 5, name: "system_account_pub_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "system_account_creds", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "skip_verify_tls", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "health_check_interval_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "health_check_timeout_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateClusterRequest {
@@ -503,6 +912,16 @@ export class UpdateClusterRequest extends Message<UpdateClusterRequest> {
    */
   skipVerifyTls?: boolean;
 
+  /**
+   * @generated from field: optional int64 health_check_interval_seconds = 6;
+   */
+  healthCheckIntervalSeconds?: bigint;
+
+  /**
+   * @generated from field: optional int64 health_check_timeout_seconds = 7;
+   */
+  healthCheckTimeoutSeconds?: bigint;
+
   constructor(data?: PartialMessage<UpdateClusterRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -516,6 +935,8 @@ export class UpdateClusterRequest extends Message<UpdateClusterRequest> {
     { no: 3, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */, opt: true },
     { no: 4, name: "server_urls", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 5, name: "skip_verify_tls", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 6, name: "health_check_interval_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
+    { no: 7, name: "health_check_timeout_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */, opt: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateClusterRequest {
@@ -820,6 +1241,8 @@ export class GenerateServerConfigRequest extends Message<GenerateServerConfigReq
   id = "";
 
   /**
+   * Override the profile's client and monitoring ports when set
+   *
    * @generated from field: int32 port = 2;
    */
   port = 0;
@@ -829,6 +1252,27 @@ export class GenerateServerConfigRequest extends Message<GenerateServerConfigReq
    */
   httpPort = 0;
 
+  /**
+   * Name of the server the configuration is for
+   *
+   * @generated from field: string server_name = 4;
+   */
+  serverName = "";
+
+  /**
+   * Replaces the cluster's stored profile when set
+   *
+   * @generated from field: nis.v1.ServerProfile profile = 5;
+   */
+  profile?: ServerProfile;
+
+  /**
+   * Store the profile with the cluster once the configuration is valid
+   *
+   * @generated from field: bool save_profile = 6;
+   */
+  saveProfile = false;
+
   constructor(data?: PartialMessage<GenerateServerConfigRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -840,6 +1284,9 @@ export class GenerateServerConfigRequest extends Message<GenerateServerConfigReq
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 3, name: "http_port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "server_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "profile", kind: "message", T: ServerProfile },
+    { no: 6, name: "save_profile", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateServerConfigRequest {
@@ -996,6 +1443,20 @@ export class SyncClusterResponse extends Message<SyncClusterResponse> {
    */
   errors: SyncError[] = [];
 
+  /**
+   * How each NATS server answered the account JWT pushes
+   *
+   * @generated from field: repeated nis.v1.ServerSyncStatus servers = 8;
+   */
+  servers: ServerSyncStatus[] = [];
+
+  /**
+   * Whether the pushed accounts reached the other clusters of the supercluster
+   *
+   * @generated from field: repeated nis.v1.SuperclusterPeerSync peers = 9;
+   */
+  peers: SuperclusterPeerSync[] = [];
+
   constructor(data?: PartialMessage<SyncClusterResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -1011,6 +1472,8 @@ export class SyncClusterResponse extends Message<SyncClusterResponse> {
     { no: 5, name: "accounts_updated", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 6, name: "removed_accounts", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
     { no: 7, name: "errors", kind: "message", T: SyncError, repeated: true },
+    { no: 8, name: "servers", kind: "message", T: ServerSyncStatus, repeated: true },
+    { no: 9, name: "peers", kind: "message", T: SuperclusterPeerSync, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SyncClusterResponse {
@@ -1031,7 +1494,157 @@ export class SyncClusterResponse extends Message<SyncClusterResponse> {
 }
 
 /**
- * SyncError represents an error encountered during sync
+ * ServerSyncStatus counts how one NATS server answered the pushes of a sync
+ *
+ * @generated from message nis.v1.ServerSyncStatus
+ */
+export class ServerSyncStatus extends Message<ServerSyncStatus> {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId = "";
+
+  /**
+   * @generated from field: string server_name = 2;
+   */
+  serverName = "";
+
+  /**
+   * @generated from field: int32 acknowledged = 3;
+   */
+  acknowledged = 0;
+
+  /**
+   * @generated from field: int32 rejected = 4;
+   */
+  rejected = 0;
+
+  /**
+   * Pushes the server did not acknowledge within the reply window
+   *
+   * @generated from field: int32 missing = 5;
+   */
+  missing = 0;
+
+  /**
+   * Cluster of the server, another member's in a supercluster
+   *
+   * @generated from field: string cluster = 6;
+   */
+  cluster = "";
+
+  constructor(data?: PartialMessage<ServerSyncStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ServerSyncStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "server_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "server_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "acknowledged", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "rejected", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "missing", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "cluster", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServerSyncStatus {
+    return new ServerSyncStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServerSyncStatus {
+    return new ServerSyncStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServerSyncStatus {
+    return new ServerSyncStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServerSyncStatus | PlainMessage<ServerSyncStatus> | undefined, b: ServerSyncStatus | PlainMessage<ServerSyncStatus> | undefined): boolean {
+    return proto3.util.equals(ServerSyncStatus, a, b);
+  }
+}
+
+/**
+ * SuperclusterPeerSync checks the pushed accounts on another cluster of the supercluster
+ *
+ * @generated from message nis.v1.SuperclusterPeerSync
+ */
+export class SuperclusterPeerSync extends Message<SuperclusterPeerSync> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string cluster_name = 2;
+   */
+  clusterName = "";
+
+  /**
+   * Servers of the cluster that answered the account listing
+   *
+   * @generated from field: int32 servers = 3;
+   */
+  servers = 0;
+
+  /**
+   * Pushed accounts held by all of them
+   *
+   * @generated from field: int32 verified = 4;
+   */
+  verified = 0;
+
+  /**
+   * Names of the pushed accounts missing on at least one of them
+   *
+   * @generated from field: repeated string missing = 5;
+   */
+  missing: string[] = [];
+
+  /**
+   * Set when the cluster could not be checked
+   *
+   * @generated from field: string error = 6;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<SuperclusterPeerSync>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.SuperclusterPeerSync";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "servers", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "verified", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "missing", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SuperclusterPeerSync {
+    return new SuperclusterPeerSync().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SuperclusterPeerSync {
+    return new SuperclusterPeerSync().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SuperclusterPeerSync {
+    return new SuperclusterPeerSync().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SuperclusterPeerSync | PlainMessage<SuperclusterPeerSync> | undefined, b: SuperclusterPeerSync | PlainMessage<SuperclusterPeerSync> | undefined): boolean {
+    return proto3.util.equals(SuperclusterPeerSync, a, b);
+  }
+}
+
+/**
+ * SyncError represents an error encountered during sync
  *
  * @generated from message nis.v1.SyncError
  */
@@ -1239,3 +1852,1977 @@ export class DeleteResolverAccountResponse extends Message<DeleteResolverAccount
   }
 }
 
+/**
+ * VerifyAccountRequest is the request to verify an account JWT reached every server
+ *
+ * @generated from message nis.v1.VerifyAccountRequest
+ */
+export class VerifyAccountRequest extends Message<VerifyAccountRequest> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  constructor(data?: PartialMessage<VerifyAccountRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.VerifyAccountRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyAccountRequest {
+    return new VerifyAccountRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyAccountRequest {
+    return new VerifyAccountRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyAccountRequest {
+    return new VerifyAccountRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerifyAccountRequest | PlainMessage<VerifyAccountRequest> | undefined, b: VerifyAccountRequest | PlainMessage<VerifyAccountRequest> | undefined): boolean {
+    return proto3.util.equals(VerifyAccountRequest, a, b);
+  }
+}
+
+/**
+ * VerifyAccountResponse reports the propagation of an account JWT across a cluster
+ *
+ * @generated from message nis.v1.VerifyAccountResponse
+ */
+export class VerifyAccountResponse extends Message<VerifyAccountResponse> {
+  /**
+   * @generated from field: string account_public_key = 1;
+   */
+  accountPublicKey = "";
+
+  /**
+   * @generated from field: string account_name = 2;
+   */
+  accountName = "";
+
+  /**
+   * True when every server holds the JWT stored in the database
+   *
+   * @generated from field: bool propagated = 3;
+   */
+  propagated = false;
+
+  /**
+   * Lookup replies holding the current JWT
+   *
+   * @generated from field: int32 current_copies = 4;
+   */
+  currentCopies = 0;
+
+  /**
+   * Lookup replies holding a different JWT
+   *
+   * @generated from field: int32 mismatched_copies = 5;
+   */
+  mismatchedCopies = 0;
+
+  /**
+   * Lookup replies from servers without the account
+   *
+   * @generated from field: int32 missing_copies = 6;
+   */
+  missingCopies = 0;
+
+  /**
+   * @generated from field: repeated nis.v1.ServerVerification servers = 7;
+   */
+  servers: ServerVerification[] = [];
+
+  constructor(data?: PartialMessage<VerifyAccountResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.VerifyAccountResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "propagated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "current_copies", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "mismatched_copies", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "missing_copies", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "servers", kind: "message", T: ServerVerification, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyAccountResponse {
+    return new VerifyAccountResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyAccountResponse {
+    return new VerifyAccountResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyAccountResponse {
+    return new VerifyAccountResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VerifyAccountResponse | PlainMessage<VerifyAccountResponse> | undefined, b: VerifyAccountResponse | PlainMessage<VerifyAccountResponse> | undefined): boolean {
+    return proto3.util.equals(VerifyAccountResponse, a, b);
+  }
+}
+
+/**
+ * ServerVerification is the state of an account on a single NATS server
+ *
+ * @generated from message nis.v1.ServerVerification
+ */
+export class ServerVerification extends Message<ServerVerification> {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId = "";
+
+  /**
+   * @generated from field: string server_name = 2;
+   */
+  serverName = "";
+
+  /**
+   * False when the server answered the ping but not the resolver listing
+   *
+   * @generated from field: bool responded = 3;
+   */
+  responded = false;
+
+  /**
+   * @generated from field: bool has_account = 4;
+   */
+  hasAccount = false;
+
+  constructor(data?: PartialMessage<ServerVerification>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ServerVerification";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "server_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "server_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "responded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "has_account", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServerVerification {
+    return new ServerVerification().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServerVerification {
+    return new ServerVerification().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServerVerification {
+    return new ServerVerification().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServerVerification | PlainMessage<ServerVerification> | undefined, b: ServerVerification | PlainMessage<ServerVerification> | undefined): boolean {
+    return proto3.util.equals(ServerVerification, a, b);
+  }
+}
+
+/**
+ * ClusterServer is a NATS server of a cluster as recorded by the health check
+ *
+ * @generated from message nis.v1.ClusterServer
+ */
+export class ClusterServer extends Message<ClusterServer> {
+  /**
+   * @generated from field: string server_id = 1;
+   */
+  serverId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string host = 3;
+   */
+  host = "";
+
+  /**
+   * @generated from field: string version = 4;
+   */
+  version = "";
+
+  /**
+   * @generated from field: bool jetstream = 5;
+   */
+  jetstream = false;
+
+  /**
+   * Name of the NATS cluster the server is routed into
+   *
+   * @generated from field: string cluster_name = 6;
+   */
+  clusterName = "";
+
+  /**
+   * Names of the gateways the server is connected to
+   *
+   * @generated from field: repeated string gateways = 7;
+   */
+  gateways: string[] = [];
+
+  /**
+   * @generated from field: int32 connections = 8;
+   */
+  connections = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 9;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: int64 uptime_seconds = 10;
+   */
+  uptimeSeconds = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen = 11;
+   */
+  lastSeen?: Timestamp;
+
+  /**
+   * True when the server did not answer the last health check
+   *
+   * @generated from field: bool missing = 12;
+   */
+  missing = false;
+
+  constructor(data?: PartialMessage<ClusterServer>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ClusterServer";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "server_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "jetstream", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "gateways", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "connections", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "started_at", kind: "message", T: Timestamp },
+    { no: 10, name: "uptime_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "last_seen", kind: "message", T: Timestamp },
+    { no: 12, name: "missing", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClusterServer {
+    return new ClusterServer().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClusterServer {
+    return new ClusterServer().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClusterServer {
+    return new ClusterServer().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClusterServer | PlainMessage<ClusterServer> | undefined, b: ClusterServer | PlainMessage<ClusterServer> | undefined): boolean {
+    return proto3.util.equals(ClusterServer, a, b);
+  }
+}
+
+/**
+ * GetClusterTopologyRequest is the request to get the servers of a cluster
+ *
+ * @generated from message nis.v1.GetClusterTopologyRequest
+ */
+export class GetClusterTopologyRequest extends Message<GetClusterTopologyRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetClusterTopologyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GetClusterTopologyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetClusterTopologyRequest {
+    return new GetClusterTopologyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetClusterTopologyRequest {
+    return new GetClusterTopologyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetClusterTopologyRequest {
+    return new GetClusterTopologyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetClusterTopologyRequest | PlainMessage<GetClusterTopologyRequest> | undefined, b: GetClusterTopologyRequest | PlainMessage<GetClusterTopologyRequest> | undefined): boolean {
+    return proto3.util.equals(GetClusterTopologyRequest, a, b);
+  }
+}
+
+/**
+ * GetClusterTopologyResponse is the response from getting the servers of a cluster
+ *
+ * @generated from message nis.v1.GetClusterTopologyResponse
+ */
+export class GetClusterTopologyResponse extends Message<GetClusterTopologyResponse> {
+  /**
+   * @generated from field: repeated nis.v1.ClusterServer servers = 1;
+   */
+  servers: ClusterServer[] = [];
+
+  /**
+   * Version skew and missing servers
+   *
+   * @generated from field: repeated string warnings = 2;
+   */
+  warnings: string[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_health_check = 3;
+   */
+  lastHealthCheck?: Timestamp;
+
+  constructor(data?: PartialMessage<GetClusterTopologyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GetClusterTopologyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "servers", kind: "message", T: ClusterServer, repeated: true },
+    { no: 2, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "last_health_check", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetClusterTopologyResponse {
+    return new GetClusterTopologyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetClusterTopologyResponse {
+    return new GetClusterTopologyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetClusterTopologyResponse {
+    return new GetClusterTopologyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetClusterTopologyResponse | PlainMessage<GetClusterTopologyResponse> | undefined, b: GetClusterTopologyResponse | PlainMessage<GetClusterTopologyResponse> | undefined): boolean {
+    return proto3.util.equals(GetClusterTopologyResponse, a, b);
+  }
+}
+
+/**
+ * ClusterHealthCheck is the outcome of a single health check of a cluster
+ *
+ * @generated from message nis.v1.ClusterHealthCheck
+ */
+export class ClusterHealthCheck extends Message<ClusterHealthCheck> {
+  /**
+   * @generated from field: google.protobuf.Timestamp checked_at = 1;
+   */
+  checkedAt?: Timestamp;
+
+  /**
+   * @generated from field: bool healthy = 2;
+   */
+  healthy = false;
+
+  /**
+   * Time to connect and complete a round trip, 0 when the check failed
+   *
+   * @generated from field: int64 latency_ms = 3;
+   */
+  latencyMs = protoInt64.zero;
+
+  /**
+   * @generated from field: string error = 4;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ClusterHealthCheck>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ClusterHealthCheck";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "checked_at", kind: "message", T: Timestamp },
+    { no: 2, name: "healthy", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "latency_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClusterHealthCheck {
+    return new ClusterHealthCheck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClusterHealthCheck {
+    return new ClusterHealthCheck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClusterHealthCheck {
+    return new ClusterHealthCheck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClusterHealthCheck | PlainMessage<ClusterHealthCheck> | undefined, b: ClusterHealthCheck | PlainMessage<ClusterHealthCheck> | undefined): boolean {
+    return proto3.util.equals(ClusterHealthCheck, a, b);
+  }
+}
+
+/**
+ * ListClusterHealthChecksRequest is the request to list the health history of a cluster
+ *
+ * @generated from message nis.v1.ListClusterHealthChecksRequest
+ */
+export class ListClusterHealthChecksRequest extends Message<ListClusterHealthChecksRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: nis.v1.ListOptions options = 2;
+   */
+  options?: ListOptions;
+
+  constructor(data?: PartialMessage<ListClusterHealthChecksRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListClusterHealthChecksRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "options", kind: "message", T: ListOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListClusterHealthChecksRequest {
+    return new ListClusterHealthChecksRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListClusterHealthChecksRequest {
+    return new ListClusterHealthChecksRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListClusterHealthChecksRequest {
+    return new ListClusterHealthChecksRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListClusterHealthChecksRequest | PlainMessage<ListClusterHealthChecksRequest> | undefined, b: ListClusterHealthChecksRequest | PlainMessage<ListClusterHealthChecksRequest> | undefined): boolean {
+    return proto3.util.equals(ListClusterHealthChecksRequest, a, b);
+  }
+}
+
+/**
+ * ListClusterHealthChecksResponse is the response from listing the health history, newest first
+ *
+ * @generated from message nis.v1.ListClusterHealthChecksResponse
+ */
+export class ListClusterHealthChecksResponse extends Message<ListClusterHealthChecksResponse> {
+  /**
+   * @generated from field: repeated nis.v1.ClusterHealthCheck checks = 1;
+   */
+  checks: ClusterHealthCheck[] = [];
+
+  constructor(data?: PartialMessage<ListClusterHealthChecksResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListClusterHealthChecksResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "checks", kind: "message", T: ClusterHealthCheck, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListClusterHealthChecksResponse {
+    return new ListClusterHealthChecksResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListClusterHealthChecksResponse {
+    return new ListClusterHealthChecksResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListClusterHealthChecksResponse {
+    return new ListClusterHealthChecksResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListClusterHealthChecksResponse | PlainMessage<ListClusterHealthChecksResponse> | undefined, b: ListClusterHealthChecksResponse | PlainMessage<ListClusterHealthChecksResponse> | undefined): boolean {
+    return proto3.util.equals(ListClusterHealthChecksResponse, a, b);
+  }
+}
+
+/**
+ * ListConnectionsRequest is the request to list the live connections of an account or user
+ *
+ * @generated from message nis.v1.ListConnectionsRequest
+ */
+export class ListConnectionsRequest extends Message<ListConnectionsRequest> {
+  /**
+   * Required unless user_id is set
+   *
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * Only list this user's connections
+   *
+   * @generated from field: string user_id = 2;
+   */
+  userId = "";
+
+  /**
+   * Only query this cluster, every cluster of the account's operator if empty
+   *
+   * @generated from field: string cluster_id = 3;
+   */
+  clusterId = "";
+
+  /**
+   * Maximum connections returned per server, 0 for the default
+   *
+   * @generated from field: int32 limit = 4;
+   */
+  limit = 0;
+
+  constructor(data?: PartialMessage<ListConnectionsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListConnectionsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectionsRequest {
+    return new ListConnectionsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectionsRequest {
+    return new ListConnectionsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectionsRequest {
+    return new ListConnectionsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectionsRequest | PlainMessage<ListConnectionsRequest> | undefined, b: ListConnectionsRequest | PlainMessage<ListConnectionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListConnectionsRequest, a, b);
+  }
+}
+
+/**
+ * ListConnectionsResponse is the response from listing live connections
+ *
+ * @generated from message nis.v1.ListConnectionsResponse
+ */
+export class ListConnectionsResponse extends Message<ListConnectionsResponse> {
+  /**
+   * @generated from field: repeated nis.v1.ClientConnection connections = 1;
+   */
+  connections: ClientConnection[] = [];
+
+  /**
+   * True when a server had more matching connections than the limit
+   *
+   * @generated from field: bool truncated = 2;
+   */
+  truncated = false;
+
+  /**
+   * Clusters and servers that could not be queried
+   *
+   * @generated from field: repeated string warnings = 3;
+   */
+  warnings: string[] = [];
+
+  constructor(data?: PartialMessage<ListConnectionsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListConnectionsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "connections", kind: "message", T: ClientConnection, repeated: true },
+    { no: 2, name: "truncated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListConnectionsResponse {
+    return new ListConnectionsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListConnectionsResponse {
+    return new ListConnectionsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListConnectionsResponse {
+    return new ListConnectionsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListConnectionsResponse | PlainMessage<ListConnectionsResponse> | undefined, b: ListConnectionsResponse | PlainMessage<ListConnectionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListConnectionsResponse, a, b);
+  }
+}
+
+/**
+ * ClientConnection is a live client connection reported by a NATS server
+ *
+ * @generated from message nis.v1.ClientConnection
+ */
+export class ClientConnection extends Message<ClientConnection> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string cluster_name = 2;
+   */
+  clusterName = "";
+
+  /**
+   * @generated from field: string server_id = 3;
+   */
+  serverId = "";
+
+  /**
+   * @generated from field: string server_name = 4;
+   */
+  serverName = "";
+
+  /**
+   * Client ID, unique per server
+   *
+   * @generated from field: int64 cid = 5;
+   */
+  cid = protoInt64.zero;
+
+  /**
+   * @generated from field: string ip = 6;
+   */
+  ip = "";
+
+  /**
+   * @generated from field: int32 port = 7;
+   */
+  port = 0;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp start = 8;
+   */
+  start?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_activity = 9;
+   */
+  lastActivity?: Timestamp;
+
+  /**
+   * @generated from field: string rtt = 10;
+   */
+  rtt = "";
+
+  /**
+   * @generated from field: string uptime = 11;
+   */
+  uptime = "";
+
+  /**
+   * @generated from field: int64 in_msgs = 12;
+   */
+  inMsgs = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 out_msgs = 13;
+   */
+  outMsgs = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 in_bytes = 14;
+   */
+  inBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 out_bytes = 15;
+   */
+  outBytes = protoInt64.zero;
+
+  /**
+   * @generated from field: int32 subscriptions = 16;
+   */
+  subscriptions = 0;
+
+  /**
+   * Name the client library connected with
+   *
+   * @generated from field: string client_name = 17;
+   */
+  clientName = "";
+
+  /**
+   * @generated from field: string lang = 18;
+   */
+  lang = "";
+
+  /**
+   * @generated from field: string version = 19;
+   */
+  version = "";
+
+  /**
+   * @generated from field: string user_public_key = 20;
+   */
+  userPublicKey = "";
+
+  /**
+   * Empty when the public key is not a NIS user of the account
+   *
+   * @generated from field: string user_id = 21;
+   */
+  userId = "";
+
+  /**
+   * @generated from field: string user_name = 22;
+   */
+  userName = "";
+
+  /**
+   * @generated from field: string scoped_signing_key_id = 23;
+   */
+  scopedSigningKeyId = "";
+
+  /**
+   * @generated from field: string scoped_signing_key_name = 24;
+   */
+  scopedSigningKeyName = "";
+
+  constructor(data?: PartialMessage<ClientConnection>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ClientConnection";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "server_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "server_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "cid", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "port", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "start", kind: "message", T: Timestamp },
+    { no: 9, name: "last_activity", kind: "message", T: Timestamp },
+    { no: 10, name: "rtt", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "uptime", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "in_msgs", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 13, name: "out_msgs", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 14, name: "in_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 15, name: "out_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 16, name: "subscriptions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 17, name: "client_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 18, name: "lang", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 19, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 20, name: "user_public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 21, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 22, name: "user_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 23, name: "scoped_signing_key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 24, name: "scoped_signing_key_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClientConnection {
+    return new ClientConnection().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClientConnection {
+    return new ClientConnection().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClientConnection {
+    return new ClientConnection().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClientConnection | PlainMessage<ClientConnection> | undefined, b: ClientConnection | PlainMessage<ClientConnection> | undefined): boolean {
+    return proto3.util.equals(ClientConnection, a, b);
+  }
+}
+
+/**
+ * DisconnectUserRequest kicks every live connection of a user
+ *
+ * @generated from message nis.v1.DisconnectUserRequest
+ */
+export class DisconnectUserRequest extends Message<DisconnectUserRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId = "";
+
+  /**
+   * Optional - every cluster of the user's operator when empty
+   *
+   * @generated from field: string cluster_id = 2;
+   */
+  clusterId = "";
+
+  constructor(data?: PartialMessage<DisconnectUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.DisconnectUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DisconnectUserRequest {
+    return new DisconnectUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DisconnectUserRequest {
+    return new DisconnectUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DisconnectUserRequest {
+    return new DisconnectUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DisconnectUserRequest | PlainMessage<DisconnectUserRequest> | undefined, b: DisconnectUserRequest | PlainMessage<DisconnectUserRequest> | undefined): boolean {
+    return proto3.util.equals(DisconnectUserRequest, a, b);
+  }
+}
+
+/**
+ * DisconnectUserResponse reports the connections terminated per server
+ *
+ * @generated from message nis.v1.DisconnectUserResponse
+ */
+export class DisconnectUserResponse extends Message<DisconnectUserResponse> {
+  /**
+   * Only servers where the user was connected are listed
+   *
+   * @generated from field: repeated nis.v1.ServerDisconnect servers = 1;
+   */
+  servers: ServerDisconnect[] = [];
+
+  /**
+   * Total connections terminated
+   *
+   * @generated from field: int32 disconnected = 2;
+   */
+  disconnected = 0;
+
+  /**
+   * Clusters and servers that could not be queried
+   *
+   * @generated from field: repeated string warnings = 3;
+   */
+  warnings: string[] = [];
+
+  constructor(data?: PartialMessage<DisconnectUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.DisconnectUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "servers", kind: "message", T: ServerDisconnect, repeated: true },
+    { no: 2, name: "disconnected", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DisconnectUserResponse {
+    return new DisconnectUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DisconnectUserResponse {
+    return new DisconnectUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DisconnectUserResponse {
+    return new DisconnectUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DisconnectUserResponse | PlainMessage<DisconnectUserResponse> | undefined, b: DisconnectUserResponse | PlainMessage<DisconnectUserResponse> | undefined): boolean {
+    return proto3.util.equals(DisconnectUserResponse, a, b);
+  }
+}
+
+/**
+ * ServerDisconnect reports the connections of a user terminated on one server
+ *
+ * @generated from message nis.v1.ServerDisconnect
+ */
+export class ServerDisconnect extends Message<ServerDisconnect> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string cluster_name = 2;
+   */
+  clusterName = "";
+
+  /**
+   * @generated from field: string server_id = 3;
+   */
+  serverId = "";
+
+  /**
+   * @generated from field: string server_name = 4;
+   */
+  serverName = "";
+
+  /**
+   * @generated from field: int32 found = 5;
+   */
+  found = 0;
+
+  /**
+   * @generated from field: int32 disconnected = 6;
+   */
+  disconnected = 0;
+
+  /**
+   * One entry per connection that could not be kicked
+   *
+   * @generated from field: repeated string errors = 7;
+   */
+  errors: string[] = [];
+
+  constructor(data?: PartialMessage<ServerDisconnect>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ServerDisconnect";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "server_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "server_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "found", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "disconnected", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "errors", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServerDisconnect {
+    return new ServerDisconnect().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServerDisconnect {
+    return new ServerDisconnect().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServerDisconnect {
+    return new ServerDisconnect().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServerDisconnect | PlainMessage<ServerDisconnect> | undefined, b: ServerDisconnect | PlainMessage<ServerDisconnect> | undefined): boolean {
+    return proto3.util.equals(ServerDisconnect, a, b);
+  }
+}
+
+/**
+ * GetClusterCapacityRequest asks for the JetStream capacity report of a cluster
+ *
+ * @generated from message nis.v1.GetClusterCapacityRequest
+ */
+export class GetClusterCapacityRequest extends Message<GetClusterCapacityRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetClusterCapacityRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GetClusterCapacityRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetClusterCapacityRequest {
+    return new GetClusterCapacityRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetClusterCapacityRequest {
+    return new GetClusterCapacityRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetClusterCapacityRequest {
+    return new GetClusterCapacityRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetClusterCapacityRequest | PlainMessage<GetClusterCapacityRequest> | undefined, b: GetClusterCapacityRequest | PlainMessage<GetClusterCapacityRequest> | undefined): boolean {
+    return proto3.util.equals(GetClusterCapacityRequest, a, b);
+  }
+}
+
+/**
+ * GetClusterCapacityResponse compares the JetStream capacity of a cluster, reported by
+ * JSZ, with the limits assigned to the operator's accounts. Sizes are in bytes.
+ *
+ * @generated from message nis.v1.GetClusterCapacityResponse
+ */
+export class GetClusterCapacityResponse extends Message<GetClusterCapacityResponse> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string cluster_name = 2;
+   */
+  clusterName = "";
+
+  /**
+   * @generated from field: int32 jetstream_servers = 3;
+   */
+  jetstreamServers = 0;
+
+  /**
+   * @generated from field: int64 memory_capacity = 4;
+   */
+  memoryCapacity = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 storage_capacity = 5;
+   */
+  storageCapacity = protoInt64.zero;
+
+  /**
+   * Sum of the account limits, unlimited accounts excluded
+   *
+   * @generated from field: int64 memory_assigned = 6;
+   */
+  memoryAssigned = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 storage_assigned = 7;
+   */
+  storageAssigned = protoInt64.zero;
+
+  /**
+   * Reserved by streams and used, as reported by the servers
+   *
+   * @generated from field: int64 memory_reserved = 8;
+   */
+  memoryReserved = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 storage_reserved = 9;
+   */
+  storageReserved = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 memory_used = 10;
+   */
+  memoryUsed = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 storage_used = 11;
+   */
+  storageUsed = protoInt64.zero;
+
+  /**
+   * JetStream enabled accounts of the operator
+   *
+   * @generated from field: int32 accounts = 12;
+   */
+  accounts = 0;
+
+  /**
+   * @generated from field: repeated string unlimited_memory_accounts = 13;
+   */
+  unlimitedMemoryAccounts: string[] = [];
+
+  /**
+   * @generated from field: repeated string unlimited_storage_accounts = 14;
+   */
+  unlimitedStorageAccounts: string[] = [];
+
+  /**
+   * Set when assigned limits exceed the capacity
+   *
+   * @generated from field: repeated string warnings = 15;
+   */
+  warnings: string[] = [];
+
+  constructor(data?: PartialMessage<GetClusterCapacityResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GetClusterCapacityResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "jetstream_servers", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "memory_capacity", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "storage_capacity", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "memory_assigned", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "storage_assigned", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "memory_reserved", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "storage_reserved", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "memory_used", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "storage_used", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 12, name: "accounts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 13, name: "unlimited_memory_accounts", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 14, name: "unlimited_storage_accounts", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 15, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetClusterCapacityResponse {
+    return new GetClusterCapacityResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetClusterCapacityResponse {
+    return new GetClusterCapacityResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetClusterCapacityResponse {
+    return new GetClusterCapacityResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetClusterCapacityResponse | PlainMessage<GetClusterCapacityResponse> | undefined, b: GetClusterCapacityResponse | PlainMessage<GetClusterCapacityResponse> | undefined): boolean {
+    return proto3.util.equals(GetClusterCapacityResponse, a, b);
+  }
+}
+
+/**
+ * AuthFailure aggregates the failed NATS client authentications of a cluster sharing
+ * the presented user key, remote address and reason within one minute
+ *
+ * @generated from message nis.v1.AuthFailure
+ */
+export class AuthFailure extends Message<AuthFailure> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string cluster_id = 2;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp window_start = 3;
+   */
+  windowStart?: Timestamp;
+
+  /**
+   * Key the client presented, empty if none
+   *
+   * @generated from field: string user_public_key = 4;
+   */
+  userPublicKey = "";
+
+  /**
+   * @generated from field: string account_public_key = 5;
+   */
+  accountPublicKey = "";
+
+  /**
+   * NIS user and account the keys belong to, empty for unknown keys
+   *
+   * @generated from field: string user_id = 6;
+   */
+  userId = "";
+
+  /**
+   * @generated from field: string account_id = 7;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string remote_ip = 8;
+   */
+  remoteIp = "";
+
+  /**
+   * @generated from field: string reason = 9;
+   */
+  reason = "";
+
+  /**
+   * @generated from field: string server_name = 10;
+   */
+  serverName = "";
+
+  /**
+   * @generated from field: string client_name = 11;
+   */
+  clientName = "";
+
+  /**
+   * @generated from field: int64 count = 12;
+   */
+  count = protoInt64.zero;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp first_seen = 13;
+   */
+  firstSeen?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen = 14;
+   */
+  lastSeen?: Timestamp;
+
+  constructor(data?: PartialMessage<AuthFailure>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.AuthFailure";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "window_start", kind: "message", T: Timestamp },
+    { no: 4, name: "user_public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "account_public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "remote_ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "server_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "client_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 13, name: "first_seen", kind: "message", T: Timestamp },
+    { no: 14, name: "last_seen", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuthFailure {
+    return new AuthFailure().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuthFailure {
+    return new AuthFailure().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuthFailure {
+    return new AuthFailure().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuthFailure | PlainMessage<AuthFailure> | undefined, b: AuthFailure | PlainMessage<AuthFailure> | undefined): boolean {
+    return proto3.util.equals(AuthFailure, a, b);
+  }
+}
+
+/**
+ * ListAuthFailuresRequest filters the NATS authentication failure log. At least one of
+ * cluster_id, account_id and user_id is required.
+ *
+ * @generated from message nis.v1.ListAuthFailuresRequest
+ */
+export class ListAuthFailuresRequest extends Message<ListAuthFailuresRequest> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string user_id = 3;
+   */
+  userId = "";
+
+  /**
+   * Only failures last seen at or after this time
+   *
+   * @generated from field: google.protobuf.Timestamp since = 4;
+   */
+  since?: Timestamp;
+
+  /**
+   * @generated from field: nis.v1.ListOptions options = 5;
+   */
+  options?: ListOptions;
+
+  constructor(data?: PartialMessage<ListAuthFailuresRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListAuthFailuresRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "since", kind: "message", T: Timestamp },
+    { no: 5, name: "options", kind: "message", T: ListOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuthFailuresRequest {
+    return new ListAuthFailuresRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuthFailuresRequest {
+    return new ListAuthFailuresRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuthFailuresRequest {
+    return new ListAuthFailuresRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuthFailuresRequest | PlainMessage<ListAuthFailuresRequest> | undefined, b: ListAuthFailuresRequest | PlainMessage<ListAuthFailuresRequest> | undefined): boolean {
+    return proto3.util.equals(ListAuthFailuresRequest, a, b);
+  }
+}
+
+/**
+ * ListAuthFailuresResponse lists the matching failures, most recently seen first
+ *
+ * @generated from message nis.v1.ListAuthFailuresResponse
+ */
+export class ListAuthFailuresResponse extends Message<ListAuthFailuresResponse> {
+  /**
+   * @generated from field: repeated nis.v1.AuthFailure failures = 1;
+   */
+  failures: AuthFailure[] = [];
+
+  constructor(data?: PartialMessage<ListAuthFailuresResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListAuthFailuresResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "failures", kind: "message", T: AuthFailure, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAuthFailuresResponse {
+    return new ListAuthFailuresResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAuthFailuresResponse {
+    return new ListAuthFailuresResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAuthFailuresResponse {
+    return new ListAuthFailuresResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListAuthFailuresResponse | PlainMessage<ListAuthFailuresResponse> | undefined, b: ListAuthFailuresResponse | PlainMessage<ListAuthFailuresResponse> | undefined): boolean {
+    return proto3.util.equals(ListAuthFailuresResponse, a, b);
+  }
+}
+
+/**
+ * LeafnodeProfile binds a NIS user to a hub cluster: edge servers open their leafnode
+ * remote to the hub as that user
+ *
+ * @generated from message nis.v1.LeafnodeProfile
+ */
+export class LeafnodeProfile extends Message<LeafnodeProfile> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Hub cluster
+   *
+   * @generated from field: string cluster_id = 2;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string user_id = 4;
+   */
+  userId = "";
+
+  /**
+   * Account of the leaf server bound to the remote, empty for its default account
+   *
+   * @generated from field: string local_account = 5;
+   */
+  localAccount = "";
+
+  /**
+   * Leafnode URLs of the hub, derived from its server URLs and leafnode port when empty
+   *
+   * @generated from field: repeated string urls = 6;
+   */
+  urls: string[] = [];
+
+  /**
+   * Path of the .creds file on the leaf server
+   *
+   * @generated from field: string credentials_path = 7;
+   */
+  credentialsPath = "";
+
+  /**
+   * CA verifying the hub's certificate, on the leaf server
+   *
+   * @generated from field: string ca_file = 8;
+   */
+  caFile = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 10;
+   */
+  updatedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<LeafnodeProfile>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.LeafnodeProfile";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "local_account", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "urls", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "credentials_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "ca_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "created_at", kind: "message", T: Timestamp },
+    { no: 10, name: "updated_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeafnodeProfile {
+    return new LeafnodeProfile().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeafnodeProfile {
+    return new LeafnodeProfile().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeafnodeProfile {
+    return new LeafnodeProfile().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LeafnodeProfile | PlainMessage<LeafnodeProfile> | undefined, b: LeafnodeProfile | PlainMessage<LeafnodeProfile> | undefined): boolean {
+    return proto3.util.equals(LeafnodeProfile, a, b);
+  }
+}
+
+/**
+ * CreateLeafnodeProfileRequest binds a user of the cluster's operator to the cluster
+ *
+ * @generated from message nis.v1.CreateLeafnodeProfileRequest
+ */
+export class CreateLeafnodeProfileRequest extends Message<CreateLeafnodeProfileRequest> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * @generated from field: string user_id = 3;
+   */
+  userId = "";
+
+  /**
+   * @generated from field: string local_account = 4;
+   */
+  localAccount = "";
+
+  /**
+   * @generated from field: repeated string urls = 5;
+   */
+  urls: string[] = [];
+
+  /**
+   * Defaults to /etc/nats/<name>.creds
+   *
+   * @generated from field: string credentials_path = 6;
+   */
+  credentialsPath = "";
+
+  /**
+   * @generated from field: string ca_file = 7;
+   */
+  caFile = "";
+
+  constructor(data?: PartialMessage<CreateLeafnodeProfileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.CreateLeafnodeProfileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "local_account", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "urls", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "credentials_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "ca_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateLeafnodeProfileRequest {
+    return new CreateLeafnodeProfileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateLeafnodeProfileRequest {
+    return new CreateLeafnodeProfileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateLeafnodeProfileRequest {
+    return new CreateLeafnodeProfileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateLeafnodeProfileRequest | PlainMessage<CreateLeafnodeProfileRequest> | undefined, b: CreateLeafnodeProfileRequest | PlainMessage<CreateLeafnodeProfileRequest> | undefined): boolean {
+    return proto3.util.equals(CreateLeafnodeProfileRequest, a, b);
+  }
+}
+
+/**
+ * CreateLeafnodeProfileResponse returns the stored profile
+ *
+ * @generated from message nis.v1.CreateLeafnodeProfileResponse
+ */
+export class CreateLeafnodeProfileResponse extends Message<CreateLeafnodeProfileResponse> {
+  /**
+   * @generated from field: nis.v1.LeafnodeProfile profile = 1;
+   */
+  profile?: LeafnodeProfile;
+
+  constructor(data?: PartialMessage<CreateLeafnodeProfileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.CreateLeafnodeProfileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profile", kind: "message", T: LeafnodeProfile },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateLeafnodeProfileResponse {
+    return new CreateLeafnodeProfileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateLeafnodeProfileResponse {
+    return new CreateLeafnodeProfileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateLeafnodeProfileResponse {
+    return new CreateLeafnodeProfileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateLeafnodeProfileResponse | PlainMessage<CreateLeafnodeProfileResponse> | undefined, b: CreateLeafnodeProfileResponse | PlainMessage<CreateLeafnodeProfileResponse> | undefined): boolean {
+    return proto3.util.equals(CreateLeafnodeProfileResponse, a, b);
+  }
+}
+
+/**
+ * ListLeafnodeProfilesRequest lists the leafnode profiles of a hub cluster
+ *
+ * @generated from message nis.v1.ListLeafnodeProfilesRequest
+ */
+export class ListLeafnodeProfilesRequest extends Message<ListLeafnodeProfilesRequest> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  constructor(data?: PartialMessage<ListLeafnodeProfilesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListLeafnodeProfilesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListLeafnodeProfilesRequest {
+    return new ListLeafnodeProfilesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListLeafnodeProfilesRequest {
+    return new ListLeafnodeProfilesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListLeafnodeProfilesRequest {
+    return new ListLeafnodeProfilesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListLeafnodeProfilesRequest | PlainMessage<ListLeafnodeProfilesRequest> | undefined, b: ListLeafnodeProfilesRequest | PlainMessage<ListLeafnodeProfilesRequest> | undefined): boolean {
+    return proto3.util.equals(ListLeafnodeProfilesRequest, a, b);
+  }
+}
+
+/**
+ * ListLeafnodeProfilesResponse lists the profiles ordered by name
+ *
+ * @generated from message nis.v1.ListLeafnodeProfilesResponse
+ */
+export class ListLeafnodeProfilesResponse extends Message<ListLeafnodeProfilesResponse> {
+  /**
+   * @generated from field: repeated nis.v1.LeafnodeProfile profiles = 1;
+   */
+  profiles: LeafnodeProfile[] = [];
+
+  constructor(data?: PartialMessage<ListLeafnodeProfilesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListLeafnodeProfilesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "profiles", kind: "message", T: LeafnodeProfile, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListLeafnodeProfilesResponse {
+    return new ListLeafnodeProfilesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListLeafnodeProfilesResponse {
+    return new ListLeafnodeProfilesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListLeafnodeProfilesResponse {
+    return new ListLeafnodeProfilesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListLeafnodeProfilesResponse | PlainMessage<ListLeafnodeProfilesResponse> | undefined, b: ListLeafnodeProfilesResponse | PlainMessage<ListLeafnodeProfilesResponse> | undefined): boolean {
+    return proto3.util.equals(ListLeafnodeProfilesResponse, a, b);
+  }
+}
+
+/**
+ * DeleteLeafnodeProfileRequest deletes a leafnode profile
+ *
+ * @generated from message nis.v1.DeleteLeafnodeProfileRequest
+ */
+export class DeleteLeafnodeProfileRequest extends Message<DeleteLeafnodeProfileRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteLeafnodeProfileRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.DeleteLeafnodeProfileRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteLeafnodeProfileRequest {
+    return new DeleteLeafnodeProfileRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteLeafnodeProfileRequest {
+    return new DeleteLeafnodeProfileRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteLeafnodeProfileRequest {
+    return new DeleteLeafnodeProfileRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteLeafnodeProfileRequest | PlainMessage<DeleteLeafnodeProfileRequest> | undefined, b: DeleteLeafnodeProfileRequest | PlainMessage<DeleteLeafnodeProfileRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteLeafnodeProfileRequest, a, b);
+  }
+}
+
+/**
+ * DeleteLeafnodeProfileResponse is the response from deleting a leafnode profile
+ *
+ * @generated from message nis.v1.DeleteLeafnodeProfileResponse
+ */
+export class DeleteLeafnodeProfileResponse extends Message<DeleteLeafnodeProfileResponse> {
+  constructor(data?: PartialMessage<DeleteLeafnodeProfileResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.DeleteLeafnodeProfileResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteLeafnodeProfileResponse {
+    return new DeleteLeafnodeProfileResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteLeafnodeProfileResponse {
+    return new DeleteLeafnodeProfileResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteLeafnodeProfileResponse {
+    return new DeleteLeafnodeProfileResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteLeafnodeProfileResponse | PlainMessage<DeleteLeafnodeProfileResponse> | undefined, b: DeleteLeafnodeProfileResponse | PlainMessage<DeleteLeafnodeProfileResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteLeafnodeProfileResponse, a, b);
+  }
+}
+
+/**
+ * GenerateLeafnodeConfigRequest selects the leafnode profile to generate for
+ *
+ * @generated from message nis.v1.GenerateLeafnodeConfigRequest
+ */
+export class GenerateLeafnodeConfigRequest extends Message<GenerateLeafnodeConfigRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GenerateLeafnodeConfigRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GenerateLeafnodeConfigRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateLeafnodeConfigRequest {
+    return new GenerateLeafnodeConfigRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateLeafnodeConfigRequest {
+    return new GenerateLeafnodeConfigRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateLeafnodeConfigRequest {
+    return new GenerateLeafnodeConfigRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateLeafnodeConfigRequest | PlainMessage<GenerateLeafnodeConfigRequest> | undefined, b: GenerateLeafnodeConfigRequest | PlainMessage<GenerateLeafnodeConfigRequest> | undefined): boolean {
+    return proto3.util.equals(GenerateLeafnodeConfigRequest, a, b);
+  }
+}
+
+/**
+ * GenerateLeafnodeConfigResponse carries what an edge server needs to join the hub
+ *
+ * @generated from message nis.v1.GenerateLeafnodeConfigResponse
+ */
+export class GenerateLeafnodeConfigResponse extends Message<GenerateLeafnodeConfigResponse> {
+  /**
+   * leafnodes block of the leaf server configuration
+   *
+   * @generated from field: string config = 1;
+   */
+  config = "";
+
+  /**
+   * .creds file of the profile's user, to install at credentials_path
+   *
+   * @generated from field: string credentials = 2;
+   */
+  credentials = "";
+
+  /**
+   * @generated from field: string credentials_path = 3;
+   */
+  credentialsPath = "";
+
+  constructor(data?: PartialMessage<GenerateLeafnodeConfigResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.GenerateLeafnodeConfigResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "credentials", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "credentials_path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GenerateLeafnodeConfigResponse {
+    return new GenerateLeafnodeConfigResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GenerateLeafnodeConfigResponse {
+    return new GenerateLeafnodeConfigResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GenerateLeafnodeConfigResponse {
+    return new GenerateLeafnodeConfigResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GenerateLeafnodeConfigResponse | PlainMessage<GenerateLeafnodeConfigResponse> | undefined, b: GenerateLeafnodeConfigResponse | PlainMessage<GenerateLeafnodeConfigResponse> | undefined): boolean {
+    return proto3.util.equals(GenerateLeafnodeConfigResponse, a, b);
+  }
+}
+
+/**
+ * SetClusterGatewayRequest makes a cluster join or leave a supercluster
+ *
+ * @generated from message nis.v1.SetClusterGatewayRequest
+ */
+export class SetClusterGatewayRequest extends Message<SetClusterGatewayRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * Supercluster to join, empty to leave it
+   *
+   * @generated from field: string supercluster = 2;
+   */
+  supercluster = "";
+
+  /**
+   * URLs the other clusters' gateways dial, derived from the server URLs and the
+   * gateway port of the server profile when empty
+   *
+   * @generated from field: repeated string gateway_urls = 3;
+   */
+  gatewayUrls: string[] = [];
+
+  constructor(data?: PartialMessage<SetClusterGatewayRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.SetClusterGatewayRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "supercluster", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "gateway_urls", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetClusterGatewayRequest {
+    return new SetClusterGatewayRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetClusterGatewayRequest {
+    return new SetClusterGatewayRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetClusterGatewayRequest {
+    return new SetClusterGatewayRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetClusterGatewayRequest | PlainMessage<SetClusterGatewayRequest> | undefined, b: SetClusterGatewayRequest | PlainMessage<SetClusterGatewayRequest> | undefined): boolean {
+    return proto3.util.equals(SetClusterGatewayRequest, a, b);
+  }
+}
+
+/**
+ * SetClusterGatewayResponse returns the updated cluster
+ *
+ * @generated from message nis.v1.SetClusterGatewayResponse
+ */
+export class SetClusterGatewayResponse extends Message<SetClusterGatewayResponse> {
+  /**
+   * @generated from field: nis.v1.Cluster cluster = 1;
+   */
+  cluster?: Cluster;
+
+  constructor(data?: PartialMessage<SetClusterGatewayResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.SetClusterGatewayResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster", kind: "message", T: Cluster },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetClusterGatewayResponse {
+    return new SetClusterGatewayResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetClusterGatewayResponse {
+    return new SetClusterGatewayResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetClusterGatewayResponse {
+    return new SetClusterGatewayResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetClusterGatewayResponse | PlainMessage<SetClusterGatewayResponse> | undefined, b: SetClusterGatewayResponse | PlainMessage<SetClusterGatewayResponse> | undefined): boolean {
+    return proto3.util.equals(SetClusterGatewayResponse, a, b);
+  }
+}
+
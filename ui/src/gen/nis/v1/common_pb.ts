@@ -344,3 +344,56 @@ export class Metadata extends Message<Metadata> {
   }
 }
 
+/**
+ * AccountPush reports the push of an account JWT to a cluster
+ *
+ * @generated from message nis.v1.AccountPush
+ */
+export class AccountPush extends Message<AccountPush> {
+  /**
+   * @generated from field: string cluster_id = 1;
+   */
+  clusterId = "";
+
+  /**
+   * @generated from field: string cluster_name = 2;
+   */
+  clusterName = "";
+
+  /**
+   * Set when the cluster could not be reached, its next sync retries
+   *
+   * @generated from field: string error = 3;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<AccountPush>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.AccountPush";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cluster_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cluster_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AccountPush {
+    return new AccountPush().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AccountPush {
+    return new AccountPush().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AccountPush {
+    return new AccountPush().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AccountPush | PlainMessage<AccountPush> | undefined, b: AccountPush | PlainMessage<AccountPush> | undefined): boolean {
+    return proto3.util.equals(AccountPush, a, b);
+  }
+}
+
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateOperatorRequest, CreateOperatorResponse, DeleteOperatorRequest, DeleteOperatorResponse, GenerateIncludeRequest, GenerateIncludeResponse, GetOperatorByNameRequest, GetOperatorByNameResponse, GetOperatorRequest, GetOperatorResponse, ListOperatorsRequest, ListOperatorsResponse, SetSystemAccountRequest, SetSystemAccountResponse, UpdateOperatorRequest, UpdateOperatorResponse } from "./operator_pb.js";
+import { CreateOperatorRequest, CreateOperatorResponse, DeleteOperatorRequest, DeleteOperatorResponse, GenerateIncludeRequest, GenerateIncludeResponse, GetOperatorByNameRequest, GetOperatorByNameResponse, GetOperatorRequest, GetOperatorResponse, LiftOperatorLockdownRequest, LiftOperatorLockdownResponse, ListOperatorsRequest, ListOperatorsResponse, LockdownOperatorRequest, LockdownOperatorResponse, SetSystemAccountRequest, SetSystemAccountResponse, UpdateOperatorRequest, UpdateOperatorResponse } from "./operator_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -88,6 +88,24 @@ export const OperatorService = {
       O: GenerateIncludeResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc nis.v1.OperatorService.LockdownOperator
+     */
+    lockdownOperator: {
+      name: "LockdownOperator",
+      I: LockdownOperatorRequest,
+      O: LockdownOperatorResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc nis.v1.OperatorService.LiftOperatorLockdown
+     */
+    liftOperatorLockdown: {
+      name: "LiftOperatorLockdown",
+      I: LiftOperatorLockdownRequest,
+      O: LiftOperatorLockdownResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -5,7 +5,7 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
-import { ListOptions } from "./common_pb.js";
+import { AccountPush, ListOptions } from "./common_pb.js";
 
 /**
  * Operator represents a NATS operator
@@ -53,6 +53,37 @@ export class Operator extends Message<Operator> {
    */
   updatedAt?: Timestamp;
 
+  /**
+   * Last emergency lockdown, kept once lifted
+   *
+   * @generated from field: google.protobuf.Timestamp locked_down_at = 9;
+   */
+  lockedDownAt?: Timestamp;
+
+  /**
+   * API user who locked the operator down
+   *
+   * @generated from field: string locked_down_by = 10;
+   */
+  lockedDownBy = "";
+
+  /**
+   * @generated from field: string lockdown_reason = 11;
+   */
+  lockdownReason = "";
+
+  /**
+   * Set once the lockdown is lifted
+   *
+   * @generated from field: google.protobuf.Timestamp lockdown_lifted_at = 12;
+   */
+  lockdownLiftedAt?: Timestamp;
+
+  /**
+   * @generated from field: string lockdown_lifted_by = 13;
+   */
+  lockdownLiftedBy = "";
+
   constructor(data?: PartialMessage<Operator>) {
     super();
     proto3.util.initPartial(data, this);
@@ -69,6 +100,11 @@ export class Operator extends Message<Operator> {
     { no: 6, name: "system_account_pub_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "created_at", kind: "message", T: Timestamp },
     { no: 8, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 9, name: "locked_down_at", kind: "message", T: Timestamp },
+    { no: 10, name: "locked_down_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "lockdown_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "lockdown_lifted_at", kind: "message", T: Timestamp },
+    { no: 13, name: "lockdown_lifted_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Operator {
@@ -736,3 +772,211 @@ export class GenerateIncludeResponse extends Message<GenerateIncludeResponse> {
   }
 }
 
+/**
+ * LockdownOperatorRequest is the request to lock an operator down
+ *
+ * @generated from message nis.v1.LockdownOperatorRequest
+ */
+export class LockdownOperatorRequest extends Message<LockdownOperatorRequest> {
+  /**
+   * @generated from field: string operator_id = 1;
+   */
+  operatorId = "";
+
+  /**
+   * @generated from field: string reason = 2;
+   */
+  reason = "";
+
+  constructor(data?: PartialMessage<LockdownOperatorRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.LockdownOperatorRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operator_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LockdownOperatorRequest {
+    return new LockdownOperatorRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LockdownOperatorRequest {
+    return new LockdownOperatorRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LockdownOperatorRequest {
+    return new LockdownOperatorRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LockdownOperatorRequest | PlainMessage<LockdownOperatorRequest> | undefined, b: LockdownOperatorRequest | PlainMessage<LockdownOperatorRequest> | undefined): boolean {
+    return proto3.util.equals(LockdownOperatorRequest, a, b);
+  }
+}
+
+/**
+ * LockdownOperatorResponse is the response from locking an operator down
+ *
+ * @generated from message nis.v1.LockdownOperatorResponse
+ */
+export class LockdownOperatorResponse extends Message<LockdownOperatorResponse> {
+  /**
+   * @generated from field: nis.v1.Operator operator = 1;
+   */
+  operator?: Operator;
+
+  /**
+   * Accounts whose users were revoked
+   *
+   * @generated from field: repeated string accounts = 2;
+   */
+  accounts: string[] = [];
+
+  /**
+   * Clusters of the operator synced with the re-signed accounts
+   *
+   * @generated from field: repeated nis.v1.AccountPush pushed = 3;
+   */
+  pushed: AccountPush[] = [];
+
+  constructor(data?: PartialMessage<LockdownOperatorResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.LockdownOperatorResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operator", kind: "message", T: Operator },
+    { no: 2, name: "accounts", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "pushed", kind: "message", T: AccountPush, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LockdownOperatorResponse {
+    return new LockdownOperatorResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LockdownOperatorResponse {
+    return new LockdownOperatorResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LockdownOperatorResponse {
+    return new LockdownOperatorResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LockdownOperatorResponse | PlainMessage<LockdownOperatorResponse> | undefined, b: LockdownOperatorResponse | PlainMessage<LockdownOperatorResponse> | undefined): boolean {
+    return proto3.util.equals(LockdownOperatorResponse, a, b);
+  }
+}
+
+/**
+ * LiftOperatorLockdownRequest is the request to lift the lockdown of an operator
+ *
+ * @generated from message nis.v1.LiftOperatorLockdownRequest
+ */
+export class LiftOperatorLockdownRequest extends Message<LiftOperatorLockdownRequest> {
+  /**
+   * @generated from field: string operator_id = 1;
+   */
+  operatorId = "";
+
+  constructor(data?: PartialMessage<LiftOperatorLockdownRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.LiftOperatorLockdownRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operator_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LiftOperatorLockdownRequest {
+    return new LiftOperatorLockdownRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LiftOperatorLockdownRequest {
+    return new LiftOperatorLockdownRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LiftOperatorLockdownRequest {
+    return new LiftOperatorLockdownRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LiftOperatorLockdownRequest | PlainMessage<LiftOperatorLockdownRequest> | undefined, b: LiftOperatorLockdownRequest | PlainMessage<LiftOperatorLockdownRequest> | undefined): boolean {
+    return proto3.util.equals(LiftOperatorLockdownRequest, a, b);
+  }
+}
+
+/**
+ * LiftOperatorLockdownResponse is the response from lifting the lockdown of an operator
+ *
+ * @generated from message nis.v1.LiftOperatorLockdownResponse
+ */
+export class LiftOperatorLockdownResponse extends Message<LiftOperatorLockdownResponse> {
+  /**
+   * @generated from field: nis.v1.Operator operator = 1;
+   */
+  operator?: Operator;
+
+  /**
+   * Accounts whose users were given a new JWT
+   *
+   * @generated from field: repeated string accounts = 2;
+   */
+  accounts: string[] = [];
+
+  /**
+   * @generated from field: int32 reissued_users = 3;
+   */
+  reissuedUsers = 0;
+
+  /**
+   * Suspended accounts whose users were left revoked
+   *
+   * @generated from field: repeated string skipped = 4;
+   */
+  skipped: string[] = [];
+
+  /**
+   * Users left revoked by a revocation of their account
+   *
+   * @generated from field: int32 revoked_users = 5;
+   */
+  revokedUsers = 0;
+
+  constructor(data?: PartialMessage<LiftOperatorLockdownResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.LiftOperatorLockdownResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operator", kind: "message", T: Operator },
+    { no: 2, name: "accounts", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "reissued_users", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "skipped", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "revoked_users", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LiftOperatorLockdownResponse {
+    return new LiftOperatorLockdownResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LiftOperatorLockdownResponse {
+    return new LiftOperatorLockdownResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LiftOperatorLockdownResponse {
+    return new LiftOperatorLockdownResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LiftOperatorLockdownResponse | PlainMessage<LiftOperatorLockdownResponse> | undefined, b: LiftOperatorLockdownResponse | PlainMessage<LiftOperatorLockdownResponse> | undefined): boolean {
+    return proto3.util.equals(LiftOperatorLockdownResponse, a, b);
+  }
+}
+
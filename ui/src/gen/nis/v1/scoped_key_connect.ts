@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateScopedSigningKeyRequest, CreateScopedSigningKeyResponse, DeleteScopedSigningKeyRequest, DeleteScopedSigningKeyResponse, GetScopedSigningKeyByNameRequest, GetScopedSigningKeyByNameResponse, GetScopedSigningKeyRequest, GetScopedSigningKeyResponse, ListScopedSigningKeysRequest, ListScopedSigningKeysResponse, UpdatePermissionsRequest, UpdatePermissionsResponse, UpdateScopedSigningKeyRequest, UpdateScopedSigningKeyResponse } from "./scoped_key_pb.js";
+import { CreateScopedSigningKeyRequest, CreateScopedSigningKeyResponse, DeleteScopedSigningKeyRequest, DeleteScopedSigningKeyResponse, GetScopedSigningKeyByNameRequest, GetScopedSigningKeyByNameResponse, GetScopedSigningKeyRequest, GetScopedSigningKeyResponse, ListScopedSigningKeysRequest, ListScopedSigningKeysResponse, RotateScopedSigningKeyRequest, RotateScopedSigningKeyResponse, UpdatePermissionsRequest, UpdatePermissionsResponse, UpdateScopedSigningKeyRequest, UpdateScopedSigningKeyResponse } from "./scoped_key_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -77,6 +77,17 @@ export const ScopedSigningKeyService = {
       O: DeleteScopedSigningKeyResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * RotateScopedSigningKey streams the progress of the rotation, then its result
+     *
+     * @generated from rpc nis.v1.ScopedSigningKeyService.RotateScopedSigningKey
+     */
+    rotateScopedSigningKey: {
+      name: "RotateScopedSigningKey",
+      I: RotateScopedSigningKeyRequest,
+      O: RotateScopedSigningKeyResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,8 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
-import { ListOptions, ResponsePermission, UserPermissions } from "./common_pb.js";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+import { AccountPush, ListOptions, ResponsePermission, UserPermissions } from "./common_pb.js";
 
 /**
  * ScopedSigningKey represents a scoped signing key for account-level user signing
@@ -58,6 +58,13 @@ export class ScopedSigningKey extends Message<ScopedSigningKey> {
    */
   updatedAt?: Timestamp;
 
+  /**
+   * Public keys replaced by a rotation, trusted until the end of their grace period
+   *
+   * @generated from field: map<string, google.protobuf.Timestamp> retired_keys = 10;
+   */
+  retiredKeys: { [key: string]: Timestamp } = {};
+
   constructor(data?: PartialMessage<ScopedSigningKey>) {
     super();
     proto3.util.initPartial(data, this);
@@ -75,6 +82,7 @@ export class ScopedSigningKey extends Message<ScopedSigningKey> {
     { no: 7, name: "response_permission", kind: "message", T: ResponsePermission },
     { no: 8, name: "created_at", kind: "message", T: Timestamp },
     { no: 9, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 10, name: "retired_keys", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: Timestamp} },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ScopedSigningKey {
@@ -694,3 +702,187 @@ export class DeleteScopedSigningKeyResponse extends Message<DeleteScopedSigningK
   }
 }
 
+/**
+ * RotateScopedSigningKeyRequest is the request to give a scoped signing key a new key pair
+ *
+ * @generated from message nis.v1.RotateScopedSigningKeyRequest
+ */
+export class RotateScopedSigningKeyRequest extends Message<RotateScopedSigningKeyRequest> {
+  /**
+   * @generated from field: string key_id = 1;
+   */
+  keyId = "";
+
+  /**
+   * How long the retired key stays trusted, 0 stops trusting it right away
+   *
+   * @generated from field: int64 grace_period_seconds = 2;
+   */
+  gracePeriodSeconds = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RotateScopedSigningKeyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.RotateScopedSigningKeyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "grace_period_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateScopedSigningKeyRequest {
+    return new RotateScopedSigningKeyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateScopedSigningKeyRequest {
+    return new RotateScopedSigningKeyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateScopedSigningKeyRequest {
+    return new RotateScopedSigningKeyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RotateScopedSigningKeyRequest | PlainMessage<RotateScopedSigningKeyRequest> | undefined, b: RotateScopedSigningKeyRequest | PlainMessage<RotateScopedSigningKeyRequest> | undefined): boolean {
+    return proto3.util.equals(RotateScopedSigningKeyRequest, a, b);
+  }
+}
+
+/**
+ * RotateScopedSigningKeyProgress reports how far the re-signing of the users signed by
+ * a rotated scoped signing key went
+ *
+ * @generated from message nis.v1.RotateScopedSigningKeyProgress
+ */
+export class RotateScopedSigningKeyProgress extends Message<RotateScopedSigningKeyProgress> {
+  /**
+   * @generated from field: int32 reissued_users = 1;
+   */
+  reissuedUsers = 0;
+
+  /**
+   * @generated from field: int32 revoked_users = 2;
+   */
+  revokedUsers = 0;
+
+  /**
+   * @generated from field: int32 users = 3;
+   */
+  users = 0;
+
+  constructor(data?: PartialMessage<RotateScopedSigningKeyProgress>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.RotateScopedSigningKeyProgress";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reissued_users", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "revoked_users", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "users", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateScopedSigningKeyProgress {
+    return new RotateScopedSigningKeyProgress().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateScopedSigningKeyProgress {
+    return new RotateScopedSigningKeyProgress().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateScopedSigningKeyProgress {
+    return new RotateScopedSigningKeyProgress().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RotateScopedSigningKeyProgress | PlainMessage<RotateScopedSigningKeyProgress> | undefined, b: RotateScopedSigningKeyProgress | PlainMessage<RotateScopedSigningKeyProgress> | undefined): boolean {
+    return proto3.util.equals(RotateScopedSigningKeyProgress, a, b);
+  }
+}
+
+/**
+ * RotateScopedSigningKeyResponse is a message of a scoped signing key rotation stream.
+ * Messages with progress set are sent while the users are re-signed, the last message
+ * carries the result.
+ *
+ * @generated from message nis.v1.RotateScopedSigningKeyResponse
+ */
+export class RotateScopedSigningKeyResponse extends Message<RotateScopedSigningKeyResponse> {
+  /**
+   * @generated from field: nis.v1.ScopedSigningKey key = 1;
+   */
+  key?: ScopedSigningKey;
+
+  /**
+   * @generated from field: string retired_public_key = 2;
+   */
+  retiredPublicKey = "";
+
+  /**
+   * When the account JWT stops trusting the retired key
+   *
+   * @generated from field: google.protobuf.Timestamp retire_at = 3;
+   */
+  retireAt?: Timestamp;
+
+  /**
+   * Users signed by the key that were given a JWT signed by the new key
+   *
+   * @generated from field: int32 reissued_users = 4;
+   */
+  reissuedUsers = 0;
+
+  /**
+   * Clusters the account JWT trusting the new key was pushed to
+   *
+   * @generated from field: repeated nis.v1.AccountPush pushed = 5;
+   */
+  pushed: AccountPush[] = [];
+
+  /**
+   * Users signed by the key but revoked by their account, left revoked
+   *
+   * @generated from field: int32 revoked_users = 6;
+   */
+  revokedUsers = 0;
+
+  /**
+   * @generated from field: nis.v1.RotateScopedSigningKeyProgress progress = 7;
+   */
+  progress?: RotateScopedSigningKeyProgress;
+
+  constructor(data?: PartialMessage<RotateScopedSigningKeyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.RotateScopedSigningKeyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "message", T: ScopedSigningKey },
+    { no: 2, name: "retired_public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "retire_at", kind: "message", T: Timestamp },
+    { no: 4, name: "reissued_users", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "pushed", kind: "message", T: AccountPush, repeated: true },
+    { no: 6, name: "revoked_users", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "progress", kind: "message", T: RotateScopedSigningKeyProgress },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateScopedSigningKeyResponse {
+    return new RotateScopedSigningKeyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateScopedSigningKeyResponse {
+    return new RotateScopedSigningKeyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateScopedSigningKeyResponse {
+    return new RotateScopedSigningKeyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RotateScopedSigningKeyResponse | PlainMessage<RotateScopedSigningKeyResponse> | undefined, b: RotateScopedSigningKeyResponse | PlainMessage<RotateScopedSigningKeyResponse> | undefined): boolean {
+    return proto3.util.equals(RotateScopedSigningKeyResponse, a, b);
+  }
+}
+
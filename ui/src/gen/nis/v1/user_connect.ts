@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { CreateUserRequest, CreateUserResponse, DeleteUserRequest, DeleteUserResponse, GetUserByNameRequest, GetUserByNameResponse, GetUserCredentialsRequest, GetUserCredentialsResponse, GetUserRequest, GetUserResponse, ListUsersRequest, ListUsersResponse, UpdateUserRequest, UpdateUserResponse } from "./user_pb.js";
+import { CreateRotationPolicyRequest, CreateRotationPolicyResponse, CreateUserRequest, CreateUserResponse, DeleteRotationPolicyRequest, DeleteRotationPolicyResponse, DeleteUserRequest, DeleteUserResponse, GetUserByNameRequest, GetUserByNameResponse, GetUserCredentialsRequest, GetUserCredentialsResponse, GetUserRequest, GetUserResponse, ListRotationPoliciesRequest, ListRotationPoliciesResponse, ListUserKeysRequest, ListUserKeysResponse, ListUsersRequest, ListUsersResponse, PlanRotationsRequest, PlanRotationsResponse, RotateUserCredentialsRequest, RotateUserCredentialsResponse, UpdateUserRequest, UpdateUserResponse } from "./user_pb.js";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -77,6 +77,60 @@ export const UserService = {
       O: GetUserCredentialsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc nis.v1.UserService.RotateUserCredentials
+     */
+    rotateUserCredentials: {
+      name: "RotateUserCredentials",
+      I: RotateUserCredentialsRequest,
+      O: RotateUserCredentialsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc nis.v1.UserService.ListUserKeys
+     */
+    listUserKeys: {
+      name: "ListUserKeys",
+      I: ListUserKeysRequest,
+      O: ListUserKeysResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc nis.v1.UserService.CreateRotationPolicy
+     */
+    createRotationPolicy: {
+      name: "CreateRotationPolicy",
+      I: CreateRotationPolicyRequest,
+      O: CreateRotationPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc nis.v1.UserService.ListRotationPolicies
+     */
+    listRotationPolicies: {
+      name: "ListRotationPolicies",
+      I: ListRotationPoliciesRequest,
+      O: ListRotationPoliciesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc nis.v1.UserService.DeleteRotationPolicy
+     */
+    deleteRotationPolicy: {
+      name: "DeleteRotationPolicy",
+      I: DeleteRotationPolicyRequest,
+      O: DeleteRotationPolicyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc nis.v1.UserService.PlanRotations
+     */
+    planRotations: {
+      name: "PlanRotations",
+      I: PlanRotationsRequest,
+      O: PlanRotationsResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,8 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, Timestamp } from "@bufbuild/protobuf";
-import { ListOptions } from "./common_pb.js";
+import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+import { AccountPush, ListOptions } from "./common_pb.js";
 
 /**
  * User represents a NATS user
@@ -58,6 +58,28 @@ export class User extends Message<User> {
    */
   updatedAt?: Timestamp;
 
+  /**
+   * Client activity recorded from the clusters' connect and disconnect events
+   *
+   * @generated from field: google.protobuf.Timestamp last_connected_at = 10;
+   */
+  lastConnectedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_seen_at = 11;
+   */
+  lastSeenAt?: Timestamp;
+
+  /**
+   * @generated from field: string last_remote_ip = 12;
+   */
+  lastRemoteIp = "";
+
+  /**
+   * @generated from field: int64 connection_count = 13;
+   */
+  connectionCount = protoInt64.zero;
+
   constructor(data?: PartialMessage<User>) {
     super();
     proto3.util.initPartial(data, this);
@@ -75,6 +97,10 @@ export class User extends Message<User> {
     { no: 7, name: "scoped_signing_key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "created_at", kind: "message", T: Timestamp },
     { no: 9, name: "updated_at", kind: "message", T: Timestamp },
+    { no: 10, name: "last_connected_at", kind: "message", T: Timestamp },
+    { no: 11, name: "last_seen_at", kind: "message", T: Timestamp },
+    { no: 12, name: "last_remote_ip", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "connection_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): User {
@@ -368,6 +394,13 @@ export class ListUsersRequest extends Message<ListUsersRequest> {
    */
   options?: ListOptions;
 
+  /**
+   * When positive, only list the users not seen on any cluster for that many days
+   *
+   * @generated from field: int32 stale_days = 3;
+   */
+  staleDays = 0;
+
   constructor(data?: PartialMessage<ListUsersRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -378,6 +411,7 @@ export class ListUsersRequest extends Message<ListUsersRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "options", kind: "message", T: ListOptions },
+    { no: 3, name: "stale_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUsersRequest {
@@ -676,3 +710,792 @@ export class GetUserCredentialsResponse extends Message<GetUserCredentialsRespon
   }
 }
 
+/**
+ * UserKey is a key a user held before a credential rotation
+ *
+ * @generated from message nis.v1.UserKey
+ */
+export class UserKey extends Message<UserKey> {
+  /**
+   * @generated from field: string public_key = 1;
+   */
+  publicKey = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 2;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp retired_at = 3;
+   */
+  retiredAt?: Timestamp;
+
+  /**
+   * End of the grace period, when the key is revoked
+   *
+   * @generated from field: google.protobuf.Timestamp revoke_at = 4;
+   */
+  revokeAt?: Timestamp;
+
+  /**
+   * Set once the account JWT revokes the key
+   *
+   * @generated from field: google.protobuf.Timestamp revoked_at = 5;
+   */
+  revokedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<UserKey>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.UserKey";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "created_at", kind: "message", T: Timestamp },
+    { no: 3, name: "retired_at", kind: "message", T: Timestamp },
+    { no: 4, name: "revoke_at", kind: "message", T: Timestamp },
+    { no: 5, name: "revoked_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UserKey {
+    return new UserKey().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UserKey {
+    return new UserKey().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UserKey {
+    return new UserKey().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UserKey | PlainMessage<UserKey> | undefined, b: UserKey | PlainMessage<UserKey> | undefined): boolean {
+    return proto3.util.equals(UserKey, a, b);
+  }
+}
+
+/**
+ * RotateUserCredentialsRequest is the request to rotate the credentials of a user
+ *
+ * @generated from message nis.v1.RotateUserCredentialsRequest
+ */
+export class RotateUserCredentialsRequest extends Message<RotateUserCredentialsRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId = "";
+
+  /**
+   * How long the retired key keeps working, 0 revokes it right away
+   *
+   * @generated from field: int64 grace_period_seconds = 2;
+   */
+  gracePeriodSeconds = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RotateUserCredentialsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.RotateUserCredentialsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "grace_period_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateUserCredentialsRequest {
+    return new RotateUserCredentialsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateUserCredentialsRequest {
+    return new RotateUserCredentialsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateUserCredentialsRequest {
+    return new RotateUserCredentialsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RotateUserCredentialsRequest | PlainMessage<RotateUserCredentialsRequest> | undefined, b: RotateUserCredentialsRequest | PlainMessage<RotateUserCredentialsRequest> | undefined): boolean {
+    return proto3.util.equals(RotateUserCredentialsRequest, a, b);
+  }
+}
+
+/**
+ * RotateUserCredentialsResponse is the response from rotating the credentials of a user
+ *
+ * @generated from message nis.v1.RotateUserCredentialsResponse
+ */
+export class RotateUserCredentialsResponse extends Message<RotateUserCredentialsResponse> {
+  /**
+   * @generated from field: nis.v1.User user = 1;
+   */
+  user?: User;
+
+  /**
+   * @generated from field: nis.v1.UserKey retired_key = 2;
+   */
+  retiredKey?: UserKey;
+
+  /**
+   * Clusters the account JWT revoking the retired key was pushed to, without grace period
+   *
+   * @generated from field: repeated nis.v1.AccountPush pushed = 3;
+   */
+  pushed: AccountPush[] = [];
+
+  constructor(data?: PartialMessage<RotateUserCredentialsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.RotateUserCredentialsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user", kind: "message", T: User },
+    { no: 2, name: "retired_key", kind: "message", T: UserKey },
+    { no: 3, name: "pushed", kind: "message", T: AccountPush, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateUserCredentialsResponse {
+    return new RotateUserCredentialsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateUserCredentialsResponse {
+    return new RotateUserCredentialsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateUserCredentialsResponse {
+    return new RotateUserCredentialsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RotateUserCredentialsResponse | PlainMessage<RotateUserCredentialsResponse> | undefined, b: RotateUserCredentialsResponse | PlainMessage<RotateUserCredentialsResponse> | undefined): boolean {
+    return proto3.util.equals(RotateUserCredentialsResponse, a, b);
+  }
+}
+
+/**
+ * ListUserKeysRequest is the request to list the keys a user held before its rotations
+ *
+ * @generated from message nis.v1.ListUserKeysRequest
+ */
+export class ListUserKeysRequest extends Message<ListUserKeysRequest> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId = "";
+
+  constructor(data?: PartialMessage<ListUserKeysRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListUserKeysRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUserKeysRequest {
+    return new ListUserKeysRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUserKeysRequest {
+    return new ListUserKeysRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUserKeysRequest {
+    return new ListUserKeysRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUserKeysRequest | PlainMessage<ListUserKeysRequest> | undefined, b: ListUserKeysRequest | PlainMessage<ListUserKeysRequest> | undefined): boolean {
+    return proto3.util.equals(ListUserKeysRequest, a, b);
+  }
+}
+
+/**
+ * ListUserKeysResponse is the response from listing the keys of a user, the latest first
+ *
+ * @generated from message nis.v1.ListUserKeysResponse
+ */
+export class ListUserKeysResponse extends Message<ListUserKeysResponse> {
+  /**
+   * @generated from field: repeated nis.v1.UserKey keys = 1;
+   */
+  keys: UserKey[] = [];
+
+  constructor(data?: PartialMessage<ListUserKeysResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListUserKeysResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "keys", kind: "message", T: UserKey, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListUserKeysResponse {
+    return new ListUserKeysResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListUserKeysResponse {
+    return new ListUserKeysResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListUserKeysResponse {
+    return new ListUserKeysResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListUserKeysResponse | PlainMessage<ListUserKeysResponse> | undefined, b: ListUserKeysResponse | PlainMessage<ListUserKeysResponse> | undefined): boolean {
+    return proto3.util.equals(ListUserKeysResponse, a, b);
+  }
+}
+
+/**
+ * RotationPolicy rotates the credentials of the users of an operator, an account or
+ * a scoped signing key on a schedule
+ *
+ * @generated from message nis.v1.RotationPolicy
+ */
+export class RotationPolicy extends Message<RotationPolicy> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string operator_id = 2;
+   */
+  operatorId = "";
+
+  /**
+   * Set for account and scoped signing key policies
+   *
+   * @generated from field: string account_id = 3;
+   */
+  accountId = "";
+
+  /**
+   * Set for scoped signing key policies
+   *
+   * @generated from field: string scoped_signing_key_id = 4;
+   */
+  scopedSigningKeyId = "";
+
+  /**
+   * operator, account or scoped_signing_key
+   *
+   * @generated from field: string scope = 5;
+   */
+  scope = "";
+
+  /**
+   * @generated from field: int64 interval_seconds = 6;
+   */
+  intervalSeconds = protoInt64.zero;
+
+  /**
+   * How long the retired keys keep working
+   *
+   * @generated from field: int64 grace_period_seconds = 7;
+   */
+  gracePeriodSeconds = protoInt64.zero;
+
+  /**
+   * @generated from field: string created_by = 8;
+   */
+  createdBy = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 9;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 10;
+   */
+  updatedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<RotationPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.RotationPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "operator_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "scoped_signing_key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "scope", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "interval_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "grace_period_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "created_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "created_at", kind: "message", T: Timestamp },
+    { no: 10, name: "updated_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotationPolicy {
+    return new RotationPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotationPolicy {
+    return new RotationPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotationPolicy {
+    return new RotationPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RotationPolicy | PlainMessage<RotationPolicy> | undefined, b: RotationPolicy | PlainMessage<RotationPolicy> | undefined): boolean {
+    return proto3.util.equals(RotationPolicy, a, b);
+  }
+}
+
+/**
+ * CreateRotationPolicyRequest is the request to schedule credential rotations. Set
+ * scoped_signing_key_id for the users signed by a scoped key, account_id for the
+ * users of an account, or neither for every user of the operator.
+ *
+ * @generated from message nis.v1.CreateRotationPolicyRequest
+ */
+export class CreateRotationPolicyRequest extends Message<CreateRotationPolicyRequest> {
+  /**
+   * @generated from field: string operator_id = 1;
+   */
+  operatorId = "";
+
+  /**
+   * @generated from field: string account_id = 2;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string scoped_signing_key_id = 3;
+   */
+  scopedSigningKeyId = "";
+
+  /**
+   * @generated from field: int64 interval_seconds = 4;
+   */
+  intervalSeconds = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 grace_period_seconds = 5;
+   */
+  gracePeriodSeconds = protoInt64.zero;
+
+  constructor(data?: PartialMessage<CreateRotationPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.CreateRotationPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operator_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "scoped_signing_key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "interval_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "grace_period_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRotationPolicyRequest {
+    return new CreateRotationPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRotationPolicyRequest {
+    return new CreateRotationPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRotationPolicyRequest {
+    return new CreateRotationPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateRotationPolicyRequest | PlainMessage<CreateRotationPolicyRequest> | undefined, b: CreateRotationPolicyRequest | PlainMessage<CreateRotationPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(CreateRotationPolicyRequest, a, b);
+  }
+}
+
+/**
+ * CreateRotationPolicyResponse is the response from creating a rotation policy
+ *
+ * @generated from message nis.v1.CreateRotationPolicyResponse
+ */
+export class CreateRotationPolicyResponse extends Message<CreateRotationPolicyResponse> {
+  /**
+   * @generated from field: nis.v1.RotationPolicy policy = 1;
+   */
+  policy?: RotationPolicy;
+
+  constructor(data?: PartialMessage<CreateRotationPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.CreateRotationPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policy", kind: "message", T: RotationPolicy },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRotationPolicyResponse {
+    return new CreateRotationPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRotationPolicyResponse {
+    return new CreateRotationPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRotationPolicyResponse {
+    return new CreateRotationPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateRotationPolicyResponse | PlainMessage<CreateRotationPolicyResponse> | undefined, b: CreateRotationPolicyResponse | PlainMessage<CreateRotationPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(CreateRotationPolicyResponse, a, b);
+  }
+}
+
+/**
+ * ListRotationPoliciesRequest is the request to list rotation policies
+ *
+ * @generated from message nis.v1.ListRotationPoliciesRequest
+ */
+export class ListRotationPoliciesRequest extends Message<ListRotationPoliciesRequest> {
+  /**
+   * Restricts the list to an operator when set
+   *
+   * @generated from field: string operator_id = 1;
+   */
+  operatorId = "";
+
+  constructor(data?: PartialMessage<ListRotationPoliciesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListRotationPoliciesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operator_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRotationPoliciesRequest {
+    return new ListRotationPoliciesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRotationPoliciesRequest {
+    return new ListRotationPoliciesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRotationPoliciesRequest {
+    return new ListRotationPoliciesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRotationPoliciesRequest | PlainMessage<ListRotationPoliciesRequest> | undefined, b: ListRotationPoliciesRequest | PlainMessage<ListRotationPoliciesRequest> | undefined): boolean {
+    return proto3.util.equals(ListRotationPoliciesRequest, a, b);
+  }
+}
+
+/**
+ * ListRotationPoliciesResponse is the response from listing rotation policies
+ *
+ * @generated from message nis.v1.ListRotationPoliciesResponse
+ */
+export class ListRotationPoliciesResponse extends Message<ListRotationPoliciesResponse> {
+  /**
+   * @generated from field: repeated nis.v1.RotationPolicy policies = 1;
+   */
+  policies: RotationPolicy[] = [];
+
+  constructor(data?: PartialMessage<ListRotationPoliciesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.ListRotationPoliciesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "policies", kind: "message", T: RotationPolicy, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRotationPoliciesResponse {
+    return new ListRotationPoliciesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRotationPoliciesResponse {
+    return new ListRotationPoliciesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRotationPoliciesResponse {
+    return new ListRotationPoliciesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListRotationPoliciesResponse | PlainMessage<ListRotationPoliciesResponse> | undefined, b: ListRotationPoliciesResponse | PlainMessage<ListRotationPoliciesResponse> | undefined): boolean {
+    return proto3.util.equals(ListRotationPoliciesResponse, a, b);
+  }
+}
+
+/**
+ * DeleteRotationPolicyRequest is the request to delete a rotation policy
+ *
+ * @generated from message nis.v1.DeleteRotationPolicyRequest
+ */
+export class DeleteRotationPolicyRequest extends Message<DeleteRotationPolicyRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<DeleteRotationPolicyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.DeleteRotationPolicyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRotationPolicyRequest {
+    return new DeleteRotationPolicyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRotationPolicyRequest {
+    return new DeleteRotationPolicyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRotationPolicyRequest {
+    return new DeleteRotationPolicyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRotationPolicyRequest | PlainMessage<DeleteRotationPolicyRequest> | undefined, b: DeleteRotationPolicyRequest | PlainMessage<DeleteRotationPolicyRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteRotationPolicyRequest, a, b);
+  }
+}
+
+/**
+ * DeleteRotationPolicyResponse is the response from deleting a rotation policy
+ *
+ * @generated from message nis.v1.DeleteRotationPolicyResponse
+ */
+export class DeleteRotationPolicyResponse extends Message<DeleteRotationPolicyResponse> {
+  constructor(data?: PartialMessage<DeleteRotationPolicyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.DeleteRotationPolicyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRotationPolicyResponse {
+    return new DeleteRotationPolicyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRotationPolicyResponse {
+    return new DeleteRotationPolicyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRotationPolicyResponse {
+    return new DeleteRotationPolicyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRotationPolicyResponse | PlainMessage<DeleteRotationPolicyResponse> | undefined, b: DeleteRotationPolicyResponse | PlainMessage<DeleteRotationPolicyResponse> | undefined): boolean {
+    return proto3.util.equals(DeleteRotationPolicyResponse, a, b);
+  }
+}
+
+/**
+ * PlannedRotation is an upcoming scheduled rotation of a user's credentials
+ *
+ * @generated from message nis.v1.PlannedRotation
+ */
+export class PlannedRotation extends Message<PlannedRotation> {
+  /**
+   * @generated from field: string user_id = 1;
+   */
+  userId = "";
+
+  /**
+   * @generated from field: string user_name = 2;
+   */
+  userName = "";
+
+  /**
+   * @generated from field: string account_id = 3;
+   */
+  accountId = "";
+
+  /**
+   * @generated from field: string account_name = 4;
+   */
+  accountName = "";
+
+  /**
+   * @generated from field: string operator_id = 5;
+   */
+  operatorId = "";
+
+  /**
+   * @generated from field: string operator_name = 6;
+   */
+  operatorName = "";
+
+  /**
+   * Most specific policy covering the user
+   *
+   * @generated from field: string policy_id = 7;
+   */
+  policyId = "";
+
+  /**
+   * @generated from field: string scope = 8;
+   */
+  scope = "";
+
+  /**
+   * When the user's current key was issued
+   *
+   * @generated from field: google.protobuf.Timestamp last_rotated_at = 9;
+   */
+  lastRotatedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp due_at = 10;
+   */
+  dueAt?: Timestamp;
+
+  constructor(data?: PartialMessage<PlannedRotation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.PlannedRotation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "user_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "account_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "operator_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "operator_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "policy_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "scope", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "last_rotated_at", kind: "message", T: Timestamp },
+    { no: 10, name: "due_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlannedRotation {
+    return new PlannedRotation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlannedRotation {
+    return new PlannedRotation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlannedRotation {
+    return new PlannedRotation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlannedRotation | PlainMessage<PlannedRotation> | undefined, b: PlannedRotation | PlainMessage<PlannedRotation> | undefined): boolean {
+    return proto3.util.equals(PlannedRotation, a, b);
+  }
+}
+
+/**
+ * PlanRotationsRequest is the request to list the upcoming scheduled rotations
+ *
+ * @generated from message nis.v1.PlanRotationsRequest
+ */
+export class PlanRotationsRequest extends Message<PlanRotationsRequest> {
+  /**
+   * Restricts the plan to an operator when set
+   *
+   * @generated from field: string operator_id = 1;
+   */
+  operatorId = "";
+
+  /**
+   * Lists the rotations due within this duration, 0 lists them all
+   *
+   * @generated from field: int64 within_seconds = 2;
+   */
+  withinSeconds = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PlanRotationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.PlanRotationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operator_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "within_seconds", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanRotationsRequest {
+    return new PlanRotationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanRotationsRequest {
+    return new PlanRotationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanRotationsRequest {
+    return new PlanRotationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanRotationsRequest | PlainMessage<PlanRotationsRequest> | undefined, b: PlanRotationsRequest | PlainMessage<PlanRotationsRequest> | undefined): boolean {
+    return proto3.util.equals(PlanRotationsRequest, a, b);
+  }
+}
+
+/**
+ * PlanRotationsResponse is the response from planning rotations, the earliest first.
+ * Overdue rotations are included.
+ *
+ * @generated from message nis.v1.PlanRotationsResponse
+ */
+export class PlanRotationsResponse extends Message<PlanRotationsResponse> {
+  /**
+   * @generated from field: repeated nis.v1.PlannedRotation rotations = 1;
+   */
+  rotations: PlannedRotation[] = [];
+
+  constructor(data?: PartialMessage<PlanRotationsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "nis.v1.PlanRotationsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rotations", kind: "message", T: PlannedRotation, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanRotationsResponse {
+    return new PlanRotationsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanRotationsResponse {
+    return new PlanRotationsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanRotationsResponse {
+    return new PlanRotationsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanRotationsResponse | PlainMessage<PlanRotationsResponse> | undefined, b: PlanRotationsResponse | PlainMessage<PlanRotationsResponse> | undefined): boolean {
+    return proto3.util.equals(PlanRotationsResponse, a, b);
+  }
+}
+